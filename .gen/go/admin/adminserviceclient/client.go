@@ -0,0 +1,755 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by thriftrw-plugin-yarpc
+// @generated
+
+package adminserviceclient
+
+import (
+	"context"
+	"github.com/uber/cadence/.gen/go/admin"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/encoding/thrift"
+	"reflect"
+)
+
+// Interface is a client for the AdminService service.
+type Interface interface {
+	BulkDeleteWorkflowExecutions(
+		ctx context.Context,
+		Request *admin.BulkDeleteWorkflowExecutionsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.BulkDeleteWorkflowExecutionsResponse, error)
+
+	DescribeBulkDeleteWorkflowExecutions(
+		ctx context.Context,
+		Request *admin.DescribeBulkDeleteWorkflowExecutionsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeBulkDeleteWorkflowExecutionsResponse, error)
+
+	DescribeHistoryHost(
+		ctx context.Context,
+		Request *admin.DescribeHistoryHostRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeHistoryHostResponse, error)
+
+	DescribeMaintenanceMode(
+		ctx context.Context,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeMaintenanceModeResponse, error)
+
+	DescribeShardDistribution(
+		ctx context.Context,
+		Request *admin.DescribeShardDistributionRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeShardDistributionResponse, error)
+
+	DrainHistoryHost(
+		ctx context.Context,
+		Request *admin.DrainHistoryHostRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DrainHistoryHostResponse, error)
+
+	GetCurrentExecution(
+		ctx context.Context,
+		Request *admin.GetCurrentExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GetCurrentExecutionResponse, error)
+
+	GetDomainStats(
+		ctx context.Context,
+		Request *admin.GetDomainStatsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GetDomainStatsResponse, error)
+
+	GetWorkflowExecutionHistoryEvent(
+		ctx context.Context,
+		Request *admin.GetWorkflowExecutionHistoryEventRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GetWorkflowExecutionHistoryEventResponse, error)
+
+	ImportWorkflowExecution(
+		ctx context.Context,
+		Request *admin.ImportWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ListAuditRecords(
+		ctx context.Context,
+		Request *admin.ListAuditRecordsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListAuditRecordsResponse, error)
+
+	ListWorkflowExecutionChain(
+		ctx context.Context,
+		Request *admin.ListWorkflowExecutionChainRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListWorkflowExecutionChainResponse, error)
+
+	NukeWorkflowExecution(
+		ctx context.Context,
+		Request *admin.NukeWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.NukeWorkflowExecutionResponse, error)
+
+	PauseTaskList(
+		ctx context.Context,
+		Request *admin.PauseTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		Request *admin.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RefreshDomainCache(
+		ctx context.Context,
+		Request *admin.RefreshDomainCacheRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ResetShardPlacement(
+		ctx context.Context,
+		Request *admin.ResetShardPlacementRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		Request *admin.ResumeTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		Request *admin.ResumeWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ResyncDomains(
+		ctx context.Context,
+		Request *admin.ResyncDomainsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ResyncDomainsResponse, error)
+
+	TerminateWorkflowExecution(
+		ctx context.Context,
+		Request *admin.TerminateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateDomainRetention(
+		ctx context.Context,
+		Request *admin.UpdateDomainRetentionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateMaintenanceMode(
+		ctx context.Context,
+		Request *admin.UpdateMaintenanceModeRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	WarmHistoryHost(
+		ctx context.Context,
+		Request *admin.WarmHistoryHostRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.WarmHistoryHostResponse, error)
+}
+
+// New builds a new client for the AdminService service.
+//
+// 	client := adminserviceclient.New(dispatcher.ClientConfig("adminservice"))
+func New(c transport.ClientConfig, opts ...thrift.ClientOption) Interface {
+	return client{
+		c: thrift.New(thrift.Config{
+			Service:      "AdminService",
+			ClientConfig: c,
+		}, opts...),
+	}
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(c transport.ClientConfig, f reflect.StructField) Interface {
+			return New(c, thrift.ClientBuilderOptions(c, f)...)
+		},
+	)
+}
+
+type client struct {
+	c thrift.Client
+}
+
+func (c client) BulkDeleteWorkflowExecutions(
+	ctx context.Context,
+	_Request *admin.BulkDeleteWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.BulkDeleteWorkflowExecutionsResponse, err error) {
+
+	args := admin.AdminService_BulkDeleteWorkflowExecutions_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_BulkDeleteWorkflowExecutions_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_BulkDeleteWorkflowExecutions_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeBulkDeleteWorkflowExecutions(
+	ctx context.Context,
+	_Request *admin.DescribeBulkDeleteWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeBulkDeleteWorkflowExecutionsResponse, err error) {
+
+	args := admin.AdminService_DescribeBulkDeleteWorkflowExecutions_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeBulkDeleteWorkflowExecutions_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeBulkDeleteWorkflowExecutions_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeHistoryHost(
+	ctx context.Context,
+	_Request *admin.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeHistoryHostResponse, err error) {
+
+	args := admin.AdminService_DescribeHistoryHost_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeHistoryHost_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeHistoryHost_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeMaintenanceMode(
+	ctx context.Context,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeMaintenanceModeResponse, err error) {
+
+	args := admin.AdminService_DescribeMaintenanceMode_Helper.Args()
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeMaintenanceMode_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeMaintenanceMode_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeShardDistribution(
+	ctx context.Context,
+	_Request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeShardDistributionResponse, err error) {
+
+	args := admin.AdminService_DescribeShardDistribution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeShardDistribution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeShardDistribution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DrainHistoryHost(
+	ctx context.Context,
+	_Request *admin.DrainHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DrainHistoryHostResponse, err error) {
+
+	args := admin.AdminService_DrainHistoryHost_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DrainHistoryHost_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DrainHistoryHost_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetCurrentExecution(
+	ctx context.Context,
+	_Request *admin.GetCurrentExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetCurrentExecutionResponse, err error) {
+
+	args := admin.AdminService_GetCurrentExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_GetCurrentExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_GetCurrentExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetDomainStats(
+	ctx context.Context,
+	_Request *admin.GetDomainStatsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetDomainStatsResponse, err error) {
+
+	args := admin.AdminService_GetDomainStats_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_GetDomainStats_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_GetDomainStats_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetWorkflowExecutionHistoryEvent(
+	ctx context.Context,
+	_Request *admin.GetWorkflowExecutionHistoryEventRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetWorkflowExecutionHistoryEventResponse, err error) {
+
+	args := admin.AdminService_GetWorkflowExecutionHistoryEvent_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_GetWorkflowExecutionHistoryEvent_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_GetWorkflowExecutionHistoryEvent_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ImportWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ImportWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ImportWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ImportWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListAuditRecords(
+	ctx context.Context,
+	_Request *admin.ListAuditRecordsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListAuditRecordsResponse, err error) {
+
+	args := admin.AdminService_ListAuditRecords_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListAuditRecords_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListAuditRecords_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListWorkflowExecutionChain(
+	ctx context.Context,
+	_Request *admin.ListWorkflowExecutionChainRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListWorkflowExecutionChainResponse, err error) {
+
+	args := admin.AdminService_ListWorkflowExecutionChain_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListWorkflowExecutionChain_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListWorkflowExecutionChain_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) NukeWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.NukeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.NukeWorkflowExecutionResponse, err error) {
+
+	args := admin.AdminService_NukeWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_NukeWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_NukeWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseTaskList(
+	ctx context.Context,
+	_Request *admin.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PauseTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PauseTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PauseTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PauseWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RefreshDomainCache(
+	ctx context.Context,
+	_Request *admin.RefreshDomainCacheRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RefreshDomainCache_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RefreshDomainCache_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RefreshDomainCache_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ResetShardPlacement(
+	ctx context.Context,
+	_Request *admin.ResetShardPlacementRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ResetShardPlacement_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ResetShardPlacement_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ResetShardPlacement_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ResumeTaskList(
+	ctx context.Context,
+	_Request *admin.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ResumeTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ResumeTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ResumeTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ResumeWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ResumeWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ResumeWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ResumeWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ResyncDomains(
+	ctx context.Context,
+	_Request *admin.ResyncDomainsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ResyncDomainsResponse, err error) {
+
+	args := admin.AdminService_ResyncDomains_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ResyncDomains_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ResyncDomains_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) TerminateWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.TerminateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_TerminateWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_TerminateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateDomainRetention(
+	ctx context.Context,
+	_Request *admin.UpdateDomainRetentionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_UpdateDomainRetention_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UpdateDomainRetention_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_UpdateDomainRetention_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateMaintenanceMode(
+	ctx context.Context,
+	_Request *admin.UpdateMaintenanceModeRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_UpdateMaintenanceMode_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UpdateMaintenanceMode_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_UpdateMaintenanceMode_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) WarmHistoryHost(
+	ctx context.Context,
+	_Request *admin.WarmHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.WarmHistoryHostResponse, err error) {
+
+	args := admin.AdminService_WarmHistoryHost_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_WarmHistoryHost_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_WarmHistoryHost_Helper.UnwrapResponse(&result)
+	return
+}
@@ -0,0 +1,894 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by thriftrw-plugin-yarpc
+// @generated
+
+package adminserviceserver
+
+import (
+	"context"
+	"github.com/uber/cadence/.gen/go/admin"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/encoding/thrift"
+)
+
+// Interface is the server-side interface for the AdminService service.
+type Interface interface {
+	BulkDeleteWorkflowExecutions(
+		ctx context.Context,
+		Request *admin.BulkDeleteWorkflowExecutionsRequest,
+	) (*admin.BulkDeleteWorkflowExecutionsResponse, error)
+
+	DescribeBulkDeleteWorkflowExecutions(
+		ctx context.Context,
+		Request *admin.DescribeBulkDeleteWorkflowExecutionsRequest,
+	) (*admin.DescribeBulkDeleteWorkflowExecutionsResponse, error)
+
+	DescribeHistoryHost(
+		ctx context.Context,
+		Request *admin.DescribeHistoryHostRequest,
+	) (*admin.DescribeHistoryHostResponse, error)
+
+	DescribeMaintenanceMode(
+		ctx context.Context,
+	) (*admin.DescribeMaintenanceModeResponse, error)
+
+	DescribeShardDistribution(
+		ctx context.Context,
+		Request *admin.DescribeShardDistributionRequest,
+	) (*admin.DescribeShardDistributionResponse, error)
+
+	DrainHistoryHost(
+		ctx context.Context,
+		Request *admin.DrainHistoryHostRequest,
+	) (*admin.DrainHistoryHostResponse, error)
+
+	GetCurrentExecution(
+		ctx context.Context,
+		Request *admin.GetCurrentExecutionRequest,
+	) (*admin.GetCurrentExecutionResponse, error)
+
+	GetDomainStats(
+		ctx context.Context,
+		Request *admin.GetDomainStatsRequest,
+	) (*admin.GetDomainStatsResponse, error)
+
+	GetWorkflowExecutionHistoryEvent(
+		ctx context.Context,
+		Request *admin.GetWorkflowExecutionHistoryEventRequest,
+	) (*admin.GetWorkflowExecutionHistoryEventResponse, error)
+
+	ImportWorkflowExecution(
+		ctx context.Context,
+		Request *admin.ImportWorkflowExecutionRequest,
+	) error
+
+	ListAuditRecords(
+		ctx context.Context,
+		Request *admin.ListAuditRecordsRequest,
+	) (*admin.ListAuditRecordsResponse, error)
+
+	ListWorkflowExecutionChain(
+		ctx context.Context,
+		Request *admin.ListWorkflowExecutionChainRequest,
+	) (*admin.ListWorkflowExecutionChainResponse, error)
+
+	NukeWorkflowExecution(
+		ctx context.Context,
+		Request *admin.NukeWorkflowExecutionRequest,
+	) (*admin.NukeWorkflowExecutionResponse, error)
+
+	PauseTaskList(
+		ctx context.Context,
+		Request *admin.PauseTaskListRequest,
+	) error
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		Request *admin.PauseWorkflowExecutionRequest,
+	) error
+
+	RefreshDomainCache(
+		ctx context.Context,
+		Request *admin.RefreshDomainCacheRequest,
+	) error
+
+	ResetShardPlacement(
+		ctx context.Context,
+		Request *admin.ResetShardPlacementRequest,
+	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		Request *admin.ResumeTaskListRequest,
+	) error
+
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		Request *admin.ResumeWorkflowExecutionRequest,
+	) error
+
+	ResyncDomains(
+		ctx context.Context,
+		Request *admin.ResyncDomainsRequest,
+	) (*admin.ResyncDomainsResponse, error)
+
+	TerminateWorkflowExecution(
+		ctx context.Context,
+		Request *admin.TerminateWorkflowExecutionRequest,
+	) error
+
+	UpdateDomainRetention(
+		ctx context.Context,
+		Request *admin.UpdateDomainRetentionRequest,
+	) error
+
+	UpdateMaintenanceMode(
+		ctx context.Context,
+		Request *admin.UpdateMaintenanceModeRequest,
+	) error
+
+	WarmHistoryHost(
+		ctx context.Context,
+		Request *admin.WarmHistoryHostRequest,
+	) (*admin.WarmHistoryHostResponse, error)
+}
+
+// New prepares an implementation of the AdminService service for
+// registration.
+//
+// 	handler := AdminServiceHandler{}
+// 	dispatcher.Register(adminserviceserver.New(handler))
+func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
+	h := handler{impl}
+	service := thrift.Service{
+		Name: "AdminService",
+		Methods: []thrift.Method{
+
+			thrift.Method{
+				Name: "BulkDeleteWorkflowExecutions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.BulkDeleteWorkflowExecutions),
+				},
+				Signature:    "BulkDeleteWorkflowExecutions(Request *admin.BulkDeleteWorkflowExecutionsRequest) (*admin.BulkDeleteWorkflowExecutionsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DescribeBulkDeleteWorkflowExecutions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeBulkDeleteWorkflowExecutions),
+				},
+				Signature:    "DescribeBulkDeleteWorkflowExecutions(Request *admin.DescribeBulkDeleteWorkflowExecutionsRequest) (*admin.DescribeBulkDeleteWorkflowExecutionsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DescribeHistoryHost",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeHistoryHost),
+				},
+				Signature:    "DescribeHistoryHost(Request *admin.DescribeHistoryHostRequest) (*admin.DescribeHistoryHostResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DescribeMaintenanceMode",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeMaintenanceMode),
+				},
+				Signature:    "DescribeMaintenanceMode() (*admin.DescribeMaintenanceModeResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DescribeShardDistribution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeShardDistribution),
+				},
+				Signature:    "DescribeShardDistribution(Request *admin.DescribeShardDistributionRequest) (*admin.DescribeShardDistributionResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DrainHistoryHost",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DrainHistoryHost),
+				},
+				Signature:    "DrainHistoryHost(Request *admin.DrainHistoryHostRequest) (*admin.DrainHistoryHostResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetCurrentExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetCurrentExecution),
+				},
+				Signature:    "GetCurrentExecution(Request *admin.GetCurrentExecutionRequest) (*admin.GetCurrentExecutionResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetDomainStats",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetDomainStats),
+				},
+				Signature:    "GetDomainStats(Request *admin.GetDomainStatsRequest) (*admin.GetDomainStatsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "GetWorkflowExecutionHistoryEvent",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetWorkflowExecutionHistoryEvent),
+				},
+				Signature:    "GetWorkflowExecutionHistoryEvent(Request *admin.GetWorkflowExecutionHistoryEventRequest) (*admin.GetWorkflowExecutionHistoryEventResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ImportWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ImportWorkflowExecution),
+				},
+				Signature:    "ImportWorkflowExecution(Request *admin.ImportWorkflowExecutionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListAuditRecords",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListAuditRecords),
+				},
+				Signature:    "ListAuditRecords(Request *admin.ListAuditRecordsRequest) (*admin.ListAuditRecordsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListWorkflowExecutionChain",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListWorkflowExecutionChain),
+				},
+				Signature:    "ListWorkflowExecutionChain(Request *admin.ListWorkflowExecutionChainRequest) (*admin.ListWorkflowExecutionChainResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "NukeWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.NukeWorkflowExecution),
+				},
+				Signature:    "NukeWorkflowExecution(Request *admin.NukeWorkflowExecutionRequest) (*admin.NukeWorkflowExecutionResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseTaskList),
+				},
+				Signature:    "PauseTaskList(Request *admin.PauseTaskListRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseWorkflowExecution),
+				},
+				Signature:    "PauseWorkflowExecution(Request *admin.PauseWorkflowExecutionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RefreshDomainCache",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RefreshDomainCache),
+				},
+				Signature:    "RefreshDomainCache(Request *admin.RefreshDomainCacheRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ResetShardPlacement",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResetShardPlacement),
+				},
+				Signature:    "ResetShardPlacement(Request *admin.ResetShardPlacementRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ResumeTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResumeTaskList),
+				},
+				Signature:    "ResumeTaskList(Request *admin.ResumeTaskListRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ResumeWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResumeWorkflowExecution),
+				},
+				Signature:    "ResumeWorkflowExecution(Request *admin.ResumeWorkflowExecutionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ResyncDomains",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResyncDomains),
+				},
+				Signature:    "ResyncDomains(Request *admin.ResyncDomainsRequest) (*admin.ResyncDomainsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "TerminateWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.TerminateWorkflowExecution),
+				},
+				Signature:    "TerminateWorkflowExecution(Request *admin.TerminateWorkflowExecutionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateDomainRetention",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateDomainRetention),
+				},
+				Signature:    "UpdateDomainRetention(Request *admin.UpdateDomainRetentionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateMaintenanceMode",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateMaintenanceMode),
+				},
+				Signature:    "UpdateMaintenanceMode(Request *admin.UpdateMaintenanceModeRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "WarmHistoryHost",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.WarmHistoryHost),
+				},
+				Signature:    "WarmHistoryHost(Request *admin.WarmHistoryHostRequest) (*admin.WarmHistoryHostResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+		},
+	}
+
+	procedures := make([]transport.Procedure, 0, 24)
+	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
+	return procedures
+}
+
+type handler struct{ impl Interface }
+
+func (h handler) BulkDeleteWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_BulkDeleteWorkflowExecutions_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.BulkDeleteWorkflowExecutions(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_BulkDeleteWorkflowExecutions_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DescribeBulkDeleteWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeBulkDeleteWorkflowExecutions_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeBulkDeleteWorkflowExecutions(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeBulkDeleteWorkflowExecutions_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeHistoryHost_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeHistoryHost(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeHistoryHost_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DescribeMaintenanceMode(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeMaintenanceMode_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeMaintenanceMode(ctx)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeMaintenanceMode_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DescribeShardDistribution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeShardDistribution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeShardDistribution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeShardDistribution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DrainHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DrainHistoryHost_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DrainHistoryHost(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DrainHistoryHost_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) GetCurrentExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetCurrentExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.GetCurrentExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_GetCurrentExecution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) GetDomainStats(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDomainStats_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.GetDomainStats(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_GetDomainStats_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) GetWorkflowExecutionHistoryEvent(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetWorkflowExecutionHistoryEvent_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.GetWorkflowExecutionHistoryEvent(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_GetWorkflowExecutionHistoryEvent_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ImportWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ImportWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ImportWorkflowExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ImportWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListAuditRecords(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListAuditRecords_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListAuditRecords(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListAuditRecords_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListWorkflowExecutionChain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListWorkflowExecutionChain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListWorkflowExecutionChain(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListWorkflowExecutionChain_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) NukeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_NukeWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.NukeWorkflowExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_NukeWorkflowExecution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PauseTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PauseTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PauseTaskList(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_PauseTaskList_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PauseWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PauseWorkflowExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_PauseWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RefreshDomainCache(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RefreshDomainCache_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RefreshDomainCache(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RefreshDomainCache_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ResetShardPlacement(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResetShardPlacement_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ResetShardPlacement(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ResetShardPlacement_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ResumeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResumeTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ResumeTaskList(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ResumeTaskList_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ResumeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResumeWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ResumeWorkflowExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ResumeWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ResyncDomains(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResyncDomains_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ResyncDomains(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ResyncDomains_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_TerminateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.TerminateWorkflowExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_TerminateWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateDomainRetention(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateDomainRetention_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UpdateDomainRetention(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_UpdateDomainRetention_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateMaintenanceMode(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateMaintenanceMode_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UpdateMaintenanceMode(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_UpdateMaintenanceMode_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) WarmHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_WarmHistoryHost_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.WarmHistoryHost(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_WarmHistoryHost_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
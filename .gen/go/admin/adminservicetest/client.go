@@ -0,0 +1,833 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by thriftrw-plugin-yarpc
+// @generated
+
+package adminservicetest
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"go.uber.org/yarpc"
+)
+
+// MockClient implements a gomock-compatible mock client for service
+// AdminService.
+type MockClient struct {
+	ctrl     *gomock.Controller
+	recorder *_MockClientRecorder
+}
+
+var _ adminserviceclient.Interface = (*MockClient)(nil)
+
+type _MockClientRecorder struct {
+	mock *MockClient
+}
+
+// Build a new mock client for service AdminService.
+//
+// 	mockCtrl := gomock.NewController(t)
+// 	client := adminservicetest.NewMockClient(mockCtrl)
+//
+// Use EXPECT() to set expectations on the mock.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &_MockClientRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows you to define an expectation on the
+// AdminService mock client.
+func (m *MockClient) EXPECT() *_MockClientRecorder {
+	return m.recorder
+}
+
+// BulkDeleteWorkflowExecutions responds to a BulkDeleteWorkflowExecutions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().BulkDeleteWorkflowExecutions(gomock.Any(), ...).Return(...)
+// 	... := client.BulkDeleteWorkflowExecutions(...)
+func (m *MockClient) BulkDeleteWorkflowExecutions(
+	ctx context.Context,
+	_Request *admin.BulkDeleteWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.BulkDeleteWorkflowExecutionsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "BulkDeleteWorkflowExecutions", args...)
+	success, _ = ret[i].(*admin.BulkDeleteWorkflowExecutionsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) BulkDeleteWorkflowExecutions(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "BulkDeleteWorkflowExecutions", args...)
+}
+
+// DescribeBulkDeleteWorkflowExecutions responds to a DescribeBulkDeleteWorkflowExecutions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeBulkDeleteWorkflowExecutions(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeBulkDeleteWorkflowExecutions(...)
+func (m *MockClient) DescribeBulkDeleteWorkflowExecutions(
+	ctx context.Context,
+	_Request *admin.DescribeBulkDeleteWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeBulkDeleteWorkflowExecutionsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeBulkDeleteWorkflowExecutions", args...)
+	success, _ = ret[i].(*admin.DescribeBulkDeleteWorkflowExecutionsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeBulkDeleteWorkflowExecutions(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeBulkDeleteWorkflowExecutions", args...)
+}
+
+// DescribeHistoryHost responds to a DescribeHistoryHost call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeHistoryHost(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeHistoryHost(...)
+func (m *MockClient) DescribeHistoryHost(
+	ctx context.Context,
+	_Request *admin.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeHistoryHostResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeHistoryHost", args...)
+	success, _ = ret[i].(*admin.DescribeHistoryHostResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeHistoryHost(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeHistoryHost", args...)
+}
+
+// DescribeMaintenanceMode responds to a DescribeMaintenanceMode call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeMaintenanceMode(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeMaintenanceMode(...)
+func (m *MockClient) DescribeMaintenanceMode(
+	ctx context.Context,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeMaintenanceModeResponse, err error) {
+
+	args := []interface{}{ctx}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeMaintenanceMode", args...)
+	success, _ = ret[i].(*admin.DescribeMaintenanceModeResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeMaintenanceMode(
+	ctx interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeMaintenanceMode", args...)
+}
+
+// DescribeShardDistribution responds to a DescribeShardDistribution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeShardDistribution(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeShardDistribution(...)
+func (m *MockClient) DescribeShardDistribution(
+	ctx context.Context,
+	_Request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeShardDistributionResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeShardDistribution", args...)
+	success, _ = ret[i].(*admin.DescribeShardDistributionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeShardDistribution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeShardDistribution", args...)
+}
+
+// DrainHistoryHost responds to a DrainHistoryHost call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DrainHistoryHost(gomock.Any(), ...).Return(...)
+// 	... := client.DrainHistoryHost(...)
+func (m *MockClient) DrainHistoryHost(
+	ctx context.Context,
+	_Request *admin.DrainHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DrainHistoryHostResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DrainHistoryHost", args...)
+	success, _ = ret[i].(*admin.DrainHistoryHostResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DrainHistoryHost(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DrainHistoryHost", args...)
+}
+
+// GetCurrentExecution responds to a GetCurrentExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().GetCurrentExecution(gomock.Any(), ...).Return(...)
+// 	... := client.GetCurrentExecution(...)
+func (m *MockClient) GetCurrentExecution(
+	ctx context.Context,
+	_Request *admin.GetCurrentExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetCurrentExecutionResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetCurrentExecution", args...)
+	success, _ = ret[i].(*admin.GetCurrentExecutionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetCurrentExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetCurrentExecution", args...)
+}
+
+// GetDomainStats responds to a GetDomainStats call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().GetDomainStats(gomock.Any(), ...).Return(...)
+// 	... := client.GetDomainStats(...)
+func (m *MockClient) GetDomainStats(
+	ctx context.Context,
+	_Request *admin.GetDomainStatsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetDomainStatsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetDomainStats", args...)
+	success, _ = ret[i].(*admin.GetDomainStatsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetDomainStats(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetDomainStats", args...)
+}
+
+// GetWorkflowExecutionHistoryEvent responds to a GetWorkflowExecutionHistoryEvent call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().GetWorkflowExecutionHistoryEvent(gomock.Any(), ...).Return(...)
+// 	... := client.GetWorkflowExecutionHistoryEvent(...)
+func (m *MockClient) GetWorkflowExecutionHistoryEvent(
+	ctx context.Context,
+	_Request *admin.GetWorkflowExecutionHistoryEventRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetWorkflowExecutionHistoryEventResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionHistoryEvent", args...)
+	success, _ = ret[i].(*admin.GetWorkflowExecutionHistoryEventResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetWorkflowExecutionHistoryEvent(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionHistoryEvent", args...)
+}
+
+// ImportWorkflowExecution responds to a ImportWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ImportWorkflowExecution(gomock.Any(), ...).Return(...)
+// 	... := client.ImportWorkflowExecution(...)
+func (m *MockClient) ImportWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ImportWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ImportWorkflowExecution", args...)
+}
+
+// ListAuditRecords responds to a ListAuditRecords call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListAuditRecords(gomock.Any(), ...).Return(...)
+// 	... := client.ListAuditRecords(...)
+func (m *MockClient) ListAuditRecords(
+	ctx context.Context,
+	_Request *admin.ListAuditRecordsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListAuditRecordsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListAuditRecords", args...)
+	success, _ = ret[i].(*admin.ListAuditRecordsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListAuditRecords(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListAuditRecords", args...)
+}
+
+// ListWorkflowExecutionChain responds to a ListWorkflowExecutionChain call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListWorkflowExecutionChain(gomock.Any(), ...).Return(...)
+// 	... := client.ListWorkflowExecutionChain(...)
+func (m *MockClient) ListWorkflowExecutionChain(
+	ctx context.Context,
+	_Request *admin.ListWorkflowExecutionChainRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListWorkflowExecutionChainResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListWorkflowExecutionChain", args...)
+	success, _ = ret[i].(*admin.ListWorkflowExecutionChainResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListWorkflowExecutionChain(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListWorkflowExecutionChain", args...)
+}
+
+// NukeWorkflowExecution responds to a NukeWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().NukeWorkflowExecution(gomock.Any(), ...).Return(...)
+// 	... := client.NukeWorkflowExecution(...)
+func (m *MockClient) NukeWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.NukeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.NukeWorkflowExecutionResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "NukeWorkflowExecution", args...)
+	success, _ = ret[i].(*admin.NukeWorkflowExecutionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) NukeWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "NukeWorkflowExecution", args...)
+}
+
+// PauseTaskList responds to a PauseTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PauseTaskList(gomock.Any(), ...).Return(...)
+// 	... := client.PauseTaskList(...)
+func (m *MockClient) PauseTaskList(
+	ctx context.Context,
+	_Request *admin.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseTaskList", args...)
+}
+
+// PauseWorkflowExecution responds to a PauseWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PauseWorkflowExecution(gomock.Any(), ...).Return(...)
+// 	... := client.PauseWorkflowExecution(...)
+func (m *MockClient) PauseWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseWorkflowExecution", args...)
+}
+
+// RefreshDomainCache responds to a RefreshDomainCache call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RefreshDomainCache(gomock.Any(), ...).Return(...)
+// 	... := client.RefreshDomainCache(...)
+func (m *MockClient) RefreshDomainCache(
+	ctx context.Context,
+	_Request *admin.RefreshDomainCacheRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RefreshDomainCache", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RefreshDomainCache(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RefreshDomainCache", args...)
+}
+
+// ResetShardPlacement responds to a ResetShardPlacement call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ResetShardPlacement(gomock.Any(), ...).Return(...)
+// 	... := client.ResetShardPlacement(...)
+func (m *MockClient) ResetShardPlacement(
+	ctx context.Context,
+	_Request *admin.ResetShardPlacementRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResetShardPlacement", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResetShardPlacement(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResetShardPlacement", args...)
+}
+
+// ResumeTaskList responds to a ResumeTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ResumeTaskList(gomock.Any(), ...).Return(...)
+// 	... := client.ResumeTaskList(...)
+func (m *MockClient) ResumeTaskList(
+	ctx context.Context,
+	_Request *admin.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResumeTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResumeTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResumeTaskList", args...)
+}
+
+// ResumeWorkflowExecution responds to a ResumeWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ResumeWorkflowExecution(gomock.Any(), ...).Return(...)
+// 	... := client.ResumeWorkflowExecution(...)
+func (m *MockClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResumeWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResumeWorkflowExecution", args...)
+}
+
+// ResyncDomains responds to a ResyncDomains call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ResyncDomains(gomock.Any(), ...).Return(...)
+// 	... := client.ResyncDomains(...)
+func (m *MockClient) ResyncDomains(
+	ctx context.Context,
+	_Request *admin.ResyncDomainsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ResyncDomainsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResyncDomains", args...)
+	success, _ = ret[i].(*admin.ResyncDomainsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResyncDomains(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResyncDomains", args...)
+}
+
+// TerminateWorkflowExecution responds to a TerminateWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().TerminateWorkflowExecution(gomock.Any(), ...).Return(...)
+// 	... := client.TerminateWorkflowExecution(...)
+func (m *MockClient) TerminateWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.TerminateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "TerminateWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) TerminateWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "TerminateWorkflowExecution", args...)
+}
+
+// UpdateDomainRetention responds to a UpdateDomainRetention call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateDomainRetention(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateDomainRetention(...)
+func (m *MockClient) UpdateDomainRetention(
+	ctx context.Context,
+	_Request *admin.UpdateDomainRetentionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateDomainRetention", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateDomainRetention(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateDomainRetention", args...)
+}
+
+// UpdateMaintenanceMode responds to a UpdateMaintenanceMode call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateMaintenanceMode(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateMaintenanceMode(...)
+func (m *MockClient) UpdateMaintenanceMode(
+	ctx context.Context,
+	_Request *admin.UpdateMaintenanceModeRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateMaintenanceMode", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateMaintenanceMode(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateMaintenanceMode", args...)
+}
+
+// WarmHistoryHost responds to a WarmHistoryHost call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().WarmHistoryHost(gomock.Any(), ...).Return(...)
+// 	... := client.WarmHistoryHost(...)
+func (m *MockClient) WarmHistoryHost(
+	ctx context.Context,
+	_Request *admin.WarmHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.WarmHistoryHostResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "WarmHistoryHost", args...)
+	success, _ = ret[i].(*admin.WarmHistoryHostResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) WarmHistoryHost(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "WarmHistoryHost", args...)
+}
@@ -42,6 +42,11 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DescribeCluster(
+		ctx context.Context,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeClusterResponse, error)
+
 	DescribeDomain(
 		ctx context.Context,
 		DescribeRequest *shared.DescribeDomainRequest,
@@ -252,6 +257,28 @@ func (c client) DeprecateDomain(
 	return
 }
 
+func (c client) DescribeCluster(
+	ctx context.Context,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeClusterResponse, err error) {
+
+	args := cadence.WorkflowService_DescribeCluster_Helper.Args()
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_DescribeCluster_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_DescribeCluster_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeDomain(
 	ctx context.Context,
 	_DescribeRequest *shared.DescribeDomainRequest,
@@ -39,6 +39,10 @@ type Interface interface {
 		DeprecateRequest *shared.DeprecateDomainRequest,
 	) error
 
+	DescribeCluster(
+		ctx context.Context,
+	) (*shared.DescribeClusterResponse, error)
+
 	DescribeDomain(
 		ctx context.Context,
 		DescribeRequest *shared.DescribeDomainRequest,
@@ -197,6 +201,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeCluster",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeCluster),
+				},
+				Signature:    "DescribeCluster() (*shared.DescribeClusterResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -496,7 +511,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 28)
+	procedures := make([]transport.Procedure, 0, 29)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -522,6 +537,25 @@ func (h handler) DeprecateDomain(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) DescribeCluster(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DescribeCluster_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeCluster(ctx)
+
+	hadError := err != nil
+	result, err := cadence.WorkflowService_DescribeCluster_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_DescribeDomain_Args
 	if err := args.FromWire(body); err != nil {
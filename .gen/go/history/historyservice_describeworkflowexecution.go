@@ -378,18 +378,6 @@ func _DescribeWorkflowExecutionResponse_Read(w wire.Value) (*shared.DescribeWork
 	return &v, err
 }
 
-func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.FromWire(w)
-	return &v, err
-}
-
 func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
 	var v shared.EntityNotExistsError
 	err := v.FromWire(w)
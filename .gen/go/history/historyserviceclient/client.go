@@ -36,18 +36,66 @@ import (
 
 // Interface is a client for the HistoryService service.
 type Interface interface {
+	DescribeHistoryHost(
+		ctx context.Context,
+		Request *history.DescribeHistoryHostRequest,
+		opts ...yarpc.CallOption,
+	) (*history.DescribeHistoryHostResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	DrainHistoryHost(
+		ctx context.Context,
+		Request *history.DrainHistoryHostRequest,
+		opts ...yarpc.CallOption,
+	) (*history.DrainHistoryHostResponse, error)
+
+	GetCurrentExecution(
+		ctx context.Context,
+		GetRequest *history.GetCurrentExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*history.GetCurrentExecutionResponse, error)
+
 	GetMutableState(
 		ctx context.Context,
 		GetRequest *history.GetMutableStateRequest,
 		opts ...yarpc.CallOption,
 	) (*history.GetMutableStateResponse, error)
 
+	GetWorkflowExecutionHistoryEvent(
+		ctx context.Context,
+		GetRequest *history.GetWorkflowExecutionHistoryEventRequest,
+		opts ...yarpc.CallOption,
+	) (*history.GetWorkflowExecutionHistoryEventResponse, error)
+
+	ImportWorkflowExecution(
+		ctx context.Context,
+		ImportRequest *history.ImportWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ListWorkflowExecutionChain(
+		ctx context.Context,
+		ListRequest *history.ListWorkflowExecutionChainRequest,
+		opts ...yarpc.CallOption,
+	) (*history.ListWorkflowExecutionChainResponse, error)
+
+	NukeWorkflowExecution(
+		ctx context.Context,
+		NukeRequest *history.NukeWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*history.NukeWorkflowExecutionResponse, error)
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -72,6 +120,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.RecordDecisionTaskStartedResponse, error)
 
+	RefreshDomainCache(
+		ctx context.Context,
+		RefreshRequest *history.RefreshDomainCacheRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveSignalMutableState(
 		ctx context.Context,
 		RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -126,6 +180,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		ResumeRequest *history.ResumeWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -155,6 +215,12 @@ type Interface interface {
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	WarmHistoryHost(
+		ctx context.Context,
+		Request *history.WarmHistoryHostRequest,
+		opts ...yarpc.CallOption,
+	) (*history.WarmHistoryHostResponse, error)
 }
 
 // New builds a new client for the HistoryService service.
@@ -181,6 +247,29 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) DescribeHistoryHost(
+	ctx context.Context,
+	_Request *history.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (success *history.DescribeHistoryHostResponse, err error) {
+
+	args := history.HistoryService_DescribeHistoryHost_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DescribeHistoryHost_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DescribeHistoryHost_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -204,6 +293,52 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) DrainHistoryHost(
+	ctx context.Context,
+	_Request *history.DrainHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (success *history.DrainHistoryHostResponse, err error) {
+
+	args := history.HistoryService_DrainHistoryHost_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DrainHistoryHost_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DrainHistoryHost_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetCurrentExecution(
+	ctx context.Context,
+	_GetRequest *history.GetCurrentExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetCurrentExecutionResponse, err error) {
+
+	args := history.HistoryService_GetCurrentExecution_Helper.Args(_GetRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetCurrentExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetCurrentExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetMutableState(
 	ctx context.Context,
 	_GetRequest *history.GetMutableStateRequest,
@@ -227,6 +362,121 @@ func (c client) GetMutableState(
 	return
 }
 
+func (c client) GetWorkflowExecutionHistoryEvent(
+	ctx context.Context,
+	_GetRequest *history.GetWorkflowExecutionHistoryEventRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetWorkflowExecutionHistoryEventResponse, err error) {
+
+	args := history.HistoryService_GetWorkflowExecutionHistoryEvent_Helper.Args(_GetRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetWorkflowExecutionHistoryEvent_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetWorkflowExecutionHistoryEvent_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ImportWorkflowExecution(
+	ctx context.Context,
+	_ImportRequest *history.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ImportWorkflowExecution_Helper.Args(_ImportRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ImportWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ImportWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListWorkflowExecutionChain(
+	ctx context.Context,
+	_ListRequest *history.ListWorkflowExecutionChainRequest,
+	opts ...yarpc.CallOption,
+) (success *history.ListWorkflowExecutionChainResponse, err error) {
+
+	args := history.HistoryService_ListWorkflowExecutionChain_Helper.Args(_ListRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ListWorkflowExecutionChain_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ListWorkflowExecutionChain_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) NukeWorkflowExecution(
+	ctx context.Context,
+	_NukeRequest *history.NukeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *history.NukeWorkflowExecutionResponse, err error) {
+
+	args := history.HistoryService_NukeWorkflowExecution_Helper.Args(_NukeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_NukeWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_NukeWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *history.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -319,6 +569,29 @@ func (c client) RecordDecisionTaskStarted(
 	return
 }
 
+func (c client) RefreshDomainCache(
+	ctx context.Context,
+	_RefreshRequest *history.RefreshDomainCacheRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RefreshDomainCache_Helper.Args(_RefreshRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RefreshDomainCache_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RefreshDomainCache_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveSignalMutableState(
 	ctx context.Context,
 	_RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -526,6 +799,29 @@ func (c client) RespondDecisionTaskFailed(
 	return
 }
 
+func (c client) ResumeWorkflowExecution(
+	ctx context.Context,
+	_ResumeRequest *history.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ResumeWorkflowExecution_Helper.Args(_ResumeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ResumeWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ResumeWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -640,3 +936,26 @@ func (c client) TerminateWorkflowExecution(
 	err = history.HistoryService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) WarmHistoryHost(
+	ctx context.Context,
+	_Request *history.WarmHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (success *history.WarmHistoryHostResponse, err error) {
+
+	args := history.HistoryService_WarmHistoryHost_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_WarmHistoryHost_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_WarmHistoryHost_Helper.UnwrapResponse(&result)
+	return
+}
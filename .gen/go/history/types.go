@@ -31,12 +31,13 @@ import (
 	"strings"
 )
 
-type DescribeWorkflowExecutionRequest struct {
-	DomainUUID *string                                  `json:"domainUUID,omitempty"`
-	Request    *shared.DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+type DescribeHistoryHostRequest struct {
+	HostAddress      *string                   `json:"hostAddress,omitempty"`
+	ShardIdForHost   *int32                    `json:"shardIdForHost,omitempty"`
+	ExecutionForHost *shared.WorkflowExecution `json:"executionForHost,omitempty"`
 }
 
-// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a DescribeHistoryHostRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -51,45 +52,53 @@ type DescribeWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *DescribeHistoryHostRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.HostAddress != nil {
+		w, err = wire.NewValueString(*(v.HostAddress)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Request != nil {
-		w, err = v.Request.ToWire()
+	if v.ShardIdForHost != nil {
+		w, err = wire.NewValueI32(*(v.ShardIdForHost)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ExecutionForHost != nil {
+		w, err = v.ExecutionForHost.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*shared.DescribeWorkflowExecutionRequest, error) {
-	var v shared.DescribeWorkflowExecutionRequest
+func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
+	var v shared.WorkflowExecution
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a DescribeHistoryHostRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a DescribeHistoryHostRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -97,12 +106,12 @@ func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*shared.DescribeWorkf
 //     return nil, err
 //   }
 //
-//   var v DescribeWorkflowExecutionRequest
+//   var v DescribeHistoryHostRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *DescribeHistoryHostRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -111,15 +120,25 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+				v.HostAddress = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardIdForHost = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				v.ExecutionForHost, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -131,25 +150,29 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeWorkflowExecutionRequest
+// String returns a readable string representation of a DescribeHistoryHostRequest
 // struct.
-func (v *DescribeWorkflowExecutionRequest) String() string {
+func (v *DescribeHistoryHostRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.HostAddress != nil {
+		fields[i] = fmt.Sprintf("HostAddress: %v", *(v.HostAddress))
 		i++
 	}
-	if v.Request != nil {
-		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+	if v.ShardIdForHost != nil {
+		fields[i] = fmt.Sprintf("ShardIdForHost: %v", *(v.ShardIdForHost))
+		i++
+	}
+	if v.ExecutionForHost != nil {
+		fields[i] = fmt.Sprintf("ExecutionForHost: %v", v.ExecutionForHost)
 		i++
 	}
 
-	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeHistoryHostRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
 func _String_EqualsPtr(lhs, rhs *string) bool {
@@ -162,36 +185,90 @@ func _String_EqualsPtr(lhs, rhs *string) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
-// provided DescribeWorkflowExecutionRequest.
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DescribeHistoryHostRequest match the
+// provided DescribeHistoryHostRequest.
 //
 // This function performs a deep comparison.
-func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+func (v *DescribeHistoryHostRequest) Equals(rhs *DescribeHistoryHostRequest) bool {
+	if !_String_EqualsPtr(v.HostAddress, rhs.HostAddress) {
 		return false
 	}
-	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+	if !_I32_EqualsPtr(v.ShardIdForHost, rhs.ShardIdForHost) {
+		return false
+	}
+	if !((v.ExecutionForHost == nil && rhs.ExecutionForHost == nil) || (v.ExecutionForHost != nil && rhs.ExecutionForHost != nil && v.ExecutionForHost.Equals(rhs.ExecutionForHost))) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetHostAddress returns the value of HostAddress if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *DescribeHistoryHostRequest) GetHostAddress() (o string) {
+	if v.HostAddress != nil {
+		return *v.HostAddress
 	}
 
 	return
 }
 
-type EventAlreadyStartedError struct {
-	Message string `json:"message,required"`
+// GetShardIdForHost returns the value of ShardIdForHost if it is set or its
+// zero value if it is unset.
+func (v *DescribeHistoryHostRequest) GetShardIdForHost() (o int32) {
+	if v.ShardIdForHost != nil {
+		return *v.ShardIdForHost
+	}
+
+	return
 }
 
-// ToWire translates a EventAlreadyStartedError struct into a Thrift-level intermediate
+type DescribeHistoryHostResponse struct {
+	Address        *string               `json:"address,omitempty"`
+	NumberOfShards *int32                `json:"numberOfShards,omitempty"`
+	Shards         []*HistoryShardStatus `json:"shards,omitempty"`
+}
+
+type _List_HistoryShardStatus_ValueList []*HistoryShardStatus
+
+func (v _List_HistoryShardStatus_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_HistoryShardStatus_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_HistoryShardStatus_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_HistoryShardStatus_ValueList) Close() {}
+
+// ToWire translates a DescribeHistoryHostResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -206,29 +283,71 @@ type EventAlreadyStartedError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *EventAlreadyStartedError) ToWire() (wire.Value, error) {
+func (v *DescribeHistoryHostResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
+	if v.Address != nil {
+		w, err = wire.NewValueString(*(v.Address)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NumberOfShards != nil {
+		w, err = wire.NewValueI32(*(v.NumberOfShards)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Shards != nil {
+		w, err = wire.NewValueList(_List_HistoryShardStatus_ValueList(v.Shards)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a EventAlreadyStartedError struct from its Thrift-level
+func _HistoryShardStatus_Read(w wire.Value) (*HistoryShardStatus, error) {
+	var v HistoryShardStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_HistoryShardStatus_Read(l wire.ValueList) ([]*HistoryShardStatus, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*HistoryShardStatus, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _HistoryShardStatus_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DescribeHistoryHostResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a EventAlreadyStartedError struct
+// An error is returned if we were unable to build a DescribeHistoryHostResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -236,74 +355,134 @@ func (v *EventAlreadyStartedError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v EventAlreadyStartedError
+//   var v DescribeHistoryHostResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *EventAlreadyStartedError) FromWire(w wire.Value) error {
+func (v *DescribeHistoryHostResponse) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Address = &x
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
+
 			}
-		}
-	}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumberOfShards = &x
+				if err != nil {
+					return err
+				}
 
-	if !messageIsSet {
-		return errors.New("field Message of EventAlreadyStartedError is required")
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.Shards, err = _List_HistoryShardStatus_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a EventAlreadyStartedError
+// String returns a readable string representation of a DescribeHistoryHostResponse
 // struct.
-func (v *EventAlreadyStartedError) String() string {
+func (v *DescribeHistoryHostResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [3]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
+	if v.Address != nil {
+		fields[i] = fmt.Sprintf("Address: %v", *(v.Address))
+		i++
+	}
+	if v.NumberOfShards != nil {
+		fields[i] = fmt.Sprintf("NumberOfShards: %v", *(v.NumberOfShards))
+		i++
+	}
+	if v.Shards != nil {
+		fields[i] = fmt.Sprintf("Shards: %v", v.Shards)
+		i++
+	}
 
-	return fmt.Sprintf("EventAlreadyStartedError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeHistoryHostResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this EventAlreadyStartedError match the
-// provided EventAlreadyStartedError.
+func _List_HistoryShardStatus_Equals(lhs, rhs []*HistoryShardStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DescribeHistoryHostResponse match the
+// provided DescribeHistoryHostResponse.
 //
 // This function performs a deep comparison.
-func (v *EventAlreadyStartedError) Equals(rhs *EventAlreadyStartedError) bool {
-	if !(v.Message == rhs.Message) {
+func (v *DescribeHistoryHostResponse) Equals(rhs *DescribeHistoryHostResponse) bool {
+	if !_String_EqualsPtr(v.Address, rhs.Address) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NumberOfShards, rhs.NumberOfShards) {
+		return false
+	}
+	if !((v.Shards == nil && rhs.Shards == nil) || (v.Shards != nil && rhs.Shards != nil && _List_HistoryShardStatus_Equals(v.Shards, rhs.Shards))) {
 		return false
 	}
 
 	return true
 }
 
-func (v *EventAlreadyStartedError) Error() string {
-	return v.String()
+// GetAddress returns the value of Address if it is set or its
+// zero value if it is unset.
+func (v *DescribeHistoryHostResponse) GetAddress() (o string) {
+	if v.Address != nil {
+		return *v.Address
+	}
+
+	return
 }
 
-type GetMutableStateRequest struct {
-	DomainUUID          *string                   `json:"domainUUID,omitempty"`
-	Execution           *shared.WorkflowExecution `json:"execution,omitempty"`
-	ExpectedNextEventId *int64                    `json:"expectedNextEventId,omitempty"`
+// GetNumberOfShards returns the value of NumberOfShards if it is set or its
+// zero value if it is unset.
+func (v *DescribeHistoryHostResponse) GetNumberOfShards() (o int32) {
+	if v.NumberOfShards != nil {
+		return *v.NumberOfShards
+	}
+
+	return
 }
 
-// ToWire translates a GetMutableStateRequest struct into a Thrift-level intermediate
+type DescribeWorkflowExecutionRequest struct {
+	DomainUUID *string                                  `json:"domainUUID,omitempty"`
+	Request    *shared.DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -318,9 +497,9 @@ type GetMutableStateRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetMutableStateRequest) ToWire() (wire.Value, error) {
+func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -334,37 +513,29 @@ func (v *GetMutableStateRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ExpectedNextEventId != nil {
-		w, err = wire.NewValueI64(*(v.ExpectedNextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
-	var v shared.WorkflowExecution
+func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*shared.DescribeWorkflowExecutionRequest, error) {
+	var v shared.DescribeWorkflowExecutionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a GetMutableStateRequest struct from its Thrift-level
+// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetMutableStateRequest struct
+// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -372,12 +543,12 @@ func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
 //     return nil, err
 //   }
 //
-//   var v GetMutableStateRequest
+//   var v DescribeWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetMutableStateRequest) FromWire(w wire.Value) error {
+func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -394,17 +565,7 @@ func (v *GetMutableStateRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ExpectedNextEventId = &x
+				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -416,53 +577,36 @@ func (v *GetMutableStateRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetMutableStateRequest
+// String returns a readable string representation of a DescribeWorkflowExecutionRequest
 // struct.
-func (v *GetMutableStateRequest) String() string {
+func (v *DescribeWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
-	}
-	if v.ExpectedNextEventId != nil {
-		fields[i] = fmt.Sprintf("ExpectedNextEventId: %v", *(v.ExpectedNextEventId))
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("GetMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetMutableStateRequest match the
-// provided GetMutableStateRequest.
+// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
+// provided DescribeWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *GetMutableStateRequest) Equals(rhs *GetMutableStateRequest) bool {
+func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ExpectedNextEventId, rhs.ExpectedNextEventId) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -471,7 +615,7 @@ func (v *GetMutableStateRequest) Equals(rhs *GetMutableStateRequest) bool {
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *GetMutableStateRequest) GetDomainUUID() (o string) {
+func (v *DescribeWorkflowExecutionRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -479,31 +623,12 @@ func (v *GetMutableStateRequest) GetDomainUUID() (o string) {
 	return
 }
 
-// GetExpectedNextEventId returns the value of ExpectedNextEventId if it is set or its
-// zero value if it is unset.
-func (v *GetMutableStateRequest) GetExpectedNextEventId() (o int64) {
-	if v.ExpectedNextEventId != nil {
-		return *v.ExpectedNextEventId
-	}
-
-	return
-}
-
-type GetMutableStateResponse struct {
-	Execution                            *shared.WorkflowExecution `json:"execution,omitempty"`
-	WorkflowType                         *shared.WorkflowType      `json:"workflowType,omitempty"`
-	NextEventId                          *int64                    `json:"NextEventId,omitempty"`
-	LastFirstEventId                     *int64                    `json:"LastFirstEventId,omitempty"`
-	TaskList                             *shared.TaskList          `json:"taskList,omitempty"`
-	StickyTaskList                       *shared.TaskList          `json:"stickyTaskList,omitempty"`
-	ClientLibraryVersion                 *string                   `json:"clientLibraryVersion,omitempty"`
-	ClientFeatureVersion                 *string                   `json:"clientFeatureVersion,omitempty"`
-	ClientImpl                           *string                   `json:"clientImpl,omitempty"`
-	IsWorkflowRunning                    *bool                     `json:"isWorkflowRunning,omitempty"`
-	StickyTaskListScheduleToStartTimeout *int32                    `json:"stickyTaskListScheduleToStartTimeout,omitempty"`
+type DrainHistoryHostRequest struct {
+	HostAddress     *string `json:"hostAddress,omitempty"`
+	ShardsPerMinute *int32  `json:"shardsPerMinute,omitempty"`
 }
 
-// ToWire translates a GetMutableStateResponse struct into a Thrift-level intermediate
+// ToWire translates a DrainHistoryHostRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -518,123 +643,39 @@ type GetMutableStateResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetMutableStateResponse) ToWire() (wire.Value, error) {
+func (v *DrainHistoryHostRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.HostAddress != nil {
+		w, err = wire.NewValueString(*(v.HostAddress)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
+	if v.ShardsPerMinute != nil {
+		w, err = wire.NewValueI32(*(v.ShardsPerMinute)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.LastFirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.LastFirstEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.StickyTaskList != nil {
-		w, err = v.StickyTaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.ClientLibraryVersion != nil {
-		w, err = wire.NewValueString(*(v.ClientLibraryVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.ClientFeatureVersion != nil {
-		w, err = wire.NewValueString(*(v.ClientFeatureVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.ClientImpl != nil {
-		w, err = wire.NewValueString(*(v.ClientImpl)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.IsWorkflowRunning != nil {
-		w, err = wire.NewValueBool(*(v.IsWorkflowRunning)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.StickyTaskListScheduleToStartTimeout != nil {
-		w, err = wire.NewValueI32(*(v.StickyTaskListScheduleToStartTimeout)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowType_Read(w wire.Value) (*shared.WorkflowType, error) {
-	var v shared.WorkflowType
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _TaskList_Read(w wire.Value) (*shared.TaskList, error) {
-	var v shared.TaskList
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a GetMutableStateResponse struct from its Thrift-level
+// FromWire deserializes a DrainHistoryHostRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetMutableStateResponse struct
+// An error is returned if we were unable to build a DrainHistoryHostRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -642,113 +683,31 @@ func _TaskList_Read(w wire.Value) (*shared.TaskList, error) {
 //     return nil, err
 //   }
 //
-//   var v GetMutableStateResponse
+//   var v DrainHistoryHostRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetMutableStateResponse) FromWire(w wire.Value) error {
+func (v *DrainHistoryHostRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastFirstEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.StickyTaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ClientLibraryVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ClientFeatureVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ClientImpl = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 100:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.IsWorkflowRunning = &x
+				v.HostAddress = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 110:
+		case 20:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
-				v.StickyTaskListScheduleToStartTimeout = &x
+				v.ShardsPerMinute = &x
 				if err != nil {
 					return err
 				}
@@ -760,203 +719,68 @@ func (v *GetMutableStateResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetMutableStateResponse
+// String returns a readable string representation of a DrainHistoryHostRequest
 // struct.
-func (v *GetMutableStateResponse) String() string {
+func (v *DrainHistoryHostRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [2]string
 	i := 0
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+	if v.HostAddress != nil {
+		fields[i] = fmt.Sprintf("HostAddress: %v", *(v.HostAddress))
 		i++
 	}
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+	if v.ShardsPerMinute != nil {
+		fields[i] = fmt.Sprintf("ShardsPerMinute: %v", *(v.ShardsPerMinute))
 		i++
 	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
-	if v.LastFirstEventId != nil {
-		fields[i] = fmt.Sprintf("LastFirstEventId: %v", *(v.LastFirstEventId))
-		i++
-	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
-		i++
-	}
-	if v.StickyTaskList != nil {
-		fields[i] = fmt.Sprintf("StickyTaskList: %v", v.StickyTaskList)
-		i++
-	}
-	if v.ClientLibraryVersion != nil {
-		fields[i] = fmt.Sprintf("ClientLibraryVersion: %v", *(v.ClientLibraryVersion))
-		i++
-	}
-	if v.ClientFeatureVersion != nil {
-		fields[i] = fmt.Sprintf("ClientFeatureVersion: %v", *(v.ClientFeatureVersion))
-		i++
-	}
-	if v.ClientImpl != nil {
-		fields[i] = fmt.Sprintf("ClientImpl: %v", *(v.ClientImpl))
-		i++
-	}
-	if v.IsWorkflowRunning != nil {
-		fields[i] = fmt.Sprintf("IsWorkflowRunning: %v", *(v.IsWorkflowRunning))
-		i++
-	}
-	if v.StickyTaskListScheduleToStartTimeout != nil {
-		fields[i] = fmt.Sprintf("StickyTaskListScheduleToStartTimeout: %v", *(v.StickyTaskListScheduleToStartTimeout))
-		i++
-	}
-
-	return fmt.Sprintf("GetMutableStateResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("DrainHistoryHostRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetMutableStateResponse match the
-// provided GetMutableStateResponse.
+// Equals returns true if all the fields of this DrainHistoryHostRequest match the
+// provided DrainHistoryHostRequest.
 //
 // This function performs a deep comparison.
-func (v *GetMutableStateResponse) Equals(rhs *GetMutableStateResponse) bool {
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
-		return false
-	}
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+func (v *DrainHistoryHostRequest) Equals(rhs *DrainHistoryHostRequest) bool {
+	if !_String_EqualsPtr(v.HostAddress, rhs.HostAddress) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.LastFirstEventId, rhs.LastFirstEventId) {
-		return false
-	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
-	}
-	if !((v.StickyTaskList == nil && rhs.StickyTaskList == nil) || (v.StickyTaskList != nil && rhs.StickyTaskList != nil && v.StickyTaskList.Equals(rhs.StickyTaskList))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ClientLibraryVersion, rhs.ClientLibraryVersion) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ClientFeatureVersion, rhs.ClientFeatureVersion) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ClientImpl, rhs.ClientImpl) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.IsWorkflowRunning, rhs.IsWorkflowRunning) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.StickyTaskListScheduleToStartTimeout, rhs.StickyTaskListScheduleToStartTimeout) {
+	if !_I32_EqualsPtr(v.ShardsPerMinute, rhs.ShardsPerMinute) {
 		return false
 	}
 
 	return true
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *GetMutableStateResponse) GetNextEventId() (o int64) {
-	if v.NextEventId != nil {
-		return *v.NextEventId
-	}
-
-	return
-}
-
-// GetLastFirstEventId returns the value of LastFirstEventId if it is set or its
-// zero value if it is unset.
-func (v *GetMutableStateResponse) GetLastFirstEventId() (o int64) {
-	if v.LastFirstEventId != nil {
-		return *v.LastFirstEventId
-	}
-
-	return
-}
-
-// GetClientLibraryVersion returns the value of ClientLibraryVersion if it is set or its
-// zero value if it is unset.
-func (v *GetMutableStateResponse) GetClientLibraryVersion() (o string) {
-	if v.ClientLibraryVersion != nil {
-		return *v.ClientLibraryVersion
-	}
-
-	return
-}
-
-// GetClientFeatureVersion returns the value of ClientFeatureVersion if it is set or its
-// zero value if it is unset.
-func (v *GetMutableStateResponse) GetClientFeatureVersion() (o string) {
-	if v.ClientFeatureVersion != nil {
-		return *v.ClientFeatureVersion
-	}
-
-	return
-}
-
-// GetClientImpl returns the value of ClientImpl if it is set or its
-// zero value if it is unset.
-func (v *GetMutableStateResponse) GetClientImpl() (o string) {
-	if v.ClientImpl != nil {
-		return *v.ClientImpl
-	}
-
-	return
-}
-
-// GetIsWorkflowRunning returns the value of IsWorkflowRunning if it is set or its
+// GetHostAddress returns the value of HostAddress if it is set or its
 // zero value if it is unset.
-func (v *GetMutableStateResponse) GetIsWorkflowRunning() (o bool) {
-	if v.IsWorkflowRunning != nil {
-		return *v.IsWorkflowRunning
+func (v *DrainHistoryHostRequest) GetHostAddress() (o string) {
+	if v.HostAddress != nil {
+		return *v.HostAddress
 	}
 
 	return
 }
 
-// GetStickyTaskListScheduleToStartTimeout returns the value of StickyTaskListScheduleToStartTimeout if it is set or its
+// GetShardsPerMinute returns the value of ShardsPerMinute if it is set or its
 // zero value if it is unset.
-func (v *GetMutableStateResponse) GetStickyTaskListScheduleToStartTimeout() (o int32) {
-	if v.StickyTaskListScheduleToStartTimeout != nil {
-		return *v.StickyTaskListScheduleToStartTimeout
+func (v *DrainHistoryHostRequest) GetShardsPerMinute() (o int32) {
+	if v.ShardsPerMinute != nil {
+		return *v.ShardsPerMinute
 	}
 
 	return
 }
 
-type ParentExecutionInfo struct {
-	DomainUUID  *string                   `json:"domainUUID,omitempty"`
-	Domain      *string                   `json:"domain,omitempty"`
-	Execution   *shared.WorkflowExecution `json:"execution,omitempty"`
-	InitiatedId *int64                    `json:"initiatedId,omitempty"`
+type DrainHistoryHostResponse struct {
+	Address        *string `json:"address,omitempty"`
+	NumberOfShards *int32  `json:"numberOfShards,omitempty"`
 }
 
-// ToWire translates a ParentExecutionInfo struct into a Thrift-level intermediate
+// ToWire translates a DrainHistoryHostResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -971,55 +795,39 @@ type ParentExecutionInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ParentExecutionInfo) ToWire() (wire.Value, error) {
+func (v *DrainHistoryHostResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.Address != nil {
+		w, err = wire.NewValueString(*(v.Address)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 15, Value: w}
-		i++
-	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.NumberOfShards != nil {
+		w, err = wire.NewValueI32(*(v.NumberOfShards)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.InitiatedId != nil {
-		w, err = wire.NewValueI64(*(v.InitiatedId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ParentExecutionInfo struct from its Thrift-level
+// FromWire deserializes a DrainHistoryHostResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ParentExecutionInfo struct
+// An error is returned if we were unable to build a DrainHistoryHostResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1027,12 +835,12 @@ func (v *ParentExecutionInfo) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ParentExecutionInfo
+//   var v DrainHistoryHostResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ParentExecutionInfo) FromWire(w wire.Value) error {
+func (v *DrainHistoryHostResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1041,35 +849,17 @@ func (v *ParentExecutionInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 15:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.Address = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.InitiatedId = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumberOfShards = &x
 				if err != nil {
 					return err
 				}
@@ -1081,92 +871,178 @@ func (v *ParentExecutionInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ParentExecutionInfo
+// String returns a readable string representation of a DrainHistoryHostResponse
 // struct.
-func (v *ParentExecutionInfo) String() string {
+func (v *DrainHistoryHostResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [2]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
-		i++
-	}
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.Address != nil {
+		fields[i] = fmt.Sprintf("Address: %v", *(v.Address))
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
-	}
-	if v.InitiatedId != nil {
-		fields[i] = fmt.Sprintf("InitiatedId: %v", *(v.InitiatedId))
+	if v.NumberOfShards != nil {
+		fields[i] = fmt.Sprintf("NumberOfShards: %v", *(v.NumberOfShards))
 		i++
 	}
 
-	return fmt.Sprintf("ParentExecutionInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DrainHistoryHostResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ParentExecutionInfo match the
-// provided ParentExecutionInfo.
+// Equals returns true if all the fields of this DrainHistoryHostResponse match the
+// provided DrainHistoryHostResponse.
 //
 // This function performs a deep comparison.
-func (v *ParentExecutionInfo) Equals(rhs *ParentExecutionInfo) bool {
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+func (v *DrainHistoryHostResponse) Equals(rhs *DrainHistoryHostResponse) bool {
+	if !_String_EqualsPtr(v.Address, rhs.Address) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.InitiatedId, rhs.InitiatedId) {
+	if !_I32_EqualsPtr(v.NumberOfShards, rhs.NumberOfShards) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetAddress returns the value of Address if it is set or its
 // zero value if it is unset.
-func (v *ParentExecutionInfo) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *DrainHistoryHostResponse) GetAddress() (o string) {
+	if v.Address != nil {
+		return *v.Address
 	}
 
 	return
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetNumberOfShards returns the value of NumberOfShards if it is set or its
 // zero value if it is unset.
-func (v *ParentExecutionInfo) GetDomain() (o string) {
-	if v.Domain != nil {
-		return *v.Domain
+func (v *DrainHistoryHostResponse) GetNumberOfShards() (o int32) {
+	if v.NumberOfShards != nil {
+		return *v.NumberOfShards
 	}
 
 	return
 }
 
-// GetInitiatedId returns the value of InitiatedId if it is set or its
-// zero value if it is unset.
-func (v *ParentExecutionInfo) GetInitiatedId() (o int64) {
-	if v.InitiatedId != nil {
-		return *v.InitiatedId
-	}
-
-	return
+type EventAlreadyStartedError struct {
+	Message string `json:"message,required"`
 }
 
-type RecordActivityTaskHeartbeatRequest struct {
-	DomainUUID       *string                                    `json:"domainUUID,omitempty"`
-	HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest `json:"heartbeatRequest,omitempty"`
-}
+// ToWire translates a EventAlreadyStartedError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *EventAlreadyStartedError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// ToWire translates a RecordActivityTaskHeartbeatRequest struct into a Thrift-level intermediate
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a EventAlreadyStartedError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a EventAlreadyStartedError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v EventAlreadyStartedError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *EventAlreadyStartedError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of EventAlreadyStartedError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a EventAlreadyStartedError
+// struct.
+func (v *EventAlreadyStartedError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("EventAlreadyStartedError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this EventAlreadyStartedError match the
+// provided EventAlreadyStartedError.
+//
+// This function performs a deep comparison.
+func (v *EventAlreadyStartedError) Equals(rhs *EventAlreadyStartedError) bool {
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+func (v *EventAlreadyStartedError) Error() string {
+	return v.String()
+}
+
+type GetCurrentExecutionRequest struct {
+	DomainUUID *string `json:"domainUUID,omitempty"`
+	WorkflowId *string `json:"workflowId,omitempty"`
+}
+
+// ToWire translates a GetCurrentExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1181,7 +1057,7 @@ type RecordActivityTaskHeartbeatRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
+func (v *GetCurrentExecutionRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -1197,8 +1073,8 @@ func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.HeartbeatRequest != nil {
-		w, err = v.HeartbeatRequest.ToWire()
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -1209,17 +1085,11 @@ func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RecordActivityTaskHeartbeatRequest_Read(w wire.Value) (*shared.RecordActivityTaskHeartbeatRequest, error) {
-	var v shared.RecordActivityTaskHeartbeatRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RecordActivityTaskHeartbeatRequest struct from its Thrift-level
+// FromWire deserializes a GetCurrentExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordActivityTaskHeartbeatRequest struct
+// An error is returned if we were unable to build a GetCurrentExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1227,12 +1097,12 @@ func _RecordActivityTaskHeartbeatRequest_Read(w wire.Value) (*shared.RecordActiv
 //     return nil, err
 //   }
 //
-//   var v RecordActivityTaskHeartbeatRequest
+//   var v GetCurrentExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
+func (v *GetCurrentExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1248,8 +1118,10 @@ func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.HeartbeatRequest, err = _RecordActivityTaskHeartbeatRequest_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
 				if err != nil {
 					return err
 				}
@@ -1261,9 +1133,9 @@ func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordActivityTaskHeartbeatRequest
+// String returns a readable string representation of a GetCurrentExecutionRequest
 // struct.
-func (v *RecordActivityTaskHeartbeatRequest) String() string {
+func (v *GetCurrentExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -1274,23 +1146,23 @@ func (v *RecordActivityTaskHeartbeatRequest) String() string {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.HeartbeatRequest != nil {
-		fields[i] = fmt.Sprintf("HeartbeatRequest: %v", v.HeartbeatRequest)
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
 		i++
 	}
 
-	return fmt.Sprintf("RecordActivityTaskHeartbeatRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetCurrentExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordActivityTaskHeartbeatRequest match the
-// provided RecordActivityTaskHeartbeatRequest.
+// Equals returns true if all the fields of this GetCurrentExecutionRequest match the
+// provided GetCurrentExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *RecordActivityTaskHeartbeatRequest) Equals(rhs *RecordActivityTaskHeartbeatRequest) bool {
+func (v *GetCurrentExecutionRequest) Equals(rhs *GetCurrentExecutionRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.HeartbeatRequest == nil && rhs.HeartbeatRequest == nil) || (v.HeartbeatRequest != nil && rhs.HeartbeatRequest != nil && v.HeartbeatRequest.Equals(rhs.HeartbeatRequest))) {
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
 		return false
 	}
 
@@ -1299,7 +1171,7 @@ func (v *RecordActivityTaskHeartbeatRequest) Equals(rhs *RecordActivityTaskHeart
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatRequest) GetDomainUUID() (o string) {
+func (v *GetCurrentExecutionRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -1307,16 +1179,24 @@ func (v *RecordActivityTaskHeartbeatRequest) GetDomainUUID() (o string) {
 	return
 }
 
-type RecordActivityTaskStartedRequest struct {
-	DomainUUID        *string                            `json:"domainUUID,omitempty"`
-	WorkflowExecution *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
-	ScheduleId        *int64                             `json:"scheduleId,omitempty"`
-	TaskId            *int64                             `json:"taskId,omitempty"`
-	RequestId         *string                            `json:"requestId,omitempty"`
-	PollRequest       *shared.PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *GetCurrentExecutionRequest) GetWorkflowId() (o string) {
+	if v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
 }
 
-// ToWire translates a RecordActivityTaskStartedRequest struct into a Thrift-level intermediate
+type GetCurrentExecutionResponse struct {
+	RunId          *string                              `json:"runId,omitempty"`
+	StartRequestId *string                              `json:"startRequestId,omitempty"`
+	State          *shared.WorkflowExecutionState       `json:"state,omitempty"`
+	CloseStatus    *shared.WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+}
+
+// ToWire translates a GetCurrentExecutionResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1331,77 +1211,67 @@ type RecordActivityTaskStartedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordActivityTaskStartedRequest) ToWire() (wire.Value, error) {
+func (v *GetCurrentExecutionResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.StartRequestId != nil {
+		w, err = wire.NewValueString(*(v.StartRequestId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ScheduleId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
+	if v.State != nil {
+		w, err = v.State.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.TaskId != nil {
-		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+	if v.CloseStatus != nil {
+		w, err = v.CloseStatus.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 45, Value: w}
-		i++
-	}
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTaskRequest, error) {
-	var v shared.PollForActivityTaskRequest
+func _WorkflowExecutionState_Read(w wire.Value) (shared.WorkflowExecutionState, error) {
+	var v shared.WorkflowExecutionState
 	err := v.FromWire(w)
-	return &v, err
+	return v, err
 }
 
-// FromWire deserializes a RecordActivityTaskStartedRequest struct from its Thrift-level
+func _WorkflowExecutionCloseStatus_Read(w wire.Value) (shared.WorkflowExecutionCloseStatus, error) {
+	var v shared.WorkflowExecutionCloseStatus
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a GetCurrentExecutionResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordActivityTaskStartedRequest struct
+// An error is returned if we were unable to build a GetCurrentExecutionResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1409,12 +1279,12 @@ func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTask
 //     return nil, err
 //   }
 //
-//   var v RecordActivityTaskStartedRequest
+//   var v GetCurrentExecutionResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordActivityTaskStartedRequest) FromWire(w wire.Value) error {
+func (v *GetCurrentExecutionResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1423,53 +1293,37 @@ func (v *RecordActivityTaskStartedRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+				v.RunId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StartRequestId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduleId = &x
+			if field.Value.Type() == wire.TI32 {
+				var x shared.WorkflowExecutionState
+				x, err = _WorkflowExecutionState_Read(field.Value)
+				v.State = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.TaskId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 45:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RequestId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForActivityTaskRequest_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x shared.WorkflowExecutionCloseStatus
+				x, err = _WorkflowExecutionCloseStatus_Read(field.Value)
+				v.CloseStatus = &x
 				if err != nil {
 					return err
 				}
@@ -1481,118 +1335,123 @@ func (v *RecordActivityTaskStartedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordActivityTaskStartedRequest
+// String returns a readable string representation of a GetCurrentExecutionResponse
 // struct.
-func (v *RecordActivityTaskStartedRequest) String() string {
+func (v *GetCurrentExecutionResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [4]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.ScheduleId != nil {
-		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
-	if v.TaskId != nil {
-		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
+	if v.StartRequestId != nil {
+		fields[i] = fmt.Sprintf("StartRequestId: %v", *(v.StartRequestId))
 		i++
 	}
-	if v.RequestId != nil {
-		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+	if v.State != nil {
+		fields[i] = fmt.Sprintf("State: %v", *(v.State))
 		i++
 	}
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.CloseStatus != nil {
+		fields[i] = fmt.Sprintf("CloseStatus: %v", *(v.CloseStatus))
 		i++
 	}
 
-	return fmt.Sprintf("RecordActivityTaskStartedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetCurrentExecutionResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordActivityTaskStartedRequest match the
-// provided RecordActivityTaskStartedRequest.
-//
-// This function performs a deep comparison.
-func (v *RecordActivityTaskStartedRequest) Equals(rhs *RecordActivityTaskStartedRequest) bool {
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
+func _WorkflowExecutionState_EqualsPtr(lhs, rhs *shared.WorkflowExecutionState) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
 	}
-	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
+	return lhs == nil && rhs == nil
+}
+
+func _WorkflowExecutionCloseStatus_EqualsPtr(lhs, rhs *shared.WorkflowExecutionCloseStatus) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this GetCurrentExecutionResponse match the
+// provided GetCurrentExecutionResponse.
+//
+// This function performs a deep comparison.
+func (v *GetCurrentExecutionResponse) Equals(rhs *GetCurrentExecutionResponse) bool {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+	if !_String_EqualsPtr(v.StartRequestId, rhs.StartRequestId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+	if !_WorkflowExecutionState_EqualsPtr(v.State, rhs.State) {
 		return false
 	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !_WorkflowExecutionCloseStatus_EqualsPtr(v.CloseStatus, rhs.CloseStatus) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *GetCurrentExecutionResponse) GetRunId() (o string) {
+	if v.RunId != nil {
+		return *v.RunId
 	}
 
 	return
 }
 
-// GetScheduleId returns the value of ScheduleId if it is set or its
+// GetStartRequestId returns the value of StartRequestId if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedRequest) GetScheduleId() (o int64) {
-	if v.ScheduleId != nil {
-		return *v.ScheduleId
+func (v *GetCurrentExecutionResponse) GetStartRequestId() (o string) {
+	if v.StartRequestId != nil {
+		return *v.StartRequestId
 	}
 
 	return
 }
 
-// GetTaskId returns the value of TaskId if it is set or its
+// GetState returns the value of State if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedRequest) GetTaskId() (o int64) {
-	if v.TaskId != nil {
-		return *v.TaskId
+func (v *GetCurrentExecutionResponse) GetState() (o shared.WorkflowExecutionState) {
+	if v.State != nil {
+		return *v.State
 	}
 
 	return
 }
 
-// GetRequestId returns the value of RequestId if it is set or its
+// GetCloseStatus returns the value of CloseStatus if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedRequest) GetRequestId() (o string) {
-	if v.RequestId != nil {
-		return *v.RequestId
+func (v *GetCurrentExecutionResponse) GetCloseStatus() (o shared.WorkflowExecutionCloseStatus) {
+	if v.CloseStatus != nil {
+		return *v.CloseStatus
 	}
 
 	return
 }
 
-type RecordActivityTaskStartedResponse struct {
-	ScheduledEvent                  *shared.HistoryEvent `json:"scheduledEvent,omitempty"`
-	StartedTimestamp                *int64               `json:"startedTimestamp,omitempty"`
-	Attempt                         *int64               `json:"attempt,omitempty"`
-	ScheduledTimestampOfThisAttempt *int64               `json:"scheduledTimestampOfThisAttempt,omitempty"`
+type GetMutableStateRequest struct {
+	DomainUUID          *string                   `json:"domainUUID,omitempty"`
+	Execution           *shared.WorkflowExecution `json:"execution,omitempty"`
+	ExpectedNextEventId *int64                    `json:"expectedNextEventId,omitempty"`
 }
 
-// ToWire translates a RecordActivityTaskStartedResponse struct into a Thrift-level intermediate
+// ToWire translates a GetMutableStateRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1607,61 +1466,47 @@ type RecordActivityTaskStartedResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordActivityTaskStartedResponse) ToWire() (wire.Value, error) {
+func (v *GetMutableStateRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ScheduledEvent != nil {
-		w, err = v.ScheduledEvent.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.StartedTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ScheduledTimestampOfThisAttempt != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTimestampOfThisAttempt)), error(nil)
+	if v.ExpectedNextEventId != nil {
+		w, err = wire.NewValueI64(*(v.ExpectedNextEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _HistoryEvent_Read(w wire.Value) (*shared.HistoryEvent, error) {
-	var v shared.HistoryEvent
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RecordActivityTaskStartedResponse struct from its Thrift-level
+// FromWire deserializes a GetMutableStateRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordActivityTaskStartedResponse struct
+// An error is returned if we were unable to build a GetMutableStateRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1669,49 +1514,39 @@ func _HistoryEvent_Read(w wire.Value) (*shared.HistoryEvent, error) {
 //     return nil, err
 //   }
 //
-//   var v RecordActivityTaskStartedResponse
+//   var v GetMutableStateRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordActivityTaskStartedResponse) FromWire(w wire.Value) error {
+func (v *GetMutableStateRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.ScheduledEvent, err = _HistoryEvent_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedTimestamp = &x
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Attempt = &x
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 50:
+		case 30:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTimestampOfThisAttempt = &x
+				v.ExpectedNextEventId = &x
 				if err != nil {
 					return err
 				}
@@ -1723,100 +1558,94 @@ func (v *RecordActivityTaskStartedResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordActivityTaskStartedResponse
+// String returns a readable string representation of a GetMutableStateRequest
 // struct.
-func (v *RecordActivityTaskStartedResponse) String() string {
+func (v *GetMutableStateRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [3]string
 	i := 0
-	if v.ScheduledEvent != nil {
-		fields[i] = fmt.Sprintf("ScheduledEvent: %v", v.ScheduledEvent)
-		i++
-	}
-	if v.StartedTimestamp != nil {
-		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
-	if v.ScheduledTimestampOfThisAttempt != nil {
-		fields[i] = fmt.Sprintf("ScheduledTimestampOfThisAttempt: %v", *(v.ScheduledTimestampOfThisAttempt))
+	if v.ExpectedNextEventId != nil {
+		fields[i] = fmt.Sprintf("ExpectedNextEventId: %v", *(v.ExpectedNextEventId))
 		i++
 	}
 
-	return fmt.Sprintf("RecordActivityTaskStartedResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordActivityTaskStartedResponse match the
-// provided RecordActivityTaskStartedResponse.
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this GetMutableStateRequest match the
+// provided GetMutableStateRequest.
 //
 // This function performs a deep comparison.
-func (v *RecordActivityTaskStartedResponse) Equals(rhs *RecordActivityTaskStartedResponse) bool {
-	if !((v.ScheduledEvent == nil && rhs.ScheduledEvent == nil) || (v.ScheduledEvent != nil && rhs.ScheduledEvent != nil && v.ScheduledEvent.Equals(rhs.ScheduledEvent))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
+func (v *GetMutableStateRequest) Equals(rhs *GetMutableStateRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ScheduledTimestampOfThisAttempt, rhs.ScheduledTimestampOfThisAttempt) {
+	if !_I64_EqualsPtr(v.ExpectedNextEventId, rhs.ExpectedNextEventId) {
 		return false
 	}
 
 	return true
 }
 
-// GetStartedTimestamp returns the value of StartedTimestamp if it is set or its
-// zero value if it is unset.
-func (v *RecordActivityTaskStartedResponse) GetStartedTimestamp() (o int64) {
-	if v.StartedTimestamp != nil {
-		return *v.StartedTimestamp
-	}
-
-	return
-}
-
-// GetAttempt returns the value of Attempt if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedResponse) GetAttempt() (o int64) {
-	if v.Attempt != nil {
-		return *v.Attempt
+func (v *GetMutableStateRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// GetScheduledTimestampOfThisAttempt returns the value of ScheduledTimestampOfThisAttempt if it is set or its
+// GetExpectedNextEventId returns the value of ExpectedNextEventId if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskStartedResponse) GetScheduledTimestampOfThisAttempt() (o int64) {
-	if v.ScheduledTimestampOfThisAttempt != nil {
-		return *v.ScheduledTimestampOfThisAttempt
+func (v *GetMutableStateRequest) GetExpectedNextEventId() (o int64) {
+	if v.ExpectedNextEventId != nil {
+		return *v.ExpectedNextEventId
 	}
 
 	return
 }
 
-// RecordChildExecutionCompletedRequest is used for reporting the completion of child execution to parent workflow
-// execution which started it.  When a child execution is completed it creates this request and calls the
-// RecordChildExecutionCompleted API with the workflowExecution of parent.  It also sets the completedExecution of the
-// child as it could potentially be different than the ChildExecutionStartedEvent of parent in the situation when
-// child creates multiple runs through ContinueAsNew before finally completing.
-type RecordChildExecutionCompletedRequest struct {
-	DomainUUID         *string                   `json:"domainUUID,omitempty"`
-	WorkflowExecution  *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
-	InitiatedId        *int64                    `json:"initiatedId,omitempty"`
-	CompletedExecution *shared.WorkflowExecution `json:"completedExecution,omitempty"`
-	CompletionEvent    *shared.HistoryEvent      `json:"completionEvent,omitempty"`
+type GetMutableStateResponse struct {
+	Execution                            *shared.WorkflowExecution `json:"execution,omitempty"`
+	WorkflowType                         *shared.WorkflowType      `json:"workflowType,omitempty"`
+	NextEventId                          *int64                    `json:"NextEventId,omitempty"`
+	LastFirstEventId                     *int64                    `json:"LastFirstEventId,omitempty"`
+	TaskList                             *shared.TaskList          `json:"taskList,omitempty"`
+	StickyTaskList                       *shared.TaskList          `json:"stickyTaskList,omitempty"`
+	ClientLibraryVersion                 *string                   `json:"clientLibraryVersion,omitempty"`
+	ClientFeatureVersion                 *string                   `json:"clientFeatureVersion,omitempty"`
+	ClientImpl                           *string                   `json:"clientImpl,omitempty"`
+	IsWorkflowRunning                    *bool                     `json:"isWorkflowRunning,omitempty"`
+	StickyTaskListScheduleToStartTimeout *int32                    `json:"stickyTaskListScheduleToStartTimeout,omitempty"`
 }
 
-// ToWire translates a RecordChildExecutionCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a GetMutableStateResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1831,302 +1660,123 @@ type RecordChildExecutionCompletedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordChildExecutionCompletedRequest) ToWire() (wire.Value, error) {
+func (v *GetMutableStateResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [11]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.InitiatedId != nil {
-		w, err = wire.NewValueI64(*(v.InitiatedId)), error(nil)
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.CompletedExecution != nil {
-		w, err = v.CompletedExecution.ToWire()
+	if v.LastFirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.LastFirstEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.CompletionEvent != nil {
-		w, err = v.CompletionEvent.ToWire()
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a RecordChildExecutionCompletedRequest struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a RecordChildExecutionCompletedRequest struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v RecordChildExecutionCompletedRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *RecordChildExecutionCompletedRequest) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.InitiatedId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.CompletedExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.CompletionEvent, err = _HistoryEvent_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a RecordChildExecutionCompletedRequest
-// struct.
-func (v *RecordChildExecutionCompletedRequest) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [5]string
-	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.InitiatedId != nil {
-		fields[i] = fmt.Sprintf("InitiatedId: %v", *(v.InitiatedId))
-		i++
-	}
-	if v.CompletedExecution != nil {
-		fields[i] = fmt.Sprintf("CompletedExecution: %v", v.CompletedExecution)
-		i++
-	}
-	if v.CompletionEvent != nil {
-		fields[i] = fmt.Sprintf("CompletionEvent: %v", v.CompletionEvent)
-		i++
-	}
-
-	return fmt.Sprintf("RecordChildExecutionCompletedRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-// Equals returns true if all the fields of this RecordChildExecutionCompletedRequest match the
-// provided RecordChildExecutionCompletedRequest.
-//
-// This function performs a deep comparison.
-func (v *RecordChildExecutionCompletedRequest) Equals(rhs *RecordChildExecutionCompletedRequest) bool {
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.InitiatedId, rhs.InitiatedId) {
-		return false
-	}
-	if !((v.CompletedExecution == nil && rhs.CompletedExecution == nil) || (v.CompletedExecution != nil && rhs.CompletedExecution != nil && v.CompletedExecution.Equals(rhs.CompletedExecution))) {
-		return false
-	}
-	if !((v.CompletionEvent == nil && rhs.CompletionEvent == nil) || (v.CompletionEvent != nil && rhs.CompletionEvent != nil && v.CompletionEvent.Equals(rhs.CompletionEvent))) {
-		return false
-	}
-
-	return true
-}
-
-// GetDomainUUID returns the value of DomainUUID if it is set or its
-// zero value if it is unset.
-func (v *RecordChildExecutionCompletedRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
-	}
-
-	return
-}
-
-// GetInitiatedId returns the value of InitiatedId if it is set or its
-// zero value if it is unset.
-func (v *RecordChildExecutionCompletedRequest) GetInitiatedId() (o int64) {
-	if v.InitiatedId != nil {
-		return *v.InitiatedId
-	}
-
-	return
-}
-
-type RecordDecisionTaskStartedRequest struct {
-	DomainUUID        *string                            `json:"domainUUID,omitempty"`
-	WorkflowExecution *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
-	ScheduleId        *int64                             `json:"scheduleId,omitempty"`
-	TaskId            *int64                             `json:"taskId,omitempty"`
-	RequestId         *string                            `json:"requestId,omitempty"`
-	PollRequest       *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
-}
-
-// ToWire translates a RecordDecisionTaskStartedRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *RecordDecisionTaskStartedRequest) ToWire() (wire.Value, error) {
-	var (
-		fields [6]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.StickyTaskList != nil {
+		w, err = v.StickyTaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.ClientLibraryVersion != nil {
+		w, err = wire.NewValueString(*(v.ClientLibraryVersion)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.ScheduleId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
+	if v.ClientFeatureVersion != nil {
+		w, err = wire.NewValueString(*(v.ClientFeatureVersion)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
-	if v.TaskId != nil {
-		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+	if v.ClientImpl != nil {
+		w, err = wire.NewValueString(*(v.ClientImpl)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+	if v.IsWorkflowRunning != nil {
+		w, err = wire.NewValueBool(*(v.IsWorkflowRunning)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 45, Value: w}
+		fields[i] = wire.Field{ID: 100, Value: w}
 		i++
 	}
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.StickyTaskListScheduleToStartTimeout != nil {
+		w, err = wire.NewValueI32(*(v.StickyTaskListScheduleToStartTimeout)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTaskRequest, error) {
-	var v shared.PollForDecisionTaskRequest
+func _WorkflowType_Read(w wire.Value) (*shared.WorkflowType, error) {
+	var v shared.WorkflowType
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RecordDecisionTaskStartedRequest struct from its Thrift-level
+func _TaskList_Read(w wire.Value) (*shared.TaskList, error) {
+	var v shared.TaskList
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a GetMutableStateResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordDecisionTaskStartedRequest struct
+// An error is returned if we were unable to build a GetMutableStateResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2134,21 +1784,19 @@ func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTask
 //     return nil, err
 //   }
 //
-//   var v RecordDecisionTaskStartedRequest
+//   var v GetMutableStateResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordDecisionTaskStartedRequest) FromWire(w wire.Value) error {
+func (v *GetMutableStateResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2156,7 +1804,7 @@ func (v *RecordDecisionTaskStartedRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2166,7 +1814,7 @@ func (v *RecordDecisionTaskStartedRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduleId = &x
+				v.NextEventId = &x
 				if err != nil {
 					return err
 				}
@@ -2176,152 +1824,270 @@ func (v *RecordDecisionTaskStartedRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.TaskId = &x
+				v.LastFirstEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 45:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RequestId = &x
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 50:
+		case 60:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForDecisionTaskRequest_Read(field.Value)
+				v.StickyTaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a RecordDecisionTaskStartedRequest
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClientLibraryVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClientFeatureVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClientImpl = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsWorkflowRunning = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StickyTaskListScheduleToStartTimeout = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetMutableStateResponse
 // struct.
-func (v *RecordDecisionTaskStartedRequest) String() string {
+func (v *GetMutableStateResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [11]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
 		i++
 	}
-	if v.ScheduleId != nil {
-		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
 		i++
 	}
-	if v.TaskId != nil {
-		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
+	if v.LastFirstEventId != nil {
+		fields[i] = fmt.Sprintf("LastFirstEventId: %v", *(v.LastFirstEventId))
 		i++
 	}
-	if v.RequestId != nil {
-		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
 		i++
 	}
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.StickyTaskList != nil {
+		fields[i] = fmt.Sprintf("StickyTaskList: %v", v.StickyTaskList)
+		i++
+	}
+	if v.ClientLibraryVersion != nil {
+		fields[i] = fmt.Sprintf("ClientLibraryVersion: %v", *(v.ClientLibraryVersion))
+		i++
+	}
+	if v.ClientFeatureVersion != nil {
+		fields[i] = fmt.Sprintf("ClientFeatureVersion: %v", *(v.ClientFeatureVersion))
+		i++
+	}
+	if v.ClientImpl != nil {
+		fields[i] = fmt.Sprintf("ClientImpl: %v", *(v.ClientImpl))
+		i++
+	}
+	if v.IsWorkflowRunning != nil {
+		fields[i] = fmt.Sprintf("IsWorkflowRunning: %v", *(v.IsWorkflowRunning))
+		i++
+	}
+	if v.StickyTaskListScheduleToStartTimeout != nil {
+		fields[i] = fmt.Sprintf("StickyTaskListScheduleToStartTimeout: %v", *(v.StickyTaskListScheduleToStartTimeout))
 		i++
 	}
 
-	return fmt.Sprintf("RecordDecisionTaskStartedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetMutableStateResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordDecisionTaskStartedRequest match the
-// provided RecordDecisionTaskStartedRequest.
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this GetMutableStateResponse match the
+// provided GetMutableStateResponse.
 //
 // This function performs a deep comparison.
-func (v *RecordDecisionTaskStartedRequest) Equals(rhs *RecordDecisionTaskStartedRequest) bool {
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+func (v *GetMutableStateResponse) Equals(rhs *GetMutableStateResponse) bool {
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+	if !_I64_EqualsPtr(v.LastFirstEventId, rhs.LastFirstEventId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
 		return false
 	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !((v.StickyTaskList == nil && rhs.StickyTaskList == nil) || (v.StickyTaskList != nil && rhs.StickyTaskList != nil && v.StickyTaskList.Equals(rhs.StickyTaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClientLibraryVersion, rhs.ClientLibraryVersion) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClientFeatureVersion, rhs.ClientFeatureVersion) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClientImpl, rhs.ClientImpl) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsWorkflowRunning, rhs.IsWorkflowRunning) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StickyTaskListScheduleToStartTimeout, rhs.StickyTaskListScheduleToStartTimeout) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetNextEventId returns the value of NextEventId if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *GetMutableStateResponse) GetNextEventId() (o int64) {
+	if v.NextEventId != nil {
+		return *v.NextEventId
 	}
 
 	return
 }
 
-// GetScheduleId returns the value of ScheduleId if it is set or its
+// GetLastFirstEventId returns the value of LastFirstEventId if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedRequest) GetScheduleId() (o int64) {
-	if v.ScheduleId != nil {
-		return *v.ScheduleId
+func (v *GetMutableStateResponse) GetLastFirstEventId() (o int64) {
+	if v.LastFirstEventId != nil {
+		return *v.LastFirstEventId
 	}
 
 	return
 }
 
-// GetTaskId returns the value of TaskId if it is set or its
+// GetClientLibraryVersion returns the value of ClientLibraryVersion if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedRequest) GetTaskId() (o int64) {
-	if v.TaskId != nil {
-		return *v.TaskId
+func (v *GetMutableStateResponse) GetClientLibraryVersion() (o string) {
+	if v.ClientLibraryVersion != nil {
+		return *v.ClientLibraryVersion
 	}
 
 	return
 }
 
-// GetRequestId returns the value of RequestId if it is set or its
+// GetClientFeatureVersion returns the value of ClientFeatureVersion if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedRequest) GetRequestId() (o string) {
-	if v.RequestId != nil {
-		return *v.RequestId
+func (v *GetMutableStateResponse) GetClientFeatureVersion() (o string) {
+	if v.ClientFeatureVersion != nil {
+		return *v.ClientFeatureVersion
 	}
 
 	return
 }
 
-type RecordDecisionTaskStartedResponse struct {
-	WorkflowType           *shared.WorkflowType          `json:"workflowType,omitempty"`
-	PreviousStartedEventId *int64                        `json:"previousStartedEventId,omitempty"`
-	ScheduledEventId       *int64                        `json:"scheduledEventId,omitempty"`
-	StartedEventId         *int64                        `json:"startedEventId,omitempty"`
-	NextEventId            *int64                        `json:"nextEventId,omitempty"`
-	Attempt                *int64                        `json:"attempt,omitempty"`
-	StickyExecutionEnabled *bool                         `json:"stickyExecutionEnabled,omitempty"`
-	DecisionInfo           *shared.TransientDecisionInfo `json:"decisionInfo,omitempty"`
+// GetClientImpl returns the value of ClientImpl if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateResponse) GetClientImpl() (o string) {
+	if v.ClientImpl != nil {
+		return *v.ClientImpl
+	}
+
+	return
 }
 
-// ToWire translates a RecordDecisionTaskStartedResponse struct into a Thrift-level intermediate
+// GetIsWorkflowRunning returns the value of IsWorkflowRunning if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateResponse) GetIsWorkflowRunning() (o bool) {
+	if v.IsWorkflowRunning != nil {
+		return *v.IsWorkflowRunning
+	}
+
+	return
+}
+
+// GetStickyTaskListScheduleToStartTimeout returns the value of StickyTaskListScheduleToStartTimeout if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateResponse) GetStickyTaskListScheduleToStartTimeout() (o int32) {
+	if v.StickyTaskListScheduleToStartTimeout != nil {
+		return *v.StickyTaskListScheduleToStartTimeout
+	}
+
+	return
+}
+
+type GetWorkflowExecutionHistoryEventRequest struct {
+	DomainUUID *string                   `json:"domainUUID,omitempty"`
+	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
+	EventId    *int64                    `json:"eventId,omitempty"`
+}
+
+// ToWire translates a GetWorkflowExecutionHistoryEventRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2336,93 +2102,47 @@ type RecordDecisionTaskStartedResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordDecisionTaskStartedResponse) ToWire() (wire.Value, error) {
+func (v *GetWorkflowExecutionHistoryEventRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.PreviousStartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ScheduledEventId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
+	if v.EventId != nil {
+		w, err = wire.NewValueI64(*(v.EventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.StickyExecutionEnabled != nil {
-		w, err = wire.NewValueBool(*(v.StickyExecutionEnabled)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.DecisionInfo != nil {
-		w, err = v.DecisionInfo.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, error) {
-	var v shared.TransientDecisionInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RecordDecisionTaskStartedResponse struct from its Thrift-level
+// FromWire deserializes a GetWorkflowExecutionHistoryEventRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordDecisionTaskStartedResponse struct
+// An error is returned if we were unable to build a GetWorkflowExecutionHistoryEventRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2430,29 +2150,29 @@ func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, e
 //     return nil, err
 //   }
 //
-//   var v RecordDecisionTaskStartedResponse
+//   var v GetWorkflowExecutionHistoryEventRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordDecisionTaskStartedResponse) FromWire(w wire.Value) error {
+func (v *GetWorkflowExecutionHistoryEventRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.PreviousStartedEventId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2462,55 +2182,7 @@ func (v *RecordDecisionTaskStartedResponse) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.StickyExecutionEnabled = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.DecisionInfo, err = _TransientDecisionInfo_Read(field.Value)
+				v.EventId = &x
 				if err != nil {
 					return err
 				}
@@ -2522,151 +2194,74 @@ func (v *RecordDecisionTaskStartedResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordDecisionTaskStartedResponse
+// String returns a readable string representation of a GetWorkflowExecutionHistoryEventRequest
 // struct.
-func (v *RecordDecisionTaskStartedResponse) String() string {
+func (v *GetWorkflowExecutionHistoryEventRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [3]string
 	i := 0
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
-		i++
-	}
-	if v.PreviousStartedEventId != nil {
-		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
-		i++
-	}
-	if v.ScheduledEventId != nil {
-		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
-		i++
-	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.StickyExecutionEnabled != nil {
-		fields[i] = fmt.Sprintf("StickyExecutionEnabled: %v", *(v.StickyExecutionEnabled))
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
-	if v.DecisionInfo != nil {
-		fields[i] = fmt.Sprintf("DecisionInfo: %v", v.DecisionInfo)
+	if v.EventId != nil {
+		fields[i] = fmt.Sprintf("EventId: %v", *(v.EventId))
 		i++
 	}
 
-	return fmt.Sprintf("RecordDecisionTaskStartedResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetWorkflowExecutionHistoryEventRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordDecisionTaskStartedResponse match the
-// provided RecordDecisionTaskStartedResponse.
+// Equals returns true if all the fields of this GetWorkflowExecutionHistoryEventRequest match the
+// provided GetWorkflowExecutionHistoryEventRequest.
 //
 // This function performs a deep comparison.
-func (v *RecordDecisionTaskStartedResponse) Equals(rhs *RecordDecisionTaskStartedResponse) bool {
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
+func (v *GetWorkflowExecutionHistoryEventRequest) Equals(rhs *GetWorkflowExecutionHistoryEventRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.StickyExecutionEnabled, rhs.StickyExecutionEnabled) {
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
-	if !((v.DecisionInfo == nil && rhs.DecisionInfo == nil) || (v.DecisionInfo != nil && rhs.DecisionInfo != nil && v.DecisionInfo.Equals(rhs.DecisionInfo))) {
+	if !_I64_EqualsPtr(v.EventId, rhs.EventId) {
 		return false
 	}
 
 	return true
 }
 
-// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
-// zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetPreviousStartedEventId() (o int64) {
-	if v.PreviousStartedEventId != nil {
-		return *v.PreviousStartedEventId
-	}
-
-	return
-}
-
-// GetScheduledEventId returns the value of ScheduledEventId if it is set or its
-// zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetScheduledEventId() (o int64) {
-	if v.ScheduledEventId != nil {
-		return *v.ScheduledEventId
-	}
-
-	return
-}
-
-// GetStartedEventId returns the value of StartedEventId if it is set or its
-// zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetStartedEventId() (o int64) {
-	if v.StartedEventId != nil {
-		return *v.StartedEventId
-	}
-
-	return
-}
-
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetNextEventId() (o int64) {
-	if v.NextEventId != nil {
-		return *v.NextEventId
-	}
-
-	return
-}
-
-// GetAttempt returns the value of Attempt if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetAttempt() (o int64) {
-	if v.Attempt != nil {
-		return *v.Attempt
+func (v *GetWorkflowExecutionHistoryEventRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// GetStickyExecutionEnabled returns the value of StickyExecutionEnabled if it is set or its
+// GetEventId returns the value of EventId if it is set or its
 // zero value if it is unset.
-func (v *RecordDecisionTaskStartedResponse) GetStickyExecutionEnabled() (o bool) {
-	if v.StickyExecutionEnabled != nil {
-		return *v.StickyExecutionEnabled
+func (v *GetWorkflowExecutionHistoryEventRequest) GetEventId() (o int64) {
+	if v.EventId != nil {
+		return *v.EventId
 	}
 
 	return
 }
 
-type RemoveSignalMutableStateRequest struct {
-	DomainUUID        *string                   `json:"domainUUID,omitempty"`
-	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
-	RequestId         *string                   `json:"requestId,omitempty"`
+type GetWorkflowExecutionHistoryEventResponse struct {
+	Event *shared.HistoryEvent `json:"event,omitempty"`
 }
 
-// ToWire translates a RemoveSignalMutableStateRequest struct into a Thrift-level intermediate
+// ToWire translates a GetWorkflowExecutionHistoryEventResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2681,47 +2276,37 @@ type RemoveSignalMutableStateRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RemoveSignalMutableStateRequest) ToWire() (wire.Value, error) {
+func (v *GetWorkflowExecutionHistoryEventResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.Event != nil {
+		w, err = v.Event.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RemoveSignalMutableStateRequest struct from its Thrift-level
+func _HistoryEvent_Read(w wire.Value) (*shared.HistoryEvent, error) {
+	var v shared.HistoryEvent
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a GetWorkflowExecutionHistoryEventResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RemoveSignalMutableStateRequest struct
+// An error is returned if we were unable to build a GetWorkflowExecutionHistoryEventResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2729,39 +2314,19 @@ func (v *RemoveSignalMutableStateRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RemoveSignalMutableStateRequest
+//   var v GetWorkflowExecutionHistoryEventResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RemoveSignalMutableStateRequest) FromWire(w wire.Value) error {
+func (v *GetWorkflowExecutionHistoryEventResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RequestId = &x
+				v.Event, err = _HistoryEvent_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2773,120 +2338,50 @@ func (v *RemoveSignalMutableStateRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RemoveSignalMutableStateRequest
+// String returns a readable string representation of a GetWorkflowExecutionHistoryEventResponse
 // struct.
-func (v *RemoveSignalMutableStateRequest) String() string {
+func (v *GetWorkflowExecutionHistoryEventResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [1]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.RequestId != nil {
-		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+	if v.Event != nil {
+		fields[i] = fmt.Sprintf("Event: %v", v.Event)
 		i++
 	}
 
-	return fmt.Sprintf("RemoveSignalMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetWorkflowExecutionHistoryEventResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RemoveSignalMutableStateRequest match the
-// provided RemoveSignalMutableStateRequest.
+// Equals returns true if all the fields of this GetWorkflowExecutionHistoryEventResponse match the
+// provided GetWorkflowExecutionHistoryEventResponse.
 //
 // This function performs a deep comparison.
-func (v *RemoveSignalMutableStateRequest) Equals(rhs *RemoveSignalMutableStateRequest) bool {
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+func (v *GetWorkflowExecutionHistoryEventResponse) Equals(rhs *GetWorkflowExecutionHistoryEventResponse) bool {
+	if !((v.Event == nil && rhs.Event == nil) || (v.Event != nil && rhs.Event != nil && v.Event.Equals(rhs.Event))) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
-// zero value if it is unset.
-func (v *RemoveSignalMutableStateRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
-	}
-
-	return
-}
-
-// GetRequestId returns the value of RequestId if it is set or its
-// zero value if it is unset.
-func (v *RemoveSignalMutableStateRequest) GetRequestId() (o string) {
-	if v.RequestId != nil {
-		return *v.RequestId
-	}
-
-	return
-}
-
-type ReplicateEventsRequest struct {
-	SourceCluster     *string                     `json:"sourceCluster,omitempty"`
-	DomainUUID        *string                     `json:"domainUUID,omitempty"`
-	WorkflowExecution *shared.WorkflowExecution   `json:"workflowExecution,omitempty"`
-	FirstEventId      *int64                      `json:"firstEventId,omitempty"`
-	NextEventId       *int64                      `json:"nextEventId,omitempty"`
-	Version           *int64                      `json:"version,omitempty"`
-	ReplicationInfo   map[string]*ReplicationInfo `json:"replicationInfo,omitempty"`
-	History           *shared.History             `json:"history,omitempty"`
-	NewRunHistory     *shared.History             `json:"newRunHistory,omitempty"`
-}
-
-type _Map_String_ReplicationInfo_MapItemList map[string]*ReplicationInfo
-
-func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
-
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+type HistoryShardStatus struct {
+	ShardId                  *int32 `json:"shardId,omitempty"`
+	TransferAckLevel         *int64 `json:"transferAckLevel,omitempty"`
+	TransferMaxReadLevel     *int64 `json:"transferMaxReadLevel,omitempty"`
+	TransferTaskIDLag        *int64 `json:"transferTaskIDLag,omitempty"`
+	TransferTasksInFlight    *int32 `json:"transferTasksInFlight,omitempty"`
+	TimerAckLevel            *int64 `json:"timerAckLevel,omitempty"`
+	TimerTasksInFlight       *int32 `json:"timerTasksInFlight,omitempty"`
+	ReplicatorAckLevel       *int64 `json:"replicatorAckLevel,omitempty"`
+	ReplicationTaskIDLag     *int64 `json:"replicationTaskIDLag,omitempty"`
+	ReplicationTasksInFlight *int32 `json:"replicationTasksInFlight,omitempty"`
+	HistoryCacheSize         *int32 `json:"historyCacheSize,omitempty"`
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) Close() {}
-
-// ToWire translates a ReplicateEventsRequest struct into a Thrift-level intermediate
+// ToWire translates a HistoryShardStatus struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2901,135 +2396,111 @@ func (_Map_String_ReplicationInfo_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ReplicateEventsRequest) ToWire() (wire.Value, error) {
+func (v *HistoryShardStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [9]wire.Field
+		fields [11]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.SourceCluster != nil {
-		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+	if v.ShardId != nil {
+		w, err = wire.NewValueI32(*(v.ShardId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.TransferAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.TransferAckLevel)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.TransferMaxReadLevel != nil {
+		w, err = wire.NewValueI64(*(v.TransferMaxReadLevel)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.FirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+	if v.TransferTaskIDLag != nil {
+		w, err = wire.NewValueI64(*(v.TransferTaskIDLag)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+	if v.TransferTasksInFlight != nil {
+		w, err = wire.NewValueI32(*(v.TransferTasksInFlight)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.Version != nil {
-		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+	if v.TimerAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.TimerAckLevel)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+	if v.TimerTasksInFlight != nil {
+		w, err = wire.NewValueI32(*(v.TimerTasksInFlight)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.History != nil {
-		w, err = v.History.ToWire()
+	if v.ReplicatorAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.ReplicatorAckLevel)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
-	if v.NewRunHistory != nil {
-		w, err = v.NewRunHistory.ToWire()
+	if v.ReplicationTaskIDLag != nil {
+		w, err = wire.NewValueI64(*(v.ReplicationTaskIDLag)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _ReplicationInfo_Read(w wire.Value) (*ReplicationInfo, error) {
-	var v ReplicationInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*ReplicationInfo, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make(map[string]*ReplicationInfo, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
+	if v.ReplicationTasksInFlight != nil {
+		w, err = wire.NewValueI32(*(v.ReplicationTasksInFlight)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-
-		v, err := _ReplicationInfo_Read(x.Value)
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.HistoryCacheSize != nil {
+		w, err = wire.NewValueI32(*(v.HistoryCacheSize)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
 
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
-}
-
-func _History_Read(w wire.Value) (*shared.History, error) {
-	var v shared.History
-	err := v.FromWire(w)
-	return &v, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ReplicateEventsRequest struct from its Thrift-level
+// FromWire deserializes a HistoryShardStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReplicateEventsRequest struct
+// An error is returned if we were unable to build a HistoryShardStatus struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3037,39 +2508,41 @@ func _History_Read(w wire.Value) (*shared.History, error) {
 //     return nil, err
 //   }
 //
-//   var v ReplicateEventsRequest
+//   var v HistoryShardStatus
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
+func (v *HistoryShardStatus) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.SourceCluster = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TransferAckLevel = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TransferMaxReadLevel = &x
 				if err != nil {
 					return err
 				}
@@ -3079,17 +2552,17 @@ func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.FirstEventId = &x
+				v.TransferTaskIDLag = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TransferTasksInFlight = &x
 				if err != nil {
 					return err
 				}
@@ -3099,31 +2572,57 @@ func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.Version = &x
+				v.TimerAckLevel = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 70:
-			if field.Value.Type() == wire.TMap {
-				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TimerTasksInFlight = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.History, err = _History_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ReplicatorAckLevel = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 90:
-			if field.Value.Type() == wire.TStruct {
-				v.NewRunHistory, err = _History_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ReplicationTaskIDLag = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ReplicationTasksInFlight = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.HistoryCacheSize = &x
 				if err != nil {
 					return err
 				}
@@ -3135,164 +2634,4367 @@ func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicateEventsRequest
+// String returns a readable string representation of a HistoryShardStatus
 // struct.
-func (v *ReplicateEventsRequest) String() string {
+func (v *HistoryShardStatus) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [9]string
+	var fields [11]string
 	i := 0
-	if v.SourceCluster != nil {
-		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
 		i++
 	}
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.TransferAckLevel != nil {
+		fields[i] = fmt.Sprintf("TransferAckLevel: %v", *(v.TransferAckLevel))
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.TransferMaxReadLevel != nil {
+		fields[i] = fmt.Sprintf("TransferMaxReadLevel: %v", *(v.TransferMaxReadLevel))
 		i++
 	}
-	if v.FirstEventId != nil {
-		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
+	if v.TransferTaskIDLag != nil {
+		fields[i] = fmt.Sprintf("TransferTaskIDLag: %v", *(v.TransferTaskIDLag))
 		i++
 	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+	if v.TransferTasksInFlight != nil {
+		fields[i] = fmt.Sprintf("TransferTasksInFlight: %v", *(v.TransferTasksInFlight))
 		i++
 	}
-	if v.Version != nil {
-		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+	if v.TimerAckLevel != nil {
+		fields[i] = fmt.Sprintf("TimerAckLevel: %v", *(v.TimerAckLevel))
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+	if v.TimerTasksInFlight != nil {
+		fields[i] = fmt.Sprintf("TimerTasksInFlight: %v", *(v.TimerTasksInFlight))
 		i++
 	}
-	if v.History != nil {
-		fields[i] = fmt.Sprintf("History: %v", v.History)
+	if v.ReplicatorAckLevel != nil {
+		fields[i] = fmt.Sprintf("ReplicatorAckLevel: %v", *(v.ReplicatorAckLevel))
 		i++
 	}
-	if v.NewRunHistory != nil {
-		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
+	if v.ReplicationTaskIDLag != nil {
+		fields[i] = fmt.Sprintf("ReplicationTaskIDLag: %v", *(v.ReplicationTaskIDLag))
+		i++
+	}
+	if v.ReplicationTasksInFlight != nil {
+		fields[i] = fmt.Sprintf("ReplicationTasksInFlight: %v", *(v.ReplicationTasksInFlight))
+		i++
+	}
+	if v.HistoryCacheSize != nil {
+		fields[i] = fmt.Sprintf("HistoryCacheSize: %v", *(v.HistoryCacheSize))
 		i++
 	}
 
-	return fmt.Sprintf("ReplicateEventsRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("HistoryShardStatus{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*ReplicationInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-	return true
-}
-
-// Equals returns true if all the fields of this ReplicateEventsRequest match the
-// provided ReplicateEventsRequest.
+// Equals returns true if all the fields of this HistoryShardStatus match the
+// provided HistoryShardStatus.
 //
 // This function performs a deep comparison.
-func (v *ReplicateEventsRequest) Equals(rhs *ReplicateEventsRequest) bool {
-	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+func (v *HistoryShardStatus) Equals(rhs *HistoryShardStatus) bool {
+	if !_I32_EqualsPtr(v.ShardId, rhs.ShardId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+	if !_I64_EqualsPtr(v.TransferAckLevel, rhs.TransferAckLevel) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !_I64_EqualsPtr(v.TransferMaxReadLevel, rhs.TransferMaxReadLevel) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
+	if !_I64_EqualsPtr(v.TransferTaskIDLag, rhs.TransferTaskIDLag) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+	if !_I32_EqualsPtr(v.TransferTasksInFlight, rhs.TransferTasksInFlight) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+	if !_I64_EqualsPtr(v.TimerAckLevel, rhs.TimerAckLevel) {
 		return false
 	}
-	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+	if !_I32_EqualsPtr(v.TimerTasksInFlight, rhs.TimerTasksInFlight) {
 		return false
 	}
-	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+	if !_I64_EqualsPtr(v.ReplicatorAckLevel, rhs.ReplicatorAckLevel) {
 		return false
 	}
-	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
+	if !_I64_EqualsPtr(v.ReplicationTaskIDLag, rhs.ReplicationTaskIDLag) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ReplicationTasksInFlight, rhs.ReplicationTasksInFlight) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.HistoryCacheSize, rhs.HistoryCacheSize) {
 		return false
 	}
 
 	return true
 }
 
-// GetSourceCluster returns the value of SourceCluster if it is set or its
+// GetShardId returns the value of ShardId if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetSourceCluster() (o string) {
-	if v.SourceCluster != nil {
-		return *v.SourceCluster
+func (v *HistoryShardStatus) GetShardId() (o int32) {
+	if v.ShardId != nil {
+		return *v.ShardId
 	}
 
 	return
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetTransferAckLevel returns the value of TransferAckLevel if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *HistoryShardStatus) GetTransferAckLevel() (o int64) {
+	if v.TransferAckLevel != nil {
+		return *v.TransferAckLevel
 	}
 
 	return
 }
 
-// GetFirstEventId returns the value of FirstEventId if it is set or its
+// GetTransferMaxReadLevel returns the value of TransferMaxReadLevel if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetFirstEventId() (o int64) {
-	if v.FirstEventId != nil {
-		return *v.FirstEventId
+func (v *HistoryShardStatus) GetTransferMaxReadLevel() (o int64) {
+	if v.TransferMaxReadLevel != nil {
+		return *v.TransferMaxReadLevel
 	}
 
 	return
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
+// GetTransferTaskIDLag returns the value of TransferTaskIDLag if it is set or its
 // zero value if it is unset.
-func (v *ReplicateEventsRequest) GetNextEventId() (o int64) {
-	if v.NextEventId != nil {
-		return *v.NextEventId
+func (v *HistoryShardStatus) GetTransferTaskIDLag() (o int64) {
+	if v.TransferTaskIDLag != nil {
+		return *v.TransferTaskIDLag
+	}
+
+	return
+}
+
+// GetTransferTasksInFlight returns the value of TransferTasksInFlight if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetTransferTasksInFlight() (o int32) {
+	if v.TransferTasksInFlight != nil {
+		return *v.TransferTasksInFlight
+	}
+
+	return
+}
+
+// GetTimerAckLevel returns the value of TimerAckLevel if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetTimerAckLevel() (o int64) {
+	if v.TimerAckLevel != nil {
+		return *v.TimerAckLevel
+	}
+
+	return
+}
+
+// GetTimerTasksInFlight returns the value of TimerTasksInFlight if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetTimerTasksInFlight() (o int32) {
+	if v.TimerTasksInFlight != nil {
+		return *v.TimerTasksInFlight
+	}
+
+	return
+}
+
+// GetReplicatorAckLevel returns the value of ReplicatorAckLevel if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetReplicatorAckLevel() (o int64) {
+	if v.ReplicatorAckLevel != nil {
+		return *v.ReplicatorAckLevel
+	}
+
+	return
+}
+
+// GetReplicationTaskIDLag returns the value of ReplicationTaskIDLag if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetReplicationTaskIDLag() (o int64) {
+	if v.ReplicationTaskIDLag != nil {
+		return *v.ReplicationTaskIDLag
+	}
+
+	return
+}
+
+// GetReplicationTasksInFlight returns the value of ReplicationTasksInFlight if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetReplicationTasksInFlight() (o int32) {
+	if v.ReplicationTasksInFlight != nil {
+		return *v.ReplicationTasksInFlight
+	}
+
+	return
+}
+
+// GetHistoryCacheSize returns the value of HistoryCacheSize if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetHistoryCacheSize() (o int32) {
+	if v.HistoryCacheSize != nil {
+		return *v.HistoryCacheSize
+	}
+
+	return
+}
+
+type ImportWorkflowExecutionRequest struct {
+	DomainUUID *string                   `json:"domainUUID,omitempty"`
+	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
+	History    *shared.History           `json:"history,omitempty"`
+}
+
+// ToWire translates a ImportWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ImportWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = v.History.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ImportWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ImportWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ImportWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ImportWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.History, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ImportWorkflowExecutionRequest
+// struct.
+func (v *ImportWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+
+	return fmt.Sprintf("ImportWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ImportWorkflowExecutionRequest match the
+// provided ImportWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *ImportWorkflowExecutionRequest) Equals(rhs *ImportWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *ImportWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+type ListWorkflowExecutionChainRequest struct {
+	DomainUUID *string                   `json:"domainUUID,omitempty"`
+	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a ListWorkflowExecutionChainRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListWorkflowExecutionChainRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ListWorkflowExecutionChainRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListWorkflowExecutionChainRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListWorkflowExecutionChainRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListWorkflowExecutionChainRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListWorkflowExecutionChainRequest
+// struct.
+func (v *ListWorkflowExecutionChainRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("ListWorkflowExecutionChainRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ListWorkflowExecutionChainRequest match the
+// provided ListWorkflowExecutionChainRequest.
+//
+// This function performs a deep comparison.
+func (v *ListWorkflowExecutionChainRequest) Equals(rhs *ListWorkflowExecutionChainRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *ListWorkflowExecutionChainRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+type ListWorkflowExecutionChainResponse struct {
+	Runs      []*WorkflowExecutionChainEntry `json:"runs,omitempty"`
+	Truncated *bool                          `json:"truncated,omitempty"`
+}
+
+type _List_WorkflowExecutionChainEntry_ValueList []*WorkflowExecutionChainEntry
+
+func (v _List_WorkflowExecutionChainEntry_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_WorkflowExecutionChainEntry_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_WorkflowExecutionChainEntry_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_WorkflowExecutionChainEntry_ValueList) Close() {}
+
+// ToWire translates a ListWorkflowExecutionChainResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListWorkflowExecutionChainResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Runs != nil {
+		w, err = wire.NewValueList(_List_WorkflowExecutionChainEntry_ValueList(v.Runs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Truncated != nil {
+		w, err = wire.NewValueBool(*(v.Truncated)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowExecutionChainEntry_Read(w wire.Value) (*WorkflowExecutionChainEntry, error) {
+	var v WorkflowExecutionChainEntry
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_WorkflowExecutionChainEntry_Read(l wire.ValueList) ([]*WorkflowExecutionChainEntry, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*WorkflowExecutionChainEntry, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _WorkflowExecutionChainEntry_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListWorkflowExecutionChainResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListWorkflowExecutionChainResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListWorkflowExecutionChainResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListWorkflowExecutionChainResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Runs, err = _List_WorkflowExecutionChainEntry_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Truncated = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListWorkflowExecutionChainResponse
+// struct.
+func (v *ListWorkflowExecutionChainResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Runs != nil {
+		fields[i] = fmt.Sprintf("Runs: %v", v.Runs)
+		i++
+	}
+	if v.Truncated != nil {
+		fields[i] = fmt.Sprintf("Truncated: %v", *(v.Truncated))
+		i++
+	}
+
+	return fmt.Sprintf("ListWorkflowExecutionChainResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_WorkflowExecutionChainEntry_Equals(lhs, rhs []*WorkflowExecutionChainEntry) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ListWorkflowExecutionChainResponse match the
+// provided ListWorkflowExecutionChainResponse.
+//
+// This function performs a deep comparison.
+func (v *ListWorkflowExecutionChainResponse) Equals(rhs *ListWorkflowExecutionChainResponse) bool {
+	if !((v.Runs == nil && rhs.Runs == nil) || (v.Runs != nil && rhs.Runs != nil && _List_WorkflowExecutionChainEntry_Equals(v.Runs, rhs.Runs))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Truncated, rhs.Truncated) {
+		return false
+	}
+
+	return true
+}
+
+// GetTruncated returns the value of Truncated if it is set or its
+// zero value if it is unset.
+func (v *ListWorkflowExecutionChainResponse) GetTruncated() (o bool) {
+	if v.Truncated != nil {
+		return *v.Truncated
+	}
+
+	return
+}
+
+type NukeWorkflowExecutionRequest struct {
+	DomainUUID        *string                   `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
+	DryRun            *bool                     `json:"dryRun,omitempty"`
+}
+
+// ToWire translates a NukeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *NukeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.DryRun != nil {
+		w, err = wire.NewValueBool(*(v.DryRun)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a NukeWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a NukeWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v NukeWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *NukeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.DryRun = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a NukeWorkflowExecutionRequest
+// struct.
+func (v *NukeWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.DryRun != nil {
+		fields[i] = fmt.Sprintf("DryRun: %v", *(v.DryRun))
+		i++
+	}
+
+	return fmt.Sprintf("NukeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this NukeWorkflowExecutionRequest match the
+// provided NukeWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *NukeWorkflowExecutionRequest) Equals(rhs *NukeWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.DryRun, rhs.DryRun) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *NukeWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetDryRun returns the value of DryRun if it is set or its
+// zero value if it is unset.
+func (v *NukeWorkflowExecutionRequest) GetDryRun() (o bool) {
+	if v.DryRun != nil {
+		return *v.DryRun
+	}
+
+	return
+}
+
+type NukeWorkflowExecutionResponse struct {
+	MutableStateFound     *bool `json:"mutableStateFound,omitempty"`
+	IsCurrentRun          *bool `json:"isCurrentRun,omitempty"`
+	HistoryFound          *bool `json:"historyFound,omitempty"`
+	VisibilityRecordFound *bool `json:"visibilityRecordFound,omitempty"`
+}
+
+// ToWire translates a NukeWorkflowExecutionResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *NukeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.MutableStateFound != nil {
+		w, err = wire.NewValueBool(*(v.MutableStateFound)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.IsCurrentRun != nil {
+		w, err = wire.NewValueBool(*(v.IsCurrentRun)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HistoryFound != nil {
+		w, err = wire.NewValueBool(*(v.HistoryFound)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.VisibilityRecordFound != nil {
+		w, err = wire.NewValueBool(*(v.VisibilityRecordFound)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a NukeWorkflowExecutionResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a NukeWorkflowExecutionResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v NukeWorkflowExecutionResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *NukeWorkflowExecutionResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.MutableStateFound = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsCurrentRun = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.HistoryFound = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.VisibilityRecordFound = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a NukeWorkflowExecutionResponse
+// struct.
+func (v *NukeWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.MutableStateFound != nil {
+		fields[i] = fmt.Sprintf("MutableStateFound: %v", *(v.MutableStateFound))
+		i++
+	}
+	if v.IsCurrentRun != nil {
+		fields[i] = fmt.Sprintf("IsCurrentRun: %v", *(v.IsCurrentRun))
+		i++
+	}
+	if v.HistoryFound != nil {
+		fields[i] = fmt.Sprintf("HistoryFound: %v", *(v.HistoryFound))
+		i++
+	}
+	if v.VisibilityRecordFound != nil {
+		fields[i] = fmt.Sprintf("VisibilityRecordFound: %v", *(v.VisibilityRecordFound))
+		i++
+	}
+
+	return fmt.Sprintf("NukeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this NukeWorkflowExecutionResponse match the
+// provided NukeWorkflowExecutionResponse.
+//
+// This function performs a deep comparison.
+func (v *NukeWorkflowExecutionResponse) Equals(rhs *NukeWorkflowExecutionResponse) bool {
+	if !_Bool_EqualsPtr(v.MutableStateFound, rhs.MutableStateFound) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsCurrentRun, rhs.IsCurrentRun) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.HistoryFound, rhs.HistoryFound) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.VisibilityRecordFound, rhs.VisibilityRecordFound) {
+		return false
+	}
+
+	return true
+}
+
+// GetMutableStateFound returns the value of MutableStateFound if it is set or its
+// zero value if it is unset.
+func (v *NukeWorkflowExecutionResponse) GetMutableStateFound() (o bool) {
+	if v.MutableStateFound != nil {
+		return *v.MutableStateFound
+	}
+
+	return
+}
+
+// GetIsCurrentRun returns the value of IsCurrentRun if it is set or its
+// zero value if it is unset.
+func (v *NukeWorkflowExecutionResponse) GetIsCurrentRun() (o bool) {
+	if v.IsCurrentRun != nil {
+		return *v.IsCurrentRun
+	}
+
+	return
+}
+
+// GetHistoryFound returns the value of HistoryFound if it is set or its
+// zero value if it is unset.
+func (v *NukeWorkflowExecutionResponse) GetHistoryFound() (o bool) {
+	if v.HistoryFound != nil {
+		return *v.HistoryFound
+	}
+
+	return
+}
+
+// GetVisibilityRecordFound returns the value of VisibilityRecordFound if it is set or its
+// zero value if it is unset.
+func (v *NukeWorkflowExecutionResponse) GetVisibilityRecordFound() (o bool) {
+	if v.VisibilityRecordFound != nil {
+		return *v.VisibilityRecordFound
+	}
+
+	return
+}
+
+type ParentExecutionInfo struct {
+	DomainUUID  *string                   `json:"domainUUID,omitempty"`
+	Domain      *string                   `json:"domain,omitempty"`
+	Execution   *shared.WorkflowExecution `json:"execution,omitempty"`
+	InitiatedId *int64                    `json:"initiatedId,omitempty"`
+}
+
+// ToWire translates a ParentExecutionInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ParentExecutionInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 15, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.InitiatedId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ParentExecutionInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ParentExecutionInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ParentExecutionInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ParentExecutionInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 15:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ParentExecutionInfo
+// struct.
+func (v *ParentExecutionInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.InitiatedId != nil {
+		fields[i] = fmt.Sprintf("InitiatedId: %v", *(v.InitiatedId))
+		i++
+	}
+
+	return fmt.Sprintf("ParentExecutionInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ParentExecutionInfo match the
+// provided ParentExecutionInfo.
+//
+// This function performs a deep comparison.
+func (v *ParentExecutionInfo) Equals(rhs *ParentExecutionInfo) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InitiatedId, rhs.InitiatedId) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *ParentExecutionInfo) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ParentExecutionInfo) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// GetInitiatedId returns the value of InitiatedId if it is set or its
+// zero value if it is unset.
+func (v *ParentExecutionInfo) GetInitiatedId() (o int64) {
+	if v.InitiatedId != nil {
+		return *v.InitiatedId
+	}
+
+	return
+}
+
+type PauseWorkflowExecutionRequest struct {
+	DomainUUID        *string                   `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+// ToWire translates a PauseWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PauseWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PauseWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PauseWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PauseWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PauseWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PauseWorkflowExecutionRequest
+// struct.
+func (v *PauseWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+
+	return fmt.Sprintf("PauseWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PauseWorkflowExecutionRequest match the
+// provided PauseWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *PauseWorkflowExecutionRequest) Equals(rhs *PauseWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *PauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+type RecordActivityTaskHeartbeatRequest struct {
+	DomainUUID       *string                                    `json:"domainUUID,omitempty"`
+	HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest `json:"heartbeatRequest,omitempty"`
+}
+
+// ToWire translates a RecordActivityTaskHeartbeatRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.HeartbeatRequest != nil {
+		w, err = v.HeartbeatRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RecordActivityTaskHeartbeatRequest_Read(w wire.Value) (*shared.RecordActivityTaskHeartbeatRequest, error) {
+	var v shared.RecordActivityTaskHeartbeatRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RecordActivityTaskHeartbeatRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RecordActivityTaskHeartbeatRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RecordActivityTaskHeartbeatRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.HeartbeatRequest, err = _RecordActivityTaskHeartbeatRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RecordActivityTaskHeartbeatRequest
+// struct.
+func (v *RecordActivityTaskHeartbeatRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.HeartbeatRequest != nil {
+		fields[i] = fmt.Sprintf("HeartbeatRequest: %v", v.HeartbeatRequest)
+		i++
+	}
+
+	return fmt.Sprintf("RecordActivityTaskHeartbeatRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RecordActivityTaskHeartbeatRequest match the
+// provided RecordActivityTaskHeartbeatRequest.
+//
+// This function performs a deep comparison.
+func (v *RecordActivityTaskHeartbeatRequest) Equals(rhs *RecordActivityTaskHeartbeatRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.HeartbeatRequest == nil && rhs.HeartbeatRequest == nil) || (v.HeartbeatRequest != nil && rhs.HeartbeatRequest != nil && v.HeartbeatRequest.Equals(rhs.HeartbeatRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskHeartbeatRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+type RecordActivityTaskStartedRequest struct {
+	DomainUUID        *string                            `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
+	ScheduleId        *int64                             `json:"scheduleId,omitempty"`
+	TaskId            *int64                             `json:"taskId,omitempty"`
+	RequestId         *string                            `json:"requestId,omitempty"`
+	PollRequest       *shared.PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+}
+
+// ToWire translates a RecordActivityTaskStartedRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RecordActivityTaskStartedRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ScheduleId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskId != nil {
+		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 45, Value: w}
+		i++
+	}
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTaskRequest, error) {
+	var v shared.PollForActivityTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RecordActivityTaskStartedRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RecordActivityTaskStartedRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RecordActivityTaskStartedRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RecordActivityTaskStartedRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduleId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 45:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.PollRequest, err = _PollForActivityTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RecordActivityTaskStartedRequest
+// struct.
+func (v *RecordActivityTaskStartedRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.ScheduleId != nil {
+		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
+		i++
+	}
+	if v.TaskId != nil {
+		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
+		i++
+	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+		i++
+	}
+
+	return fmt.Sprintf("RecordActivityTaskStartedRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RecordActivityTaskStartedRequest match the
+// provided RecordActivityTaskStartedRequest.
+//
+// This function performs a deep comparison.
+func (v *RecordActivityTaskStartedRequest) Equals(rhs *RecordActivityTaskStartedRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskStartedRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetScheduleId returns the value of ScheduleId if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskStartedRequest) GetScheduleId() (o int64) {
+	if v.ScheduleId != nil {
+		return *v.ScheduleId
+	}
+
+	return
+}
+
+// GetTaskId returns the value of TaskId if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskStartedRequest) GetTaskId() (o int64) {
+	if v.TaskId != nil {
+		return *v.TaskId
+	}
+
+	return
+}
+
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskStartedRequest) GetRequestId() (o string) {
+	if v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+type RecordActivityTaskStartedResponse struct {
+	ScheduledEvent                  *shared.HistoryEvent `json:"scheduledEvent,omitempty"`
+	StartedTimestamp                *int64               `json:"startedTimestamp,omitempty"`
+	Attempt                         *int64               `json:"attempt,omitempty"`
+	ScheduledTimestampOfThisAttempt *int64               `json:"scheduledTimestampOfThisAttempt,omitempty"`
+}
+
+// ToWire translates a RecordActivityTaskStartedResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RecordActivityTaskStartedResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ScheduledEvent != nil {
+		w, err = v.ScheduledEvent.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ScheduledTimestampOfThisAttempt != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTimestampOfThisAttempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RecordActivityTaskStartedResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RecordActivityTaskStartedResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RecordActivityTaskStartedResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RecordActivityTaskStartedResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.ScheduledEvent, err = _HistoryEvent_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTimestampOfThisAttempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RecordActivityTaskStartedResponse
+// struct.
+func (v *RecordActivityTaskStartedResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ScheduledEvent != nil {
+		fields[i] = fmt.Sprintf("ScheduledEvent: %v", v.ScheduledEvent)
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.ScheduledTimestampOfThisAttempt != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimestampOfThisAttempt: %v", *(v.ScheduledTimestampOfThisAttempt))
+		i++
+	}
+
+	return fmt.Sprintf("RecordActivityTaskStartedResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RecordActivityTaskStartedResponse match the
+// provided RecordActivityTaskStartedResponse.
+//
+// This function performs a deep comparison.
+func (v *RecordActivityTaskStartedResponse) Equals(rhs *RecordActivityTaskStartedResponse) bool {
+	if !((v.ScheduledEvent == nil && rhs.ScheduledEvent == nil) || (v.ScheduledEvent != nil && rhs.ScheduledEvent != nil && v.ScheduledEvent.Equals(rhs.ScheduledEvent))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledTimestampOfThisAttempt, rhs.ScheduledTimestampOfThisAttempt) {
+		return false
+	}
+
+	return true
+}
+
+// GetStartedTimestamp returns the value of StartedTimestamp if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskStartedResponse) GetStartedTimestamp() (o int64) {
+	if v.StartedTimestamp != nil {
+		return *v.StartedTimestamp
+	}
+
+	return
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskStartedResponse) GetAttempt() (o int64) {
+	if v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// GetScheduledTimestampOfThisAttempt returns the value of ScheduledTimestampOfThisAttempt if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskStartedResponse) GetScheduledTimestampOfThisAttempt() (o int64) {
+	if v.ScheduledTimestampOfThisAttempt != nil {
+		return *v.ScheduledTimestampOfThisAttempt
+	}
+
+	return
+}
+
+// RecordChildExecutionCompletedRequest is used for reporting the completion of child execution to parent workflow
+// execution which started it.  When a child execution is completed it creates this request and calls the
+// RecordChildExecutionCompleted API with the workflowExecution of parent.  It also sets the completedExecution of the
+// child as it could potentially be different than the ChildExecutionStartedEvent of parent in the situation when
+// child creates multiple runs through ContinueAsNew before finally completing.
+type RecordChildExecutionCompletedRequest struct {
+	DomainUUID         *string                   `json:"domainUUID,omitempty"`
+	WorkflowExecution  *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
+	InitiatedId        *int64                    `json:"initiatedId,omitempty"`
+	CompletedExecution *shared.WorkflowExecution `json:"completedExecution,omitempty"`
+	CompletionEvent    *shared.HistoryEvent      `json:"completionEvent,omitempty"`
+}
+
+// ToWire translates a RecordChildExecutionCompletedRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RecordChildExecutionCompletedRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.InitiatedId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.CompletedExecution != nil {
+		w, err = v.CompletedExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.CompletionEvent != nil {
+		w, err = v.CompletionEvent.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RecordChildExecutionCompletedRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RecordChildExecutionCompletedRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RecordChildExecutionCompletedRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RecordChildExecutionCompletedRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.CompletedExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.CompletionEvent, err = _HistoryEvent_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RecordChildExecutionCompletedRequest
+// struct.
+func (v *RecordChildExecutionCompletedRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.InitiatedId != nil {
+		fields[i] = fmt.Sprintf("InitiatedId: %v", *(v.InitiatedId))
+		i++
+	}
+	if v.CompletedExecution != nil {
+		fields[i] = fmt.Sprintf("CompletedExecution: %v", v.CompletedExecution)
+		i++
+	}
+	if v.CompletionEvent != nil {
+		fields[i] = fmt.Sprintf("CompletionEvent: %v", v.CompletionEvent)
+		i++
+	}
+
+	return fmt.Sprintf("RecordChildExecutionCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RecordChildExecutionCompletedRequest match the
+// provided RecordChildExecutionCompletedRequest.
+//
+// This function performs a deep comparison.
+func (v *RecordChildExecutionCompletedRequest) Equals(rhs *RecordChildExecutionCompletedRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InitiatedId, rhs.InitiatedId) {
+		return false
+	}
+	if !((v.CompletedExecution == nil && rhs.CompletedExecution == nil) || (v.CompletedExecution != nil && rhs.CompletedExecution != nil && v.CompletedExecution.Equals(rhs.CompletedExecution))) {
+		return false
+	}
+	if !((v.CompletionEvent == nil && rhs.CompletionEvent == nil) || (v.CompletionEvent != nil && rhs.CompletionEvent != nil && v.CompletionEvent.Equals(rhs.CompletionEvent))) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *RecordChildExecutionCompletedRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetInitiatedId returns the value of InitiatedId if it is set or its
+// zero value if it is unset.
+func (v *RecordChildExecutionCompletedRequest) GetInitiatedId() (o int64) {
+	if v.InitiatedId != nil {
+		return *v.InitiatedId
+	}
+
+	return
+}
+
+type RecordDecisionTaskStartedRequest struct {
+	DomainUUID        *string                            `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
+	ScheduleId        *int64                             `json:"scheduleId,omitempty"`
+	TaskId            *int64                             `json:"taskId,omitempty"`
+	RequestId         *string                            `json:"requestId,omitempty"`
+	PollRequest       *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+}
+
+// ToWire translates a RecordDecisionTaskStartedRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RecordDecisionTaskStartedRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ScheduleId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskId != nil {
+		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 45, Value: w}
+		i++
+	}
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTaskRequest, error) {
+	var v shared.PollForDecisionTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RecordDecisionTaskStartedRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RecordDecisionTaskStartedRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RecordDecisionTaskStartedRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RecordDecisionTaskStartedRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduleId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 45:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.PollRequest, err = _PollForDecisionTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RecordDecisionTaskStartedRequest
+// struct.
+func (v *RecordDecisionTaskStartedRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.ScheduleId != nil {
+		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
+		i++
+	}
+	if v.TaskId != nil {
+		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
+		i++
+	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+		i++
+	}
+
+	return fmt.Sprintf("RecordDecisionTaskStartedRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RecordDecisionTaskStartedRequest match the
+// provided RecordDecisionTaskStartedRequest.
+//
+// This function performs a deep comparison.
+func (v *RecordDecisionTaskStartedRequest) Equals(rhs *RecordDecisionTaskStartedRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetScheduleId returns the value of ScheduleId if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedRequest) GetScheduleId() (o int64) {
+	if v.ScheduleId != nil {
+		return *v.ScheduleId
+	}
+
+	return
+}
+
+// GetTaskId returns the value of TaskId if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedRequest) GetTaskId() (o int64) {
+	if v.TaskId != nil {
+		return *v.TaskId
+	}
+
+	return
+}
+
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedRequest) GetRequestId() (o string) {
+	if v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+type RecordDecisionTaskStartedResponse struct {
+	WorkflowType           *shared.WorkflowType          `json:"workflowType,omitempty"`
+	PreviousStartedEventId *int64                        `json:"previousStartedEventId,omitempty"`
+	ScheduledEventId       *int64                        `json:"scheduledEventId,omitempty"`
+	StartedEventId         *int64                        `json:"startedEventId,omitempty"`
+	NextEventId            *int64                        `json:"nextEventId,omitempty"`
+	Attempt                *int64                        `json:"attempt,omitempty"`
+	StickyExecutionEnabled *bool                         `json:"stickyExecutionEnabled,omitempty"`
+	DecisionInfo           *shared.TransientDecisionInfo `json:"decisionInfo,omitempty"`
+	SuggestContinueAsNew   *bool                         `json:"suggestContinueAsNew,omitempty"`
+}
+
+// ToWire translates a RecordDecisionTaskStartedResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RecordDecisionTaskStartedResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PreviousStartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ScheduledEventId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.StickyExecutionEnabled != nil {
+		w, err = wire.NewValueBool(*(v.StickyExecutionEnabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.DecisionInfo != nil {
+		w, err = v.DecisionInfo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.SuggestContinueAsNew != nil {
+		w, err = wire.NewValueBool(*(v.SuggestContinueAsNew)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, error) {
+	var v shared.TransientDecisionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RecordDecisionTaskStartedResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RecordDecisionTaskStartedResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RecordDecisionTaskStartedResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RecordDecisionTaskStartedResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PreviousStartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.StickyExecutionEnabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.DecisionInfo, err = _TransientDecisionInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.SuggestContinueAsNew = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RecordDecisionTaskStartedResponse
+// struct.
+func (v *RecordDecisionTaskStartedResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+		i++
+	}
+	if v.PreviousStartedEventId != nil {
+		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
+		i++
+	}
+	if v.ScheduledEventId != nil {
+		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
+		i++
+	}
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.StickyExecutionEnabled != nil {
+		fields[i] = fmt.Sprintf("StickyExecutionEnabled: %v", *(v.StickyExecutionEnabled))
+		i++
+	}
+	if v.DecisionInfo != nil {
+		fields[i] = fmt.Sprintf("DecisionInfo: %v", v.DecisionInfo)
+		i++
+	}
+	if v.SuggestContinueAsNew != nil {
+		fields[i] = fmt.Sprintf("SuggestContinueAsNew: %v", *(v.SuggestContinueAsNew))
+		i++
+	}
+
+	return fmt.Sprintf("RecordDecisionTaskStartedResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RecordDecisionTaskStartedResponse match the
+// provided RecordDecisionTaskStartedResponse.
+//
+// This function performs a deep comparison.
+func (v *RecordDecisionTaskStartedResponse) Equals(rhs *RecordDecisionTaskStartedResponse) bool {
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.StickyExecutionEnabled, rhs.StickyExecutionEnabled) {
+		return false
+	}
+	if !((v.DecisionInfo == nil && rhs.DecisionInfo == nil) || (v.DecisionInfo != nil && rhs.DecisionInfo != nil && v.DecisionInfo.Equals(rhs.DecisionInfo))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.SuggestContinueAsNew, rhs.SuggestContinueAsNew) {
+		return false
+	}
+
+	return true
+}
+
+// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedResponse) GetPreviousStartedEventId() (o int64) {
+	if v.PreviousStartedEventId != nil {
+		return *v.PreviousStartedEventId
+	}
+
+	return
+}
+
+// GetScheduledEventId returns the value of ScheduledEventId if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedResponse) GetScheduledEventId() (o int64) {
+	if v.ScheduledEventId != nil {
+		return *v.ScheduledEventId
+	}
+
+	return
+}
+
+// GetStartedEventId returns the value of StartedEventId if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedResponse) GetStartedEventId() (o int64) {
+	if v.StartedEventId != nil {
+		return *v.StartedEventId
+	}
+
+	return
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedResponse) GetNextEventId() (o int64) {
+	if v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedResponse) GetAttempt() (o int64) {
+	if v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// GetStickyExecutionEnabled returns the value of StickyExecutionEnabled if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedResponse) GetStickyExecutionEnabled() (o bool) {
+	if v.StickyExecutionEnabled != nil {
+		return *v.StickyExecutionEnabled
+	}
+
+	return
+}
+
+// GetSuggestContinueAsNew returns the value of SuggestContinueAsNew if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedResponse) GetSuggestContinueAsNew() (o bool) {
+	if v.SuggestContinueAsNew != nil {
+		return *v.SuggestContinueAsNew
+	}
+
+	return
+}
+
+type RefreshDomainCacheRequest struct {
+	Domain *string `json:"domain,omitempty"`
+}
+
+// ToWire translates a RefreshDomainCacheRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RefreshDomainCacheRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RefreshDomainCacheRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RefreshDomainCacheRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RefreshDomainCacheRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RefreshDomainCacheRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RefreshDomainCacheRequest
+// struct.
+func (v *RefreshDomainCacheRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+
+	return fmt.Sprintf("RefreshDomainCacheRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RefreshDomainCacheRequest match the
+// provided RefreshDomainCacheRequest.
+//
+// This function performs a deep comparison.
+func (v *RefreshDomainCacheRequest) Equals(rhs *RefreshDomainCacheRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *RefreshDomainCacheRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+type RemoveSignalMutableStateRequest struct {
+	DomainUUID        *string                   `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
+	RequestId         *string                   `json:"requestId,omitempty"`
+}
+
+// ToWire translates a RemoveSignalMutableStateRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RemoveSignalMutableStateRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RemoveSignalMutableStateRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RemoveSignalMutableStateRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RemoveSignalMutableStateRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RemoveSignalMutableStateRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RemoveSignalMutableStateRequest
+// struct.
+func (v *RemoveSignalMutableStateRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
+
+	return fmt.Sprintf("RemoveSignalMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RemoveSignalMutableStateRequest match the
+// provided RemoveSignalMutableStateRequest.
+//
+// This function performs a deep comparison.
+func (v *RemoveSignalMutableStateRequest) Equals(rhs *RemoveSignalMutableStateRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *RemoveSignalMutableStateRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *RemoveSignalMutableStateRequest) GetRequestId() (o string) {
+	if v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+type ReplicateEventsRequest struct {
+	SourceCluster     *string                     `json:"sourceCluster,omitempty"`
+	DomainUUID        *string                     `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution   `json:"workflowExecution,omitempty"`
+	FirstEventId      *int64                      `json:"firstEventId,omitempty"`
+	NextEventId       *int64                      `json:"nextEventId,omitempty"`
+	Version           *int64                      `json:"version,omitempty"`
+	ReplicationInfo   map[string]*ReplicationInfo `json:"replicationInfo,omitempty"`
+	History           *shared.History             `json:"history,omitempty"`
+	NewRunHistory     *shared.History             `json:"newRunHistory,omitempty"`
+}
+
+type _Map_String_ReplicationInfo_MapItemList map[string]*ReplicationInfo
+
+func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) Close() {}
+
+// ToWire translates a ReplicateEventsRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicateEventsRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = v.History.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.NewRunHistory != nil {
+		w, err = v.NewRunHistory.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationInfo_Read(w wire.Value) (*ReplicationInfo, error) {
+	var v ReplicationInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*ReplicationInfo, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*ReplicationInfo, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _ReplicationInfo_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _History_Read(w wire.Value) (*shared.History, error) {
+	var v shared.History
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ReplicateEventsRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicateEventsRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicateEventsRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicateEventsRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TMap {
+				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.History, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.NewRunHistory, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicateEventsRequest
+// struct.
+func (v *ReplicateEventsRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.NewRunHistory != nil {
+		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
+		i++
+	}
+
+	return fmt.Sprintf("ReplicateEventsRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*ReplicationInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this ReplicateEventsRequest match the
+// provided ReplicateEventsRequest.
+//
+// This function performs a deep comparison.
+func (v *ReplicateEventsRequest) Equals(rhs *ReplicateEventsRequest) bool {
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+		return false
+	}
+	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
+		return false
+	}
+
+	return true
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *ReplicateEventsRequest) GetSourceCluster() (o string) {
+	if v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *ReplicateEventsRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetFirstEventId returns the value of FirstEventId if it is set or its
+// zero value if it is unset.
+func (v *ReplicateEventsRequest) GetFirstEventId() (o int64) {
+	if v.FirstEventId != nil {
+		return *v.FirstEventId
+	}
+
+	return
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *ReplicateEventsRequest) GetNextEventId() (o int64) {
+	if v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *ReplicateEventsRequest) GetVersion() (o int64) {
+	if v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+type ReplicationInfo struct {
+	Version     *int64 `json:"version,omitempty"`
+	LastEventId *int64 `json:"lastEventId,omitempty"`
+}
+
+// ToWire translates a ReplicationInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastEventId != nil {
+		w, err = wire.NewValueI64(*(v.LastEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationInfo
+// struct.
+func (v *ReplicationInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.LastEventId != nil {
+		fields[i] = fmt.Sprintf("LastEventId: %v", *(v.LastEventId))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReplicationInfo match the
+// provided ReplicationInfo.
+//
+// This function performs a deep comparison.
+func (v *ReplicationInfo) Equals(rhs *ReplicationInfo) bool {
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastEventId, rhs.LastEventId) {
+		return false
+	}
+
+	return true
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *ReplicationInfo) GetVersion() (o int64) {
+	if v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// GetLastEventId returns the value of LastEventId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationInfo) GetLastEventId() (o int64) {
+	if v.LastEventId != nil {
+		return *v.LastEventId
+	}
+
+	return
+}
+
+type RequestCancelWorkflowExecutionRequest struct {
+	DomainUUID                *string                                       `json:"domainUUID,omitempty"`
+	CancelRequest             *shared.RequestCancelWorkflowExecutionRequest `json:"cancelRequest,omitempty"`
+	ExternalInitiatedEventId  *int64                                        `json:"externalInitiatedEventId,omitempty"`
+	ExternalWorkflowExecution *shared.WorkflowExecution                     `json:"externalWorkflowExecution,omitempty"`
+	ChildWorkflowOnly         *bool                                         `json:"childWorkflowOnly,omitempty"`
+}
+
+// ToWire translates a RequestCancelWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.CancelRequest != nil {
+		w, err = v.CancelRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ExternalInitiatedEventId != nil {
+		w, err = wire.NewValueI64(*(v.ExternalInitiatedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ExternalWorkflowExecution != nil {
+		w, err = v.ExternalWorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ChildWorkflowOnly != nil {
+		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RequestCancelWorkflowExecutionRequest_Read(w wire.Value) (*shared.RequestCancelWorkflowExecutionRequest, error) {
+	var v shared.RequestCancelWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RequestCancelWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RequestCancelWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RequestCancelWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.CancelRequest, err = _RequestCancelWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExternalInitiatedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.ExternalWorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ChildWorkflowOnly = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RequestCancelWorkflowExecutionRequest
+// struct.
+func (v *RequestCancelWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.CancelRequest != nil {
+		fields[i] = fmt.Sprintf("CancelRequest: %v", v.CancelRequest)
+		i++
+	}
+	if v.ExternalInitiatedEventId != nil {
+		fields[i] = fmt.Sprintf("ExternalInitiatedEventId: %v", *(v.ExternalInitiatedEventId))
+		i++
+	}
+	if v.ExternalWorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("ExternalWorkflowExecution: %v", v.ExternalWorkflowExecution)
+		i++
+	}
+	if v.ChildWorkflowOnly != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
+		i++
+	}
+
+	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RequestCancelWorkflowExecutionRequest match the
+// provided RequestCancelWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.CancelRequest == nil && rhs.CancelRequest == nil) || (v.CancelRequest != nil && rhs.CancelRequest != nil && v.CancelRequest.Equals(rhs.CancelRequest))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExternalInitiatedEventId, rhs.ExternalInitiatedEventId) {
+		return false
+	}
+	if !((v.ExternalWorkflowExecution == nil && rhs.ExternalWorkflowExecution == nil) || (v.ExternalWorkflowExecution != nil && rhs.ExternalWorkflowExecution != nil && v.ExternalWorkflowExecution.Equals(rhs.ExternalWorkflowExecution))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetExternalInitiatedEventId returns the value of ExternalInitiatedEventId if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelWorkflowExecutionRequest) GetExternalInitiatedEventId() (o int64) {
+	if v.ExternalInitiatedEventId != nil {
+		return *v.ExternalInitiatedEventId
+	}
+
+	return
+}
+
+// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelWorkflowExecutionRequest) GetChildWorkflowOnly() (o bool) {
+	if v.ChildWorkflowOnly != nil {
+		return *v.ChildWorkflowOnly
+	}
+
+	return
+}
+
+type ResetStickyTaskListRequest struct {
+	DomainUUID *string                   `json:"domainUUID,omitempty"`
+	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a ResetStickyTaskListRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResetStickyTaskListRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResetStickyTaskListRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResetStickyTaskListRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResetStickyTaskListRequest
+// struct.
+func (v *ResetStickyTaskListRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("ResetStickyTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResetStickyTaskListRequest match the
+// provided ResetStickyTaskListRequest.
+//
+// This function performs a deep comparison.
+func (v *ResetStickyTaskListRequest) Equals(rhs *ResetStickyTaskListRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *ResetStickyTaskListRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+type ResetStickyTaskListResponse struct {
+}
+
+// ToWire translates a ResetStickyTaskListResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResetStickyTaskListResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResetStickyTaskListResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResetStickyTaskListResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResetStickyTaskListResponse) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResetStickyTaskListResponse
+// struct.
+func (v *ResetStickyTaskListResponse) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("ResetStickyTaskListResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetVersion returns the value of Version if it is set or its
-// zero value if it is unset.
-func (v *ReplicateEventsRequest) GetVersion() (o int64) {
-	if v.Version != nil {
-		return *v.Version
-	}
+// Equals returns true if all the fields of this ResetStickyTaskListResponse match the
+// provided ResetStickyTaskListResponse.
+//
+// This function performs a deep comparison.
+func (v *ResetStickyTaskListResponse) Equals(rhs *ResetStickyTaskListResponse) bool {
 
-	return
+	return true
 }
 
-type ReplicationInfo struct {
-	Version     *int64 `json:"version,omitempty"`
-	LastEventId *int64 `json:"lastEventId,omitempty"`
+type RespondActivityTaskCanceledRequest struct {
+	DomainUUID    *string                                    `json:"domainUUID,omitempty"`
+	CancelRequest *shared.RespondActivityTaskCanceledRequest `json:"cancelRequest,omitempty"`
 }
 
-// ToWire translates a ReplicationInfo struct into a Thrift-level intermediate
+// ToWire translates a RespondActivityTaskCanceledRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3307,7 +7009,7 @@ type ReplicationInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ReplicationInfo) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -3315,16 +7017,16 @@ func (v *ReplicationInfo) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Version != nil {
-		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.LastEventId != nil {
-		w, err = wire.NewValueI64(*(v.LastEventId)), error(nil)
+	if v.CancelRequest != nil {
+		w, err = v.CancelRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3335,11 +7037,17 @@ func (v *ReplicationInfo) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ReplicationInfo struct from its Thrift-level
+func _RespondActivityTaskCanceledRequest_Read(w wire.Value) (*shared.RespondActivityTaskCanceledRequest, error) {
+	var v shared.RespondActivityTaskCanceledRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RespondActivityTaskCanceledRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReplicationInfo struct
+// An error is returned if we were unable to build a RespondActivityTaskCanceledRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3347,31 +7055,29 @@ func (v *ReplicationInfo) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ReplicationInfo
+//   var v RespondActivityTaskCanceledRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ReplicationInfo) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Version = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastEventId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.CancelRequest, err = _RespondActivityTaskCanceledRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3383,71 +7089,58 @@ func (v *ReplicationInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicationInfo
+// String returns a readable string representation of a RespondActivityTaskCanceledRequest
 // struct.
-func (v *ReplicationInfo) String() string {
+func (v *RespondActivityTaskCanceledRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.Version != nil {
-		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.LastEventId != nil {
-		fields[i] = fmt.Sprintf("LastEventId: %v", *(v.LastEventId))
+	if v.CancelRequest != nil {
+		fields[i] = fmt.Sprintf("CancelRequest: %v", v.CancelRequest)
 		i++
 	}
 
-	return fmt.Sprintf("ReplicationInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskCanceledRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ReplicationInfo match the
-// provided ReplicationInfo.
+// Equals returns true if all the fields of this RespondActivityTaskCanceledRequest match the
+// provided RespondActivityTaskCanceledRequest.
 //
 // This function performs a deep comparison.
-func (v *ReplicationInfo) Equals(rhs *ReplicationInfo) bool {
-	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanceledRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.LastEventId, rhs.LastEventId) {
+	if !((v.CancelRequest == nil && rhs.CancelRequest == nil) || (v.CancelRequest != nil && rhs.CancelRequest != nil && v.CancelRequest.Equals(rhs.CancelRequest))) {
 		return false
 	}
 
 	return true
 }
 
-// GetVersion returns the value of Version if it is set or its
-// zero value if it is unset.
-func (v *ReplicationInfo) GetVersion() (o int64) {
-	if v.Version != nil {
-		return *v.Version
-	}
-
-	return
-}
-
-// GetLastEventId returns the value of LastEventId if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *ReplicationInfo) GetLastEventId() (o int64) {
-	if v.LastEventId != nil {
-		return *v.LastEventId
+func (v *RespondActivityTaskCanceledRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-type RequestCancelWorkflowExecutionRequest struct {
-	DomainUUID                *string                                       `json:"domainUUID,omitempty"`
-	CancelRequest             *shared.RequestCancelWorkflowExecutionRequest `json:"cancelRequest,omitempty"`
-	ExternalInitiatedEventId  *int64                                        `json:"externalInitiatedEventId,omitempty"`
-	ExternalWorkflowExecution *shared.WorkflowExecution                     `json:"externalWorkflowExecution,omitempty"`
-	ChildWorkflowOnly         *bool                                         `json:"childWorkflowOnly,omitempty"`
+type RespondActivityTaskCompletedRequest struct {
+	DomainUUID      *string                                     `json:"domainUUID,omitempty"`
+	CompleteRequest *shared.RespondActivityTaskCompletedRequest `json:"completeRequest,omitempty"`
 }
 
-// ToWire translates a RequestCancelWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondActivityTaskCompletedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3462,9 +7155,9 @@ type RequestCancelWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -3478,53 +7171,29 @@ func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.CancelRequest != nil {
-		w, err = v.CancelRequest.ToWire()
+	if v.CompleteRequest != nil {
+		w, err = v.CompleteRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ExternalInitiatedEventId != nil {
-		w, err = wire.NewValueI64(*(v.ExternalInitiatedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.ExternalWorkflowExecution != nil {
-		w, err = v.ExternalWorkflowExecution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ChildWorkflowOnly != nil {
-		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RequestCancelWorkflowExecutionRequest_Read(w wire.Value) (*shared.RequestCancelWorkflowExecutionRequest, error) {
-	var v shared.RequestCancelWorkflowExecutionRequest
+func _RespondActivityTaskCompletedRequest_Read(w wire.Value) (*shared.RespondActivityTaskCompletedRequest, error) {
+	var v shared.RespondActivityTaskCompletedRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RequestCancelWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a RespondActivityTaskCompletedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a RespondActivityTaskCompletedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3532,12 +7201,12 @@ func _RequestCancelWorkflowExecutionRequest_Read(w wire.Value) (*shared.RequestC
 //     return nil, err
 //   }
 //
-//   var v RequestCancelWorkflowExecutionRequest
+//   var v RespondActivityTaskCompletedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -3554,35 +7223,7 @@ func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.CancelRequest, err = _RequestCancelWorkflowExecutionRequest_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ExternalInitiatedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.ExternalWorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.ChildWorkflowOnly = &x
+				v.CompleteRequest, err = _RespondActivityTaskCompletedRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3594,99 +7235,58 @@ func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelWorkflowExecutionRequest
+// String returns a readable string representation of a RespondActivityTaskCompletedRequest
 // struct.
-func (v *RequestCancelWorkflowExecutionRequest) String() string {
+func (v *RespondActivityTaskCompletedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [2]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.CancelRequest != nil {
-		fields[i] = fmt.Sprintf("CancelRequest: %v", v.CancelRequest)
-		i++
-	}
-	if v.ExternalInitiatedEventId != nil {
-		fields[i] = fmt.Sprintf("ExternalInitiatedEventId: %v", *(v.ExternalInitiatedEventId))
-		i++
-	}
-	if v.ExternalWorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("ExternalWorkflowExecution: %v", v.ExternalWorkflowExecution)
-		i++
-	}
-	if v.ChildWorkflowOnly != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
+	if v.CompleteRequest != nil {
+		fields[i] = fmt.Sprintf("CompleteRequest: %v", v.CompleteRequest)
 		i++
 	}
 
-	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelWorkflowExecutionRequest match the
-// provided RequestCancelWorkflowExecutionRequest.
+// Equals returns true if all the fields of this RespondActivityTaskCompletedRequest match the
+// provided RespondActivityTaskCompletedRequest.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
+func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCompletedRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.CancelRequest == nil && rhs.CancelRequest == nil) || (v.CancelRequest != nil && rhs.CancelRequest != nil && v.CancelRequest.Equals(rhs.CancelRequest))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ExternalInitiatedEventId, rhs.ExternalInitiatedEventId) {
-		return false
-	}
-	if !((v.ExternalWorkflowExecution == nil && rhs.ExternalWorkflowExecution == nil) || (v.ExternalWorkflowExecution != nil && rhs.ExternalWorkflowExecution != nil && v.ExternalWorkflowExecution.Equals(rhs.ExternalWorkflowExecution))) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
-		return false
-	}
-
-	return true
-}
-
-// GetDomainUUID returns the value of DomainUUID if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
-	}
-
-	return
-}
-
-// GetExternalInitiatedEventId returns the value of ExternalInitiatedEventId if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetExternalInitiatedEventId() (o int64) {
-	if v.ExternalInitiatedEventId != nil {
-		return *v.ExternalInitiatedEventId
+	if !((v.CompleteRequest == nil && rhs.CompleteRequest == nil) || (v.CompleteRequest != nil && rhs.CompleteRequest != nil && v.CompleteRequest.Equals(rhs.CompleteRequest))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetChildWorkflowOnly() (o bool) {
-	if v.ChildWorkflowOnly != nil {
-		return *v.ChildWorkflowOnly
+func (v *RespondActivityTaskCompletedRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-type ResetStickyTaskListRequest struct {
-	DomainUUID *string                   `json:"domainUUID,omitempty"`
-	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
+type RespondActivityTaskFailedRequest struct {
+	DomainUUID    *string                                  `json:"domainUUID,omitempty"`
+	FailedRequest *shared.RespondActivityTaskFailedRequest `json:"failedRequest,omitempty"`
 }
 
-// ToWire translates a ResetStickyTaskListRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondActivityTaskFailedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3701,7 +7301,7 @@ type ResetStickyTaskListRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -3717,8 +7317,8 @@ func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.FailedRequest != nil {
+		w, err = v.FailedRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3729,11 +7329,17 @@ func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetStickyTaskListRequest struct from its Thrift-level
+func _RespondActivityTaskFailedRequest_Read(w wire.Value) (*shared.RespondActivityTaskFailedRequest, error) {
+	var v shared.RespondActivityTaskFailedRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RespondActivityTaskFailedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetStickyTaskListRequest struct
+// An error is returned if we were unable to build a RespondActivityTaskFailedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3741,12 +7347,12 @@ func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetStickyTaskListRequest
+//   var v RespondActivityTaskFailedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -3763,7 +7369,7 @@ func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				v.FailedRequest, err = _RespondActivityTaskFailedRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3775,9 +7381,9 @@ func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetStickyTaskListRequest
+// String returns a readable string representation of a RespondActivityTaskFailedRequest
 // struct.
-func (v *ResetStickyTaskListRequest) String() string {
+func (v *RespondActivityTaskFailedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -3788,23 +7394,23 @@ func (v *ResetStickyTaskListRequest) String() string {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+	if v.FailedRequest != nil {
+		fields[i] = fmt.Sprintf("FailedRequest: %v", v.FailedRequest)
 		i++
 	}
 
-	return fmt.Sprintf("ResetStickyTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetStickyTaskListRequest match the
-// provided ResetStickyTaskListRequest.
+// Equals returns true if all the fields of this RespondActivityTaskFailedRequest match the
+// provided RespondActivityTaskFailedRequest.
 //
 // This function performs a deep comparison.
-func (v *ResetStickyTaskListRequest) Equals(rhs *ResetStickyTaskListRequest) bool {
+func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailedRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+	if !((v.FailedRequest == nil && rhs.FailedRequest == nil) || (v.FailedRequest != nil && rhs.FailedRequest != nil && v.FailedRequest.Equals(rhs.FailedRequest))) {
 		return false
 	}
 
@@ -3813,7 +7419,7 @@ func (v *ResetStickyTaskListRequest) Equals(rhs *ResetStickyTaskListRequest) boo
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *ResetStickyTaskListRequest) GetDomainUUID() (o string) {
+func (v *RespondActivityTaskFailedRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -3821,10 +7427,12 @@ func (v *ResetStickyTaskListRequest) GetDomainUUID() (o string) {
 	return
 }
 
-type ResetStickyTaskListResponse struct {
+type RespondDecisionTaskCompletedRequest struct {
+	DomainUUID      *string                                     `json:"domainUUID,omitempty"`
+	CompleteRequest *shared.RespondDecisionTaskCompletedRequest `json:"completeRequest,omitempty"`
 }
 
-// ToWire translates a ResetStickyTaskListResponse struct into a Thrift-level intermediate
+// ToWire translates a RespondDecisionTaskCompletedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3839,20 +7447,45 @@ type ResetStickyTaskListResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
+func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [0]wire.Field
+		fields [2]wire.Field
 		i      int = 0
+		w      wire.Value
+		err    error
 	)
 
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.CompleteRequest != nil {
+		w, err = v.CompleteRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetStickyTaskListResponse struct from its Thrift-level
+func _RespondDecisionTaskCompletedRequest_Read(w wire.Value) (*shared.RespondDecisionTaskCompletedRequest, error) {
+	var v shared.RespondDecisionTaskCompletedRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RespondDecisionTaskCompletedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetStickyTaskListResponse struct
+// An error is returned if we were unable to build a RespondDecisionTaskCompletedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3860,49 +7493,92 @@ func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetStickyTaskListResponse
+//   var v RespondDecisionTaskCompletedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetStickyTaskListResponse) FromWire(w wire.Value) error {
+func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
+	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.CompleteRequest, err = _RespondDecisionTaskCompletedRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a ResetStickyTaskListResponse
+// String returns a readable string representation of a RespondDecisionTaskCompletedRequest
 // struct.
-func (v *ResetStickyTaskListResponse) String() string {
+func (v *RespondDecisionTaskCompletedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [0]string
+	var fields [2]string
 	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.CompleteRequest != nil {
+		fields[i] = fmt.Sprintf("CompleteRequest: %v", v.CompleteRequest)
+		i++
+	}
 
-	return fmt.Sprintf("ResetStickyTaskListResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondDecisionTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetStickyTaskListResponse match the
-// provided ResetStickyTaskListResponse.
+// Equals returns true if all the fields of this RespondDecisionTaskCompletedRequest match the
+// provided RespondDecisionTaskCompletedRequest.
 //
 // This function performs a deep comparison.
-func (v *ResetStickyTaskListResponse) Equals(rhs *ResetStickyTaskListResponse) bool {
+func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCompletedRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.CompleteRequest == nil && rhs.CompleteRequest == nil) || (v.CompleteRequest != nil && rhs.CompleteRequest != nil && v.CompleteRequest.Equals(rhs.CompleteRequest))) {
+		return false
+	}
 
 	return true
 }
 
-type RespondActivityTaskCanceledRequest struct {
-	DomainUUID    *string                                    `json:"domainUUID,omitempty"`
-	CancelRequest *shared.RespondActivityTaskCanceledRequest `json:"cancelRequest,omitempty"`
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *RespondDecisionTaskCompletedRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
 }
 
-// ToWire translates a RespondActivityTaskCanceledRequest struct into a Thrift-level intermediate
+type RespondDecisionTaskFailedRequest struct {
+	DomainUUID    *string                                  `json:"domainUUID,omitempty"`
+	FailedRequest *shared.RespondDecisionTaskFailedRequest `json:"failedRequest,omitempty"`
+}
+
+// ToWire translates a RespondDecisionTaskFailedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3917,7 +7593,7 @@ type RespondActivityTaskCanceledRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
+func (v *RespondDecisionTaskFailedRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -3933,8 +7609,8 @@ func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.CancelRequest != nil {
-		w, err = v.CancelRequest.ToWire()
+	if v.FailedRequest != nil {
+		w, err = v.FailedRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3945,17 +7621,17 @@ func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondActivityTaskCanceledRequest_Read(w wire.Value) (*shared.RespondActivityTaskCanceledRequest, error) {
-	var v shared.RespondActivityTaskCanceledRequest
+func _RespondDecisionTaskFailedRequest_Read(w wire.Value) (*shared.RespondDecisionTaskFailedRequest, error) {
+	var v shared.RespondDecisionTaskFailedRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RespondActivityTaskCanceledRequest struct from its Thrift-level
+// FromWire deserializes a RespondDecisionTaskFailedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskCanceledRequest struct
+// An error is returned if we were unable to build a RespondDecisionTaskFailedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3963,12 +7639,12 @@ func _RespondActivityTaskCanceledRequest_Read(w wire.Value) (*shared.RespondActi
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskCanceledRequest
+//   var v RespondDecisionTaskFailedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
+func (v *RespondDecisionTaskFailedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -3985,7 +7661,7 @@ func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.CancelRequest, err = _RespondActivityTaskCanceledRequest_Read(field.Value)
+				v.FailedRequest, err = _RespondDecisionTaskFailedRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3997,9 +7673,9 @@ func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskCanceledRequest
+// String returns a readable string representation of a RespondDecisionTaskFailedRequest
 // struct.
-func (v *RespondActivityTaskCanceledRequest) String() string {
+func (v *RespondDecisionTaskFailedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -4010,23 +7686,23 @@ func (v *RespondActivityTaskCanceledRequest) String() string {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.CancelRequest != nil {
-		fields[i] = fmt.Sprintf("CancelRequest: %v", v.CancelRequest)
+	if v.FailedRequest != nil {
+		fields[i] = fmt.Sprintf("FailedRequest: %v", v.FailedRequest)
 		i++
 	}
 
-	return fmt.Sprintf("RespondActivityTaskCanceledRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondDecisionTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskCanceledRequest match the
-// provided RespondActivityTaskCanceledRequest.
+// Equals returns true if all the fields of this RespondDecisionTaskFailedRequest match the
+// provided RespondDecisionTaskFailedRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanceledRequest) bool {
+func (v *RespondDecisionTaskFailedRequest) Equals(rhs *RespondDecisionTaskFailedRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.CancelRequest == nil && rhs.CancelRequest == nil) || (v.CancelRequest != nil && rhs.CancelRequest != nil && v.CancelRequest.Equals(rhs.CancelRequest))) {
+	if !((v.FailedRequest == nil && rhs.FailedRequest == nil) || (v.FailedRequest != nil && rhs.FailedRequest != nil && v.FailedRequest.Equals(rhs.FailedRequest))) {
 		return false
 	}
 
@@ -4035,7 +7711,7 @@ func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanc
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledRequest) GetDomainUUID() (o string) {
+func (v *RespondDecisionTaskFailedRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -4043,12 +7719,12 @@ func (v *RespondActivityTaskCanceledRequest) GetDomainUUID() (o string) {
 	return
 }
 
-type RespondActivityTaskCompletedRequest struct {
-	DomainUUID      *string                                     `json:"domainUUID,omitempty"`
-	CompleteRequest *shared.RespondActivityTaskCompletedRequest `json:"completeRequest,omitempty"`
+type ResumeWorkflowExecutionRequest struct {
+	DomainUUID        *string                   `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
 }
 
-// ToWire translates a RespondActivityTaskCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a ResumeWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4063,7 +7739,7 @@ type RespondActivityTaskCompletedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *ResumeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -4079,8 +7755,8 @@ func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.CompleteRequest != nil {
-		w, err = v.CompleteRequest.ToWire()
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -4091,17 +7767,11 @@ func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondActivityTaskCompletedRequest_Read(w wire.Value) (*shared.RespondActivityTaskCompletedRequest, error) {
-	var v shared.RespondActivityTaskCompletedRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RespondActivityTaskCompletedRequest struct from its Thrift-level
+// FromWire deserializes a ResumeWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskCompletedRequest struct
+// An error is returned if we were unable to build a ResumeWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4109,12 +7779,12 @@ func _RespondActivityTaskCompletedRequest_Read(w wire.Value) (*shared.RespondAct
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskCompletedRequest
+//   var v ResumeWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *ResumeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -4131,7 +7801,7 @@ func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.CompleteRequest, err = _RespondActivityTaskCompletedRequest_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4143,9 +7813,9 @@ func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskCompletedRequest
+// String returns a readable string representation of a ResumeWorkflowExecutionRequest
 // struct.
-func (v *RespondActivityTaskCompletedRequest) String() string {
+func (v *ResumeWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -4156,23 +7826,23 @@ func (v *RespondActivityTaskCompletedRequest) String() string {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.CompleteRequest != nil {
-		fields[i] = fmt.Sprintf("CompleteRequest: %v", v.CompleteRequest)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
 
-	return fmt.Sprintf("RespondActivityTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResumeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskCompletedRequest match the
-// provided RespondActivityTaskCompletedRequest.
+// Equals returns true if all the fields of this ResumeWorkflowExecutionRequest match the
+// provided ResumeWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCompletedRequest) bool {
+func (v *ResumeWorkflowExecutionRequest) Equals(rhs *ResumeWorkflowExecutionRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.CompleteRequest == nil && rhs.CompleteRequest == nil) || (v.CompleteRequest != nil && rhs.CompleteRequest != nil && v.CompleteRequest.Equals(rhs.CompleteRequest))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
 
@@ -4181,7 +7851,7 @@ func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCom
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCompletedRequest) GetDomainUUID() (o string) {
+func (v *ResumeWorkflowExecutionRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -4189,12 +7859,12 @@ func (v *RespondActivityTaskCompletedRequest) GetDomainUUID() (o string) {
 	return
 }
 
-type RespondActivityTaskFailedRequest struct {
-	DomainUUID    *string                                  `json:"domainUUID,omitempty"`
-	FailedRequest *shared.RespondActivityTaskFailedRequest `json:"failedRequest,omitempty"`
+type ScheduleDecisionTaskRequest struct {
+	DomainUUID        *string                   `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
 }
 
-// ToWire translates a RespondActivityTaskFailedRequest struct into a Thrift-level intermediate
+// ToWire translates a ScheduleDecisionTaskRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4209,7 +7879,7 @@ type RespondActivityTaskFailedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
+func (v *ScheduleDecisionTaskRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -4225,8 +7895,8 @@ func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.FailedRequest != nil {
-		w, err = v.FailedRequest.ToWire()
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -4237,17 +7907,11 @@ func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondActivityTaskFailedRequest_Read(w wire.Value) (*shared.RespondActivityTaskFailedRequest, error) {
-	var v shared.RespondActivityTaskFailedRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RespondActivityTaskFailedRequest struct from its Thrift-level
+// FromWire deserializes a ScheduleDecisionTaskRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskFailedRequest struct
+// An error is returned if we were unable to build a ScheduleDecisionTaskRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4255,12 +7919,12 @@ func _RespondActivityTaskFailedRequest_Read(w wire.Value) (*shared.RespondActivi
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskFailedRequest
+//   var v ScheduleDecisionTaskRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
+func (v *ScheduleDecisionTaskRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -4277,7 +7941,7 @@ func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.FailedRequest, err = _RespondActivityTaskFailedRequest_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4289,9 +7953,9 @@ func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskFailedRequest
+// String returns a readable string representation of a ScheduleDecisionTaskRequest
 // struct.
-func (v *RespondActivityTaskFailedRequest) String() string {
+func (v *ScheduleDecisionTaskRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -4302,23 +7966,23 @@ func (v *RespondActivityTaskFailedRequest) String() string {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.FailedRequest != nil {
-		fields[i] = fmt.Sprintf("FailedRequest: %v", v.FailedRequest)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
 
-	return fmt.Sprintf("RespondActivityTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ScheduleDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskFailedRequest match the
-// provided RespondActivityTaskFailedRequest.
+// Equals returns true if all the fields of this ScheduleDecisionTaskRequest match the
+// provided ScheduleDecisionTaskRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailedRequest) bool {
+func (v *ScheduleDecisionTaskRequest) Equals(rhs *ScheduleDecisionTaskRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.FailedRequest == nil && rhs.FailedRequest == nil) || (v.FailedRequest != nil && rhs.FailedRequest != nil && v.FailedRequest.Equals(rhs.FailedRequest))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
 
@@ -4327,7 +7991,7 @@ func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailed
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedRequest) GetDomainUUID() (o string) {
+func (v *ScheduleDecisionTaskRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -4335,12 +7999,12 @@ func (v *RespondActivityTaskFailedRequest) GetDomainUUID() (o string) {
 	return
 }
 
-type RespondDecisionTaskCompletedRequest struct {
-	DomainUUID      *string                                     `json:"domainUUID,omitempty"`
-	CompleteRequest *shared.RespondDecisionTaskCompletedRequest `json:"completeRequest,omitempty"`
+type ShardOwnershipLostError struct {
+	Message *string `json:"message,omitempty"`
+	Owner   *string `json:"owner,omitempty"`
 }
 
-// ToWire translates a RespondDecisionTaskCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a ShardOwnershipLostError struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4355,7 +8019,7 @@ type RespondDecisionTaskCompletedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *ShardOwnershipLostError) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -4363,16 +8027,16 @@ func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.CompleteRequest != nil {
-		w, err = v.CompleteRequest.ToWire()
+	if v.Owner != nil {
+		w, err = wire.NewValueString(*(v.Owner)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -4383,17 +8047,11 @@ func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondDecisionTaskCompletedRequest_Read(w wire.Value) (*shared.RespondDecisionTaskCompletedRequest, error) {
-	var v shared.RespondDecisionTaskCompletedRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RespondDecisionTaskCompletedRequest struct from its Thrift-level
+// FromWire deserializes a ShardOwnershipLostError struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondDecisionTaskCompletedRequest struct
+// An error is returned if we were unable to build a ShardOwnershipLostError struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4401,12 +8059,12 @@ func _RespondDecisionTaskCompletedRequest_Read(w wire.Value) (*shared.RespondDec
 //     return nil, err
 //   }
 //
-//   var v RespondDecisionTaskCompletedRequest
+//   var v ShardOwnershipLostError
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *ShardOwnershipLostError) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -4415,15 +8073,17 @@ func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+				v.Message = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.CompleteRequest, err = _RespondDecisionTaskCompletedRequest_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Owner = &x
 				if err != nil {
 					return err
 				}
@@ -4435,58 +8095,72 @@ func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondDecisionTaskCompletedRequest
+// String returns a readable string representation of a ShardOwnershipLostError
 // struct.
-func (v *RespondDecisionTaskCompletedRequest) String() string {
+func (v *ShardOwnershipLostError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
 		i++
 	}
-	if v.CompleteRequest != nil {
-		fields[i] = fmt.Sprintf("CompleteRequest: %v", v.CompleteRequest)
+	if v.Owner != nil {
+		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
 		i++
 	}
 
-	return fmt.Sprintf("RespondDecisionTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ShardOwnershipLostError{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondDecisionTaskCompletedRequest match the
-// provided RespondDecisionTaskCompletedRequest.
+// Equals returns true if all the fields of this ShardOwnershipLostError match the
+// provided ShardOwnershipLostError.
 //
 // This function performs a deep comparison.
-func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCompletedRequest) bool {
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+func (v *ShardOwnershipLostError) Equals(rhs *ShardOwnershipLostError) bool {
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
 		return false
 	}
-	if !((v.CompleteRequest == nil && rhs.CompleteRequest == nil) || (v.CompleteRequest != nil && rhs.CompleteRequest != nil && v.CompleteRequest.Equals(rhs.CompleteRequest))) {
+	if !_String_EqualsPtr(v.Owner, rhs.Owner) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetMessage returns the value of Message if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskCompletedRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *ShardOwnershipLostError) GetMessage() (o string) {
+	if v.Message != nil {
+		return *v.Message
 	}
 
 	return
 }
 
-type RespondDecisionTaskFailedRequest struct {
-	DomainUUID    *string                                  `json:"domainUUID,omitempty"`
-	FailedRequest *shared.RespondDecisionTaskFailedRequest `json:"failedRequest,omitempty"`
+// GetOwner returns the value of Owner if it is set or its
+// zero value if it is unset.
+func (v *ShardOwnershipLostError) GetOwner() (o string) {
+	if v.Owner != nil {
+		return *v.Owner
+	}
+
+	return
 }
 
-// ToWire translates a RespondDecisionTaskFailedRequest struct into a Thrift-level intermediate
+func (v *ShardOwnershipLostError) Error() string {
+	return v.String()
+}
+
+type SignalWithStartWorkflowExecutionRequest struct {
+	DomainUUID             *string                                         `json:"domainUUID,omitempty"`
+	SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest `json:"signalWithStartRequest,omitempty"`
+}
+
+// ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4501,7 +8175,7 @@ type RespondDecisionTaskFailedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondDecisionTaskFailedRequest) ToWire() (wire.Value, error) {
+func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -4517,8 +8191,8 @@ func (v *RespondDecisionTaskFailedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.FailedRequest != nil {
-		w, err = v.FailedRequest.ToWire()
+	if v.SignalWithStartRequest != nil {
+		w, err = v.SignalWithStartRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -4529,17 +8203,17 @@ func (v *RespondDecisionTaskFailedRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondDecisionTaskFailedRequest_Read(w wire.Value) (*shared.RespondDecisionTaskFailedRequest, error) {
-	var v shared.RespondDecisionTaskFailedRequest
+func _SignalWithStartWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWithStartWorkflowExecutionRequest, error) {
+	var v shared.SignalWithStartWorkflowExecutionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RespondDecisionTaskFailedRequest struct from its Thrift-level
+// FromWire deserializes a SignalWithStartWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondDecisionTaskFailedRequest struct
+// An error is returned if we were unable to build a SignalWithStartWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4547,12 +8221,12 @@ func _RespondDecisionTaskFailedRequest_Read(w wire.Value) (*shared.RespondDecisi
 //     return nil, err
 //   }
 //
-//   var v RespondDecisionTaskFailedRequest
+//   var v SignalWithStartWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondDecisionTaskFailedRequest) FromWire(w wire.Value) error {
+func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -4569,7 +8243,7 @@ func (v *RespondDecisionTaskFailedRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.FailedRequest, err = _RespondDecisionTaskFailedRequest_Read(field.Value)
+				v.SignalWithStartRequest, err = _SignalWithStartWorkflowExecutionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4581,9 +8255,9 @@ func (v *RespondDecisionTaskFailedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondDecisionTaskFailedRequest
+// String returns a readable string representation of a SignalWithStartWorkflowExecutionRequest
 // struct.
-func (v *RespondDecisionTaskFailedRequest) String() string {
+func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -4594,23 +8268,23 @@ func (v *RespondDecisionTaskFailedRequest) String() string {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.FailedRequest != nil {
-		fields[i] = fmt.Sprintf("FailedRequest: %v", v.FailedRequest)
+	if v.SignalWithStartRequest != nil {
+		fields[i] = fmt.Sprintf("SignalWithStartRequest: %v", v.SignalWithStartRequest)
 		i++
 	}
 
-	return fmt.Sprintf("RespondDecisionTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondDecisionTaskFailedRequest match the
-// provided RespondDecisionTaskFailedRequest.
+// Equals returns true if all the fields of this SignalWithStartWorkflowExecutionRequest match the
+// provided SignalWithStartWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondDecisionTaskFailedRequest) Equals(rhs *RespondDecisionTaskFailedRequest) bool {
+func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWorkflowExecutionRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.FailedRequest == nil && rhs.FailedRequest == nil) || (v.FailedRequest != nil && rhs.FailedRequest != nil && v.FailedRequest.Equals(rhs.FailedRequest))) {
+	if !((v.SignalWithStartRequest == nil && rhs.SignalWithStartRequest == nil) || (v.SignalWithStartRequest != nil && rhs.SignalWithStartRequest != nil && v.SignalWithStartRequest.Equals(rhs.SignalWithStartRequest))) {
 		return false
 	}
 
@@ -4619,7 +8293,7 @@ func (v *RespondDecisionTaskFailedRequest) Equals(rhs *RespondDecisionTaskFailed
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskFailedRequest) GetDomainUUID() (o string) {
+func (v *SignalWithStartWorkflowExecutionRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -4627,12 +8301,14 @@ func (v *RespondDecisionTaskFailedRequest) GetDomainUUID() (o string) {
 	return
 }
 
-type ScheduleDecisionTaskRequest struct {
-	DomainUUID        *string                   `json:"domainUUID,omitempty"`
-	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
+type SignalWorkflowExecutionRequest struct {
+	DomainUUID                *string                                `json:"domainUUID,omitempty"`
+	SignalRequest             *shared.SignalWorkflowExecutionRequest `json:"signalRequest,omitempty"`
+	ExternalWorkflowExecution *shared.WorkflowExecution              `json:"externalWorkflowExecution,omitempty"`
+	ChildWorkflowOnly         *bool                                  `json:"childWorkflowOnly,omitempty"`
 }
 
-// ToWire translates a ScheduleDecisionTaskRequest struct into a Thrift-level intermediate
+// ToWire translates a SignalWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4647,9 +8323,9 @@ type ScheduleDecisionTaskRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ScheduleDecisionTaskRequest) ToWire() (wire.Value, error) {
+func (v *SignalWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4663,23 +8339,45 @@ func (v *ScheduleDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.SignalRequest != nil {
+		w, err = v.SignalRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ExternalWorkflowExecution != nil {
+		w, err = v.ExternalWorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ChildWorkflowOnly != nil {
+		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ScheduleDecisionTaskRequest struct from its Thrift-level
+func _SignalWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWorkflowExecutionRequest, error) {
+	var v shared.SignalWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a SignalWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ScheduleDecisionTaskRequest struct
+// An error is returned if we were unable to build a SignalWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4687,12 +8385,12 @@ func (v *ScheduleDecisionTaskRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ScheduleDecisionTaskRequest
+//   var v SignalWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ScheduleDecisionTaskRequest) FromWire(w wire.Value) error {
+func (v *SignalWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -4709,7 +8407,25 @@ func (v *ScheduleDecisionTaskRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				v.SignalRequest, err = _SignalWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ExternalWorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ChildWorkflowOnly = &x
 				if err != nil {
 					return err
 				}
@@ -4721,36 +8437,50 @@ func (v *ScheduleDecisionTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ScheduleDecisionTaskRequest
+// String returns a readable string representation of a SignalWorkflowExecutionRequest
 // struct.
-func (v *ScheduleDecisionTaskRequest) String() string {
+func (v *SignalWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.SignalRequest != nil {
+		fields[i] = fmt.Sprintf("SignalRequest: %v", v.SignalRequest)
+		i++
+	}
+	if v.ExternalWorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("ExternalWorkflowExecution: %v", v.ExternalWorkflowExecution)
+		i++
+	}
+	if v.ChildWorkflowOnly != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
 		i++
 	}
 
-	return fmt.Sprintf("ScheduleDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("SignalWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ScheduleDecisionTaskRequest match the
-// provided ScheduleDecisionTaskRequest.
+// Equals returns true if all the fields of this SignalWorkflowExecutionRequest match the
+// provided SignalWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *ScheduleDecisionTaskRequest) Equals(rhs *ScheduleDecisionTaskRequest) bool {
+func (v *SignalWorkflowExecutionRequest) Equals(rhs *SignalWorkflowExecutionRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !((v.SignalRequest == nil && rhs.SignalRequest == nil) || (v.SignalRequest != nil && rhs.SignalRequest != nil && v.SignalRequest.Equals(rhs.SignalRequest))) {
+		return false
+	}
+	if !((v.ExternalWorkflowExecution == nil && rhs.ExternalWorkflowExecution == nil) || (v.ExternalWorkflowExecution != nil && rhs.ExternalWorkflowExecution != nil && v.ExternalWorkflowExecution.Equals(rhs.ExternalWorkflowExecution))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
 		return false
 	}
 
@@ -4759,7 +8489,7 @@ func (v *ScheduleDecisionTaskRequest) Equals(rhs *ScheduleDecisionTaskRequest) b
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *ScheduleDecisionTaskRequest) GetDomainUUID() (o string) {
+func (v *SignalWorkflowExecutionRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -4767,12 +8497,26 @@ func (v *ScheduleDecisionTaskRequest) GetDomainUUID() (o string) {
 	return
 }
 
-type ShardOwnershipLostError struct {
-	Message *string `json:"message,omitempty"`
-	Owner   *string `json:"owner,omitempty"`
+// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// zero value if it is unset.
+func (v *SignalWorkflowExecutionRequest) GetChildWorkflowOnly() (o bool) {
+	if v.ChildWorkflowOnly != nil {
+		return *v.ChildWorkflowOnly
+	}
+
+	return
 }
 
-// ToWire translates a ShardOwnershipLostError struct into a Thrift-level intermediate
+type StartWorkflowExecutionRequest struct {
+	DomainUUID                      *string                               `json:"domainUUID,omitempty"`
+	StartRequest                    *shared.StartWorkflowExecutionRequest `json:"startRequest,omitempty"`
+	ParentExecutionInfo             *ParentExecutionInfo                  `json:"parentExecutionInfo,omitempty"`
+	Attempt                         *int32                                `json:"attempt,omitempty"`
+	ExpirationTimestamp             *int64                                `json:"expirationTimestamp,omitempty"`
+	FirstDecisionTaskBackoffSeconds *int32                                `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+}
+
+// ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4787,39 +8531,83 @@ type ShardOwnershipLostError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ShardOwnershipLostError) ToWire() (wire.Value, error) {
+func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Message != nil {
-		w, err = wire.NewValueString(*(v.Message)), error(nil)
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Owner != nil {
-		w, err = wire.NewValueString(*(v.Owner)), error(nil)
+	if v.StartRequest != nil {
+		w, err = v.StartRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ParentExecutionInfo != nil {
+		w, err = v.ParentExecutionInfo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpirationTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		w, err = wire.NewValueI32(*(v.FirstDecisionTaskBackoffSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ShardOwnershipLostError struct from its Thrift-level
+func _StartWorkflowExecutionRequest_Read(w wire.Value) (*shared.StartWorkflowExecutionRequest, error) {
+	var v shared.StartWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ParentExecutionInfo_Read(w wire.Value) (*ParentExecutionInfo, error) {
+	var v ParentExecutionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a StartWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ShardOwnershipLostError struct
+// An error is returned if we were unable to build a StartWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4827,12 +8615,12 @@ func (v *ShardOwnershipLostError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ShardOwnershipLostError
+//   var v StartWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ShardOwnershipLostError) FromWire(w wire.Value) error {
+func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -4841,17 +8629,53 @@ func (v *ShardOwnershipLostError) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Message = &x
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Owner = &x
+			if field.Value.Type() == wire.TStruct {
+				v.StartRequest, err = _StartWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ParentExecutionInfo, err = _ParentExecutionInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpirationTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FirstDecisionTaskBackoffSeconds = &x
 				if err != nil {
 					return err
 				}
@@ -4863,72 +8687,116 @@ func (v *ShardOwnershipLostError) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ShardOwnershipLostError
+// String returns a readable string representation of a StartWorkflowExecutionRequest
 // struct.
-func (v *ShardOwnershipLostError) String() string {
+func (v *StartWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [6]string
 	i := 0
-	if v.Message != nil {
-		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.Owner != nil {
-		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
+	if v.StartRequest != nil {
+		fields[i] = fmt.Sprintf("StartRequest: %v", v.StartRequest)
+		i++
+	}
+	if v.ParentExecutionInfo != nil {
+		fields[i] = fmt.Sprintf("ParentExecutionInfo: %v", v.ParentExecutionInfo)
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpirationTimestamp: %v", *(v.ExpirationTimestamp))
+		i++
+	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		fields[i] = fmt.Sprintf("FirstDecisionTaskBackoffSeconds: %v", *(v.FirstDecisionTaskBackoffSeconds))
 		i++
 	}
 
-	return fmt.Sprintf("ShardOwnershipLostError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ShardOwnershipLostError match the
-// provided ShardOwnershipLostError.
+// Equals returns true if all the fields of this StartWorkflowExecutionRequest match the
+// provided StartWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *ShardOwnershipLostError) Equals(rhs *ShardOwnershipLostError) bool {
-	if !_String_EqualsPtr(v.Message, rhs.Message) {
+func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Owner, rhs.Owner) {
+	if !((v.StartRequest == nil && rhs.StartRequest == nil) || (v.StartRequest != nil && rhs.StartRequest != nil && v.StartRequest.Equals(rhs.StartRequest))) {
+		return false
+	}
+	if !((v.ParentExecutionInfo == nil && rhs.ParentExecutionInfo == nil) || (v.ParentExecutionInfo != nil && rhs.ParentExecutionInfo != nil && v.ParentExecutionInfo.Equals(rhs.ParentExecutionInfo))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpirationTimestamp, rhs.ExpirationTimestamp) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.FirstDecisionTaskBackoffSeconds, rhs.FirstDecisionTaskBackoffSeconds) {
 		return false
 	}
 
 	return true
 }
 
-// GetMessage returns the value of Message if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *ShardOwnershipLostError) GetMessage() (o string) {
-	if v.Message != nil {
-		return *v.Message
+func (v *StartWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// GetOwner returns the value of Owner if it is set or its
+// GetAttempt returns the value of Attempt if it is set or its
 // zero value if it is unset.
-func (v *ShardOwnershipLostError) GetOwner() (o string) {
-	if v.Owner != nil {
-		return *v.Owner
+func (v *StartWorkflowExecutionRequest) GetAttempt() (o int32) {
+	if v.Attempt != nil {
+		return *v.Attempt
 	}
 
 	return
 }
 
-func (v *ShardOwnershipLostError) Error() string {
-	return v.String()
+// GetExpirationTimestamp returns the value of ExpirationTimestamp if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetExpirationTimestamp() (o int64) {
+	if v.ExpirationTimestamp != nil {
+		return *v.ExpirationTimestamp
+	}
+
+	return
 }
 
-type SignalWithStartWorkflowExecutionRequest struct {
-	DomainUUID             *string                                         `json:"domainUUID,omitempty"`
-	SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest `json:"signalWithStartRequest,omitempty"`
+// GetFirstDecisionTaskBackoffSeconds returns the value of FirstDecisionTaskBackoffSeconds if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetFirstDecisionTaskBackoffSeconds() (o int32) {
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		return *v.FirstDecisionTaskBackoffSeconds
+	}
+
+	return
 }
 
-// ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
+type TerminateWorkflowExecutionRequest struct {
+	DomainUUID       *string                                   `json:"domainUUID,omitempty"`
+	TerminateRequest *shared.TerminateWorkflowExecutionRequest `json:"terminateRequest,omitempty"`
+}
+
+// ToWire translates a TerminateWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4943,7 +8811,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *TerminateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -4959,8 +8827,8 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.SignalWithStartRequest != nil {
-		w, err = v.SignalWithStartRequest.ToWire()
+	if v.TerminateRequest != nil {
+		w, err = v.TerminateRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -4971,17 +8839,17 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _SignalWithStartWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWithStartWorkflowExecutionRequest, error) {
-	var v shared.SignalWithStartWorkflowExecutionRequest
+func _TerminateWorkflowExecutionRequest_Read(w wire.Value) (*shared.TerminateWorkflowExecutionRequest, error) {
+	var v shared.TerminateWorkflowExecutionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a SignalWithStartWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a TerminateWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a SignalWithStartWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a TerminateWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4989,12 +8857,12 @@ func _SignalWithStartWorkflowExecutionRequest_Read(w wire.Value) (*shared.Signal
 //     return nil, err
 //   }
 //
-//   var v SignalWithStartWorkflowExecutionRequest
+//   var v TerminateWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -5011,7 +8879,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.SignalWithStartRequest, err = _SignalWithStartWorkflowExecutionRequest_Read(field.Value)
+				v.TerminateRequest, err = _TerminateWorkflowExecutionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5023,9 +8891,9 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a SignalWithStartWorkflowExecutionRequest
+// String returns a readable string representation of a TerminateWorkflowExecutionRequest
 // struct.
-func (v *SignalWithStartWorkflowExecutionRequest) String() string {
+func (v *TerminateWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -5036,23 +8904,23 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.SignalWithStartRequest != nil {
-		fields[i] = fmt.Sprintf("SignalWithStartRequest: %v", v.SignalWithStartRequest)
+	if v.TerminateRequest != nil {
+		fields[i] = fmt.Sprintf("TerminateRequest: %v", v.TerminateRequest)
 		i++
 	}
 
-	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TerminateWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this SignalWithStartWorkflowExecutionRequest match the
-// provided SignalWithStartWorkflowExecutionRequest.
+// Equals returns true if all the fields of this TerminateWorkflowExecutionRequest match the
+// provided TerminateWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWorkflowExecutionRequest) bool {
+func (v *TerminateWorkflowExecutionRequest) Equals(rhs *TerminateWorkflowExecutionRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.SignalWithStartRequest == nil && rhs.SignalWithStartRequest == nil) || (v.SignalWithStartRequest != nil && rhs.SignalWithStartRequest != nil && v.SignalWithStartRequest.Equals(rhs.SignalWithStartRequest))) {
+	if !((v.TerminateRequest == nil && rhs.TerminateRequest == nil) || (v.TerminateRequest != nil && rhs.TerminateRequest != nil && v.TerminateRequest.Equals(rhs.TerminateRequest))) {
 		return false
 	}
 
@@ -5061,7 +8929,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWor
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *SignalWithStartWorkflowExecutionRequest) GetDomainUUID() (o string) {
+func (v *TerminateWorkflowExecutionRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -5069,14 +8937,12 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetDomainUUID() (o string) {
 	return
 }
 
-type SignalWorkflowExecutionRequest struct {
-	DomainUUID                *string                                `json:"domainUUID,omitempty"`
-	SignalRequest             *shared.SignalWorkflowExecutionRequest `json:"signalRequest,omitempty"`
-	ExternalWorkflowExecution *shared.WorkflowExecution              `json:"externalWorkflowExecution,omitempty"`
-	ChildWorkflowOnly         *bool                                  `json:"childWorkflowOnly,omitempty"`
+type WarmHistoryHostRequest struct {
+	HostAddress               *string `json:"hostAddress,omitempty"`
+	MaximumExecutionsPerShard *int32  `json:"maximumExecutionsPerShard,omitempty"`
 }
 
-// ToWire translates a SignalWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a WarmHistoryHostRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5091,61 +8957,39 @@ type SignalWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *SignalWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *WarmHistoryHostRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.HostAddress != nil {
+		w, err = wire.NewValueString(*(v.HostAddress)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.SignalRequest != nil {
-		w, err = v.SignalRequest.ToWire()
+	if v.MaximumExecutionsPerShard != nil {
+		w, err = wire.NewValueI32(*(v.MaximumExecutionsPerShard)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ExternalWorkflowExecution != nil {
-		w, err = v.ExternalWorkflowExecution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.ChildWorkflowOnly != nil {
-		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _SignalWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWorkflowExecutionRequest, error) {
-	var v shared.SignalWorkflowExecutionRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a SignalWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a WarmHistoryHostRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a SignalWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a WarmHistoryHostRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5153,12 +8997,12 @@ func _SignalWorkflowExecutionRequest_Read(w wire.Value) (*shared.SignalWorkflowE
 //     return nil, err
 //   }
 //
-//   var v SignalWorkflowExecutionRequest
+//   var v WarmHistoryHostRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *SignalWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *WarmHistoryHostRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -5167,33 +9011,17 @@ func (v *SignalWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+				v.HostAddress = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.SignalRequest, err = _SignalWorkflowExecutionRequest_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.ExternalWorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.ChildWorkflowOnly = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumExecutionsPerShard = &x
 				if err != nil {
 					return err
 				}
@@ -5205,83 +9033,69 @@ func (v *SignalWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a SignalWorkflowExecutionRequest
+// String returns a readable string representation of a WarmHistoryHostRequest
 // struct.
-func (v *SignalWorkflowExecutionRequest) String() string {
+func (v *WarmHistoryHostRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [2]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
-		i++
-	}
-	if v.SignalRequest != nil {
-		fields[i] = fmt.Sprintf("SignalRequest: %v", v.SignalRequest)
+	if v.HostAddress != nil {
+		fields[i] = fmt.Sprintf("HostAddress: %v", *(v.HostAddress))
 		i++
 	}
-	if v.ExternalWorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("ExternalWorkflowExecution: %v", v.ExternalWorkflowExecution)
-		i++
-	}
-	if v.ChildWorkflowOnly != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
+	if v.MaximumExecutionsPerShard != nil {
+		fields[i] = fmt.Sprintf("MaximumExecutionsPerShard: %v", *(v.MaximumExecutionsPerShard))
 		i++
 	}
 
-	return fmt.Sprintf("SignalWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WarmHistoryHostRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this SignalWorkflowExecutionRequest match the
-// provided SignalWorkflowExecutionRequest.
+// Equals returns true if all the fields of this WarmHistoryHostRequest match the
+// provided WarmHistoryHostRequest.
 //
 // This function performs a deep comparison.
-func (v *SignalWorkflowExecutionRequest) Equals(rhs *SignalWorkflowExecutionRequest) bool {
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
-		return false
-	}
-	if !((v.SignalRequest == nil && rhs.SignalRequest == nil) || (v.SignalRequest != nil && rhs.SignalRequest != nil && v.SignalRequest.Equals(rhs.SignalRequest))) {
-		return false
-	}
-	if !((v.ExternalWorkflowExecution == nil && rhs.ExternalWorkflowExecution == nil) || (v.ExternalWorkflowExecution != nil && rhs.ExternalWorkflowExecution != nil && v.ExternalWorkflowExecution.Equals(rhs.ExternalWorkflowExecution))) {
+func (v *WarmHistoryHostRequest) Equals(rhs *WarmHistoryHostRequest) bool {
+	if !_String_EqualsPtr(v.HostAddress, rhs.HostAddress) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
+	if !_I32_EqualsPtr(v.MaximumExecutionsPerShard, rhs.MaximumExecutionsPerShard) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetHostAddress returns the value of HostAddress if it is set or its
 // zero value if it is unset.
-func (v *SignalWorkflowExecutionRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *WarmHistoryHostRequest) GetHostAddress() (o string) {
+	if v.HostAddress != nil {
+		return *v.HostAddress
 	}
 
 	return
 }
 
-// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// GetMaximumExecutionsPerShard returns the value of MaximumExecutionsPerShard if it is set or its
 // zero value if it is unset.
-func (v *SignalWorkflowExecutionRequest) GetChildWorkflowOnly() (o bool) {
-	if v.ChildWorkflowOnly != nil {
-		return *v.ChildWorkflowOnly
+func (v *WarmHistoryHostRequest) GetMaximumExecutionsPerShard() (o int32) {
+	if v.MaximumExecutionsPerShard != nil {
+		return *v.MaximumExecutionsPerShard
 	}
 
 	return
 }
 
-type StartWorkflowExecutionRequest struct {
-	DomainUUID          *string                               `json:"domainUUID,omitempty"`
-	StartRequest        *shared.StartWorkflowExecutionRequest `json:"startRequest,omitempty"`
-	ParentExecutionInfo *ParentExecutionInfo                  `json:"parentExecutionInfo,omitempty"`
+type WarmHistoryHostResponse struct {
+	Address          *string `json:"address,omitempty"`
+	NumberOfShards   *int32  `json:"numberOfShards,omitempty"`
+	ExecutionsLoaded *int32  `json:"executionsLoaded,omitempty"`
 }
 
-// ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a WarmHistoryHostResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5296,7 +9110,7 @@ type StartWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *WarmHistoryHostResponse) ToWire() (wire.Value, error) {
 	var (
 		fields [3]wire.Field
 		i      int = 0
@@ -5304,24 +9118,24 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.Address != nil {
+		w, err = wire.NewValueString(*(v.Address)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartRequest != nil {
-		w, err = v.StartRequest.ToWire()
+	if v.NumberOfShards != nil {
+		w, err = wire.NewValueI32(*(v.NumberOfShards)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ParentExecutionInfo != nil {
-		w, err = v.ParentExecutionInfo.ToWire()
+	if v.ExecutionsLoaded != nil {
+		w, err = wire.NewValueI32(*(v.ExecutionsLoaded)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -5332,23 +9146,11 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _StartWorkflowExecutionRequest_Read(w wire.Value) (*shared.StartWorkflowExecutionRequest, error) {
-	var v shared.StartWorkflowExecutionRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ParentExecutionInfo_Read(w wire.Value) (*ParentExecutionInfo, error) {
-	var v ParentExecutionInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a StartWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a WarmHistoryHostResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a StartWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a WarmHistoryHostResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5356,12 +9158,12 @@ func _ParentExecutionInfo_Read(w wire.Value) (*ParentExecutionInfo, error) {
 //     return nil, err
 //   }
 //
-//   var v StartWorkflowExecutionRequest
+//   var v WarmHistoryHostResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *WarmHistoryHostResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -5370,23 +9172,27 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+				v.Address = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.StartRequest, err = _StartWorkflowExecutionRequest_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumberOfShards = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.ParentExecutionInfo, err = _ParentExecutionInfo_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExecutionsLoaded = &x
 				if err != nil {
 					return err
 				}
@@ -5398,65 +9204,86 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a StartWorkflowExecutionRequest
+// String returns a readable string representation of a WarmHistoryHostResponse
 // struct.
-func (v *StartWorkflowExecutionRequest) String() string {
+func (v *WarmHistoryHostResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [3]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.Address != nil {
+		fields[i] = fmt.Sprintf("Address: %v", *(v.Address))
 		i++
 	}
-	if v.StartRequest != nil {
-		fields[i] = fmt.Sprintf("StartRequest: %v", v.StartRequest)
+	if v.NumberOfShards != nil {
+		fields[i] = fmt.Sprintf("NumberOfShards: %v", *(v.NumberOfShards))
 		i++
 	}
-	if v.ParentExecutionInfo != nil {
-		fields[i] = fmt.Sprintf("ParentExecutionInfo: %v", v.ParentExecutionInfo)
+	if v.ExecutionsLoaded != nil {
+		fields[i] = fmt.Sprintf("ExecutionsLoaded: %v", *(v.ExecutionsLoaded))
 		i++
 	}
 
-	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WarmHistoryHostResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this StartWorkflowExecutionRequest match the
-// provided StartWorkflowExecutionRequest.
+// Equals returns true if all the fields of this WarmHistoryHostResponse match the
+// provided WarmHistoryHostResponse.
 //
 // This function performs a deep comparison.
-func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionRequest) bool {
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+func (v *WarmHistoryHostResponse) Equals(rhs *WarmHistoryHostResponse) bool {
+	if !_String_EqualsPtr(v.Address, rhs.Address) {
 		return false
 	}
-	if !((v.StartRequest == nil && rhs.StartRequest == nil) || (v.StartRequest != nil && rhs.StartRequest != nil && v.StartRequest.Equals(rhs.StartRequest))) {
+	if !_I32_EqualsPtr(v.NumberOfShards, rhs.NumberOfShards) {
 		return false
 	}
-	if !((v.ParentExecutionInfo == nil && rhs.ParentExecutionInfo == nil) || (v.ParentExecutionInfo != nil && rhs.ParentExecutionInfo != nil && v.ParentExecutionInfo.Equals(rhs.ParentExecutionInfo))) {
+	if !_I32_EqualsPtr(v.ExecutionsLoaded, rhs.ExecutionsLoaded) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetAddress returns the value of Address if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *WarmHistoryHostResponse) GetAddress() (o string) {
+	if v.Address != nil {
+		return *v.Address
 	}
 
 	return
 }
 
-type TerminateWorkflowExecutionRequest struct {
-	DomainUUID       *string                                   `json:"domainUUID,omitempty"`
-	TerminateRequest *shared.TerminateWorkflowExecutionRequest `json:"terminateRequest,omitempty"`
+// GetNumberOfShards returns the value of NumberOfShards if it is set or its
+// zero value if it is unset.
+func (v *WarmHistoryHostResponse) GetNumberOfShards() (o int32) {
+	if v.NumberOfShards != nil {
+		return *v.NumberOfShards
+	}
+
+	return
 }
 
-// ToWire translates a TerminateWorkflowExecutionRequest struct into a Thrift-level intermediate
+// GetExecutionsLoaded returns the value of ExecutionsLoaded if it is set or its
+// zero value if it is unset.
+func (v *WarmHistoryHostResponse) GetExecutionsLoaded() (o int32) {
+	if v.ExecutionsLoaded != nil {
+		return *v.ExecutionsLoaded
+	}
+
+	return
+}
+
+type WorkflowExecutionChainEntry struct {
+	RunId          *string `json:"runId,omitempty"`
+	StartTimestamp *int64  `json:"startTimestamp,omitempty"`
+	Attempt        *int32  `json:"attempt,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionChainEntry struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5471,45 +9298,47 @@ type TerminateWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TerminateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *WorkflowExecutionChainEntry) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TerminateRequest != nil {
-		w, err = v.TerminateRequest.ToWire()
+	if v.StartTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.StartTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _TerminateWorkflowExecutionRequest_Read(w wire.Value) (*shared.TerminateWorkflowExecutionRequest, error) {
-	var v shared.TerminateWorkflowExecutionRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a TerminateWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a WorkflowExecutionChainEntry struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TerminateWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a WorkflowExecutionChainEntry struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5517,12 +9346,12 @@ func _TerminateWorkflowExecutionRequest_Read(w wire.Value) (*shared.TerminateWor
 //     return nil, err
 //   }
 //
-//   var v TerminateWorkflowExecutionRequest
+//   var v WorkflowExecutionChainEntry
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *WorkflowExecutionChainEntry) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -5531,15 +9360,27 @@ func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+				v.RunId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.TerminateRequest, err = _TerminateWorkflowExecutionRequest_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
 				if err != nil {
 					return err
 				}
@@ -5551,47 +9392,74 @@ func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a TerminateWorkflowExecutionRequest
+// String returns a readable string representation of a WorkflowExecutionChainEntry
 // struct.
-func (v *TerminateWorkflowExecutionRequest) String() string {
+func (v *WorkflowExecutionChainEntry) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
-	if v.TerminateRequest != nil {
-		fields[i] = fmt.Sprintf("TerminateRequest: %v", v.TerminateRequest)
+	if v.StartTimestamp != nil {
+		fields[i] = fmt.Sprintf("StartTimestamp: %v", *(v.StartTimestamp))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
 		i++
 	}
 
-	return fmt.Sprintf("TerminateWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("WorkflowExecutionChainEntry{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TerminateWorkflowExecutionRequest match the
-// provided TerminateWorkflowExecutionRequest.
+// Equals returns true if all the fields of this WorkflowExecutionChainEntry match the
+// provided WorkflowExecutionChainEntry.
 //
 // This function performs a deep comparison.
-func (v *TerminateWorkflowExecutionRequest) Equals(rhs *TerminateWorkflowExecutionRequest) bool {
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+func (v *WorkflowExecutionChainEntry) Equals(rhs *WorkflowExecutionChainEntry) bool {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
-	if !((v.TerminateRequest == nil && rhs.TerminateRequest == nil) || (v.TerminateRequest != nil && rhs.TerminateRequest != nil && v.TerminateRequest.Equals(rhs.TerminateRequest))) {
+	if !_I64_EqualsPtr(v.StartTimestamp, rhs.StartTimestamp) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *TerminateWorkflowExecutionRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *WorkflowExecutionChainEntry) GetRunId() (o string) {
+	if v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// GetStartTimestamp returns the value of StartTimestamp if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionChainEntry) GetStartTimestamp() (o int64) {
+	if v.StartTimestamp != nil {
+		return *v.StartTimestamp
+	}
+
+	return
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionChainEntry) GetAttempt() (o int32) {
+	if v.Attempt != nil {
+		return *v.Attempt
 	}
 
 	return
@@ -60,6 +60,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeTaskListResponse, error)
 
+	PauseTaskList(
+		ctx context.Context,
+		Request *matching.PauseTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -83,6 +89,12 @@ type Interface interface {
 		Request *matching.RespondQueryTaskCompletedRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		Request *matching.ResumeTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the MatchingService service.
@@ -201,6 +213,29 @@ func (c client) DescribeTaskList(
 	return
 }
 
+func (c client) PauseTaskList(
+	ctx context.Context,
+	_Request *matching.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := matching.MatchingService_PauseTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_PauseTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = matching.MatchingService_PauseTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *matching.PollForActivityTaskRequest,
@@ -292,3 +327,26 @@ func (c client) RespondQueryTaskCompleted(
 	err = matching.MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) ResumeTaskList(
+	ctx context.Context,
+	_Request *matching.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := matching.MatchingService_ResumeTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_ResumeTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = matching.MatchingService_ResumeTaskList_Helper.UnwrapResponse(&result)
+	return
+}
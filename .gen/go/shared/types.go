@@ -667,13 +667,41 @@ func (v *ActivityTaskCompletedEventAttributes) GetIdentity() (o string) {
 }
 
 type ActivityTaskFailedEventAttributes struct {
-	Reason           *string `json:"reason,omitempty"`
-	Details          []byte  `json:"details,omitempty"`
-	ScheduledEventId *int64  `json:"scheduledEventId,omitempty"`
-	StartedEventId   *int64  `json:"startedEventId,omitempty"`
-	Identity         *string `json:"identity,omitempty"`
+	Reason           *string  `json:"reason,omitempty"`
+	Details          []byte   `json:"details,omitempty"`
+	ScheduledEventId *int64   `json:"scheduledEventId,omitempty"`
+	StartedEventId   *int64   `json:"startedEventId,omitempty"`
+	Identity         *string  `json:"identity,omitempty"`
+	FailureTypes     []string `json:"failureTypes,omitempty"`
+	Failure          *Failure `json:"failure,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
 }
 
+func (_List_String_ValueList) Close() {}
+
 // ToWire translates a ActivityTaskFailedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -691,7 +719,7 @@ type ActivityTaskFailedEventAttributes struct {
 //   }
 func (v *ActivityTaskFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -737,10 +765,50 @@ func (v *ActivityTaskFailedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.FailureTypes != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.FailureTypes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.Failure != nil {
+		w, err = v.Failure.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Failure_Read(w wire.Value) (*Failure, error) {
+	var v Failure
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a ActivityTaskFailedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -810,6 +878,22 @@ func (v *ActivityTaskFailedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.FailureTypes, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.Failure, err = _Failure_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -824,7 +908,7 @@ func (v *ActivityTaskFailedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [7]string
 	i := 0
 	if v.Reason != nil {
 		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
@@ -846,10 +930,33 @@ func (v *ActivityTaskFailedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.FailureTypes != nil {
+		fields[i] = fmt.Sprintf("FailureTypes: %v", v.FailureTypes)
+		i++
+	}
+	if v.Failure != nil {
+		fields[i] = fmt.Sprintf("Failure: %v", v.Failure)
+		i++
+	}
 
 	return fmt.Sprintf("ActivityTaskFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this ActivityTaskFailedEventAttributes match the
 // provided ActivityTaskFailedEventAttributes.
 //
@@ -870,6 +977,12 @@ func (v *ActivityTaskFailedEventAttributes) Equals(rhs *ActivityTaskFailedEventA
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !((v.FailureTypes == nil && rhs.FailureTypes == nil) || (v.FailureTypes != nil && rhs.FailureTypes != nil && _List_String_Equals(v.FailureTypes, rhs.FailureTypes))) {
+		return false
+	}
+	if !((v.Failure == nil && rhs.Failure == nil) || (v.Failure != nil && rhs.Failure != nil && v.Failure.Equals(rhs.Failure))) {
+		return false
+	}
 
 	return true
 }
@@ -3538,6 +3651,7 @@ type ChildWorkflowExecutionFailedEventAttributes struct {
 	WorkflowType      *WorkflowType      `json:"workflowType,omitempty"`
 	InitiatedEventId  *int64             `json:"initiatedEventId,omitempty"`
 	StartedEventId    *int64             `json:"startedEventId,omitempty"`
+	Failure           *Failure           `json:"failure,omitempty"`
 }
 
 // ToWire translates a ChildWorkflowExecutionFailedEventAttributes struct into a Thrift-level intermediate
@@ -3557,7 +3671,7 @@ type ChildWorkflowExecutionFailedEventAttributes struct {
 //   }
 func (v *ChildWorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -3619,6 +3733,14 @@ func (v *ChildWorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, erro
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.Failure != nil {
+		w, err = v.Failure.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -3708,6 +3830,14 @@ func (v *ChildWorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) err
 					return err
 				}
 
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.Failure, err = _Failure_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -3722,7 +3852,7 @@ func (v *ChildWorkflowExecutionFailedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [8]string
 	i := 0
 	if v.Reason != nil {
 		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
@@ -3752,6 +3882,10 @@ func (v *ChildWorkflowExecutionFailedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
 		i++
 	}
+	if v.Failure != nil {
+		fields[i] = fmt.Sprintf("Failure: %v", v.Failure)
+		i++
+	}
 
 	return fmt.Sprintf("ChildWorkflowExecutionFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -3782,6 +3916,9 @@ func (v *ChildWorkflowExecutionFailedEventAttributes) Equals(rhs *ChildWorkflowE
 	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
 		return false
 	}
+	if !((v.Failure == nil && rhs.Failure == nil) || (v.Failure != nil && rhs.Failure != nil && v.Failure.Equals(rhs.Failure))) {
+		return false
+	}
 
 	return true
 }
@@ -4754,12 +4891,153 @@ func (v *CompleteWorkflowExecutionDecisionAttributes) Equals(rhs *CompleteWorkfl
 	return true
 }
 
+type ContinueAsNewInitiator int32
+
+const (
+	ContinueAsNewInitiatorDecider     ContinueAsNewInitiator = 0
+	ContinueAsNewInitiatorRetryPolicy ContinueAsNewInitiator = 1
+)
+
+// ContinueAsNewInitiator_Values returns all recognized values of ContinueAsNewInitiator.
+func ContinueAsNewInitiator_Values() []ContinueAsNewInitiator {
+	return []ContinueAsNewInitiator{
+		ContinueAsNewInitiatorDecider,
+		ContinueAsNewInitiatorRetryPolicy,
+	}
+}
+
+// UnmarshalText tries to decode ContinueAsNewInitiator from a byte slice
+// containing its name.
+//
+//   var v ContinueAsNewInitiator
+//   err := v.UnmarshalText([]byte("Decider"))
+func (v *ContinueAsNewInitiator) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "Decider":
+		*v = ContinueAsNewInitiatorDecider
+		return nil
+	case "RetryPolicy":
+		*v = ContinueAsNewInitiatorRetryPolicy
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "ContinueAsNewInitiator")
+	}
+}
+
+// Ptr returns a pointer to this enum value.
+func (v ContinueAsNewInitiator) Ptr() *ContinueAsNewInitiator {
+	return &v
+}
+
+// ToWire translates ContinueAsNewInitiator into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v ContinueAsNewInitiator) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes ContinueAsNewInitiator from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return ContinueAsNewInitiator(0), err
+//   }
+//
+//   var v ContinueAsNewInitiator
+//   if err := v.FromWire(x); err != nil {
+//     return ContinueAsNewInitiator(0), err
+//   }
+//   return v, nil
+func (v *ContinueAsNewInitiator) FromWire(w wire.Value) error {
+	*v = (ContinueAsNewInitiator)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of ContinueAsNewInitiator.
+func (v ContinueAsNewInitiator) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Decider"
+	case 1:
+		return "RetryPolicy"
+	}
+	return fmt.Sprintf("ContinueAsNewInitiator(%d)", w)
+}
+
+// Equals returns true if this ContinueAsNewInitiator value matches the provided
+// value.
+func (v ContinueAsNewInitiator) Equals(rhs ContinueAsNewInitiator) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes ContinueAsNewInitiator into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v ContinueAsNewInitiator) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Decider\""), nil
+	case 1:
+		return ([]byte)("\"RetryPolicy\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode ContinueAsNewInitiator from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *ContinueAsNewInitiator) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ContinueAsNewInitiator")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ContinueAsNewInitiator")
+		}
+		*v = (ContinueAsNewInitiator)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ContinueAsNewInitiator")
+	}
+}
+
 type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
-	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
-	TaskList                            *TaskList     `json:"taskList,omitempty"`
-	Input                               []byte        `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
+	TaskList                            *TaskList               `json:"taskList,omitempty"`
+	Input                               []byte                  `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                  `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                  `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	BackoffStartIntervalInSeconds       *int32                  `json:"backoffStartIntervalInSeconds,omitempty"`
+	RetryPolicy                         *RetryPolicy            `json:"retryPolicy,omitempty"`
+	Initiator                           *ContinueAsNewInitiator `json:"initiator,omitempty"`
+	FailureReason                       *string                 `json:"failureReason,omitempty"`
+	FailureDetails                      []byte                  `json:"failureDetails,omitempty"`
+	Failure                             *Failure                `json:"failure,omitempty"`
 }
 
 // ToWire translates a ContinueAsNewWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
@@ -4779,7 +5057,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 //   }
 func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [11]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4825,11 +5103,65 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) ToWire() (wire.Value,
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.BackoffStartIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Initiator != nil {
+		w, err = v.Initiator.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.FailureReason != nil {
+		w, err = wire.NewValueString(*(v.FailureReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.FailureDetails != nil {
+		w, err = wire.NewValueBinary(v.FailureDetails), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.Failure != nil {
+		w, err = v.Failure.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ContinueAsNewWorkflowExecutionDecisionAttributes struct from its Thrift-level
+func _ContinueAsNewInitiator_Read(w wire.Value) (ContinueAsNewInitiator, error) {
+	var v ContinueAsNewInitiator
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a ContinueAsNewWorkflowExecutionDecisionAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
@@ -4894,6 +5226,60 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) FromWire(w wire.Value
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.BackoffStartIntervalInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x ContinueAsNewInitiator
+				x, err = _ContinueAsNewInitiator_Read(field.Value)
+				v.Initiator = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FailureReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TBinary {
+				v.FailureDetails, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TStruct {
+				v.Failure, err = _Failure_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4908,7 +5294,7 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [11]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -4930,10 +5316,44 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("TaskStartToCloseTimeoutSeconds: %v", *(v.TaskStartToCloseTimeoutSeconds))
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("BackoffStartIntervalInSeconds: %v", *(v.BackoffStartIntervalInSeconds))
+		i++
+	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
+	if v.Initiator != nil {
+		fields[i] = fmt.Sprintf("Initiator: %v", *(v.Initiator))
+		i++
+	}
+	if v.FailureReason != nil {
+		fields[i] = fmt.Sprintf("FailureReason: %v", *(v.FailureReason))
+		i++
+	}
+	if v.FailureDetails != nil {
+		fields[i] = fmt.Sprintf("FailureDetails: %v", v.FailureDetails)
+		i++
+	}
+	if v.Failure != nil {
+		fields[i] = fmt.Sprintf("Failure: %v", v.Failure)
+		i++
+	}
 
 	return fmt.Sprintf("ContinueAsNewWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _ContinueAsNewInitiator_EqualsPtr(lhs, rhs *ContinueAsNewInitiator) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
 // Equals returns true if all the fields of this ContinueAsNewWorkflowExecutionDecisionAttributes match the
 // provided ContinueAsNewWorkflowExecutionDecisionAttributes.
 //
@@ -4954,6 +5374,24 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) Equals(rhs *ContinueA
 	if !_I32_EqualsPtr(v.TaskStartToCloseTimeoutSeconds, rhs.TaskStartToCloseTimeoutSeconds) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.BackoffStartIntervalInSeconds, rhs.BackoffStartIntervalInSeconds) {
+		return false
+	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
+	if !_ContinueAsNewInitiator_EqualsPtr(v.Initiator, rhs.Initiator) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FailureReason, rhs.FailureReason) {
+		return false
+	}
+	if !((v.FailureDetails == nil && rhs.FailureDetails == nil) || (v.FailureDetails != nil && rhs.FailureDetails != nil && bytes.Equal(v.FailureDetails, rhs.FailureDetails))) {
+		return false
+	}
+	if !((v.Failure == nil && rhs.Failure == nil) || (v.Failure != nil && rhs.Failure != nil && v.Failure.Equals(rhs.Failure))) {
+		return false
+	}
 
 	return true
 }
@@ -4978,6 +5416,36 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetTaskStartToCloseTi
 	return
 }
 
+// GetBackoffStartIntervalInSeconds returns the value of BackoffStartIntervalInSeconds if it is set or its
+// zero value if it is unset.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetBackoffStartIntervalInSeconds() (o int32) {
+	if v.BackoffStartIntervalInSeconds != nil {
+		return *v.BackoffStartIntervalInSeconds
+	}
+
+	return
+}
+
+// GetInitiator returns the value of Initiator if it is set or its
+// zero value if it is unset.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetInitiator() (o ContinueAsNewInitiator) {
+	if v.Initiator != nil {
+		return *v.Initiator
+	}
+
+	return
+}
+
+// GetFailureReason returns the value of FailureReason if it is set or its
+// zero value if it is unset.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetFailureReason() (o string) {
+	if v.FailureReason != nil {
+		return *v.FailureReason
+	}
+
+	return
+}
+
 type Decision struct {
 	DecisionType                                             *DecisionType                                             `json:"decisionType,omitempty"`
 	ScheduleActivityTaskDecisionAttributes                   *ScheduleActivityTaskDecisionAttributes                   `json:"scheduleActivityTaskDecisionAttributes,omitempty"`
@@ -7100,11 +7568,18 @@ func (v *DeprecateDomainRequest) GetName() (o string) {
 	return
 }
 
-type DescribeDomainRequest struct {
-	Name *string `json:"name,omitempty"`
+type DescribeClusterResponse struct {
+	ServerVersion             *string  `json:"serverVersion,omitempty"`
+	ClusterName               *string  `json:"clusterName,omitempty"`
+	PersistenceBackend        *string  `json:"persistenceBackend,omitempty"`
+	VisibilityBackend         *string  `json:"visibilityBackend,omitempty"`
+	GlobalDomainEnabled       *bool    `json:"globalDomainEnabled,omitempty"`
+	AdvancedVisibilityEnabled *bool    `json:"advancedVisibilityEnabled,omitempty"`
+	ArchivalEnabled           *bool    `json:"archivalEnabled,omitempty"`
+	SupportedClientFeatures   []string `json:"supportedClientFeatures,omitempty"`
 }
 
-// ToWire translates a DescribeDomainRequest struct into a Thrift-level intermediate
+// ToWire translates a DescribeClusterResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7119,31 +7594,87 @@ type DescribeDomainRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
+func (v *DescribeClusterResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.ServerVersion != nil {
+		w, err = wire.NewValueString(*(v.ServerVersion)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.PersistenceBackend != nil {
+		w, err = wire.NewValueString(*(v.PersistenceBackend)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.VisibilityBackend != nil {
+		w, err = wire.NewValueString(*(v.VisibilityBackend)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.GlobalDomainEnabled != nil {
+		w, err = wire.NewValueBool(*(v.GlobalDomainEnabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.AdvancedVisibilityEnabled != nil {
+		w, err = wire.NewValueBool(*(v.AdvancedVisibilityEnabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ArchivalEnabled != nil {
+		w, err = wire.NewValueBool(*(v.ArchivalEnabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.SupportedClientFeatures != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.SupportedClientFeatures)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DescribeDomainRequest struct from its Thrift-level
+// FromWire deserializes a DescribeClusterResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeDomainRequest struct
+// An error is returned if we were unable to build a DescribeClusterResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7151,12 +7682,12 @@ func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeDomainRequest
+//   var v DescribeClusterResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
+func (v *DescribeClusterResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -7165,7 +7696,75 @@ func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+				v.ServerVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PersistenceBackend = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.VisibilityBackend = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.GlobalDomainEnabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.AdvancedVisibilityEnabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ArchivalEnabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TList {
+				v.SupportedClientFeatures, err = _List_String_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -7177,31 +7776,266 @@ func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeDomainRequest
+// String returns a readable string representation of a DescribeClusterResponse
 // struct.
-func (v *DescribeDomainRequest) String() string {
+func (v *DescribeClusterResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [8]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+	if v.ServerVersion != nil {
+		fields[i] = fmt.Sprintf("ServerVersion: %v", *(v.ServerVersion))
+		i++
+	}
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+		i++
+	}
+	if v.PersistenceBackend != nil {
+		fields[i] = fmt.Sprintf("PersistenceBackend: %v", *(v.PersistenceBackend))
+		i++
+	}
+	if v.VisibilityBackend != nil {
+		fields[i] = fmt.Sprintf("VisibilityBackend: %v", *(v.VisibilityBackend))
+		i++
+	}
+	if v.GlobalDomainEnabled != nil {
+		fields[i] = fmt.Sprintf("GlobalDomainEnabled: %v", *(v.GlobalDomainEnabled))
+		i++
+	}
+	if v.AdvancedVisibilityEnabled != nil {
+		fields[i] = fmt.Sprintf("AdvancedVisibilityEnabled: %v", *(v.AdvancedVisibilityEnabled))
+		i++
+	}
+	if v.ArchivalEnabled != nil {
+		fields[i] = fmt.Sprintf("ArchivalEnabled: %v", *(v.ArchivalEnabled))
+		i++
+	}
+	if v.SupportedClientFeatures != nil {
+		fields[i] = fmt.Sprintf("SupportedClientFeatures: %v", v.SupportedClientFeatures)
 		i++
 	}
 
-	return fmt.Sprintf("DescribeDomainRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeClusterResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DescribeDomainRequest match the
-// provided DescribeDomainRequest.
-//
-// This function performs a deep comparison.
-func (v *DescribeDomainRequest) Equals(rhs *DescribeDomainRequest) bool {
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
-		return false
-	}
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DescribeClusterResponse match the
+// provided DescribeClusterResponse.
+//
+// This function performs a deep comparison.
+func (v *DescribeClusterResponse) Equals(rhs *DescribeClusterResponse) bool {
+	if !_String_EqualsPtr(v.ServerVersion, rhs.ServerVersion) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+		return false
+	}
+	if !_String_EqualsPtr(v.PersistenceBackend, rhs.PersistenceBackend) {
+		return false
+	}
+	if !_String_EqualsPtr(v.VisibilityBackend, rhs.VisibilityBackend) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.GlobalDomainEnabled, rhs.GlobalDomainEnabled) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.AdvancedVisibilityEnabled, rhs.AdvancedVisibilityEnabled) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ArchivalEnabled, rhs.ArchivalEnabled) {
+		return false
+	}
+	if !((v.SupportedClientFeatures == nil && rhs.SupportedClientFeatures == nil) || (v.SupportedClientFeatures != nil && rhs.SupportedClientFeatures != nil && _List_String_Equals(v.SupportedClientFeatures, rhs.SupportedClientFeatures))) {
+		return false
+	}
+
+	return true
+}
+
+// GetServerVersion returns the value of ServerVersion if it is set or its
+// zero value if it is unset.
+func (v *DescribeClusterResponse) GetServerVersion() (o string) {
+	if v.ServerVersion != nil {
+		return *v.ServerVersion
+	}
+
+	return
+}
+
+// GetClusterName returns the value of ClusterName if it is set or its
+// zero value if it is unset.
+func (v *DescribeClusterResponse) GetClusterName() (o string) {
+	if v.ClusterName != nil {
+		return *v.ClusterName
+	}
+
+	return
+}
+
+// GetPersistenceBackend returns the value of PersistenceBackend if it is set or its
+// zero value if it is unset.
+func (v *DescribeClusterResponse) GetPersistenceBackend() (o string) {
+	if v.PersistenceBackend != nil {
+		return *v.PersistenceBackend
+	}
+
+	return
+}
+
+// GetVisibilityBackend returns the value of VisibilityBackend if it is set or its
+// zero value if it is unset.
+func (v *DescribeClusterResponse) GetVisibilityBackend() (o string) {
+	if v.VisibilityBackend != nil {
+		return *v.VisibilityBackend
+	}
+
+	return
+}
+
+// GetGlobalDomainEnabled returns the value of GlobalDomainEnabled if it is set or its
+// zero value if it is unset.
+func (v *DescribeClusterResponse) GetGlobalDomainEnabled() (o bool) {
+	if v.GlobalDomainEnabled != nil {
+		return *v.GlobalDomainEnabled
+	}
+
+	return
+}
+
+// GetAdvancedVisibilityEnabled returns the value of AdvancedVisibilityEnabled if it is set or its
+// zero value if it is unset.
+func (v *DescribeClusterResponse) GetAdvancedVisibilityEnabled() (o bool) {
+	if v.AdvancedVisibilityEnabled != nil {
+		return *v.AdvancedVisibilityEnabled
+	}
+
+	return
+}
+
+// GetArchivalEnabled returns the value of ArchivalEnabled if it is set or its
+// zero value if it is unset.
+func (v *DescribeClusterResponse) GetArchivalEnabled() (o bool) {
+	if v.ArchivalEnabled != nil {
+		return *v.ArchivalEnabled
+	}
+
+	return
+}
+
+type DescribeDomainRequest struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// ToWire translates a DescribeDomainRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeDomainRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeDomainRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeDomainRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeDomainRequest
+// struct.
+func (v *DescribeDomainRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("DescribeDomainRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeDomainRequest match the
+// provided DescribeDomainRequest.
+//
+// This function performs a deep comparison.
+func (v *DescribeDomainRequest) Equals(rhs *DescribeDomainRequest) bool {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
 
 	return true
 }
@@ -7414,16 +8248,6 @@ func (v *DescribeDomainResponse) String() string {
 	return fmt.Sprintf("DescribeDomainResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 // Equals returns true if all the fields of this DescribeDomainResponse match the
 // provided DescribeDomainResponse.
 //
@@ -10219,8 +11043,9 @@ func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetDomain() (o string
 }
 
 type FailWorkflowExecutionDecisionAttributes struct {
-	Reason  *string `json:"reason,omitempty"`
-	Details []byte  `json:"details,omitempty"`
+	Reason  *string  `json:"reason,omitempty"`
+	Details []byte   `json:"details,omitempty"`
+	Failure *Failure `json:"failure,omitempty"`
 }
 
 // ToWire translates a FailWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
@@ -10240,7 +11065,7 @@ type FailWorkflowExecutionDecisionAttributes struct {
 //   }
 func (v *FailWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -10262,6 +11087,14 @@ func (v *FailWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Failure != nil {
+		w, err = v.Failure.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -10305,6 +11138,14 @@ func (v *FailWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.Failure, err = _Failure_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -10319,7 +11160,7 @@ func (v *FailWorkflowExecutionDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.Reason != nil {
 		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
@@ -10329,6 +11170,10 @@ func (v *FailWorkflowExecutionDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("Details: %v", v.Details)
 		i++
 	}
+	if v.Failure != nil {
+		fields[i] = fmt.Sprintf("Failure: %v", v.Failure)
+		i++
+	}
 
 	return fmt.Sprintf("FailWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -10344,6 +11189,9 @@ func (v *FailWorkflowExecutionDecisionAttributes) Equals(rhs *FailWorkflowExecut
 	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
 		return false
 	}
+	if !((v.Failure == nil && rhs.Failure == nil) || (v.Failure != nil && rhs.Failure != nil && v.Failure.Equals(rhs.Failure))) {
+		return false
+	}
 
 	return true
 }
@@ -10358,16 +11206,14 @@ func (v *FailWorkflowExecutionDecisionAttributes) GetReason() (o string) {
 	return
 }
 
-type GetWorkflowExecutionHistoryRequest struct {
-	Domain                 *string                 `json:"domain,omitempty"`
-	Execution              *WorkflowExecution      `json:"execution,omitempty"`
-	MaximumPageSize        *int32                  `json:"maximumPageSize,omitempty"`
-	NextPageToken          []byte                  `json:"nextPageToken,omitempty"`
-	WaitForNewEvent        *bool                   `json:"waitForNewEvent,omitempty"`
-	HistoryEventFilterType *HistoryEventFilterType `json:"HistoryEventFilterType,omitempty"`
+type Failure struct {
+	Type       *string  `json:"type,omitempty"`
+	Message    *string  `json:"message,omitempty"`
+	StackTrace *string  `json:"stackTrace,omitempty"`
+	Cause      *Failure `json:"cause,omitempty"`
 }
 
-// ToWire translates a GetWorkflowExecutionHistoryRequest struct into a Thrift-level intermediate
+// ToWire translates a Failure struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10382,71 +11228,285 @@ type GetWorkflowExecutionHistoryRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
+func (v *Failure) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.Type != nil {
+		w, err = wire.NewValueString(*(v.Type)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+	if v.StackTrace != nil {
+		w, err = wire.NewValueString(*(v.StackTrace)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.Cause != nil {
+		w, err = v.Cause.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.WaitForNewEvent != nil {
-		w, err = wire.NewValueBool(*(v.WaitForNewEvent)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.HistoryEventFilterType != nil {
-		w, err = v.HistoryEventFilterType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _HistoryEventFilterType_Read(w wire.Value) (HistoryEventFilterType, error) {
-	var v HistoryEventFilterType
-	err := v.FromWire(w)
-	return v, err
-}
+// FromWire deserializes a Failure struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Failure struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Failure
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Failure) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Type = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Message = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StackTrace = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.Cause, err = _Failure_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Failure
+// struct.
+func (v *Failure) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+		i++
+	}
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+	if v.StackTrace != nil {
+		fields[i] = fmt.Sprintf("StackTrace: %v", *(v.StackTrace))
+		i++
+	}
+	if v.Cause != nil {
+		fields[i] = fmt.Sprintf("Cause: %v", v.Cause)
+		i++
+	}
+
+	return fmt.Sprintf("Failure{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Failure match the
+// provided Failure.
+//
+// This function performs a deep comparison.
+func (v *Failure) Equals(rhs *Failure) bool {
+	if !_String_EqualsPtr(v.Type, rhs.Type) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+	if !_String_EqualsPtr(v.StackTrace, rhs.StackTrace) {
+		return false
+	}
+	if !((v.Cause == nil && rhs.Cause == nil) || (v.Cause != nil && rhs.Cause != nil && v.Cause.Equals(rhs.Cause))) {
+		return false
+	}
+
+	return true
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *Failure) GetType() (o string) {
+	if v.Type != nil {
+		return *v.Type
+	}
+
+	return
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *Failure) GetMessage() (o string) {
+	if v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// GetStackTrace returns the value of StackTrace if it is set or its
+// zero value if it is unset.
+func (v *Failure) GetStackTrace() (o string) {
+	if v.StackTrace != nil {
+		return *v.StackTrace
+	}
+
+	return
+}
+
+type GetWorkflowExecutionHistoryRequest struct {
+	Domain                 *string                 `json:"domain,omitempty"`
+	Execution              *WorkflowExecution      `json:"execution,omitempty"`
+	MaximumPageSize        *int32                  `json:"maximumPageSize,omitempty"`
+	NextPageToken          []byte                  `json:"nextPageToken,omitempty"`
+	WaitForNewEvent        *bool                   `json:"waitForNewEvent,omitempty"`
+	HistoryEventFilterType *HistoryEventFilterType `json:"HistoryEventFilterType,omitempty"`
+}
+
+// ToWire translates a GetWorkflowExecutionHistoryRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.WaitForNewEvent != nil {
+		w, err = wire.NewValueBool(*(v.WaitForNewEvent)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.HistoryEventFilterType != nil {
+		w, err = v.HistoryEventFilterType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _HistoryEventFilterType_Read(w wire.Value) (HistoryEventFilterType, error) {
+	var v HistoryEventFilterType
+	err := v.FromWire(w)
+	return v, err
+}
 
 // FromWire deserializes a GetWorkflowExecutionHistoryRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
@@ -14980,6 +16040,7 @@ type PollForDecisionTaskResponse struct {
 	History                *History           `json:"history,omitempty"`
 	NextPageToken          []byte             `json:"nextPageToken,omitempty"`
 	Query                  *WorkflowQuery     `json:"query,omitempty"`
+	SuggestContinueAsNew   *bool              `json:"suggestContinueAsNew,omitempty"`
 }
 
 // ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
@@ -14999,7 +16060,7 @@ type PollForDecisionTaskResponse struct {
 //   }
 func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [10]wire.Field
+		fields [11]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -15085,6 +16146,14 @@ func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
+	if v.SuggestContinueAsNew != nil {
+		w, err = wire.NewValueBool(*(v.SuggestContinueAsNew)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -15204,6 +16273,16 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 90:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.SuggestContinueAsNew = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -15218,7 +16297,7 @@ func (v *PollForDecisionTaskResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [10]string
+	var fields [11]string
 	i := 0
 	if v.TaskToken != nil {
 		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
@@ -15260,6 +16339,10 @@ func (v *PollForDecisionTaskResponse) String() string {
 		fields[i] = fmt.Sprintf("Query: %v", v.Query)
 		i++
 	}
+	if v.SuggestContinueAsNew != nil {
+		fields[i] = fmt.Sprintf("SuggestContinueAsNew: %v", *(v.SuggestContinueAsNew))
+		i++
+	}
 
 	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -15299,6 +16382,9 @@ func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) b
 	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.SuggestContinueAsNew, rhs.SuggestContinueAsNew) {
+		return false
+	}
 
 	return true
 }
@@ -15343,6 +16429,16 @@ func (v *PollForDecisionTaskResponse) GetBacklogCountHint() (o int64) {
 	return
 }
 
+// GetSuggestContinueAsNew returns the value of SuggestContinueAsNew if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetSuggestContinueAsNew() (o bool) {
+	if v.SuggestContinueAsNew != nil {
+		return *v.SuggestContinueAsNew
+	}
+
+	return
+}
+
 type PollerInfo struct {
 	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
 	Identity       *string `json:"identity,omitempty"`
@@ -19217,13 +20313,15 @@ func (v *RespondActivityTaskCompletedRequest) GetIdentity() (o string) {
 }
 
 type RespondActivityTaskFailedByIDRequest struct {
-	Domain     *string `json:"domain,omitempty"`
-	WorkflowID *string `json:"workflowID,omitempty"`
-	RunID      *string `json:"runID,omitempty"`
-	ActivityID *string `json:"activityID,omitempty"`
-	Reason     *string `json:"reason,omitempty"`
-	Details    []byte  `json:"details,omitempty"`
-	Identity   *string `json:"identity,omitempty"`
+	Domain       *string  `json:"domain,omitempty"`
+	WorkflowID   *string  `json:"workflowID,omitempty"`
+	RunID        *string  `json:"runID,omitempty"`
+	ActivityID   *string  `json:"activityID,omitempty"`
+	Reason       *string  `json:"reason,omitempty"`
+	Details      []byte   `json:"details,omitempty"`
+	Identity     *string  `json:"identity,omitempty"`
+	FailureTypes []string `json:"failureTypes,omitempty"`
+	Failure      *Failure `json:"failure,omitempty"`
 }
 
 // ToWire translates a RespondActivityTaskFailedByIDRequest struct into a Thrift-level intermediate
@@ -19243,7 +20341,7 @@ type RespondActivityTaskFailedByIDRequest struct {
 //   }
 func (v *RespondActivityTaskFailedByIDRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -19305,6 +20403,22 @@ func (v *RespondActivityTaskFailedByIDRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.FailureTypes != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.FailureTypes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.Failure != nil {
+		w, err = v.Failure.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -19399,7 +20513,23 @@ func (v *RespondActivityTaskFailedByIDRequest) FromWire(w wire.Value) error {
 				}
 
 			}
-		}
+		case 80:
+			if field.Value.Type() == wire.TList {
+				v.FailureTypes, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.Failure, err = _Failure_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
 
 	return nil
@@ -19412,7 +20542,7 @@ func (v *RespondActivityTaskFailedByIDRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [9]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -19442,6 +20572,14 @@ func (v *RespondActivityTaskFailedByIDRequest) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.FailureTypes != nil {
+		fields[i] = fmt.Sprintf("FailureTypes: %v", v.FailureTypes)
+		i++
+	}
+	if v.Failure != nil {
+		fields[i] = fmt.Sprintf("Failure: %v", v.Failure)
+		i++
+	}
 
 	return fmt.Sprintf("RespondActivityTaskFailedByIDRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -19472,6 +20610,12 @@ func (v *RespondActivityTaskFailedByIDRequest) Equals(rhs *RespondActivityTaskFa
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !((v.FailureTypes == nil && rhs.FailureTypes == nil) || (v.FailureTypes != nil && rhs.FailureTypes != nil && _List_String_Equals(v.FailureTypes, rhs.FailureTypes))) {
+		return false
+	}
+	if !((v.Failure == nil && rhs.Failure == nil) || (v.Failure != nil && rhs.Failure != nil && v.Failure.Equals(rhs.Failure))) {
+		return false
+	}
 
 	return true
 }
@@ -19537,10 +20681,12 @@ func (v *RespondActivityTaskFailedByIDRequest) GetIdentity() (o string) {
 }
 
 type RespondActivityTaskFailedRequest struct {
-	TaskToken []byte  `json:"taskToken,omitempty"`
-	Reason    *string `json:"reason,omitempty"`
-	Details   []byte  `json:"details,omitempty"`
-	Identity  *string `json:"identity,omitempty"`
+	TaskToken    []byte   `json:"taskToken,omitempty"`
+	Reason       *string  `json:"reason,omitempty"`
+	Details      []byte   `json:"details,omitempty"`
+	Identity     *string  `json:"identity,omitempty"`
+	FailureTypes []string `json:"failureTypes,omitempty"`
+	Failure      *Failure `json:"failure,omitempty"`
 }
 
 // ToWire translates a RespondActivityTaskFailedRequest struct into a Thrift-level intermediate
@@ -19560,7 +20706,7 @@ type RespondActivityTaskFailedRequest struct {
 //   }
 func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -19598,6 +20744,22 @@ func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.FailureTypes != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.FailureTypes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Failure != nil {
+		w, err = v.Failure.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -19659,6 +20821,22 @@ func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TList {
+				v.FailureTypes, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.Failure, err = _Failure_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -19673,7 +20851,7 @@ func (v *RespondActivityTaskFailedRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
 	if v.TaskToken != nil {
 		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
@@ -19691,6 +20869,14 @@ func (v *RespondActivityTaskFailedRequest) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.FailureTypes != nil {
+		fields[i] = fmt.Sprintf("FailureTypes: %v", v.FailureTypes)
+		i++
+	}
+	if v.Failure != nil {
+		fields[i] = fmt.Sprintf("Failure: %v", v.Failure)
+		i++
+	}
 
 	return fmt.Sprintf("RespondActivityTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -19712,6 +20898,12 @@ func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailed
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !((v.FailureTypes == nil && rhs.FailureTypes == nil) || (v.FailureTypes != nil && rhs.FailureTypes != nil && _List_String_Equals(v.FailureTypes, rhs.FailureTypes))) {
+		return false
+	}
+	if !((v.Failure == nil && rhs.Failure == nil) || (v.Failure != nil && rhs.Failure != nil && v.Failure.Equals(rhs.Failure))) {
+		return false
+	}
 
 	return true
 }
@@ -20439,39 +21631,15 @@ func (v *RespondQueryTaskCompletedRequest) GetErrorMessage() (o string) {
 }
 
 type RetryPolicy struct {
-	InitialIntervalInSeconds *int32   `json:"initialIntervalInSeconds,omitempty"`
-	BackoffCoefficient       *float64 `json:"backoffCoefficient,omitempty"`
-	MaximumIntervalInSeconds *int32   `json:"maximumIntervalInSeconds,omitempty"`
-	MaximumAttempts          *int32   `json:"maximumAttempts,omitempty"`
-	NonRetriableErrorReasons []string `json:"nonRetriableErrorReasons,omitempty"`
-}
-
-type _List_String_ValueList []string
-
-func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_String_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_String_ValueList) ValueType() wire.Type {
-	return wire.TBinary
+	InitialIntervalInSeconds    *int32   `json:"initialIntervalInSeconds,omitempty"`
+	BackoffCoefficient          *float64 `json:"backoffCoefficient,omitempty"`
+	MaximumIntervalInSeconds    *int32   `json:"maximumIntervalInSeconds,omitempty"`
+	MaximumAttempts             *int32   `json:"maximumAttempts,omitempty"`
+	NonRetriableErrorReasons    []string `json:"nonRetriableErrorReasons,omitempty"`
+	NonRetriableErrorTypes      []string `json:"nonRetriableErrorTypes,omitempty"`
+	ExpirationIntervalInSeconds *int32   `json:"expirationIntervalInSeconds,omitempty"`
 }
 
-func (_List_String_ValueList) Close() {}
-
 // ToWire translates a RetryPolicy struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -20489,7 +21657,7 @@ func (_List_String_ValueList) Close() {}
 //   }
 func (v *RetryPolicy) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -20535,26 +21703,24 @@ func (v *RetryPolicy) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _List_String_Read(l wire.ValueList) ([]string, error) {
-	if l.ValueType() != wire.TBinary {
-		return nil, nil
+	if v.NonRetriableErrorTypes != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.NonRetriableErrorTypes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
 	}
-
-	o := make([]string, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
+	if v.ExpirationIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ExpirationIntervalInSeconds)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
 // FromWire deserializes a RetryPolicy struct from its Thrift-level
@@ -20626,6 +21792,24 @@ func (v *RetryPolicy) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.NonRetriableErrorTypes, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExpirationIntervalInSeconds = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -20640,7 +21824,7 @@ func (v *RetryPolicy) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [7]string
 	i := 0
 	if v.InitialIntervalInSeconds != nil {
 		fields[i] = fmt.Sprintf("InitialIntervalInSeconds: %v", *(v.InitialIntervalInSeconds))
@@ -20662,6 +21846,14 @@ func (v *RetryPolicy) String() string {
 		fields[i] = fmt.Sprintf("NonRetriableErrorReasons: %v", v.NonRetriableErrorReasons)
 		i++
 	}
+	if v.NonRetriableErrorTypes != nil {
+		fields[i] = fmt.Sprintf("NonRetriableErrorTypes: %v", v.NonRetriableErrorTypes)
+		i++
+	}
+	if v.ExpirationIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("ExpirationIntervalInSeconds: %v", *(v.ExpirationIntervalInSeconds))
+		i++
+	}
 
 	return fmt.Sprintf("RetryPolicy{%v}", strings.Join(fields[:i], ", "))
 }
@@ -20676,21 +21868,6 @@ func _Double_EqualsPtr(lhs, rhs *float64) bool {
 	return lhs == nil && rhs == nil
 }
 
-func _List_String_Equals(lhs, rhs []string) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
-		}
-	}
-
-	return true
-}
-
 // Equals returns true if all the fields of this RetryPolicy match the
 // provided RetryPolicy.
 //
@@ -20711,6 +21888,12 @@ func (v *RetryPolicy) Equals(rhs *RetryPolicy) bool {
 	if !((v.NonRetriableErrorReasons == nil && rhs.NonRetriableErrorReasons == nil) || (v.NonRetriableErrorReasons != nil && rhs.NonRetriableErrorReasons != nil && _List_String_Equals(v.NonRetriableErrorReasons, rhs.NonRetriableErrorReasons))) {
 		return false
 	}
+	if !((v.NonRetriableErrorTypes == nil && rhs.NonRetriableErrorTypes == nil) || (v.NonRetriableErrorTypes != nil && rhs.NonRetriableErrorTypes != nil && _List_String_Equals(v.NonRetriableErrorTypes, rhs.NonRetriableErrorTypes))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ExpirationIntervalInSeconds, rhs.ExpirationIntervalInSeconds) {
+		return false
+	}
 
 	return true
 }
@@ -20755,6 +21938,16 @@ func (v *RetryPolicy) GetMaximumAttempts() (o int32) {
 	return
 }
 
+// GetExpirationIntervalInSeconds returns the value of ExpirationIntervalInSeconds if it is set or its
+// zero value if it is unset.
+func (v *RetryPolicy) GetExpirationIntervalInSeconds() (o int32) {
+	if v.ExpirationIntervalInSeconds != nil {
+		return *v.ExpirationIntervalInSeconds
+	}
+
+	return
+}
+
 type ScheduleActivityTaskDecisionAttributes struct {
 	ActivityId                    *string       `json:"activityId,omitempty"`
 	ActivityType                  *ActivityType `json:"activityType,omitempty"`
@@ -22241,6 +23434,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	SignalName                          *string                `json:"signalName,omitempty"`
 	SignalInput                         []byte                 `json:"signalInput,omitempty"`
 	Control                             []byte                 `json:"control,omitempty"`
+	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
 }
 
 // ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -22260,7 +23454,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 //   }
 func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -22370,6 +23564,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 130, Value: w}
 		i++
 	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -22521,6 +23723,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 140:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -22535,7 +23745,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [13]string
+	var fields [14]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -22589,6 +23799,10 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("Control: %v", v.Control)
 		i++
 	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
 
 	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -22647,6 +23861,9 @@ func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWor
 	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
 		return false
 	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
 
 	return true
 }
@@ -24503,6 +25720,7 @@ type StartWorkflowExecutionRequest struct {
 	RequestId                           *string                `json:"requestId,omitempty"`
 	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
 	ChildPolicy                         *ChildPolicy           `json:"childPolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
 }
 
 // ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -24522,7 +25740,7 @@ type StartWorkflowExecutionRequest struct {
 //   }
 func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -24616,6 +25834,14 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -24745,6 +25971,14 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -24759,7 +25993,7 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [12]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -24805,6 +26039,10 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("ChildPolicy: %v", *(v.ChildPolicy))
 		i++
 	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
 
 	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -24847,6 +26085,9 @@ func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionReques
 	if !_ChildPolicy_EqualsPtr(v.ChildPolicy, rhs.ChildPolicy) {
 		return false
 	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
 
 	return true
 }
@@ -28612,13 +29853,18 @@ func (v *WorkflowExecutionConfiguration) GetChildPolicy() (o ChildPolicy) {
 }
 
 type WorkflowExecutionContinuedAsNewEventAttributes struct {
-	NewExecutionRunId                   *string       `json:"newExecutionRunId,omitempty"`
-	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
-	TaskList                            *TaskList     `json:"taskList,omitempty"`
-	Input                               []byte        `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	DecisionTaskCompletedEventId        *int64        `json:"decisionTaskCompletedEventId,omitempty"`
+	NewExecutionRunId                   *string                 `json:"newExecutionRunId,omitempty"`
+	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
+	TaskList                            *TaskList               `json:"taskList,omitempty"`
+	Input                               []byte                  `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                  `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                  `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	DecisionTaskCompletedEventId        *int64                  `json:"decisionTaskCompletedEventId,omitempty"`
+	BackoffStartIntervalInSeconds       *int32                  `json:"backoffStartIntervalInSeconds,omitempty"`
+	Initiator                           *ContinueAsNewInitiator `json:"initiator,omitempty"`
+	FailureReason                       *string                 `json:"failureReason,omitempty"`
+	FailureDetails                      []byte                  `json:"failureDetails,omitempty"`
+	Failure                             *Failure                `json:"failure,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionContinuedAsNewEventAttributes struct into a Thrift-level intermediate
@@ -28638,7 +29884,7 @@ type WorkflowExecutionContinuedAsNewEventAttributes struct {
 //   }
 func (v *WorkflowExecutionContinuedAsNewEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -28700,6 +29946,46 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) ToWire() (wire.Value, e
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.BackoffStartIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.Initiator != nil {
+		w, err = v.Initiator.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.FailureReason != nil {
+		w, err = wire.NewValueString(*(v.FailureReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.FailureDetails != nil {
+		w, err = wire.NewValueBinary(v.FailureDetails), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.Failure != nil {
+		w, err = v.Failure.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -28790,10 +30076,56 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) FromWire(w wire.Value)
 				}
 
 			}
-		}
-	}
-
-	return nil
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.BackoffStartIntervalInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI32 {
+				var x ContinueAsNewInitiator
+				x, err = _ContinueAsNewInitiator_Read(field.Value)
+				v.Initiator = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FailureReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				v.FailureDetails, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.Failure, err = _Failure_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
 }
 
 // String returns a readable string representation of a WorkflowExecutionContinuedAsNewEventAttributes
@@ -28803,7 +30135,7 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [12]string
 	i := 0
 	if v.NewExecutionRunId != nil {
 		fields[i] = fmt.Sprintf("NewExecutionRunId: %v", *(v.NewExecutionRunId))
@@ -28833,6 +30165,26 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("BackoffStartIntervalInSeconds: %v", *(v.BackoffStartIntervalInSeconds))
+		i++
+	}
+	if v.Initiator != nil {
+		fields[i] = fmt.Sprintf("Initiator: %v", *(v.Initiator))
+		i++
+	}
+	if v.FailureReason != nil {
+		fields[i] = fmt.Sprintf("FailureReason: %v", *(v.FailureReason))
+		i++
+	}
+	if v.FailureDetails != nil {
+		fields[i] = fmt.Sprintf("FailureDetails: %v", v.FailureDetails)
+		i++
+	}
+	if v.Failure != nil {
+		fields[i] = fmt.Sprintf("Failure: %v", v.Failure)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionContinuedAsNewEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -28863,6 +30215,21 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Equals(rhs *WorkflowExe
 	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.BackoffStartIntervalInSeconds, rhs.BackoffStartIntervalInSeconds) {
+		return false
+	}
+	if !_ContinueAsNewInitiator_EqualsPtr(v.Initiator, rhs.Initiator) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FailureReason, rhs.FailureReason) {
+		return false
+	}
+	if !((v.FailureDetails == nil && rhs.FailureDetails == nil) || (v.FailureDetails != nil && rhs.FailureDetails != nil && bytes.Equal(v.FailureDetails, rhs.FailureDetails))) {
+		return false
+	}
+	if !((v.Failure == nil && rhs.Failure == nil) || (v.Failure != nil && rhs.Failure != nil && v.Failure.Equals(rhs.Failure))) {
+		return false
+	}
 
 	return true
 }
@@ -28907,10 +30274,41 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetDecisionTaskComplete
 	return
 }
 
+// GetBackoffStartIntervalInSeconds returns the value of BackoffStartIntervalInSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetBackoffStartIntervalInSeconds() (o int32) {
+	if v.BackoffStartIntervalInSeconds != nil {
+		return *v.BackoffStartIntervalInSeconds
+	}
+
+	return
+}
+
+// GetInitiator returns the value of Initiator if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetInitiator() (o ContinueAsNewInitiator) {
+	if v.Initiator != nil {
+		return *v.Initiator
+	}
+
+	return
+}
+
+// GetFailureReason returns the value of FailureReason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetFailureReason() (o string) {
+	if v.FailureReason != nil {
+		return *v.FailureReason
+	}
+
+	return
+}
+
 type WorkflowExecutionFailedEventAttributes struct {
-	Reason                       *string `json:"reason,omitempty"`
-	Details                      []byte  `json:"details,omitempty"`
-	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+	Reason                       *string  `json:"reason,omitempty"`
+	Details                      []byte   `json:"details,omitempty"`
+	DecisionTaskCompletedEventId *int64   `json:"decisionTaskCompletedEventId,omitempty"`
+	Failure                      *Failure `json:"failure,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionFailedEventAttributes struct into a Thrift-level intermediate
@@ -28930,7 +30328,7 @@ type WorkflowExecutionFailedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -28960,6 +30358,14 @@ func (v *WorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.Failure != nil {
+		w, err = v.Failure.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -29013,6 +30419,14 @@ func (v *WorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.Failure, err = _Failure_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -29027,7 +30441,7 @@ func (v *WorkflowExecutionFailedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.Reason != nil {
 		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
@@ -29041,6 +30455,10 @@ func (v *WorkflowExecutionFailedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
+	if v.Failure != nil {
+		fields[i] = fmt.Sprintf("Failure: %v", v.Failure)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -29059,6 +30477,9 @@ func (v *WorkflowExecutionFailedEventAttributes) Equals(rhs *WorkflowExecutionFa
 	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
+	if !((v.Failure == nil && rhs.Failure == nil) || (v.Failure != nil && rhs.Failure != nil && v.Failure.Equals(rhs.Failure))) {
+		return false
+	}
 
 	return true
 }
@@ -29200,12 +30621,15 @@ func (v *WorkflowExecutionFilter) GetWorkflowId() (o string) {
 }
 
 type WorkflowExecutionInfo struct {
-	Execution     *WorkflowExecution            `json:"execution,omitempty"`
-	Type          *WorkflowType                 `json:"type,omitempty"`
-	StartTime     *int64                        `json:"startTime,omitempty"`
-	CloseTime     *int64                        `json:"closeTime,omitempty"`
-	CloseStatus   *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
-	HistoryLength *int64                        `json:"historyLength,omitempty"`
+	Execution      *WorkflowExecution            `json:"execution,omitempty"`
+	Type           *WorkflowType                 `json:"type,omitempty"`
+	StartTime      *int64                        `json:"startTime,omitempty"`
+	CloseTime      *int64                        `json:"closeTime,omitempty"`
+	CloseStatus    *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	HistoryLength  *int64                        `json:"historyLength,omitempty"`
+	Attempt        *int32                        `json:"attempt,omitempty"`
+	LastUpdateTime *int64                        `json:"lastUpdateTime,omitempty"`
+	Initiator      *WorkflowExecutionInitiator   `json:"initiator,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionInfo struct into a Thrift-level intermediate
@@ -29225,7 +30649,7 @@ type WorkflowExecutionInfo struct {
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -29279,10 +30703,40 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.LastUpdateTime != nil {
+		w, err = wire.NewValueI64(*(v.LastUpdateTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.Initiator != nil {
+		w, err = v.Initiator.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _WorkflowExecutionInitiator_Read(w wire.Value) (WorkflowExecutionInitiator, error) {
+	var v WorkflowExecutionInitiator
+	err := v.FromWire(w)
+	return v, err
+}
+
 // FromWire deserializes a WorkflowExecutionInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -29360,6 +30814,36 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastUpdateTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowExecutionInitiator
+				x, err = _WorkflowExecutionInitiator_Read(field.Value)
+				v.Initiator = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -29374,7 +30858,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [9]string
 	i := 0
 	if v.Execution != nil {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
@@ -29400,10 +30884,32 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("HistoryLength: %v", *(v.HistoryLength))
 		i++
 	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.LastUpdateTime != nil {
+		fields[i] = fmt.Sprintf("LastUpdateTime: %v", *(v.LastUpdateTime))
+		i++
+	}
+	if v.Initiator != nil {
+		fields[i] = fmt.Sprintf("Initiator: %v", *(v.Initiator))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _WorkflowExecutionInitiator_EqualsPtr(lhs, rhs *WorkflowExecutionInitiator) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
 // Equals returns true if all the fields of this WorkflowExecutionInfo match the
 // provided WorkflowExecutionInfo.
 //
@@ -29427,6 +30933,15 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_I64_EqualsPtr(v.HistoryLength, rhs.HistoryLength) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastUpdateTime, rhs.LastUpdateTime) {
+		return false
+	}
+	if !_WorkflowExecutionInitiator_EqualsPtr(v.Initiator, rhs.Initiator) {
+		return false
+	}
 
 	return true
 }
@@ -29471,6 +30986,189 @@ func (v *WorkflowExecutionInfo) GetHistoryLength() (o int64) {
 	return
 }
 
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetAttempt() (o int32) {
+	if v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// GetLastUpdateTime returns the value of LastUpdateTime if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetLastUpdateTime() (o int64) {
+	if v.LastUpdateTime != nil {
+		return *v.LastUpdateTime
+	}
+
+	return
+}
+
+// GetInitiator returns the value of Initiator if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetInitiator() (o WorkflowExecutionInitiator) {
+	if v.Initiator != nil {
+		return *v.Initiator
+	}
+
+	return
+}
+
+type WorkflowExecutionInitiator int32
+
+const (
+	WorkflowExecutionInitiatorClient         WorkflowExecutionInitiator = 0
+	WorkflowExecutionInitiatorParentWorkflow WorkflowExecutionInitiator = 1
+	WorkflowExecutionInitiatorContinueAsNew  WorkflowExecutionInitiator = 2
+	WorkflowExecutionInitiatorRetryPolicy    WorkflowExecutionInitiator = 3
+)
+
+// WorkflowExecutionInitiator_Values returns all recognized values of WorkflowExecutionInitiator.
+func WorkflowExecutionInitiator_Values() []WorkflowExecutionInitiator {
+	return []WorkflowExecutionInitiator{
+		WorkflowExecutionInitiatorClient,
+		WorkflowExecutionInitiatorParentWorkflow,
+		WorkflowExecutionInitiatorContinueAsNew,
+		WorkflowExecutionInitiatorRetryPolicy,
+	}
+}
+
+// UnmarshalText tries to decode WorkflowExecutionInitiator from a byte slice
+// containing its name.
+//
+//   var v WorkflowExecutionInitiator
+//   err := v.UnmarshalText([]byte("Client"))
+func (v *WorkflowExecutionInitiator) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "Client":
+		*v = WorkflowExecutionInitiatorClient
+		return nil
+	case "ParentWorkflow":
+		*v = WorkflowExecutionInitiatorParentWorkflow
+		return nil
+	case "ContinueAsNew":
+		*v = WorkflowExecutionInitiatorContinueAsNew
+		return nil
+	case "RetryPolicy":
+		*v = WorkflowExecutionInitiatorRetryPolicy
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "WorkflowExecutionInitiator")
+	}
+}
+
+// Ptr returns a pointer to this enum value.
+func (v WorkflowExecutionInitiator) Ptr() *WorkflowExecutionInitiator {
+	return &v
+}
+
+// ToWire translates WorkflowExecutionInitiator into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v WorkflowExecutionInitiator) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes WorkflowExecutionInitiator from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return WorkflowExecutionInitiator(0), err
+//   }
+//
+//   var v WorkflowExecutionInitiator
+//   if err := v.FromWire(x); err != nil {
+//     return WorkflowExecutionInitiator(0), err
+//   }
+//   return v, nil
+func (v *WorkflowExecutionInitiator) FromWire(w wire.Value) error {
+	*v = (WorkflowExecutionInitiator)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of WorkflowExecutionInitiator.
+func (v WorkflowExecutionInitiator) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Client"
+	case 1:
+		return "ParentWorkflow"
+	case 2:
+		return "ContinueAsNew"
+	case 3:
+		return "RetryPolicy"
+	}
+	return fmt.Sprintf("WorkflowExecutionInitiator(%d)", w)
+}
+
+// Equals returns true if this WorkflowExecutionInitiator value matches the provided
+// value.
+func (v WorkflowExecutionInitiator) Equals(rhs WorkflowExecutionInitiator) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes WorkflowExecutionInitiator into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v WorkflowExecutionInitiator) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Client\""), nil
+	case 1:
+		return ([]byte)("\"ParentWorkflow\""), nil
+	case 2:
+		return ([]byte)("\"ContinueAsNew\""), nil
+	case 3:
+		return ([]byte)("\"RetryPolicy\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode WorkflowExecutionInitiator from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *WorkflowExecutionInitiator) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "WorkflowExecutionInitiator")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "WorkflowExecutionInitiator")
+		}
+		*v = (WorkflowExecutionInitiator)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "WorkflowExecutionInitiator")
+	}
+}
+
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName *string `json:"signalName,omitempty"`
 	Input      []byte  `json:"input,omitempty"`
@@ -29659,6 +31357,10 @@ type WorkflowExecutionStartedEventAttributes struct {
 	ChildPolicy                         *ChildPolicy       `json:"childPolicy,omitempty"`
 	ContinuedExecutionRunId             *string            `json:"continuedExecutionRunId,omitempty"`
 	Identity                            *string            `json:"identity,omitempty"`
+	Attempt                             *int32             `json:"attempt,omitempty"`
+	ExpirationTimestamp                 *int64             `json:"expirationTimestamp,omitempty"`
+	FirstDecisionTaskBackoffSeconds     *int32             `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+	RetryPolicy                         *RetryPolicy       `json:"retryPolicy,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionStartedEventAttributes struct into a Thrift-level intermediate
@@ -29678,7 +31380,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [15]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -29772,6 +31474,38 @@ func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpirationTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		w, err = wire.NewValueI32(*(v.FirstDecisionTaskBackoffSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -29899,6 +31633,44 @@ func (v *WorkflowExecutionStartedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpirationTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FirstDecisionTaskBackoffSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -29913,7 +31685,7 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [15]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -29959,6 +31731,22 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpirationTimestamp: %v", *(v.ExpirationTimestamp))
+		i++
+	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		fields[i] = fmt.Sprintf("FirstDecisionTaskBackoffSeconds: %v", *(v.FirstDecisionTaskBackoffSeconds))
+		i++
+	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -30001,6 +31789,18 @@ func (v *WorkflowExecutionStartedEventAttributes) Equals(rhs *WorkflowExecutionS
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpirationTimestamp, rhs.ExpirationTimestamp) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.FirstDecisionTaskBackoffSeconds, rhs.FirstDecisionTaskBackoffSeconds) {
+		return false
+	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
 
 	return true
 }
@@ -30075,6 +31875,180 @@ func (v *WorkflowExecutionStartedEventAttributes) GetIdentity() (o string) {
 	return
 }
 
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetAttempt() (o int32) {
+	if v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// GetExpirationTimestamp returns the value of ExpirationTimestamp if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetExpirationTimestamp() (o int64) {
+	if v.ExpirationTimestamp != nil {
+		return *v.ExpirationTimestamp
+	}
+
+	return
+}
+
+// GetFirstDecisionTaskBackoffSeconds returns the value of FirstDecisionTaskBackoffSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetFirstDecisionTaskBackoffSeconds() (o int32) {
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		return *v.FirstDecisionTaskBackoffSeconds
+	}
+
+	return
+}
+
+type WorkflowExecutionState int32
+
+const (
+	WorkflowExecutionStateCreated   WorkflowExecutionState = 0
+	WorkflowExecutionStateRunning   WorkflowExecutionState = 1
+	WorkflowExecutionStateCompleted WorkflowExecutionState = 2
+)
+
+// WorkflowExecutionState_Values returns all recognized values of WorkflowExecutionState.
+func WorkflowExecutionState_Values() []WorkflowExecutionState {
+	return []WorkflowExecutionState{
+		WorkflowExecutionStateCreated,
+		WorkflowExecutionStateRunning,
+		WorkflowExecutionStateCompleted,
+	}
+}
+
+// UnmarshalText tries to decode WorkflowExecutionState from a byte slice
+// containing its name.
+//
+//   var v WorkflowExecutionState
+//   err := v.UnmarshalText([]byte("CREATED"))
+func (v *WorkflowExecutionState) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "CREATED":
+		*v = WorkflowExecutionStateCreated
+		return nil
+	case "RUNNING":
+		*v = WorkflowExecutionStateRunning
+		return nil
+	case "COMPLETED":
+		*v = WorkflowExecutionStateCompleted
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "WorkflowExecutionState")
+	}
+}
+
+// Ptr returns a pointer to this enum value.
+func (v WorkflowExecutionState) Ptr() *WorkflowExecutionState {
+	return &v
+}
+
+// ToWire translates WorkflowExecutionState into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v WorkflowExecutionState) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes WorkflowExecutionState from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return WorkflowExecutionState(0), err
+//   }
+//
+//   var v WorkflowExecutionState
+//   if err := v.FromWire(x); err != nil {
+//     return WorkflowExecutionState(0), err
+//   }
+//   return v, nil
+func (v *WorkflowExecutionState) FromWire(w wire.Value) error {
+	*v = (WorkflowExecutionState)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of WorkflowExecutionState.
+func (v WorkflowExecutionState) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "CREATED"
+	case 1:
+		return "RUNNING"
+	case 2:
+		return "COMPLETED"
+	}
+	return fmt.Sprintf("WorkflowExecutionState(%d)", w)
+}
+
+// Equals returns true if this WorkflowExecutionState value matches the provided
+// value.
+func (v WorkflowExecutionState) Equals(rhs WorkflowExecutionState) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes WorkflowExecutionState into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v WorkflowExecutionState) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"CREATED\""), nil
+	case 1:
+		return ([]byte)("\"RUNNING\""), nil
+	case 2:
+		return ([]byte)("\"COMPLETED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode WorkflowExecutionState from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *WorkflowExecutionState) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "WorkflowExecutionState")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "WorkflowExecutionState")
+		}
+		*v = (WorkflowExecutionState)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "WorkflowExecutionState")
+	}
+}
+
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason   *string `json:"reason,omitempty"`
 	Details  []byte  `json:"details,omitempty"`
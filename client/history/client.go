@@ -22,6 +22,8 @@ package history
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -158,6 +160,78 @@ func (c *clientImpl) DescribeWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) GetWorkflowExecutionHistoryEvent(
+	ctx context.Context,
+	request *h.GetWorkflowExecutionHistoryEventRequest,
+	opts ...yarpc.CallOption) (*h.GetWorkflowExecutionHistoryEventResponse, error) {
+	client, err := c.getHostForRequest(request.Execution.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.GetWorkflowExecutionHistoryEventResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GetWorkflowExecutionHistoryEvent(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GetCurrentExecution(
+	ctx context.Context,
+	request *h.GetCurrentExecutionRequest,
+	opts ...yarpc.CallOption) (*h.GetCurrentExecutionResponse, error) {
+	client, err := c.getHostForRequest(request.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.GetCurrentExecutionResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GetCurrentExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ListWorkflowExecutionChain(
+	ctx context.Context,
+	request *h.ListWorkflowExecutionChainRequest,
+	opts ...yarpc.CallOption) (*h.ListWorkflowExecutionChainResponse, error) {
+	client, err := c.getHostForRequest(request.Execution.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.ListWorkflowExecutionChainResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ListWorkflowExecutionChain(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RecordDecisionTaskStarted(
 	ctx context.Context,
 	request *h.RecordDecisionTaskStartedRequest,
@@ -441,6 +515,66 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForRequest(*request.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForRequest(*request.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) NukeWorkflowExecution(
+	ctx context.Context,
+	request *h.NukeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*h.NukeWorkflowExecutionResponse, error) {
+	client, err := c.getHostForRequest(*request.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.NukeWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.NukeWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ScheduleDecisionTask(
 	ctx context.Context,
 	request *h.ScheduleDecisionTaskRequest,
@@ -495,9 +629,130 @@ func (c *clientImpl) ReplicateEvents(
 	return err
 }
 
+func (c *clientImpl) ImportWorkflowExecution(
+	ctx context.Context,
+	request *h.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForRequest(request.Execution.GetWorkflowId())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.ImportWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+// RefreshDomainCache is broadcast to all the history hosts currently in the ring, the returned error lists the hosts
+// which failed to refresh
+func (c *clientImpl) RefreshDomainCache(
+	ctx context.Context,
+	request *h.RefreshDomainCacheRequest,
+	opts ...yarpc.CallOption) error {
+	hosts := c.resolver.Members()
+	if len(hosts) == 0 {
+		return membership.ErrInsufficientHosts
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+
+	var failedLock sync.Mutex
+	var failed []string
+	var wg sync.WaitGroup
+	for _, host := range hosts {
+		wg.Add(1)
+		go func(hostPort string) {
+			defer wg.Done()
+			ctx, cancel := c.createContext(ctx)
+			defer cancel()
+			if err := c.getThriftClient(hostPort).RefreshDomainCache(ctx, request, opts...); err != nil {
+				failedLock.Lock()
+				failed = append(failed, fmt.Sprintf("%v: %v", hostPort, err))
+				failedLock.Unlock()
+			}
+		}(host.GetAddress())
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to refresh domain cache on %v of %v history hosts: %v",
+				len(failed), len(hosts), strings.Join(failed, "; ")),
+		}
+	}
+	return nil
+}
+
+func (c *clientImpl) DescribeHistoryHost(
+	ctx context.Context,
+	request *h.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption) (*h.DescribeHistoryHostResponse, error) {
+	var client historyserviceclient.Interface
+	var err error
+	switch {
+	case request.HostAddress != nil:
+		client = c.getThriftClient(request.GetHostAddress())
+	case request.ShardIdForHost != nil:
+		client, err = c.getHostForShard(int(request.GetShardIdForHost()))
+	case request.ExecutionForHost != nil:
+		client, err = c.getHostForRequest(request.ExecutionForHost.GetWorkflowId())
+	default:
+		return nil, &workflow.BadRequestError{Message: "Host address, shard ID or workflow execution is not set on request."}
+	}
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeHistoryHost(ctx, request, opts...)
+}
+
+func (c *clientImpl) WarmHistoryHost(
+	ctx context.Context,
+	request *h.WarmHistoryHostRequest,
+	opts ...yarpc.CallOption) (*h.WarmHistoryHostResponse, error) {
+	if request.GetHostAddress() == "" {
+		return nil, &workflow.BadRequestError{Message: "Host address is not set on request."}
+	}
+	client := c.getThriftClient(request.GetHostAddress())
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	// loading the caches of every shard takes longer than a regular call, so the deadline of the caller is kept
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = c.createContext(ctx)
+		defer cancel()
+	}
+	return client.WarmHistoryHost(ctx, request, opts...)
+}
+
+func (c *clientImpl) DrainHistoryHost(
+	ctx context.Context,
+	request *h.DrainHistoryHostRequest,
+	opts ...yarpc.CallOption) (*h.DrainHistoryHostResponse, error) {
+	if request.GetHostAddress() == "" {
+		return nil, &workflow.BadRequestError{Message: "Host address is not set on request."}
+	}
+	client := c.getThriftClient(request.GetHostAddress())
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DrainHistoryHost(ctx, request, opts...)
+}
+
 func (c *clientImpl) getHostForRequest(workflowID string) (historyserviceclient.Interface, error) {
-	key := common.WorkflowIDToHistoryShard(workflowID, c.numberOfShards)
-	host, err := c.resolver.Lookup(string(key))
+	return c.getHostForShard(common.WorkflowIDToHistoryShard(workflowID, c.numberOfShards))
+}
+
+// getHostForShard returns the history host the membership ring places the shard on. The client does not load the
+// shards pinned by the shard rebalancer of the history service, a request for a pinned shard is first sent to the host
+// of the ring, which rejects it with a ShardOwnershipLostError naming the pinned owner, and executeWithRedirect sends it
+// again to that owner. Requests for pinned shards pay for this extra hop until the shards are unpinned.
+func (c *clientImpl) getHostForShard(shardID int) (historyserviceclient.Interface, error) {
+	host, err := c.resolver.Lookup(string(shardID))
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +112,125 @@ func (c *metricClient) DescribeWorkflowExecution(
 	return resp, err
 }
 
+func (c *metricClient) GetWorkflowExecutionHistoryEvent(
+	context context.Context,
+	request *h.GetWorkflowExecutionHistoryEventRequest,
+	opts ...yarpc.CallOption) (*h.GetWorkflowExecutionHistoryEventResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetWorkflowExecutionHistoryEventScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetWorkflowExecutionHistoryEventScope, metrics.CadenceLatency)
+	resp, err := c.client.GetWorkflowExecutionHistoryEvent(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetWorkflowExecutionHistoryEventScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RefreshDomainCache(
+	context context.Context,
+	request *h.RefreshDomainCacheRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRefreshDomainCacheScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRefreshDomainCacheScope, metrics.CadenceLatency)
+	err := c.client.RefreshDomainCache(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRefreshDomainCacheScope, metrics.HistoryClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) DescribeHistoryHost(
+	context context.Context,
+	request *h.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption) (*h.DescribeHistoryHostResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeHistoryHostScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeHistoryHostScope, metrics.CadenceLatency)
+	resp, err := c.client.DescribeHistoryHost(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeHistoryHostScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) WarmHistoryHost(
+	context context.Context,
+	request *h.WarmHistoryHostRequest,
+	opts ...yarpc.CallOption) (*h.WarmHistoryHostResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientWarmHistoryHostScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientWarmHistoryHostScope, metrics.CadenceLatency)
+	resp, err := c.client.WarmHistoryHost(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientWarmHistoryHostScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DrainHistoryHost(
+	context context.Context,
+	request *h.DrainHistoryHostRequest,
+	opts ...yarpc.CallOption) (*h.DrainHistoryHostResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDrainHistoryHostScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDrainHistoryHostScope, metrics.CadenceLatency)
+	resp, err := c.client.DrainHistoryHost(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDrainHistoryHostScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) GetCurrentExecution(
+	context context.Context,
+	request *h.GetCurrentExecutionRequest,
+	opts ...yarpc.CallOption) (*h.GetCurrentExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetCurrentExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetCurrentExecutionScope, metrics.CadenceLatency)
+	resp, err := c.client.GetCurrentExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetCurrentExecutionScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) ListWorkflowExecutionChain(
+	context context.Context,
+	request *h.ListWorkflowExecutionChainRequest,
+	opts ...yarpc.CallOption) (*h.ListWorkflowExecutionChainResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientListWorkflowExecutionChainScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientListWorkflowExecutionChainScope, metrics.CadenceLatency)
+	resp, err := c.client.ListWorkflowExecutionChain(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientListWorkflowExecutionChainScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) RecordDecisionTaskStarted(
 	context context.Context,
 	request *h.RecordDecisionTaskStartedRequest,
@@ -333,6 +452,57 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	context context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceLatency)
+	err := c.client.PauseWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.HistoryClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ResumeWorkflowExecution(
+	context context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceLatency)
+	err := c.client.ResumeWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.HistoryClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) NukeWorkflowExecution(
+	context context.Context,
+	request *h.NukeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*h.NukeWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientNukeWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientNukeWorkflowExecutionScope, metrics.CadenceLatency)
+	resp, err := c.client.NukeWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientNukeWorkflowExecutionScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) ScheduleDecisionTask(
 	context context.Context,
 	request *h.ScheduleDecisionTaskRequest,
@@ -383,3 +553,20 @@ func (c *metricClient) ReplicateEvents(
 
 	return err
 }
+
+func (c *metricClient) ImportWorkflowExecution(
+	context context.Context,
+	request *h.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientImportWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientImportWorkflowExecutionScope, metrics.CadenceLatency)
+	err := c.client.ImportWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientImportWorkflowExecutionScope, metrics.HistoryClientFailures)
+	}
+
+	return err
+}
@@ -157,6 +157,28 @@ func (c *clientImpl) DescribeTaskList(ctx context.Context, request *m.DescribeTa
 	return client.DescribeTaskList(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseTaskList(ctx context.Context, request *m.PauseTaskListRequest, opts ...yarpc.CallOption) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getHostForRequest(request.TaskList.GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseTaskList(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResumeTaskList(ctx context.Context, request *m.ResumeTaskListRequest, opts ...yarpc.CallOption) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getHostForRequest(request.TaskList.GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResumeTaskList(ctx, request, opts...)
+}
+
 func (c *clientImpl) getHostForRequest(key string) (matchingserviceclient.Interface, error) {
 	host, err := c.resolver.Lookup(key)
 	if err != nil {
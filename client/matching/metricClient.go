@@ -179,3 +179,37 @@ func (c *metricClient) DescribeTaskList(
 
 	return resp, err
 }
+
+func (c *metricClient) PauseTaskList(
+	ctx context.Context,
+	request *m.PauseTaskListRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientPauseTaskListScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientPauseTaskListScope, metrics.CadenceLatency)
+	err := c.client.PauseTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientPauseTaskListScope, metrics.MatchingClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ResumeTaskList(
+	ctx context.Context,
+	request *m.ResumeTaskListRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientResumeTaskListScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientResumeTaskListScope, metrics.CadenceLatency)
+	err := c.client.ResumeTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientResumeTaskListScope, metrics.MatchingClientFailures)
+	}
+
+	return err
+}
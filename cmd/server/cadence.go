@@ -25,6 +25,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/tools/cassandra"
 
@@ -147,7 +148,7 @@ func buildCLI() *cli.App {
 	app := cli.NewApp()
 	app.Name = "cadence"
 	app.Usage = "Cadence server"
-	app.Version = "0.0.1"
+	app.Version = common.ServerVersion
 
 	app.Flags = []cli.Flag{
 		cli.StringFlag{
@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const dependencyDialTimeout = 5 * time.Second
+
+// waitForDependencies blocks until the stores and brokers used by the
+// service are reachable, as configured by the startupWait section
+func waitForDependencies(cfg *config.Config, logger bark.Logger) error {
+	if err := cfg.StartupWait.WaitFor("cassandra", logger, func() error {
+		return checkCassandra(&cfg.Cassandra)
+	}); err != nil {
+		return err
+	}
+
+	for store := range cfg.Cassandra.Stores {
+		storeCfg := cfg.Cassandra.ForStore(store)
+		if err := cfg.StartupWait.WaitFor("cassandra "+store+" store", logger, func() error {
+			return checkCassandra(&storeCfg)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if cfg.ClustersInfo.EnableGlobalDomain {
+		for name, cluster := range cfg.Kafka.Clusters {
+			brokers := cluster.Brokers
+			if err := cfg.StartupWait.WaitFor("kafka cluster "+name, logger, func() error {
+				return checkBrokers(brokers)
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkCassandra(cfg *config.Cassandra) error {
+	cluster := common.NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
+	cluster.Keyspace = cfg.Keyspace
+	cluster.Timeout = dependencyDialTimeout
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return err
+	}
+	session.Close()
+	return nil
+}
+
+// checkBrokers succeeds if any of the brokers accepts a connection
+func checkBrokers(brokers []string) error {
+	var lastErr error
+	for _, broker := range brokers {
+		if !strings.Contains(broker, ":") {
+			broker += ":9092"
+		}
+		conn, err := net.DialTimeout("tcp", broker, dependencyDialTimeout)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		conn.Close()
+		return nil
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no brokers configured")
+	}
+	return lastErr
+}
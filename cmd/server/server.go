@@ -99,6 +99,17 @@ func (s *server) startService() common.Daemon {
 	params.Name = "cadence-" + s.name
 	params.Logger = s.cfg.Log.NewBarkLogger()
 	params.CassandraConfig = s.cfg.Cassandra
+	params.BenchConfig = s.cfg.Bench
+	params.CanaryConfig = s.cfg.Canary
+	params.BulkDeleteConfig = s.cfg.BulkDelete
+
+	if err = s.cfg.Cassandra.ValidateStores(); err != nil {
+		log.Fatalf("invalid cassandra config: %v", err)
+	}
+
+	if err = waitForDependencies(s.cfg, params.Logger); err != nil {
+		log.Fatalf("error waiting for dependencies: %v", err)
+	}
 
 	params.RingpopFactory, err = s.cfg.Ringpop.NewFactory()
 	if err != nil {
@@ -107,6 +118,7 @@ func (s *server) startService() common.Daemon {
 
 	svcCfg := s.cfg.Services[s.name]
 	params.MetricScope = svcCfg.Metrics.NewScope()
+	params.DomainMetricTags = svcCfg.Metrics.DomainTags
 	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger)
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
 	params.ClusterMetadata = cluster.NewMetadata(
@@ -123,6 +135,13 @@ func (s *server) startService() common.Daemon {
 		params.MessagingClient = nil
 	}
 
+	if s.cfg.Blobstore.IsConfigured() {
+		params.BlobstoreClient, err = s.cfg.Blobstore.NewClient(params.Logger)
+		if err != nil {
+			log.Fatalf("error creating blobstore client: %v", err)
+		}
+	}
+
 	params.DynamicConfig = dynamicconfig.NewNopClient()
 
 	var daemon common.Daemon
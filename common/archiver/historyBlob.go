@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+// HistoryBlobVersion is the version of the history blob format written by this package
+const HistoryBlobVersion = 1
+
+var (
+	// ErrUnsupportedHistoryBlobVersion is returned when decoding a history blob of an unknown format version
+	ErrUnsupportedHistoryBlobVersion = errors.New("unsupported history blob version")
+	// ErrEmptyHistoryBlob is returned when a history blob does not contain any event
+	ErrEmptyHistoryBlob = errors.New("history blob has no events")
+)
+
+type (
+	// HistoryBlobHeader describes the workflow execution and the range of events contained in a history blob
+	HistoryBlobHeader struct {
+		Version      int    `json:"version"`
+		DomainName   string `json:"domain_name"`
+		WorkflowID   string `json:"workflow_id"`
+		RunID        string `json:"run_id"`
+		FirstEventID int64  `json:"first_event_id"`
+		LastEventID  int64  `json:"last_event_id"`
+		EventCount   int64  `json:"event_count"`
+	}
+
+	// HistoryBlob is the portable representation of the history of a single workflow execution,
+	// used for archival as well as for exporting and importing workflow executions
+	HistoryBlob struct {
+		Header *HistoryBlobHeader `json:"header"`
+		Body   *shared.History    `json:"body"`
+	}
+)
+
+// NewHistoryBlob creates a history blob for the given execution, the header is derived from the history events
+func NewHistoryBlob(domainName string, execution *shared.WorkflowExecution, history *shared.History) (*HistoryBlob, error) {
+	if history == nil || len(history.Events) == 0 {
+		return nil, ErrEmptyHistoryBlob
+	}
+	events := history.Events
+	return &HistoryBlob{
+		Header: &HistoryBlobHeader{
+			Version:      HistoryBlobVersion,
+			DomainName:   domainName,
+			WorkflowID:   execution.GetWorkflowId(),
+			RunID:        execution.GetRunId(),
+			FirstEventID: events[0].GetEventId(),
+			LastEventID:  events[len(events)-1].GetEventId(),
+			EventCount:   int64(len(events)),
+		},
+		Body: history,
+	}, nil
+}
+
+// EncodeHistoryBlob serializes the history blob
+func EncodeHistoryBlob(blob *HistoryBlob) ([]byte, error) {
+	return json.Marshal(blob)
+}
+
+// DecodeHistoryBlob deserializes and validates a history blob
+func DecodeHistoryBlob(data []byte) (*HistoryBlob, error) {
+	blob := &HistoryBlob{}
+	if err := json.Unmarshal(data, blob); err != nil {
+		return nil, err
+	}
+	if blob.Header == nil || blob.Header.Version != HistoryBlobVersion {
+		return nil, ErrUnsupportedHistoryBlobVersion
+	}
+	if blob.Body == nil || len(blob.Body.Events) == 0 {
+		return nil, ErrEmptyHistoryBlob
+	}
+	return blob, nil
+}
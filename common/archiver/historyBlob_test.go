@@ -0,0 +1,73 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type historyBlobSuite struct {
+	suite.Suite
+}
+
+func TestHistoryBlobSuite(t *testing.T) {
+	suite.Run(t, new(historyBlobSuite))
+}
+
+func (s *historyBlobSuite) TestEncodeDecode() {
+	history := &shared.History{
+		Events: []*shared.HistoryEvent{
+			{EventId: common.Int64Ptr(common.FirstEventID), EventType: shared.EventTypeWorkflowExecutionStarted.Ptr()},
+			{EventId: common.Int64Ptr(common.FirstEventID + 1), EventType: shared.EventTypeDecisionTaskScheduled.Ptr()},
+		},
+	}
+	execution := &shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("wid"),
+		RunId:      common.StringPtr("rid"),
+	}
+	blob, err := NewHistoryBlob("domain", execution, history)
+	s.NoError(err)
+	s.Equal(int64(2), blob.Header.EventCount)
+	s.Equal(common.FirstEventID+1, blob.Header.LastEventID)
+
+	data, err := EncodeHistoryBlob(blob)
+	s.NoError(err)
+	decoded, err := DecodeHistoryBlob(data)
+	s.NoError(err)
+	s.Equal(blob.Header, decoded.Header)
+	s.Equal(len(history.Events), len(decoded.Body.Events))
+	s.Equal(shared.EventTypeDecisionTaskScheduled, decoded.Body.Events[1].GetEventType())
+}
+
+func (s *historyBlobSuite) TestDecodeInvalid() {
+	_, err := DecodeHistoryBlob([]byte(`{"header":{"version":42},"body":{"events":[{}]}}`))
+	s.Equal(ErrUnsupportedHistoryBlobVersion, err)
+
+	_, err = DecodeHistoryBlob([]byte(`{"header":{"version":1},"body":{"events":[]}}`))
+	s.Equal(ErrEmptyHistoryBlob, err)
+
+	_, err = NewHistoryBlob("domain", &shared.WorkflowExecution{}, &shared.History{})
+	s.Equal(ErrEmptyHistoryBlob, err)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"errors"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/httpclient"
+)
+
+const (
+	// DefaultMaxBlobSize is the max blob size used when it is not configured
+	DefaultMaxBlobSize = 64 * 1024 * 1024
+	// DefaultMultipartThreshold is the size from which S3 blobs are written with a multipart upload when it is not
+	// configured
+	DefaultMultipartThreshold = 16 * 1024 * 1024
+	// DefaultMultipartPartSize is the part size of S3 multipart uploads when it is not configured
+	DefaultMultipartPartSize = 8 * 1024 * 1024
+)
+
+type (
+	// Config describes the configuration of the blobstore, exactly one of the drivers should be configured
+	Config struct {
+		// MaxBlobSize is the max size in bytes of a single blob, DefaultMaxBlobSize is used if not set
+		MaxBlobSize int `yaml:"maxBlobSize"`
+		// Filestore is the configuration of the local filesystem driver
+		Filestore *FilestoreConfig `yaml:"filestore"`
+		// S3 is the configuration of the S3 driver
+		S3 *S3Config `yaml:"s3"`
+		// HTTPClient configures proxy, CA and default headers of the drivers talking http
+		HTTPClient *httpclient.Config `yaml:"httpClient"`
+	}
+
+	// FilestoreConfig describes the configuration of the local filesystem driver
+	FilestoreConfig struct {
+		// StoreDirectory is the root directory under which buckets are created
+		StoreDirectory string `yaml:"storeDirectory"`
+	}
+
+	// S3Config describes the configuration of the S3 driver, credentials are
+	// resolved through the default AWS credential chain
+	S3Config struct {
+		// Region is the AWS region of the buckets
+		Region string `yaml:"region"`
+		// Endpoint overrides the S3 endpoint, used for S3 compatible stores
+		Endpoint string `yaml:"endpoint"`
+		// S3ForcePathStyle forces path style bucket addressing, required by most S3 compatible stores
+		S3ForcePathStyle bool `yaml:"s3ForcePathStyle"`
+		// ServerSideEncryption is the server side encryption of written blobs, AES256 or aws:kms, none if not set
+		ServerSideEncryption string `yaml:"serverSideEncryption"`
+		// SSEKMSKeyID is the KMS key used by aws:kms server side encryption, the AWS managed key if not set
+		SSEKMSKeyID string `yaml:"sseKMSKeyID"`
+		// MultipartThreshold is the blob size in bytes from which blobs are written with a multipart upload,
+		// DefaultMultipartThreshold is used if not set
+		MultipartThreshold int `yaml:"multipartThreshold"`
+		// MultipartPartSize is the size in bytes of the parts of a multipart upload, DefaultMultipartPartSize is
+		// used if not set, S3 requires at least 5MB
+		MultipartPartSize int `yaml:"multipartPartSize"`
+	}
+)
+
+// IsConfigured returns true if one of the blobstore drivers is configured
+func (c *Config) IsConfigured() bool {
+	return c.Filestore != nil || c.S3 != nil
+}
+
+// NewClient creates a blobstore client for the configured driver, the returned
+// client enforces the max blob size and verifies blob checksums
+func (c *Config) NewClient(logger bark.Logger) (Client, error) {
+	if c.Filestore != nil && c.S3 != nil {
+		return nil, errors.New("blobstore: only one of filestore and s3 can be configured")
+	}
+
+	var client Client
+	var err error
+	switch {
+	case c.Filestore != nil:
+		client, err = NewFilestoreClient(c.Filestore)
+	case c.S3 != nil:
+		client, err = NewS3Client(c.S3, c.HTTPClient)
+	default:
+		return nil, errors.New("blobstore: no driver configured")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	maxBlobSize := c.MaxBlobSize
+	if maxBlobSize <= 0 {
+		maxBlobSize = DefaultMaxBlobSize
+	}
+	return NewValidationClient(client, maxBlobSize, logger), nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type (
+	filestoreClient struct {
+		storeDirectory string
+	}
+
+	// filestoreBlob is the on disk representation of a blob
+	filestoreBlob struct {
+		Tags map[string]string `json:"tags"`
+		Body []byte            `json:"body"`
+	}
+)
+
+var _ Client = (*filestoreClient)(nil)
+
+// NewFilestoreClient creates a blobstore client which keeps each blob as a file under
+// <storeDirectory>/<bucket>/<key>, meant for development and single host deployments
+func NewFilestoreClient(cfg *FilestoreConfig) (Client, error) {
+	if len(cfg.StoreDirectory) == 0 {
+		return nil, errors.New("blobstore: filestore storeDirectory is not set")
+	}
+	if err := os.MkdirAll(cfg.StoreDirectory, os.FileMode(0700)); err != nil {
+		return nil, err
+	}
+	return &filestoreClient{
+		storeDirectory: cfg.StoreDirectory,
+	}, nil
+}
+
+func (c *filestoreClient) Put(ctx context.Context, bucket string, key string, blob *Blob) error {
+	path, err := c.blobPath(bucket, key)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(&filestoreBlob{Tags: blob.Tags, Body: blob.Body})
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, os.FileMode(0700)); err != nil {
+		return err
+	}
+	// write to a temp file first so readers never observe a partially written blob
+	f, err := ioutil.TempFile(dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (c *filestoreClient) Get(ctx context.Context, bucket string, key string) (*Blob, error) {
+	path, err := c.blobPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrBlobNotExists
+		}
+		return nil, err
+	}
+
+	blob := &filestoreBlob{}
+	if err := json.Unmarshal(data, blob); err != nil {
+		return nil, err
+	}
+	return &Blob{Body: blob.Body, Tags: blob.Tags}, nil
+}
+
+func (c *filestoreClient) Exists(ctx context.Context, bucket string, key string) (bool, error) {
+	path, err := c.blobPath(bucket, key)
+	if err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *filestoreClient) Delete(ctx context.Context, bucket string, key string) error {
+	path, err := c.blobPath(bucket, key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// blobPath maps bucket and key to a file path, rejecting keys which would escape the bucket directory
+func (c *filestoreClient) blobPath(bucket string, key string) (string, error) {
+	if strings.ContainsRune(bucket, filepath.Separator) || bucket == "." || bucket == ".." {
+		return "", ErrInvalidKey
+	}
+	bucketDir := filepath.Join(c.storeDirectory, bucket)
+	path := filepath.Join(bucketDir, key)
+	if !strings.HasPrefix(path, bucketDir+string(filepath.Separator)) {
+		return "", ErrInvalidKey
+	}
+	return path, nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+)
+
+type (
+	filestoreSuite struct {
+		suite.Suite
+		storeDirectory string
+		client         Client
+	}
+)
+
+const (
+	testBucket      = "test-bucket"
+	testMaxBlobSize = 1024
+)
+
+func TestFilestoreSuite(t *testing.T) {
+	suite.Run(t, new(filestoreSuite))
+}
+
+func (s *filestoreSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "filestore")
+	s.Nil(err)
+	s.storeDirectory = dir
+
+	cfg := &Config{
+		MaxBlobSize: testMaxBlobSize,
+		Filestore:   &FilestoreConfig{StoreDirectory: dir},
+	}
+	s.client, err = cfg.NewClient(bark.NewLoggerFromLogrus(logrus.New()))
+	s.Nil(err)
+}
+
+func (s *filestoreSuite) TearDownTest() {
+	os.RemoveAll(s.storeDirectory)
+}
+
+func (s *filestoreSuite) TestPutGetDelete() {
+	ctx := context.Background()
+	key := "domain/workflow/run"
+
+	exists, err := s.client.Exists(ctx, testBucket, key)
+	s.Nil(err)
+	s.False(exists)
+	_, err = s.client.Get(ctx, testBucket, key)
+	s.Equal(ErrBlobNotExists, err)
+
+	blob := &Blob{Body: []byte("body"), Tags: map[string]string{"tag": "value"}}
+	s.Nil(s.client.Put(ctx, testBucket, key, blob))
+	exists, err = s.client.Exists(ctx, testBucket, key)
+	s.Nil(err)
+	s.True(exists)
+
+	result, err := s.client.Get(ctx, testBucket, key)
+	s.Nil(err)
+	s.Equal(blob, result)
+
+	s.Nil(s.client.Delete(ctx, testBucket, key))
+	exists, err = s.client.Exists(ctx, testBucket, key)
+	s.Nil(err)
+	s.False(exists)
+	// deleting a missing blob is a no-op
+	s.Nil(s.client.Delete(ctx, testBucket, key))
+}
+
+func (s *filestoreSuite) TestBlobTooLarge() {
+	blob := &Blob{Body: make([]byte, testMaxBlobSize+1)}
+	s.Equal(ErrBlobTooLarge, s.client.Put(context.Background(), testBucket, "key", blob))
+}
+
+func (s *filestoreSuite) TestInvalidKey() {
+	ctx := context.Background()
+	blob := &Blob{Body: []byte("body")}
+	s.Equal(ErrInvalidKey, s.client.Put(ctx, "", "key", blob))
+	s.Equal(ErrInvalidKey, s.client.Put(ctx, testBucket, "", blob))
+	s.Equal(ErrInvalidKey, s.client.Put(ctx, testBucket, "../escape", blob))
+	s.Equal(ErrInvalidKey, s.client.Put(ctx, "..", "key", blob))
+}
+
+func (s *filestoreSuite) TestChecksumMismatch() {
+	ctx := context.Background()
+	s.Nil(s.client.Put(ctx, testBucket, "key", &Blob{Body: []byte("body")}))
+
+	// write a blob bypassing the validation client, as if the file got corrupted
+	driver, err := NewFilestoreClient(&FilestoreConfig{StoreDirectory: s.storeDirectory})
+	s.Nil(err)
+	s.Nil(driver.Put(ctx, testBucket, "key", &Blob{
+		Body: []byte("corrupted"),
+		Tags: map[string]string{ChecksumTag: checksum([]byte("body"))},
+	}))
+	_, err = os.Stat(filepath.Join(s.storeDirectory, testBucket, "key"))
+	s.Nil(err)
+
+	_, err = s.client.Get(ctx, testBucket, "key")
+	s.Equal(ErrChecksumMismatch, err)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrBlobNotExists is returned when the requested blob does not exist
+	ErrBlobNotExists = errors.New("blob does not exist")
+	// ErrBlobTooLarge is returned when a blob exceeds the configured max blob size
+	ErrBlobTooLarge = errors.New("blob exceeds max blob size")
+	// ErrChecksumMismatch is returned when a blob read back does not match the checksum it was written with
+	ErrChecksumMismatch = errors.New("blob checksum mismatch")
+	// ErrInvalidKey is returned when bucket or key of a blob is not valid
+	ErrInvalidKey = errors.New("invalid blob bucket or key")
+)
+
+type (
+	// Blob is a payload stored in the blobstore along with its tags
+	Blob struct {
+		Body []byte
+		Tags map[string]string
+	}
+
+	// Client is the interface used to store and retrieve blobs, it is used by archival,
+	// large payload offloading and profile capture
+	Client interface {
+		// Put writes the blob under the given bucket and key, overwriting any existing blob
+		Put(ctx context.Context, bucket string, key string, blob *Blob) error
+		// Get reads the blob under the given bucket and key, ErrBlobNotExists is returned if it does not exist
+		Get(ctx context.Context, bucket string, key string) (*Blob, error)
+		// Exists returns true if a blob exists under the given bucket and key
+		Exists(ctx context.Context, bucket string, key string) (bool, error)
+		// Delete removes the blob under the given bucket and key, deleting a missing blob is not an error
+		Delete(ctx context.Context, bucket string, key string) error
+	}
+)
@@ -0,0 +1,194 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/uber/cadence/common/httpclient"
+)
+
+type (
+	s3Client struct {
+		s3cli                s3iface.S3API
+		uploader             *s3manager.Uploader
+		multipartThreshold   int
+		serverSideEncryption *string
+		sseKMSKeyID          *string
+	}
+)
+
+var _ Client = (*s3Client)(nil)
+
+// NewS3Client creates a blobstore client backed by S3 or an S3 compatible store
+func NewS3Client(cfg *S3Config, httpCfg *httpclient.Config) (Client, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	httpClient, err := httpCfg.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	awsConfig := &aws.Config{
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+		HTTPClient:       httpClient,
+	}
+	if len(cfg.Endpoint) != 0 {
+		awsConfig.Endpoint = aws.String(cfg.Endpoint)
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return newS3Client(s3.New(sess), cfg), nil
+}
+
+func newS3Client(s3cli s3iface.S3API, cfg *S3Config) *s3Client {
+	multipartThreshold := cfg.MultipartThreshold
+	if multipartThreshold <= 0 {
+		multipartThreshold = DefaultMultipartThreshold
+	}
+	partSize := int64(cfg.MultipartPartSize)
+	if partSize <= 0 {
+		partSize = DefaultMultipartPartSize
+	}
+	client := &s3Client{
+		s3cli: s3cli,
+		uploader: s3manager.NewUploaderWithClient(s3cli, func(u *s3manager.Uploader) {
+			u.PartSize = partSize
+		}),
+		multipartThreshold: multipartThreshold,
+	}
+	if len(cfg.ServerSideEncryption) != 0 {
+		client.serverSideEncryption = aws.String(cfg.ServerSideEncryption)
+	}
+	if len(cfg.SSEKMSKeyID) != 0 {
+		client.sseKMSKeyID = aws.String(cfg.SSEKMSKeyID)
+	}
+	return client
+}
+
+func (cfg *S3Config) validate() error {
+	switch cfg.ServerSideEncryption {
+	case "", s3.ServerSideEncryptionAes256, s3.ServerSideEncryptionAwsKms:
+	default:
+		return fmt.Errorf("blobstore: invalid s3 server side encryption %q, expected %v or %v",
+			cfg.ServerSideEncryption, s3.ServerSideEncryptionAes256, s3.ServerSideEncryptionAwsKms)
+	}
+	if len(cfg.SSEKMSKeyID) != 0 && cfg.ServerSideEncryption != s3.ServerSideEncryptionAwsKms {
+		return fmt.Errorf("blobstore: s3 kms key is only used with %v server side encryption", s3.ServerSideEncryptionAwsKms)
+	}
+	if cfg.MultipartPartSize > 0 && cfg.MultipartPartSize < s3manager.MinUploadPartSize {
+		return fmt.Errorf("blobstore: s3 multipart part size must be at least %v bytes", s3manager.MinUploadPartSize)
+	}
+	return nil
+}
+
+// Put writes small blobs with a single request, blobs of at least the multipart threshold are uploaded in parts,
+// which are sent concurrently and retried individually
+func (c *s3Client) Put(ctx context.Context, bucket string, key string, blob *Blob) error {
+	if len(blob.Body) >= c.multipartThreshold {
+		_, err := c.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+			Bucket:               aws.String(bucket),
+			Key:                  aws.String(key),
+			Body:                 bytes.NewReader(blob.Body),
+			Metadata:             aws.StringMap(blob.Tags),
+			ServerSideEncryption: c.serverSideEncryption,
+			SSEKMSKeyId:          c.sseKMSKeyID,
+		})
+		return err
+	}
+	_, err := c.s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:               aws.String(bucket),
+		Key:                  aws.String(key),
+		Body:                 bytes.NewReader(blob.Body),
+		Metadata:             aws.StringMap(blob.Tags),
+		ServerSideEncryption: c.serverSideEncryption,
+		SSEKMSKeyId:          c.sseKMSKeyID,
+	})
+	return err
+}
+
+func (c *s3Client) Get(ctx context.Context, bucket string, key string) (*Blob, error) {
+	result, err := c.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrBlobNotExists
+		}
+		return nil, err
+	}
+	defer result.Body.Close()
+
+	body, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+	// S3 returns metadata keys in canonical header form, blob tags are expected to be lower case
+	tags := make(map[string]string, len(result.Metadata))
+	for k, v := range result.Metadata {
+		tags[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	return &Blob{Body: body, Tags: tags}, nil
+}
+
+func (c *s3Client) Exists(ctx context.Context, bucket string, key string) (bool, error) {
+	_, err := c.s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *s3Client) Delete(ctx context.Context, bucket string, key string) error {
+	_, err := c.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+func isNotFoundError(err error) bool {
+	if aerr, ok := err.(awserr.RequestFailure); ok {
+		return aerr.StatusCode() == http.StatusNotFound || aerr.Code() == s3.ErrCodeNoSuchKey
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	s3storeSuite struct {
+		suite.Suite
+	}
+
+	// fakeS3 records the writes of the S3 API used by the s3 blobstore client
+	fakeS3 struct {
+		s3iface.S3API
+
+		sync.Mutex
+		putObjects       []*s3.PutObjectInput
+		multipartUploads []*s3.CreateMultipartUploadInput
+		uploadedParts    int
+		uploadedBytes    int
+		completedUploads int
+	}
+)
+
+func TestS3storeSuite(t *testing.T) {
+	suite.Run(t, new(s3storeSuite))
+}
+
+func (s *s3storeSuite) TestPut_SinglePart() {
+	fake := &fakeS3{}
+	client := newS3Client(fake, &S3Config{
+		ServerSideEncryption: s3.ServerSideEncryptionAwsKms,
+		SSEKMSKeyID:          "test-key",
+	})
+
+	err := client.Put(context.Background(), testBucket, "small", &Blob{
+		Body: []byte("body"),
+		Tags: map[string]string{"key": "value"},
+	})
+	s.Nil(err)
+	s.Equal(1, len(fake.putObjects))
+	s.Equal(0, len(fake.multipartUploads))
+	input := fake.putObjects[0]
+	s.Equal("small", aws.StringValue(input.Key))
+	s.Equal(s3.ServerSideEncryptionAwsKms, aws.StringValue(input.ServerSideEncryption))
+	s.Equal("test-key", aws.StringValue(input.SSEKMSKeyId))
+	s.Equal("value", aws.StringValue(input.Metadata["key"]))
+}
+
+func (s *s3storeSuite) TestPut_Multipart() {
+	fake := &fakeS3{}
+	client := newS3Client(fake, &S3Config{
+		ServerSideEncryption: s3.ServerSideEncryptionAes256,
+		MultipartThreshold:   1024,
+		MultipartPartSize:    s3manager.MinUploadPartSize,
+	})
+
+	body := make([]byte, s3manager.MinUploadPartSize+1024)
+	err := client.Put(context.Background(), testBucket, "large", &Blob{Body: body})
+	s.Nil(err)
+	s.Equal(0, len(fake.putObjects))
+	s.Equal(1, len(fake.multipartUploads))
+	s.Equal(s3.ServerSideEncryptionAes256, aws.StringValue(fake.multipartUploads[0].ServerSideEncryption))
+	s.Equal(2, fake.uploadedParts)
+	s.Equal(len(body), fake.uploadedBytes)
+	s.Equal(1, fake.completedUploads)
+}
+
+func (s *s3storeSuite) TestValidateConfig() {
+	s.Nil((&S3Config{}).validate())
+	s.Nil((&S3Config{ServerSideEncryption: s3.ServerSideEncryptionAes256}).validate())
+	s.Nil((&S3Config{ServerSideEncryption: s3.ServerSideEncryptionAwsKms, SSEKMSKeyID: "key"}).validate())
+	s.NotNil((&S3Config{ServerSideEncryption: "rot13"}).validate())
+	s.NotNil((&S3Config{ServerSideEncryption: s3.ServerSideEncryptionAes256, SSEKMSKeyID: "key"}).validate())
+	s.NotNil((&S3Config{MultipartPartSize: 1024}).validate())
+}
+
+func (f *fakeS3) PutObjectWithContext(ctx aws.Context, input *s3.PutObjectInput,
+	opts ...request.Option) (*s3.PutObjectOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.putObjects = append(f.putObjects, input)
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeS3) CreateMultipartUploadWithContext(ctx aws.Context, input *s3.CreateMultipartUploadInput,
+	opts ...request.Option) (*s3.CreateMultipartUploadOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.multipartUploads = append(f.multipartUploads, input)
+	return &s3.CreateMultipartUploadOutput{UploadId: aws.String("upload-id")}, nil
+}
+
+func (f *fakeS3) UploadPartWithContext(ctx aws.Context, input *s3.UploadPartInput,
+	opts ...request.Option) (*s3.UploadPartOutput, error) {
+	body, err := ioutil.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.uploadedParts++
+	f.uploadedBytes += len(body)
+	return &s3.UploadPartOutput{ETag: aws.String("etag")}, nil
+}
+
+func (f *fakeS3) CompleteMultipartUploadWithContext(ctx aws.Context, input *s3.CompleteMultipartUploadInput,
+	opts ...request.Option) (*s3.CompleteMultipartUploadOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.completedUploads++
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"hash/crc32"
+	"strconv"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+)
+
+const (
+	// ChecksumTag is the tag under which the checksum of the blob body is stored
+	ChecksumTag = "cadence-checksum"
+)
+
+type (
+	validationClient struct {
+		client      Client
+		maxBlobSize int
+		logger      bark.Logger
+	}
+)
+
+var _ Client = (*validationClient)(nil)
+
+// NewValidationClient wraps a blobstore driver, rejecting blobs larger than maxBlobSize and
+// verifying the checksum of the blob body on reads
+func NewValidationClient(client Client, maxBlobSize int, logger bark.Logger) Client {
+	return &validationClient{
+		client:      client,
+		maxBlobSize: maxBlobSize,
+		logger:      logger,
+	}
+}
+
+func (c *validationClient) Put(ctx context.Context, bucket string, key string, blob *Blob) error {
+	if err := validateKey(bucket, key); err != nil {
+		return err
+	}
+	if len(blob.Body) > c.maxBlobSize {
+		return ErrBlobTooLarge
+	}
+
+	tags := make(map[string]string, len(blob.Tags)+1)
+	for k, v := range blob.Tags {
+		tags[k] = v
+	}
+	tags[ChecksumTag] = checksum(blob.Body)
+	return c.client.Put(ctx, bucket, key, &Blob{Body: blob.Body, Tags: tags})
+}
+
+func (c *validationClient) Get(ctx context.Context, bucket string, key string) (*Blob, error) {
+	if err := validateKey(bucket, key); err != nil {
+		return nil, err
+	}
+
+	blob, err := c.client.Get(ctx, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string, len(blob.Tags))
+	var expected string
+	for k, v := range blob.Tags {
+		if k == ChecksumTag {
+			expected = v
+			continue
+		}
+		tags[k] = v
+	}
+	if expected != checksum(blob.Body) {
+		c.logger.WithFields(bark.Fields{
+			logging.TagErr: ErrChecksumMismatch,
+		}).Errorf("Blob checksum mismatch. Bucket: %v, Key: %v, Expected: %v", bucket, key, expected)
+		return nil, ErrChecksumMismatch
+	}
+	return &Blob{Body: blob.Body, Tags: tags}, nil
+}
+
+func (c *validationClient) Exists(ctx context.Context, bucket string, key string) (bool, error) {
+	if err := validateKey(bucket, key); err != nil {
+		return false, err
+	}
+	return c.client.Exists(ctx, bucket, key)
+}
+
+func (c *validationClient) Delete(ctx context.Context, bucket string, key string) error {
+	if err := validateKey(bucket, key); err != nil {
+		return err
+	}
+	return c.client.Delete(ctx, bucket, key)
+}
+
+func validateKey(bucket string, key string) error {
+	if len(bucket) == 0 || len(key) == 0 {
+		return ErrInvalidKey
+	}
+	return nil
+}
+
+func checksum(body []byte) string {
+	return strconv.FormatUint(uint64(crc32.ChecksumIEEE(body)), 10)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package bulkdelete holds what the frontend and the worker service share about the workflows deleting the closed
+// workflow executions of a domain, the frontend starts and queries them while the worker service runs them
+package bulkdelete
+
+const (
+	// WorkflowName is the registered name of the bulk delete workflow
+	WorkflowName = "cadence-bulk-delete-workflow"
+	// ProgressQueryType is the query type returning the Progress of a bulk delete workflow
+	ProgressQueryType = "progress"
+	// DefaultTaskList is the task list of the bulk delete workflows when none is configured
+	DefaultTaskList = "cadence-bulk-delete"
+	// DefaultDeletesPerSecond is the rate at which executions are deleted when none is requested
+	DefaultDeletesPerSecond = 10
+
+	workflowIDPrefix = "cadence-bulk-delete-"
+)
+
+type (
+	// Progress is both the input of a bulk delete workflow and the state it reports, it is carried over when the
+	// workflow continues as new
+	Progress struct {
+		// DomainID is the ID of the domain whose workflow executions are deleted
+		DomainID string `json:"domain_id"`
+		// ClosedBeforeTime is the time, in nanoseconds since epoch, before which the deleted executions closed
+		ClosedBeforeTime int64 `json:"closed_before_time"`
+		// DeletesPerSecond is the max rate at which executions are deleted
+		DeletesPerSecond int `json:"deletes_per_second"`
+		// NextPageToken is the token of the next page of closed executions to scan, empty for the first page
+		NextPageToken []byte `json:"next_page_token"`
+		// Scanned is the number of closed executions listed so far
+		Scanned int64 `json:"scanned"`
+		// Deleted is the number of executions deleted so far
+		Deleted int64 `json:"deleted"`
+		// Skipped is the number of executions listed but not deleted since they closed after ClosedBeforeTime
+		Skipped int64 `json:"skipped"`
+		// Failed is the number of executions which failed to be deleted, they are not retried
+		Failed int64 `json:"failed"`
+		// Completed is true once every page of closed executions is scanned
+		Completed bool `json:"completed"`
+	}
+)
+
+// WorkflowID returns the ID of the bulk delete workflow of a domain, there is a single ID per domain so that only
+// one bulk delete runs for a domain at a time
+func WorkflowID(domainID string) string {
+	return workflowIDPrefix + domainID
+}
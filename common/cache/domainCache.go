@@ -59,6 +59,7 @@ type (
 		GetDomain(name string) (*DomainCacheEntry, error)
 		GetDomainByID(id string) (*DomainCacheEntry, error)
 		GetDomainID(name string) (string, error)
+		Invalidate(name string)
 	}
 
 	domainCache struct {
@@ -152,6 +153,27 @@ func (c *domainCache) GetDomainID(name string) (string, error) {
 	return entry.info.ID, nil
 }
 
+// Invalidate expires the cached entries of the given domain, or of all domains if name is empty, so that the next
+// lookup reloads them from metadata store.  The stale entries are kept to be served if the reload fails.
+func (c *domainCache) Invalidate(name string) {
+	c.invalidate(c.cacheByName, name)
+	c.invalidate(c.cacheByID, name)
+}
+
+func (c *domainCache) invalidate(cache Cache, name string) {
+	it := cache.Iterator()
+	defer it.Close()
+	for it.HasNext() {
+		entry := it.Next().Value().(*DomainCacheEntry)
+		entry.Lock()
+		if !entry.expiry.IsZero() && (name == "" || entry.info.Name == name) {
+			// a non zero expiry in the past, as a zero expiry marks the entry as not yet initialized
+			entry.expiry = time.Unix(0, 0)
+		}
+		entry.Unlock()
+	}
+}
+
 // GetDomain retrieves the information from the cache if it exists, otherwise retrieves the information from metadata
 // store and writes it to the cache with an expiry before returning back
 func (c *domainCache) getDomain(key, id, name string, cache Cache) (*DomainCacheEntry, error) {
@@ -0,0 +1,78 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"errors"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestDomainCacheInvalidate(t *testing.T) {
+	metadataMgr := &mocks.MetadataManager{}
+	domainCache := NewDomainCache(metadataMgr, cluster.GetTestClusterMetadata(false, false), bark.NewLoggerFromLogrus(log.New()))
+
+	response := &persistence.GetDomainResponse{
+		Info:              &persistence.DomainInfo{ID: "test-domain-id", Name: "test-domain"},
+		Config:            &persistence.DomainConfig{Retention: 1},
+		ReplicationConfig: &persistence.DomainReplicationConfig{},
+	}
+	byName := &persistence.GetDomainRequest{Name: "test-domain"}
+	byID := &persistence.GetDomainRequest{ID: "test-domain-id"}
+	metadataMgr.On("GetDomain", byName).Return(response, nil).Once()
+	metadataMgr.On("GetDomain", byID).Return(response, nil).Once()
+
+	_, err := domainCache.GetDomain("test-domain")
+	assert.Nil(t, err)
+	_, err = domainCache.GetDomainByID("test-domain-id")
+	assert.Nil(t, err)
+
+	// invalidating another domain should not touch the cached entries
+	domainCache.Invalidate("another-domain")
+	_, err = domainCache.GetDomain("test-domain")
+	assert.Nil(t, err)
+	_, err = domainCache.GetDomainByID("test-domain-id")
+	assert.Nil(t, err)
+	metadataMgr.AssertExpectations(t)
+
+	domainCache.Invalidate("test-domain")
+	metadataMgr.On("GetDomain", byName).Return(response, nil).Once()
+	metadataMgr.On("GetDomain", byID).Return(response, nil).Once()
+	_, err = domainCache.GetDomain("test-domain")
+	assert.Nil(t, err)
+	_, err = domainCache.GetDomainByID("test-domain-id")
+	assert.Nil(t, err)
+	metadataMgr.AssertExpectations(t)
+
+	// stale entry should still be served if the reload fails
+	domainCache.Invalidate("")
+	metadataMgr.On("GetDomain", byName).Return(nil, errors.New("some random error")).Once()
+	entry, err := domainCache.GetDomain("test-domain")
+	assert.Nil(t, err)
+	assert.Equal(t, "test-domain-id", entry.GetInfo().ID)
+	metadataMgr.AssertExpectations(t)
+}
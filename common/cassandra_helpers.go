@@ -118,6 +118,7 @@ func LoadCassandraSchema(
 		SchemaFilePath:    tmpFile.Name(),
 		Overwrite:         override,
 		DisableVersioning: true,
+		Reason:            "test schema setup",
 	}
 
 	err = cassandra.SetupSchema(config)
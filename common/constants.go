@@ -35,6 +35,9 @@ const (
 	TransientEventID int64 = -124
 )
 
+// ServerVersion is the version of the cadence server
+const ServerVersion = "0.0.1"
+
 const (
 	// FrontendServiceName is the name of the frontend service
 	FrontendServiceName = "cadence-frontend"
@@ -56,3 +59,6 @@ type (
 	// EncodingType is an enum that represents various data encoding types
 	EncodingType string
 )
+
+// WorkflowMetricsMarkerName is the name of the marker whose details are emitted as metrics by the history service
+const WorkflowMetricsMarkerName = "cadence.workflow-metrics"
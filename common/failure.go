@@ -0,0 +1,72 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"fmt"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+const (
+	// MaxFailureCauseDepth is the maximum number of failures which can be chained through Failure.Cause
+	MaxFailureCauseDepth = 16
+	// MaxFailureSize is the maximum total size of the types, messages and stack traces of a failure and its causes
+	MaxFailureSize = 32 * 1024
+)
+
+// ValidateFailure validates a structured failure reported by a worker, including its chain of causes
+func ValidateFailure(failure *workflow.Failure) error {
+	depth := 0
+	size := 0
+	for f := failure; f != nil; f = f.Cause {
+		depth++
+		if depth > MaxFailureCauseDepth {
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("Failure cannot have more than %v chained causes.", MaxFailureCauseDepth),
+			}
+		}
+		if f.GetType() == "" {
+			return &workflow.BadRequestError{Message: "Type is not set on failure."}
+		}
+		if len(f.GetType()) > MaxFailureTypeLength {
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("Failure type cannot be longer than %v characters.", MaxFailureTypeLength),
+			}
+		}
+		size += len(f.GetType()) + len(f.GetMessage()) + len(f.GetStackTrace())
+		if size > MaxFailureSize {
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("Failure and its causes cannot be larger than %v bytes.", MaxFailureSize),
+			}
+		}
+	}
+	return nil
+}
+
+// FailureReason returns the reason recorded for a failure. Workers reporting a structured failure may leave the
+// reason unset, in which case the type of the failure is used so readers which only know about reasons still get one.
+func FailureReason(reason *string, failure *workflow.Failure) string {
+	if reason != nil || failure == nil {
+		return StringDefault(reason)
+	}
+	return failure.GetType()
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	failureSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestFailureSuite(t *testing.T) {
+	suite.Run(t, new(failureSuite))
+}
+
+func (s *failureSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *failureSuite) TestValidateFailure() {
+	s.NoError(ValidateFailure(nil))
+
+	failure := &workflow.Failure{
+		Type:    StringPtr("ActivityError"),
+		Message: StringPtr("activity failed"),
+		Cause: &workflow.Failure{
+			Type:       StringPtr("IOError"),
+			StackTrace: StringPtr("main.go:42"),
+		},
+	}
+	s.NoError(ValidateFailure(failure))
+
+	failure.Cause.Type = nil
+	s.IsType(&workflow.BadRequestError{}, ValidateFailure(failure))
+	failure.Cause.Type = StringPtr(strings.Repeat("a", MaxFailureTypeLength+1))
+	s.IsType(&workflow.BadRequestError{}, ValidateFailure(failure))
+
+	chain := &workflow.Failure{Type: StringPtr("Error")}
+	for i := 1; i < MaxFailureCauseDepth; i++ {
+		chain = &workflow.Failure{Type: StringPtr("Error"), Cause: chain}
+	}
+	s.NoError(ValidateFailure(chain))
+	chain = &workflow.Failure{Type: StringPtr("Error"), Cause: chain}
+	s.IsType(&workflow.BadRequestError{}, ValidateFailure(chain))
+
+	failure = &workflow.Failure{
+		Type:    StringPtr("Error"),
+		Message: StringPtr(strings.Repeat("a", MaxFailureSize/2)),
+		Cause: &workflow.Failure{
+			Type:       StringPtr("Error"),
+			StackTrace: StringPtr(strings.Repeat("a", MaxFailureSize/2-10)),
+		},
+	}
+	s.NoError(ValidateFailure(failure))
+	failure.Cause.StackTrace = StringPtr(strings.Repeat("a", MaxFailureSize/2))
+	s.IsType(&workflow.BadRequestError{}, ValidateFailure(failure))
+}
+
+func (s *failureSuite) TestFailureReason() {
+	failure := &workflow.Failure{Type: StringPtr("IOError")}
+	s.Equal("", FailureReason(nil, nil))
+	s.Equal("reason", FailureReason(StringPtr("reason"), failure))
+	s.Equal("IOError", FailureReason(nil, failure))
+}
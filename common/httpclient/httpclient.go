@@ -0,0 +1,158 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// DefaultDialTimeout is the connection timeout used when it is not configured
+	DefaultDialTimeout = 10 * time.Second
+	// DefaultTLSHandshakeTimeout is the TLS handshake timeout used when it is not configured
+	DefaultTLSHandshakeTimeout = 10 * time.Second
+)
+
+type (
+	// Config describes the configuration of the http clients used for outgoing calls
+	// made by archivers and other http integrations
+	Config struct {
+		// ProxyURL is the egress proxy all requests are sent through, the proxy
+		// from the HTTP_PROXY / HTTPS_PROXY environment variables is used if not set
+		ProxyURL string `yaml:"proxyURL"`
+		// CAFile is the path to a PEM encoded bundle of CA certificates trusted
+		// in addition to the system pool
+		CAFile string `yaml:"caFile"`
+		// Headers are added to every outgoing request that does not already set them
+		Headers map[string]string `yaml:"headers"`
+		// Timeout is the overall request timeout, requests are only bounded by their context if not set
+		Timeout time.Duration `yaml:"timeout"`
+		// DialTimeout is the connection timeout, DefaultDialTimeout is used if not set
+		DialTimeout time.Duration `yaml:"dialTimeout"`
+		// TLSHandshakeTimeout is the TLS handshake timeout, DefaultTLSHandshakeTimeout is used if not set
+		TLSHandshakeTimeout time.Duration `yaml:"tlsHandshakeTimeout"`
+	}
+
+	// headerTransport adds the default headers to requests before passing them on
+	headerTransport struct {
+		headers http.Header
+		base    http.RoundTripper
+	}
+)
+
+// NewClient creates an http client from the config, a nil config
+// results in a client with the default dial and TLS handshake timeouts
+// and no overall request timeout
+func (c *Config) NewClient() (*http.Client, error) {
+	cfg := Config{}
+	if c != nil {
+		cfg = *c
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if len(cfg.ProxyURL) != 0 {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("httpclient: invalid proxyURL: %v", err)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	transport := &http.Transport{
+		Proxy: proxy,
+		DialContext: (&net.Dialer{
+			Timeout:   durationOrDefault(cfg.DialTimeout, DefaultDialTimeout),
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout: durationOrDefault(cfg.TLSHandshakeTimeout, DefaultTLSHandshakeTimeout),
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+	}
+
+	if len(cfg.CAFile) != 0 {
+		pool, err := loadCertPool(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if len(cfg.Headers) != 0 {
+		headers := make(http.Header, len(cfg.Headers))
+		for k, v := range cfg.Headers {
+			headers.Set(k, v)
+		}
+		roundTripper = &headerTransport{headers: headers, base: transport}
+	}
+
+	return &http.Client{
+		Transport: roundTripper,
+		Timeout:   cfg.Timeout,
+	}, nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// a RoundTripper must not modify the request it was given
+	clone := new(http.Request)
+	*clone = *req
+	clone.Header = make(http.Header, len(req.Header)+len(t.headers))
+	for k, v := range req.Header {
+		clone.Header[k] = v
+	}
+	for k, v := range t.headers {
+		if _, ok := clone.Header[k]; !ok {
+			clone.Header[k] = v
+		}
+	}
+	return t.base.RoundTrip(clone)
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("httpclient: unable to read caFile: %v", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.New("httpclient: no certificates found in caFile")
+	}
+	return pool, nil
+}
+
+func durationOrDefault(d time.Duration, def time.Duration) time.Duration {
+	if d <= 0 {
+		return def
+	}
+	return d
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httpclient
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	httpClientSuite struct {
+		suite.Suite
+	}
+)
+
+func TestHTTPClientSuite(t *testing.T) {
+	suite.Run(t, new(httpClientSuite))
+}
+
+func (s *httpClientSuite) TestDefaults() {
+	var cfg *Config
+	client, err := cfg.NewClient()
+	s.Nil(err)
+	s.Equal(time.Duration(0), client.Timeout)
+	_, ok := client.Transport.(*http.Transport)
+	s.True(ok)
+}
+
+func (s *httpClientSuite) TestDefaultHeaders() {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+	}))
+	defer server.Close()
+
+	cfg := &Config{
+		Headers: map[string]string{"x-team": "cadence", "User-Agent": "cadence-archiver"},
+		Timeout: time.Second,
+	}
+	client, err := cfg.NewClient()
+	s.Nil(err)
+	s.Equal(time.Second, client.Timeout)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	s.Nil(err)
+	req.Header.Set("User-Agent", "explicit")
+	resp, err := client.Do(req)
+	s.Nil(err)
+	resp.Body.Close()
+
+	s.Equal("cadence", received.Get("X-Team"))
+	// headers set on the request take precedence
+	s.Equal("explicit", received.Get("User-Agent"))
+	s.Empty(req.Header.Get("X-Team"))
+}
+
+func (s *httpClientSuite) TestProxy() {
+	proxied := false
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = true
+		s.Equal("archive.example.com", r.Host)
+	}))
+	defer proxy.Close()
+
+	client, err := (&Config{ProxyURL: proxy.URL}).NewClient()
+	s.Nil(err)
+	resp, err := client.Get("http://archive.example.com/blob")
+	s.Nil(err)
+	resp.Body.Close()
+	s.True(proxied)
+
+	_, err = (&Config{ProxyURL: "://bad"}).NewClient()
+	s.NotNil(err)
+}
+
+func (s *httpClientSuite) TestCAFile() {
+	_, err := (&Config{CAFile: "/does/not/exist.pem"}).NewClient()
+	s.NotNil(err)
+
+	f, err := ioutil.TempFile("", "ca")
+	s.Nil(err)
+	defer os.Remove(f.Name())
+	f.WriteString("not a certificate")
+	f.Close()
+	_, err = (&Config{CAFile: f.Name()}).NewClient()
+	s.NotNil(err)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"encoding/binary"
+
+	"github.com/pborman/uuid"
+)
+
+const (
+	// IDTypeUUIDv4 generates random UUIDs
+	IDTypeUUIDv4 = "uuidv4"
+	// IDTypeUUIDv7 generates UUIDs prefixed by their creation time in milliseconds, IDs created close together sort
+	// close together which keeps inserts keyed by them local
+	IDTypeUUIDv7 = "uuidv7"
+)
+
+type (
+	// IDGenerator is an interface for any entity that generates
+	// the unique IDs given to new entities, like workflow runs
+	IDGenerator interface {
+		NextID() string
+	}
+
+	uuidV4Generator struct{}
+
+	uuidV7Generator struct {
+		timeSource TimeSource
+	}
+)
+
+// NewIDGenerator returns the generator for the given ID type,
+// unknown types fall back to random UUIDs
+func NewIDGenerator(idType string, timeSource TimeSource) IDGenerator {
+	if idType == IDTypeUUIDv7 {
+		return NewUUIDv7Generator(timeSource)
+	}
+	return NewUUIDv4Generator()
+}
+
+// NewUUIDv4Generator returns a generator of random UUIDs
+func NewUUIDv4Generator() IDGenerator {
+	return uuidV4Generator{}
+}
+
+// NewUUIDv7Generator returns a generator of time ordered UUIDs
+func NewUUIDv7Generator(timeSource TimeSource) IDGenerator {
+	return uuidV7Generator{timeSource: timeSource}
+}
+
+// NextID returns a new random UUID
+func (g uuidV4Generator) NextID() string {
+	return uuid.New()
+}
+
+// NextID returns a new UUID whose first 48 bits are the unix time in milliseconds
+func (g uuidV7Generator) NextID() string {
+	// start from a random UUID, which already carries the RFC 4122 variant bits
+	id := uuid.NewRandom()
+	var millis [8]byte
+	binary.BigEndian.PutUint64(millis[:], uint64(g.timeSource.Now().UnixNano()/1000000))
+	copy(id[0:6], millis[2:8])
+	id[6] = (id[6] & 0x0f) | 0x70
+	return id.String()
+}
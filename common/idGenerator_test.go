@@ -0,0 +1,66 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUIDv4Generator(t *testing.T) {
+	id := uuid.Parse(NewUUIDv4Generator().NextID())
+	assert.NotNil(t, id)
+	assert.Equal(t, uuid.Version(4), idVersion(id.String()))
+	assert.Equal(t, uuid.RFC4122, id.Variant())
+}
+
+func TestUUIDv7Generator(t *testing.T) {
+	timeSource := NewFakeTimeSource()
+	timeSource.Update(time.Unix(1500000000, 0))
+	generator := NewUUIDv7Generator(timeSource)
+
+	first := generator.NextID()
+	id := uuid.Parse(first)
+	assert.NotNil(t, id)
+	assert.Equal(t, uuid.Version(7), idVersion(first))
+	assert.Equal(t, uuid.RFC4122, id.Variant())
+	// 1500000000000 milliseconds
+	assert.Equal(t, "015d3ef7-9800", first[:13])
+
+	assert.NotEqual(t, first, generator.NextID())
+	timeSource.Update(time.Unix(1500000000, int64(time.Millisecond)))
+	assert.True(t, generator.NextID() > first)
+}
+
+func TestNewIDGenerator(t *testing.T) {
+	timeSource := NewRealTimeSource()
+	assert.Equal(t, uuid.Version(7), idVersion(NewIDGenerator(IDTypeUUIDv7, timeSource).NextID()))
+	assert.Equal(t, uuid.Version(4), idVersion(NewIDGenerator(IDTypeUUIDv4, timeSource).NextID()))
+	assert.Equal(t, uuid.Version(4), idVersion(NewIDGenerator("unknown", timeSource).NextID()))
+}
+
+func idVersion(id string) uuid.Version {
+	version, _ := uuid.Parse(id).Version()
+	return version
+}
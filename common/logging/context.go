@@ -0,0 +1,96 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package logging
+
+import (
+	"context"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+type contextKey int
+
+const tagsContextKey contextKey = iota
+
+// ContextWithTags returns a child context carrying the given tags in addition to the tags already carried by the
+// parent, a tag given here overrides the parent tag with the same name
+func ContextWithTags(ctx context.Context, tags bark.Fields) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	merged := bark.Fields{}
+	for k, v := range TagsFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, tagsContextKey, merged)
+}
+
+// ContextWithWorkflowTags returns a child context carrying the domainID, workflowID and runID tags, the empty values
+// are not added
+func ContextWithWorkflowTags(ctx context.Context, domainID, workflowID, runID string) context.Context {
+	tags := bark.Fields{}
+	if domainID != "" {
+		tags[TagDomainID] = domainID
+	}
+	if workflowID != "" {
+		tags[TagWorkflowExecutionID] = workflowID
+	}
+	if runID != "" {
+		tags[TagWorkflowRunID] = runID
+	}
+	return ContextWithTags(ctx, tags)
+}
+
+// ContextWithExecutionTags returns a child context carrying the domainID and the tags of the workflow execution,
+// which may be nil
+func ContextWithExecutionTags(ctx context.Context, domainID string, execution *shared.WorkflowExecution) context.Context {
+	if execution == nil {
+		return ContextWithWorkflowTags(ctx, domainID, "", "")
+	}
+	return ContextWithWorkflowTags(ctx, domainID, execution.GetWorkflowId(), execution.GetRunId())
+}
+
+// ContextWithShardID returns a child context carrying the history shardID tag
+func ContextWithShardID(ctx context.Context, shardID int) context.Context {
+	return ContextWithTags(ctx, bark.Fields{TagHistoryShardID: shardID})
+}
+
+// TagsFromContext returns the tags carried by the context, the returned fields must not be modified
+func TagsFromContext(ctx context.Context) bark.Fields {
+	if ctx == nil {
+		return nil
+	}
+	tags, _ := ctx.Value(tagsContextKey).(bark.Fields)
+	return tags
+}
+
+// LoggerFromContext returns the logger with the tags carried by the context attached to every log line
+func LoggerFromContext(ctx context.Context, logger bark.Logger) bark.Logger {
+	tags := TagsFromContext(ctx)
+	if len(tags) == 0 {
+		return logger
+	}
+	return logger.WithFields(tags)
+}
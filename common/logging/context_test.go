@@ -0,0 +1,80 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+type contextSuite struct {
+	suite.Suite
+}
+
+func TestContextSuite(t *testing.T) {
+	suite.Run(t, new(contextSuite))
+}
+
+func (s *contextSuite) TestContextWithTags() {
+	ctx := ContextWithWorkflowTags(context.Background(), "domain-id", "workflow-id", "")
+	s.Equal(bark.Fields{TagDomainID: "domain-id", TagWorkflowExecutionID: "workflow-id"}, TagsFromContext(ctx))
+
+	child := ContextWithTags(ContextWithShardID(ctx, 3), bark.Fields{TagWorkflowExecutionID: "other-workflow-id"})
+	s.Equal(bark.Fields{
+		TagDomainID:            "domain-id",
+		TagWorkflowExecutionID: "other-workflow-id",
+		TagHistoryShardID:      3,
+	}, TagsFromContext(child))
+	// parent is not changed by the child
+	s.Equal("workflow-id", TagsFromContext(ctx)[TagWorkflowExecutionID])
+
+	workflowID, runID := "workflow-id", "run-id"
+	execution := &shared.WorkflowExecution{WorkflowId: &workflowID, RunId: &runID}
+	s.Equal(bark.Fields{TagDomainID: "domain-id", TagWorkflowExecutionID: "workflow-id", TagWorkflowRunID: "run-id"},
+		TagsFromContext(ContextWithExecutionTags(context.Background(), "domain-id", execution)))
+	s.Equal(bark.Fields{TagDomainID: "domain-id"},
+		TagsFromContext(ContextWithExecutionTags(context.Background(), "domain-id", nil)))
+
+	s.Nil(TagsFromContext(context.Background()))
+	s.Nil(TagsFromContext(nil))
+}
+
+func (s *contextSuite) TestLoggerFromContext() {
+	logrusLogger, hook := test.NewNullLogger()
+	logger := bark.NewLoggerFromLogrus(logrusLogger)
+
+	LoggerFromContext(context.Background(), logger).Info("no tags")
+	s.Empty(hook.LastEntry().Data)
+
+	ctx := ContextWithWorkflowTags(context.Background(), "domain-id", "workflow-id", "run-id")
+	LoggerFromContext(ctx, logger).Info("with tags")
+	s.Equal(logrus.Fields{
+		TagDomainID:            "domain-id",
+		TagWorkflowExecutionID: "workflow-id",
+		TagWorkflowRunID:       "run-id",
+	}, hook.LastEntry().Data)
+}
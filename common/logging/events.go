@@ -64,6 +64,9 @@ const (
 	ShardEngineCreated              = 4021
 	ShardEngineStopping             = 4022
 	ShardEngineStopped              = 4023
+	ShardDrainStarted               = 4030
+	ShardDrainCompleted             = 4031
+	ShardPinned                     = 4032
 
 	// MutableSateBuilder events
 	InvalidMutableStateActionEventID = 4100
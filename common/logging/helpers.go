@@ -246,6 +246,28 @@ func LogShardEngineStoppedEvent(logger bark.Logger, host string, shardID int) {
 	}).Infof("ShardController on host '%v' stopped engine for shardID '%v'.", host, shardID)
 }
 
+// LogShardDrainStartedEvent is used to log the start of the drain of the shards of a host
+func LogShardDrainStartedEvent(logger bark.Logger, host string, numShards int) {
+	logger.WithFields(bark.Fields{
+		TagWorkflowEventID: ShardDrainStarted,
+	}).Infof("ShardController on host '%v' started draining %v shards.", host, numShards)
+}
+
+// LogShardDrainCompletedEvent is used to log the completion of the drain of the shards of a host
+func LogShardDrainCompletedEvent(logger bark.Logger, host string) {
+	logger.WithFields(bark.Fields{
+		TagWorkflowEventID: ShardDrainCompleted,
+	}).Infof("ShardController on host '%v' completed draining its shards.", host)
+}
+
+// LogShardPinnedEvent is used to log when a shard is moved to another host by pinning it to that host
+func LogShardPinnedEvent(logger bark.Logger, host string, shardID int, target string) {
+	logger.WithFields(bark.Fields{
+		TagWorkflowEventID: ShardPinned,
+		TagHistoryShardID:  shardID,
+	}).Infof("ShardController on host '%v' pinned shardID '%v' to host '%v'.", host, shardID, target)
+}
+
 // LogMutableStateInvalidAction is used to log invalid mutable state builder state
 func LogMutableStateInvalidAction(logger bark.Logger, errorMsg string) {
 	logger.WithFields(bark.Fields{
@@ -36,6 +36,7 @@ const (
 	TagHistoryBuilderAction = "history-builder-action"
 	TagStoreOperation       = "store-operation"
 	TagDomainID             = "domain-id"
+	TagDomainName           = "domain-name"
 	TagWorkflowExecutionID  = "execution-id"
 	TagWorkflowRunID        = "run-id"
 	TagHistoryShardID       = "shard-id"
@@ -48,6 +49,9 @@ const (
 	TagConsumerName         = "consumer-name"
 	TagPartition            = "partition"
 	TagOffset               = "offset"
+	TagRangeID              = "range-id"
+	TagExpectedNextEventID  = "expected-next-event-id"
+	TagActualNextEventID    = "actual-next-event-id"
 
 	// workflow logging tag values
 	// TagWorkflowComponent Values
@@ -61,6 +65,13 @@ const (
 	TagValueMatchingEngineComponent           = "matching-engine"
 	TagValueReplicatorComponent               = "replicator"
 	TagValueReplicationTaskProcessorComponent = "replication-task-processor"
+	TagValueDomainStatsScannerComponent       = "domain-stats-scanner"
+	TagValueBenchComponent                    = "bench"
+	TagValueCanaryComponent                   = "canary"
+	TagValueStuckWorkflowScannerComponent     = "stuck-workflow-scanner"
+	TagValueBulkDeleteComponent               = "bulk-delete"
+
+	TagValueDomainReplicationTaskProcessorComponent = "domain-replication-task-processor"
 
 	// TagHistoryBuilderAction values
 	TagValueActionWorkflowStarted                 = "add-workflowexecution-started-event"
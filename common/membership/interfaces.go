@@ -63,6 +63,8 @@ type (
 	// It can be used to resolve which member host is responsible for serving a given key.
 	ServiceResolver interface {
 		Lookup(key string) (*HostInfo, error)
+		// Members returns all the hosts of the service currently in the ring
+		Members() []*HostInfo
 		// AddListener adds a listener which will get notified on the given
 		// channel, whenever membership changes.
 		// @name: The name for identifying the listener
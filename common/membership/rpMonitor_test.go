@@ -83,6 +83,14 @@ func (s *RpoSuite) TestRingpopMonitor() {
 	s.Nil(err, "Ringpop monitor failed to find host for key")
 	s.NotEqual(testService.hostAddrs[1], host.GetAddress(), "Ringpop monitor assigned key to dead host")
 
+	resolver, err := rpm.GetResolver("rpm-test")
+	s.Nil(err, "Ringpop monitor failed to return resolver")
+	members := resolver.Members()
+	s.Equal(2, len(members), "Ringpop resolver returned wrong number of members")
+	for _, member := range members {
+		s.NotEqual(testService.hostAddrs[1], member.GetAddress(), "Ringpop resolver returned dead host as member")
+	}
+
 	err = rpm.RemoveListener("rpm-test", "test-listener")
 	s.Nil(err, "RemoveListener() failed")
 
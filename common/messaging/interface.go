@@ -21,16 +21,27 @@
 package messaging
 
 import (
+	"errors"
+
 	"github.com/uber-go/kafka-client/kafka"
 
 	"github.com/uber/cadence/.gen/go/replicator"
 )
 
+var (
+	// ErrDomainTopicsNotConfigured is the error to indicate the domain replication topics of a cluster are not set
+	ErrDomainTopicsNotConfigured = errors.New("domain replication topics are not configured")
+)
+
 type (
 	// Client is the interface used to abstract out interaction with messaging system for replication
 	Client interface {
 		NewConsumer(currentCluster, sourceCluster, consumerName string, concurrency int) (kafka.Consumer, error)
 		NewProducer(sourceCluster string) (Producer, error)
+		// NewDomainConsumer returns ErrDomainTopicsNotConfigured if the source cluster has no domain topics
+		NewDomainConsumer(currentCluster, sourceCluster, consumerName string, concurrency int) (kafka.Consumer, error)
+		// NewDomainProducer falls back to the history replication topic if the source cluster has no domain topics
+		NewDomainProducer(sourceCluster string) (Producer, error)
 	}
 
 	// Producer is the interface used to send replication tasks to other clusters through replicator
@@ -40,23 +40,41 @@ func (c *kafkaClient) NewConsumer(currentCluster, sourceCluster, consumerName st
 	currentTopics := c.config.getTopicsForCadenceCluster(currentCluster)
 	sourceTopics := c.config.getTopicsForCadenceCluster(sourceCluster)
 
-	topicKafkaCluster := c.config.getKafkaClusterForTopic(sourceTopics.Topic)
-	retryTopicKafkaCluster := c.config.getKafkaClusterForTopic(currentTopics.RetryTopic)
-	dqlTopicKafkaCluster := c.config.getKafkaClusterForTopic(currentTopics.DLQTopic)
+	return c.newConsumer(sourceTopics.Topic, currentTopics.RetryTopic, currentTopics.DLQTopic, consumerName, concurrency)
+}
+
+// NewDomainConsumer is used to create a Kafka consumer for the domain replication tasks of the source cluster
+func (c *kafkaClient) NewDomainConsumer(currentCluster, sourceCluster, consumerName string,
+	concurrency int) (kafka.Consumer, error) {
+	currentTopics := c.config.getTopicsForCadenceCluster(currentCluster)
+	sourceTopics := c.config.getTopicsForCadenceCluster(sourceCluster)
+	if !sourceTopics.hasDomainTopics() || !currentTopics.hasDomainTopics() {
+		return nil, ErrDomainTopicsNotConfigured
+	}
+
+	return c.newConsumer(sourceTopics.DomainTopic, currentTopics.DomainRetryTopic, currentTopics.DomainDLQTopic,
+		consumerName, concurrency)
+}
+
+func (c *kafkaClient) newConsumer(topic, retryTopic, dlqTopic, consumerName string,
+	concurrency int) (kafka.Consumer, error) {
+	topicKafkaCluster := c.config.getKafkaClusterForTopic(topic)
+	retryTopicKafkaCluster := c.config.getKafkaClusterForTopic(retryTopic)
+	dqlTopicKafkaCluster := c.config.getKafkaClusterForTopic(dlqTopic)
 	topicList := kafka.ConsumerTopicList{
 		kafka.ConsumerTopic{
 			Topic: kafka.Topic{
-				Name:       sourceTopics.Topic,
+				Name:       topic,
 				Cluster:    topicKafkaCluster,
 				BrokerList: c.config.getBrokersForKafkaCluster(topicKafkaCluster),
 			},
 			RetryQ: kafka.Topic{
-				Name:       currentTopics.RetryTopic,
+				Name:       retryTopic,
 				Cluster:    retryTopicKafkaCluster,
 				BrokerList: c.config.getBrokersForKafkaCluster(retryTopicKafkaCluster),
 			},
 			DLQ: kafka.Topic{
-				Name:       currentTopics.DLQTopic,
+				Name:       dlqTopic,
 				Cluster:    dqlTopicKafkaCluster,
 				BrokerList: c.config.getBrokersForKafkaCluster(dqlTopicKafkaCluster),
 			},
@@ -74,7 +92,20 @@ func (c *kafkaClient) NewConsumer(currentCluster, sourceCluster, consumerName st
 // NewProducer is used to create a Kafka producer for shipping replication tasks
 func (c *kafkaClient) NewProducer(sourceCluster string) (Producer, error) {
 	topics := c.config.getTopicsForCadenceCluster(sourceCluster)
-	kafkaClusterName := c.config.getKafkaClusterForTopic(topics.Topic)
+	return c.newProducer(topics.Topic)
+}
+
+// NewDomainProducer is used to create a Kafka producer for shipping domain replication tasks
+func (c *kafkaClient) NewDomainProducer(sourceCluster string) (Producer, error) {
+	topics := c.config.getTopicsForCadenceCluster(sourceCluster)
+	if !topics.hasDomainTopics() {
+		return c.newProducer(topics.Topic)
+	}
+	return c.newProducer(topics.DomainTopic)
+}
+
+func (c *kafkaClient) newProducer(topic string) (Producer, error) {
+	kafkaClusterName := c.config.getKafkaClusterForTopic(topic)
 	brokers := c.config.getBrokersForKafkaCluster(kafkaClusterName)
 
 	producer, err := sarama.NewSyncProducer(brokers, nil)
@@ -82,5 +113,5 @@ func (c *kafkaClient) NewProducer(sourceCluster string) (Producer, error) {
 		return nil, err
 	}
 
-	return NewKafkaProducer(topics.Topic, producer, c.logger), nil
+	return NewKafkaProducer(topic, producer, c.logger), nil
 }
@@ -49,11 +49,15 @@ type (
 		Cluster string `yaml:"cluster"`
 	}
 
-	// TopicList describes the topic names for each cluster
+	// TopicList describes the topic names for each cluster. The domain topics are optional, domain replication tasks
+	// share the history replication topics when they are not set.
 	TopicList struct {
-		Topic      string `yaml:"topic"`
-		RetryTopic string `yaml:"retry-topic"`
-		DLQTopic   string `yaml:"dlq-topic"`
+		Topic            string `yaml:"topic"`
+		RetryTopic       string `yaml:"retry-topic"`
+		DLQTopic         string `yaml:"dlq-topic"`
+		DomainTopic      string `yaml:"domain-topic"`
+		DomainRetryTopic string `yaml:"domain-retry-topic"`
+		DomainDLQTopic   string `yaml:"domain-dlq-topic"`
 	}
 )
 
@@ -114,9 +118,18 @@ func (k *KafkaConfig) validate() {
 		validateTopicsFn(topics.Topic)
 		validateTopicsFn(topics.RetryTopic)
 		validateTopicsFn(topics.DLQTopic)
+		if topics.hasDomainTopics() {
+			validateTopicsFn(topics.DomainTopic)
+			validateTopicsFn(topics.DomainRetryTopic)
+			validateTopicsFn(topics.DomainDLQTopic)
+		}
 	}
 }
 
+func (t TopicList) hasDomainTopics() bool {
+	return t.DomainTopic != "" || t.DomainRetryTopic != "" || t.DomainDLQTopic != ""
+}
+
 func (k *KafkaConfig) getTopicsForCadenceCluster(cadenceCluster string) TopicList {
 	return k.ClusterToTopic[cadenceCluster]
 }
@@ -117,6 +117,11 @@ func (m *ClientImpl) Tagged(tags map[string]string) Client {
 	return NewClient(scope, m.serviceIdx)
 }
 
+// Scope returns the tally scope of the given scope, for the metrics whose names are only known at runtime
+func (m *ClientImpl) Scope(scopeIdx int) tally.Scope {
+	return m.childScopes[scopeIdx]
+}
+
 func getMetricDefs(serviceIdx ServiceIdx) map[int]metricDefinition {
 	defs := make(map[int]metricDefinition)
 	for idx, def := range MetricDefs[Common] {
@@ -67,6 +67,20 @@ const (
 	OperationTagName = "operation"
 	// ShardTagName is temporary until we can get all metric data removed for the service
 	ShardTagName = "shard"
+	// ConfigKeyTagName is the dynamic config key a metric is emitted for
+	ConfigKeyTagName = "config-key"
+	// DomainTagName is the name of the domain a metric is emitted for
+	DomainTagName = "domain"
+	// KeyspaceTagName is the cassandra keyspace a metric is emitted for
+	KeyspaceTagName = "keyspace"
+	// SchemaVersionTagName is the schema version of the keyspace a metric is emitted for
+	SchemaVersionTagName = "schema-version"
+	// TableTagName is the cassandra table a metric is emitted for
+	TableTagName = "table"
+	// EncodingTagName is the encoding type of the blob a metric is emitted for
+	EncodingTagName = "encoding"
+	// DomainIDTagName is the ID of the domain a metric is emitted for, where the domain name is not known
+	DomainIDTagName = "domain-id"
 )
 
 // This package should hold all the metrics and tags for cadence
@@ -88,11 +102,16 @@ const (
 	MemoryStackGauge     = "memory.stack"
 	NumGCCounter         = "memory.num-gc"
 	GcPauseMsTimer       = "memory.gc-pause-ms"
+
+	SchemaVersionGauge           = "persistence.schema-version"
+	SchemaLastMigrationTimeGauge = "persistence.schema-last-migration-time"
 )
 
 // ServiceMetrics are types for common service base metrics
 var ServiceMetrics = map[MetricName]MetricType{
-	RestartCount: Counter,
+	RestartCount:                 Counter,
+	SchemaVersionGauge:           Gauge,
+	SchemaLastMigrationTimeGauge: Gauge,
 }
 
 // GoRuntimeMetrics represent the runtime stats from go runtime
@@ -128,6 +147,8 @@ const (
 	PersistenceResetMutableStateScope
 	// PersistenceDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution calls made by service to persistence layer
 	PersistenceDeleteWorkflowExecutionScope
+	// PersistenceDeleteCurrentWorkflowExecutionScope tracks DeleteCurrentWorkflowExecution calls made by service to persistence layer
+	PersistenceDeleteCurrentWorkflowExecutionScope
 	// PersistenceGetCurrentExecutionScope tracks GetCurrentExecution calls made by service to persistence layer
 	PersistenceGetCurrentExecutionScope
 	// PersistenceGetTransferTasksScope tracks GetTransferTasks calls made by service to persistence layer
@@ -158,6 +179,8 @@ const (
 	PersistenceGetWorkflowExecutionHistoryScope
 	// PersistenceDeleteWorkflowExecutionHistoryScope tracks DeleteWorkflowExecutionHistory calls made by service to persistence layer
 	PersistenceDeleteWorkflowExecutionHistoryScope
+	// PersistenceGetWorkflowExecutionHistoryBatchScope tracks GetWorkflowExecutionHistoryBatch calls made by service to persistence layer
+	PersistenceGetWorkflowExecutionHistoryBatchScope
 	// PersistenceCreateDomainScope tracks CreateDomain calls made by service to persistence layer
 	PersistenceCreateDomainScope
 	// PersistenceGetDomainScope tracks GetDomain calls made by service to persistence layer
@@ -168,6 +191,8 @@ const (
 	PersistenceDeleteDomainScope
 	// PersistenceDeleteDomainByNameScope tracks DeleteDomainByName calls made by service to persistence layer
 	PersistenceDeleteDomainByNameScope
+	// PersistenceListDomainsScope tracks ListDomains calls made by service to persistence layer
+	PersistenceListDomainsScope
 	// PersistenceRecordWorkflowExecutionStartedScope tracks RecordWorkflowExecutionStarted calls made by service to persistence layer
 	PersistenceRecordWorkflowExecutionStartedScope
 	// PersistenceRecordWorkflowExecutionClosedScope tracks RecordWorkflowExecutionClosed calls made by service to persistence layer
@@ -188,6 +213,32 @@ const (
 	PersistenceListClosedWorkflowExecutionsByStatusScope
 	// PersistenceGetClosedWorkflowExecutionScope tracks GetClosedWorkflowExecution calls made by service to persistence layer
 	PersistenceGetClosedWorkflowExecutionScope
+	// PersistenceVisibilityDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution calls made by service to visibility persistence layer
+	PersistenceVisibilityDeleteWorkflowExecutionScope
+	// PersistenceAddHistoryCountsScope tracks AddHistoryCounts calls made by service to persistence layer
+	PersistenceAddHistoryCountsScope
+	// PersistenceGetHistoryCountsScope tracks GetHistoryCounts calls made by service to persistence layer
+	PersistenceGetHistoryCountsScope
+	// PersistenceListHistoryCountDomainsScope tracks ListHistoryCountDomains calls made by service to persistence layer
+	PersistenceListHistoryCountDomainsScope
+	// PersistenceUpsertDomainStatsScope tracks UpsertDomainStats calls made by service to persistence layer
+	PersistenceUpsertDomainStatsScope
+	// PersistenceGetDomainStatsScope tracks GetDomainStats calls made by service to persistence layer
+	PersistenceGetDomainStatsScope
+	// PersistenceRecordAuditScope tracks RecordAudit calls made by service to persistence layer
+	PersistenceRecordAuditScope
+	// PersistenceListAuditRecordsScope tracks ListAuditRecords calls made by service to persistence layer
+	PersistenceListAuditRecordsScope
+	// PersistenceGetMaintenanceModeScope tracks GetMaintenanceMode calls made by service to persistence layer
+	PersistenceGetMaintenanceModeScope
+	// PersistenceUpdateMaintenanceModeScope tracks UpdateMaintenanceMode calls made by service to persistence layer
+	PersistenceUpdateMaintenanceModeScope
+	// PersistenceGetShardPlacementScope tracks GetShardPlacement calls made by service to persistence layer
+	PersistenceGetShardPlacementScope
+	// PersistenceUpdateShardPlacementScope tracks UpdateShardPlacement calls made by service to persistence layer
+	PersistenceUpdateShardPlacementScope
+	// PersistenceResetShardPlacementScope tracks ResetShardPlacement calls made by service to persistence layer
+	PersistenceResetShardPlacementScope
 	// HistoryClientStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientStartWorkflowExecutionScope
 	// HistoryClientRecordActivityTaskHeartbeatScope tracks RPC calls to history service
@@ -208,6 +259,12 @@ const (
 	HistoryClientResetStickyTaskListScope
 	// HistoryClientDescribeWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientDescribeWorkflowExecutionScope
+	// HistoryClientGetWorkflowExecutionHistoryEventScope tracks RPC calls to history service
+	HistoryClientGetWorkflowExecutionHistoryEventScope
+	// HistoryClientGetCurrentExecutionScope tracks RPC calls to history service
+	HistoryClientGetCurrentExecutionScope
+	// HistoryClientListWorkflowExecutionChainScope tracks RPC calls to history service
+	HistoryClientListWorkflowExecutionChainScope
 	// HistoryClientRecordDecisionTaskStartedScope tracks RPC calls to history service
 	HistoryClientRecordDecisionTaskStartedScope
 	// HistoryClientRecordActivityTaskStartedScope tracks RPC calls to history service
@@ -228,6 +285,22 @@ const (
 	HistoryClientRecordChildExecutionCompletedScope
 	// HistoryClientReplicateEventsScope tracks RPC calls to history service
 	HistoryClientReplicateEventsScope
+	// HistoryClientRefreshDomainCacheScope tracks RPC calls to history service
+	HistoryClientRefreshDomainCacheScope
+	// HistoryClientDescribeHistoryHostScope tracks RPC calls to history service
+	HistoryClientDescribeHistoryHostScope
+	// HistoryClientWarmHistoryHostScope tracks RPC calls to history service
+	HistoryClientWarmHistoryHostScope
+	// HistoryClientDrainHistoryHostScope tracks RPC calls to history service
+	HistoryClientDrainHistoryHostScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientResumeWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResumeWorkflowExecutionScope
+	// HistoryClientNukeWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientNukeWorkflowExecutionScope
+	// HistoryClientImportWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientImportWorkflowExecutionScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -244,6 +317,12 @@ const (
 	MatchingClientCancelOutstandingPollScope
 	// MatchingClientDescribeTaskListScope tracks RPC calls to matching service
 	MatchingClientDescribeTaskListScope
+	// MatchingClientPauseTaskListScope tracks RPC calls to matching service
+	MatchingClientPauseTaskListScope
+	// MatchingClientResumeTaskListScope tracks RPC calls to matching service
+	MatchingClientResumeTaskListScope
+	// DynamicConfigScope is the scope used by dynamic config lookups
+	DynamicConfigScope
 
 	NumCommonScopes
 )
@@ -306,6 +385,56 @@ const (
 	FrontendDescribeWorkflowExecutionScope
 	// FrontendDescribeTaskListScope is the metric scope for frontend.DescribeTaskList
 	FrontendDescribeTaskListScope
+	// FrontendDescribeClusterScope is the metric scope for frontend.DescribeCluster
+	FrontendDescribeClusterScope
+	// AdminImportWorkflowExecutionScope is the metric scope for admin.ImportWorkflowExecution
+	AdminImportWorkflowExecutionScope
+	// AdminPauseTaskListScope is the metric scope for admin.PauseTaskList
+	AdminPauseTaskListScope
+	// AdminResumeTaskListScope is the metric scope for admin.ResumeTaskList
+	AdminResumeTaskListScope
+	// AdminGetDomainStatsScope is the metric scope for admin.GetDomainStats
+	AdminGetDomainStatsScope
+	// AdminTerminateWorkflowExecutionScope is the metric scope for admin.TerminateWorkflowExecution
+	AdminTerminateWorkflowExecutionScope
+	// AdminListAuditRecordsScope is the metric scope for admin.ListAuditRecords
+	AdminListAuditRecordsScope
+	// AdminGetWorkflowExecutionHistoryEventScope is the metric scope for admin.GetWorkflowExecutionHistoryEvent
+	AdminGetWorkflowExecutionHistoryEventScope
+	// AdminRefreshDomainCacheScope is the metric scope for admin.RefreshDomainCache
+	AdminRefreshDomainCacheScope
+	// AdminGetCurrentExecutionScope is the metric scope for admin.GetCurrentExecution
+	AdminGetCurrentExecutionScope
+	// AdminListWorkflowExecutionChainScope is the metric scope for admin.ListWorkflowExecutionChain
+	AdminListWorkflowExecutionChainScope
+	// AdminUpdateDomainRetentionScope is the metric scope for admin.UpdateDomainRetention
+	AdminUpdateDomainRetentionScope
+	// AdminDescribeHistoryHostScope is the metric scope for admin.DescribeHistoryHost
+	AdminDescribeHistoryHostScope
+	// AdminWarmHistoryHostScope is the metric scope for admin.WarmHistoryHost
+	AdminWarmHistoryHostScope
+	// AdminPauseWorkflowExecutionScope is the metric scope for admin.PauseWorkflowExecution
+	AdminPauseWorkflowExecutionScope
+	// AdminResumeWorkflowExecutionScope is the metric scope for admin.ResumeWorkflowExecution
+	AdminResumeWorkflowExecutionScope
+	// AdminNukeWorkflowExecutionScope is the metric scope for admin.NukeWorkflowExecution
+	AdminNukeWorkflowExecutionScope
+	// AdminResyncDomainsScope is the metric scope for admin.ResyncDomains
+	AdminResyncDomainsScope
+	// AdminUpdateMaintenanceModeScope is the metric scope for admin.UpdateMaintenanceMode
+	AdminUpdateMaintenanceModeScope
+	// AdminDescribeMaintenanceModeScope is the metric scope for admin.DescribeMaintenanceMode
+	AdminDescribeMaintenanceModeScope
+	// AdminBulkDeleteWorkflowExecutionsScope is the metric scope for admin.BulkDeleteWorkflowExecutions
+	AdminBulkDeleteWorkflowExecutionsScope
+	// AdminDescribeBulkDeleteWorkflowExecutionsScope is the metric scope for admin.DescribeBulkDeleteWorkflowExecutions
+	AdminDescribeBulkDeleteWorkflowExecutionsScope
+	// AdminDescribeShardDistributionScope is the metric scope for admin.DescribeShardDistribution
+	AdminDescribeShardDistributionScope
+	// AdminDrainHistoryHostScope is the metric scope for admin.DrainHistoryHost
+	AdminDrainHistoryHostScope
+	// AdminResetShardPlacementScope is the metric scope for admin.ResetShardPlacement
+	AdminResetShardPlacementScope
 
 	NumFrontendScopes
 )
@@ -332,6 +461,12 @@ const (
 	HistoryResetStickyTaskListScope
 	// HistoryDescribeWorkflowExecutionScope tracks DescribeWorkflowExecution API calls received by service
 	HistoryDescribeWorkflowExecutionScope
+	// HistoryGetWorkflowExecutionHistoryEventScope tracks GetWorkflowExecutionHistoryEvent API calls received by service
+	HistoryGetWorkflowExecutionHistoryEventScope
+	// HistoryGetCurrentExecutionScope tracks GetCurrentExecution API calls received by service
+	HistoryGetCurrentExecutionScope
+	// HistoryListWorkflowExecutionChainScope tracks ListWorkflowExecutionChain API calls received by service
+	HistoryListWorkflowExecutionChainScope
 	// HistoryRecordDecisionTaskStartedScope tracks RecordDecisionTaskStarted API calls received by service
 	HistoryRecordDecisionTaskStartedScope
 	// HistoryRecordActivityTaskStartedScope tracks RecordActivityTaskStarted API calls received by service
@@ -352,6 +487,22 @@ const (
 	HistoryRequestCancelWorkflowExecutionScope
 	// HistoryReplicateEventsScope tracks ReplicateEvents API calls received by service
 	HistoryReplicateEventsScope
+	// HistoryRefreshDomainCacheScope tracks RefreshDomainCache API calls received by service
+	HistoryRefreshDomainCacheScope
+	// HistoryDescribeHistoryHostScope tracks DescribeHistoryHost API calls received by service
+	HistoryDescribeHistoryHostScope
+	// HistoryWarmHistoryHostScope tracks WarmHistoryHost API calls received by service
+	HistoryWarmHistoryHostScope
+	// HistoryDrainHistoryHostScope tracks DrainHistoryHost API calls received by service
+	HistoryDrainHistoryHostScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryResumeWorkflowExecutionScope tracks ResumeWorkflowExecution API calls received by service
+	HistoryResumeWorkflowExecutionScope
+	// HistoryNukeWorkflowExecutionScope tracks NukeWorkflowExecution API calls received by service
+	HistoryNukeWorkflowExecutionScope
+	// HistoryImportWorkflowExecutionScope tracks ImportWorkflowExecution API calls received by service
+	HistoryImportWorkflowExecutionScope
 	// HistoryShardControllerScope is the scope used by shard controller
 	HistoryShardControllerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -380,6 +531,8 @@ const (
 	TimerTaskWorkflowTimeoutScope
 	// TimerTaskRetryTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerTaskRetryTimerScope
+	// TimerTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing workflow backoff timers
+	TimerTaskWorkflowBackoffTimerScope
 	// TimerTaskDeleteHistoryEvent is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerTaskDeleteHistoryEvent
 	// HistoryEventNotificationScope is the scope used by shard history event nitification
@@ -388,6 +541,8 @@ const (
 	ReplicatorQueueProcessorScope
 	// ReplicatorTaskHistoryScope is the scope used for history task processing by replicator queue processor
 	ReplicatorTaskHistoryScope
+	// HistoryWorkflowMetricsScope is the scope of the metrics recorded by workflows in metrics markers
+	HistoryWorkflowMetricsScope
 
 	NumHistoryScopes
 )
@@ -412,6 +567,10 @@ const (
 	MatchingCancelOutstandingPollScope
 	// MatchingDescribeTaskListScope tracks DescribeTaskList API calls received by service
 	MatchingDescribeTaskListScope
+	// MatchingPauseTaskListScope tracks PauseTaskList API calls received by service
+	MatchingPauseTaskListScope
+	// MatchingResumeTaskListScope tracks ResumeTaskList API calls received by service
+	MatchingResumeTaskListScope
 
 	NumMatchingScopes
 )
@@ -420,6 +579,24 @@ const (
 const (
 	// ReplicationScope is the scope used by all metric emitted by replicator
 	ReplicatorScope = iota + NumCommonScopes
+	// DomainStatsScannerScope is the scope used by all metric emitted by the domain stats scanner
+	DomainStatsScannerScope
+	// DomainReplicationTaskScope is the scope used by all metric emitted by the domain replication task processor
+	DomainReplicationTaskScope
+	// BenchScope is the scope used by all metric emitted by the bench load
+	BenchScope
+	// CanaryTimerScope is the scope used by the metrics of the timer canary
+	CanaryTimerScope
+	// CanarySignalScope is the scope used by the metrics of the signal canary
+	CanarySignalScope
+	// CanaryChildScope is the scope used by the metrics of the child workflow canary
+	CanaryChildScope
+	// CanaryQueryScope is the scope used by the metrics of the query canary
+	CanaryQueryScope
+	// StuckWorkflowScannerScope is the scope used by all metric emitted by the stuck workflow scanner
+	StuckWorkflowScannerScope
+	// BulkDeleteScope is the scope used by all metric emitted by the bulk delete workflows
+	BulkDeleteScope
 
 	NumWorkerScopes
 )
@@ -436,6 +613,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceUpdateWorkflowExecutionScope:                  {operation: "UpdateWorkflowExecution"},
 		PersistenceResetMutableStateScope:                        {operation: "ResetMutableState"},
 		PersistenceDeleteWorkflowExecutionScope:                  {operation: "DeleteWorkflowExecution"},
+		PersistenceDeleteCurrentWorkflowExecutionScope:           {operation: "DeleteCurrentWorkflowExecution"},
 		PersistenceGetCurrentExecutionScope:                      {operation: "GetCurrentExecution"},
 		PersistenceGetTransferTasksScope:                         {operation: "GetTransferTasks"},
 		PersistenceGetReplicationTasksScope:                      {operation: "GetReplicationTasks"},
@@ -451,11 +629,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceAppendHistoryEventsScope:                      {operation: "AppendHistoryEvents", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceGetWorkflowExecutionHistoryScope:              {operation: "GetWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceDeleteWorkflowExecutionHistoryScope:           {operation: "DeleteWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetWorkflowExecutionHistoryBatchScope:         {operation: "GetWorkflowExecutionHistoryBatch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceCreateDomainScope:                             {operation: "CreateDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceGetDomainScope:                                {operation: "GetDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceUpdateDomainScope:                             {operation: "UpdateDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceDeleteDomainScope:                             {operation: "DeleteDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceDeleteDomainByNameScope:                       {operation: "DeleteDomainByName", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceListDomainsScope:                              {operation: "ListDomains", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceRecordWorkflowExecutionStartedScope:           {operation: "RecordWorkflowExecutionStarted"},
 		PersistenceRecordWorkflowExecutionClosedScope:            {operation: "RecordWorkflowExecutionClosed"},
 		PersistenceListOpenWorkflowExecutionsScope:               {operation: "ListOpenWorkflowExecutions"},
@@ -466,6 +646,19 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceListClosedWorkflowExecutionsByWorkflowIDScope: {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
 		PersistenceListClosedWorkflowExecutionsByStatusScope:     {operation: "ListClosedWorkflowExecutionsByStatus"},
 		PersistenceGetClosedWorkflowExecutionScope:               {operation: "GetClosedWorkflowExecution"},
+		PersistenceVisibilityDeleteWorkflowExecutionScope:        {operation: "VisibilityDeleteWorkflowExecution"},
+		PersistenceAddHistoryCountsScope:                         {operation: "AddHistoryCounts", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetHistoryCountsScope:                         {operation: "GetHistoryCounts", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceListHistoryCountDomainsScope:                  {operation: "ListHistoryCountDomains", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceUpsertDomainStatsScope:                        {operation: "UpsertDomainStats", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetDomainStatsScope:                           {operation: "GetDomainStats", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceRecordAuditScope:                              {operation: "RecordAudit", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceListAuditRecordsScope:                         {operation: "ListAuditRecords", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetMaintenanceModeScope:                       {operation: "GetMaintenanceMode", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceUpdateMaintenanceModeScope:                    {operation: "UpdateMaintenanceMode", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetShardPlacementScope:                        {operation: "GetShardPlacement", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceUpdateShardPlacementScope:                     {operation: "UpdateShardPlacement", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceResetShardPlacementScope:                      {operation: "ResetShardPlacement", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 
 		HistoryClientStartWorkflowExecutionScope:           {operation: "HistoryClientStartWorkflowExecution"},
 		HistoryClientRecordActivityTaskHeartbeatScope:      {operation: "HistoryClientRecordActivityTaskHeartbeat"},
@@ -477,6 +670,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientGetMutableStateScope:                  {operation: "HistoryClientGetMutableState"},
 		HistoryClientResetStickyTaskListScope:              {operation: "HistoryClientResetStickyTaskListScope"},
 		HistoryClientDescribeWorkflowExecutionScope:        {operation: "HistoryClientDescribeWorkflowExecution"},
+		HistoryClientGetWorkflowExecutionHistoryEventScope: {operation: "HistoryClientGetWorkflowExecutionHistoryEvent"},
+		HistoryClientGetCurrentExecutionScope:              {operation: "HistoryClientGetCurrentExecution"},
+		HistoryClientListWorkflowExecutionChainScope:       {operation: "HistoryClientListWorkflowExecutionChain"},
 		HistoryClientRecordDecisionTaskStartedScope:        {operation: "HistoryClientRecordDecisionTaskStarted"},
 		HistoryClientRecordActivityTaskStartedScope:        {operation: "HistoryClientRecordActivityTaskStarted"},
 		HistoryClientRequestCancelWorkflowExecutionScope:   {operation: "HistoryClientRequestCancelWorkflowExecution"},
@@ -487,6 +683,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientScheduleDecisionTaskScope:             {operation: "HistoryClientScheduleDecisionTask"},
 		HistoryClientRecordChildExecutionCompletedScope:    {operation: "HistoryClientRecordChildExecutionCompleted"},
 		HistoryClientReplicateEventsScope:                  {operation: "HistoryClientReplicateEvents"},
+		HistoryClientRefreshDomainCacheScope:               {operation: "HistoryClientRefreshDomainCache"},
+		HistoryClientDescribeHistoryHostScope:              {operation: "HistoryClientDescribeHistoryHost"},
+		HistoryClientWarmHistoryHostScope:                  {operation: "HistoryClientWarmHistoryHost"},
+		HistoryClientDrainHistoryHostScope:                 {operation: "HistoryClientDrainHistoryHost"},
+		HistoryClientPauseWorkflowExecutionScope:           {operation: "HistoryClientPauseWorkflowExecution"},
+		HistoryClientResumeWorkflowExecutionScope:          {operation: "HistoryClientResumeWorkflowExecution"},
+		HistoryClientNukeWorkflowExecutionScope:            {operation: "HistoryClientNukeWorkflowExecution"},
+		HistoryClientImportWorkflowExecutionScope:          {operation: "HistoryClientImportWorkflowExecution"},
 		MatchingClientPollForDecisionTaskScope:             {operation: "MatchingClientPollForDecisionTask"},
 		MatchingClientPollForActivityTaskScope:             {operation: "MatchingClientPollForActivityTask"},
 		MatchingClientAddActivityTaskScope:                 {operation: "MatchingClientAddActivityTask"},
@@ -495,37 +699,65 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientRespondQueryTaskCompletedScope:       {operation: "MatchingClientRespondQueryTaskCompleted"},
 		MatchingClientCancelOutstandingPollScope:           {operation: "MatchingClientCancelOutstandingPoll"},
 		MatchingClientDescribeTaskListScope:                {operation: "MatchingClientDescribeTaskList"},
+		MatchingClientPauseTaskListScope:                   {operation: "MatchingClientPauseTaskList"},
+		MatchingClientResumeTaskListScope:                  {operation: "MatchingClientResumeTaskList"},
+		DynamicConfigScope:                                 {operation: "DynamicConfig"},
 	},
 	// Frontend Scope Names
 	Frontend: {
-		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
-		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
-		FrontendPollForActivityTaskScope:              {operation: "PollForActivityTask"},
-		FrontendRecordActivityTaskHeartbeatScope:      {operation: "RecordActivityTaskHeartbeat"},
-		FrontendRecordActivityTaskHeartbeatByIDScope:  {operation: "RecordActivityTaskHeartbeatByID"},
-		FrontendRespondDecisionTaskCompletedScope:     {operation: "RespondDecisionTaskCompleted"},
-		FrontendRespondDecisionTaskFailedScope:        {operation: "RespondDecisionTaskFailed"},
-		FrontendRespondQueryTaskCompletedScope:        {operation: "RespondQueryTaskCompleted"},
-		FrontendRespondActivityTaskCompletedScope:     {operation: "RespondActivityTaskCompleted"},
-		FrontendRespondActivityTaskFailedScope:        {operation: "RespondActivityTaskFailed"},
-		FrontendRespondActivityTaskCanceledScope:      {operation: "RespondActivityTaskCanceled"},
-		FrontendRespondActivityTaskCompletedByIDScope: {operation: "RespondActivityTaskCompletedByID"},
-		FrontendRespondActivityTaskFailedByIDScope:    {operation: "RespondActivityTaskFailedByID"},
-		FrontendRespondActivityTaskCanceledByIDScope:  {operation: "RespondActivityTaskCanceledByID"},
-		FrontendGetWorkflowExecutionHistoryScope:      {operation: "GetWorkflowExecutionHistory"},
-		FrontendSignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
-		FrontendSignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
-		FrontendTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
-		FrontendRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
-		FrontendListOpenWorkflowExecutionsScope:       {operation: "ListOpenWorkflowExecutions"},
-		FrontendListClosedWorkflowExecutionsScope:     {operation: "ListClosedWorkflowExecutions"},
-		FrontendRegisterDomainScope:                   {operation: "RegisterDomain"},
-		FrontendDescribeDomainScope:                   {operation: "DescribeDomain"},
-		FrontendUpdateDomainScope:                     {operation: "UpdateDomain"},
-		FrontendDeprecateDomainScope:                  {operation: "DeprecateDomain"},
-		FrontendQueryWorkflowScope:                    {operation: "QueryWorkflow"},
-		FrontendDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
-		FrontendDescribeTaskListScope:                 {operation: "DescribeTaskList"},
+		FrontendStartWorkflowExecutionScope:            {operation: "StartWorkflowExecution"},
+		FrontendPollForDecisionTaskScope:               {operation: "PollForDecisionTask"},
+		FrontendPollForActivityTaskScope:               {operation: "PollForActivityTask"},
+		FrontendRecordActivityTaskHeartbeatScope:       {operation: "RecordActivityTaskHeartbeat"},
+		FrontendRecordActivityTaskHeartbeatByIDScope:   {operation: "RecordActivityTaskHeartbeatByID"},
+		FrontendRespondDecisionTaskCompletedScope:      {operation: "RespondDecisionTaskCompleted"},
+		FrontendRespondDecisionTaskFailedScope:         {operation: "RespondDecisionTaskFailed"},
+		FrontendRespondQueryTaskCompletedScope:         {operation: "RespondQueryTaskCompleted"},
+		FrontendRespondActivityTaskCompletedScope:      {operation: "RespondActivityTaskCompleted"},
+		FrontendRespondActivityTaskFailedScope:         {operation: "RespondActivityTaskFailed"},
+		FrontendRespondActivityTaskCanceledScope:       {operation: "RespondActivityTaskCanceled"},
+		FrontendRespondActivityTaskCompletedByIDScope:  {operation: "RespondActivityTaskCompletedByID"},
+		FrontendRespondActivityTaskFailedByIDScope:     {operation: "RespondActivityTaskFailedByID"},
+		FrontendRespondActivityTaskCanceledByIDScope:   {operation: "RespondActivityTaskCanceledByID"},
+		FrontendGetWorkflowExecutionHistoryScope:       {operation: "GetWorkflowExecutionHistory"},
+		FrontendSignalWorkflowExecutionScope:           {operation: "SignalWorkflowExecution"},
+		FrontendSignalWithStartWorkflowExecutionScope:  {operation: "SignalWithStartWorkflowExecution"},
+		FrontendTerminateWorkflowExecutionScope:        {operation: "TerminateWorkflowExecution"},
+		FrontendRequestCancelWorkflowExecutionScope:    {operation: "RequestCancelWorkflowExecution"},
+		FrontendListOpenWorkflowExecutionsScope:        {operation: "ListOpenWorkflowExecutions"},
+		FrontendListClosedWorkflowExecutionsScope:      {operation: "ListClosedWorkflowExecutions"},
+		FrontendRegisterDomainScope:                    {operation: "RegisterDomain"},
+		FrontendDescribeDomainScope:                    {operation: "DescribeDomain"},
+		FrontendUpdateDomainScope:                      {operation: "UpdateDomain"},
+		FrontendDeprecateDomainScope:                   {operation: "DeprecateDomain"},
+		FrontendQueryWorkflowScope:                     {operation: "QueryWorkflow"},
+		FrontendDescribeWorkflowExecutionScope:         {operation: "DescribeWorkflowExecution"},
+		FrontendDescribeTaskListScope:                  {operation: "DescribeTaskList"},
+		FrontendDescribeClusterScope:                   {operation: "DescribeCluster"},
+		AdminImportWorkflowExecutionScope:              {operation: "AdminImportWorkflowExecution"},
+		AdminPauseTaskListScope:                        {operation: "AdminPauseTaskList"},
+		AdminResumeTaskListScope:                       {operation: "AdminResumeTaskList"},
+		AdminGetDomainStatsScope:                       {operation: "AdminGetDomainStats"},
+		AdminTerminateWorkflowExecutionScope:           {operation: "AdminTerminateWorkflowExecution"},
+		AdminListAuditRecordsScope:                     {operation: "AdminListAuditRecords"},
+		AdminGetWorkflowExecutionHistoryEventScope:     {operation: "AdminGetWorkflowExecutionHistoryEvent"},
+		AdminRefreshDomainCacheScope:                   {operation: "AdminRefreshDomainCache"},
+		AdminGetCurrentExecutionScope:                  {operation: "AdminGetCurrentExecution"},
+		AdminListWorkflowExecutionChainScope:           {operation: "AdminListWorkflowExecutionChain"},
+		AdminUpdateDomainRetentionScope:                {operation: "AdminUpdateDomainRetention"},
+		AdminDescribeHistoryHostScope:                  {operation: "AdminDescribeHistoryHost"},
+		AdminWarmHistoryHostScope:                      {operation: "AdminWarmHistoryHost"},
+		AdminPauseWorkflowExecutionScope:               {operation: "AdminPauseWorkflowExecution"},
+		AdminResumeWorkflowExecutionScope:              {operation: "AdminResumeWorkflowExecution"},
+		AdminNukeWorkflowExecutionScope:                {operation: "AdminNukeWorkflowExecution"},
+		AdminResyncDomainsScope:                        {operation: "AdminResyncDomains"},
+		AdminUpdateMaintenanceModeScope:                {operation: "AdminUpdateMaintenanceMode"},
+		AdminDescribeMaintenanceModeScope:              {operation: "AdminDescribeMaintenanceMode"},
+		AdminBulkDeleteWorkflowExecutionsScope:         {operation: "AdminBulkDeleteWorkflowExecutions"},
+		AdminDescribeBulkDeleteWorkflowExecutionsScope: {operation: "AdminDescribeBulkDeleteWorkflowExecutions"},
+		AdminDescribeShardDistributionScope:            {operation: "AdminDescribeShardDistribution"},
+		AdminDrainHistoryHostScope:                     {operation: "AdminDrainHistoryHost"},
+		AdminResetShardPlacementScope:                  {operation: "AdminResetShardPlacement"},
 	},
 	// History Scope Names
 	History: {
@@ -539,6 +771,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryGetMutableStateScope:                  {operation: "GetMutableState"},
 		HistoryResetStickyTaskListScope:              {operation: "ResetStickyTaskListScope"},
 		HistoryDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
+		HistoryGetWorkflowExecutionHistoryEventScope: {operation: "GetWorkflowExecutionHistoryEvent"},
+		HistoryGetCurrentExecutionScope:              {operation: "GetCurrentExecution"},
+		HistoryListWorkflowExecutionChainScope:       {operation: "ListWorkflowExecutionChain"},
 		HistoryRecordDecisionTaskStartedScope:        {operation: "RecordDecisionTaskStarted"},
 		HistoryRecordActivityTaskStartedScope:        {operation: "RecordActivityTaskStarted"},
 		HistorySignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
@@ -549,6 +784,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRecordChildExecutionCompletedScope:    {operation: "RecordChildExecutionCompleted"},
 		HistoryRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
 		HistoryReplicateEventsScope:                  {operation: "ReplicateEvents"},
+		HistoryRefreshDomainCacheScope:               {operation: "RefreshDomainCache"},
+		HistoryDescribeHistoryHostScope:              {operation: "DescribeHistoryHost"},
+		HistoryWarmHistoryHostScope:                  {operation: "WarmHistoryHost"},
+		HistoryDrainHistoryHostScope:                 {operation: "DrainHistoryHost"},
+		HistoryPauseWorkflowExecutionScope:           {operation: "PauseWorkflowExecution"},
+		HistoryResumeWorkflowExecutionScope:          {operation: "ResumeWorkflowExecution"},
+		HistoryNukeWorkflowExecutionScope:            {operation: "NukeWorkflowExecution"},
+		HistoryImportWorkflowExecutionScope:          {operation: "ImportWorkflowExecution"},
 		HistoryShardControllerScope:                  {operation: "ShardController"},
 		TransferQueueProcessorScope:                  {operation: "TransferQueueProcessor"},
 		TransferTaskActivityScope:                    {operation: "TransferTaskActivity"},
@@ -563,10 +806,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerTaskUserTimerScope:                      {operation: "TimerTaskUserTimer"},
 		TimerTaskWorkflowTimeoutScope:                {operation: "TimerTaskWorkflowTimeout"},
 		TimerTaskRetryTimerScope:                     {operation: "TimerTaskRetryTimer"},
+		TimerTaskWorkflowBackoffTimerScope:           {operation: "TimerTaskWorkflowBackoffTimer"},
 		TimerTaskDeleteHistoryEvent:                  {operation: "TimerTaskDeleteHistoryEvent"},
 		HistoryEventNotificationScope:                {operation: "HistoryEventNotification"},
 		ReplicatorQueueProcessorScope:                {operation: "ReplicatorQueueProcessor"},
 		ReplicatorTaskHistoryScope:                   {operation: "ReplicatorTaskHistory"},
+		HistoryWorkflowMetricsScope:                  {operation: "WorkflowMetrics"},
 	},
 	// Matching Scope Names
 	Matching: {
@@ -579,10 +824,21 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
 		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
 		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
+		MatchingPauseTaskListScope:             {operation: "PauseTaskList"},
+		MatchingResumeTaskListScope:            {operation: "ResumeTaskList"},
 	},
 	// Worker Scope Names
 	Worker: {
-		ReplicatorScope: {operation: "Replicator"},
+		ReplicatorScope:            {operation: "Replicator"},
+		DomainStatsScannerScope:    {operation: "DomainStatsScanner"},
+		DomainReplicationTaskScope: {operation: "DomainReplicationTask"},
+		BenchScope:                 {operation: "Bench"},
+		CanaryTimerScope:           {operation: "CanaryTimer"},
+		CanarySignalScope:          {operation: "CanarySignal"},
+		CanaryChildScope:           {operation: "CanaryChild"},
+		CanaryQueryScope:           {operation: "CanaryQuery"},
+		StuckWorkflowScannerScope:  {operation: "StuckWorkflowScanner"},
+		BulkDeleteScope:            {operation: "BulkDelete"},
 	},
 }
 
@@ -606,13 +862,24 @@ const (
 	PersistenceErrConditionFailedCounter
 	PersistenceErrTimeoutCounter
 	PersistenceErrBusyCounter
+	PersistenceHedgedRequests
+	PersistenceSampledBlobs
+	PersistenceSampledBlobBytes
 
 	HistoryClientFailures
 	MatchingClientFailures
 
+	DynamicConfigBackendValueCounter
+	DynamicConfigDefaultValueCounter
+
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
+// Frontend metrics enum
+const (
+	DedupedRequestCounter = iota + NumCommonMetrics
+)
+
 // History Metrics enum
 const (
 	TaskRequests = iota + NumCommonMetrics
@@ -652,15 +919,23 @@ const (
 	ShardItemRemovedCounter
 	MembershipChangedCounter
 	NumShardsGauge
+	ShardPinnedCounter
 	GetEngineForShardErrorCounter
 	GetEngineForShardLatency
 	RemoveEngineForShardLatency
 	CompleteDecisionWithStickyEnabledCounter
 	CompleteDecisionWithStickyDisabledCounter
+	CompleteDecisionWithStickyRejectedCounter
+	StickyDecisionFallbackCounter
 	HistoryEventNotificationQueueingLatency
 	HistoryEventNotificationFanoutLatency
 	HistoryEventNotificationInFlightMessageGauge
 	HistoryEventNotificationFailDeliveryCount
+	TaskFilterShadowDivergenceCounter
+	TaskFilterShadowErrorCounter
+	WorkflowMetricsEmittedCounter
+	WorkflowMetricsInvalidCounter
+	WorkflowMetricsDroppedCounter
 )
 
 // Matching metrics enum
@@ -674,6 +949,10 @@ const (
 	RespondQueryTaskFailedCounter
 	SyncThrottleCounter
 	BufferThrottleCounter
+	SyncMatchAfterWaitCounter
+	SyncMatchWaitSpillCounter
+	QueryExpiredBeforePollCounter
+	QueryExpiredAfterMatchCounter
 )
 
 // Worker metrics enum
@@ -681,6 +960,21 @@ const (
 	ReplicatorMessages = iota + NumCommonMetrics
 	ReplicatorFailures
 	ReplicatorLatency
+	DomainReplicationLag
+	DomainOpenExecutions
+	DomainTotalBytesAppended
+	DomainEventsAppended
+	DomainBytesAppended
+	BenchWorkflowsStarted
+	BenchWorkflowsCompleted
+	BenchWorkflowFailures
+	BenchSignalsSent
+	BenchSignalFailures
+	BenchLatency
+	DomainStuckWorkflows
+	BulkDeleteExecutionsDeleted
+	BulkDeleteExecutionsSkipped
+	BulkDeleteExecutionFailures
 )
 
 // MetricDefs record the metrics for all services
@@ -704,10 +998,17 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrConditionFailedCounter:          {metricName: "persistence.errors.condition-failed", metricType: Counter},
 		PersistenceErrTimeoutCounter:                  {metricName: "persistence.errors.timeout", metricType: Counter},
 		PersistenceErrBusyCounter:                     {metricName: "persistence.errors.busy", metricType: Counter},
+		PersistenceHedgedRequests:                     {metricName: "persistence.hedged-requests", metricType: Counter},
+		PersistenceSampledBlobs:                       {metricName: "persistence.sampled-blobs", metricType: Counter},
+		PersistenceSampledBlobBytes:                   {metricName: "persistence.sampled-blob-bytes", metricType: Counter},
 		HistoryClientFailures:                         {metricName: "client.history.errors", metricType: Counter},
 		MatchingClientFailures:                        {metricName: "client.matching.errors", metricType: Counter},
+		DynamicConfigBackendValueCounter:              {metricName: "dynamic-config.backend-values", metricType: Counter},
+		DynamicConfigDefaultValueCounter:              {metricName: "dynamic-config.default-values", metricType: Counter},
+	},
+	Frontend: {
+		DedupedRequestCounter: {metricName: "deduped-requests", metricType: Counter},
 	},
-	Frontend: {},
 	History: {
 		TaskRequests:                                 {metricName: "task.requests", metricType: Counter},
 		TaskFailures:                                 {metricName: "task.errors", metricType: Counter},
@@ -745,15 +1046,23 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ShardItemRemovedCounter:                      {metricName: "sharditem-removed-count", metricType: Counter},
 		MembershipChangedCounter:                     {metricName: "membership-changed-count", metricType: Counter},
 		NumShardsGauge:                               {metricName: "numshards-gauge", metricType: Gauge},
+		ShardPinnedCounter:                           {metricName: "shard-pinned-count", metricType: Counter},
 		GetEngineForShardErrorCounter:                {metricName: "get-engine-for-shard-errors", metricType: Counter},
 		GetEngineForShardLatency:                     {metricName: "get-engine-for-shard-latency", metricType: Timer},
 		RemoveEngineForShardLatency:                  {metricName: "remove-engine-for-shard-latency", metricType: Timer},
 		CompleteDecisionWithStickyEnabledCounter:     {metricName: "complete-decision-sticky-enabled-count", metricType: Counter},
 		CompleteDecisionWithStickyDisabledCounter:    {metricName: "complete-decision-sticky-disabled-count", metricType: Counter},
+		CompleteDecisionWithStickyRejectedCounter:    {metricName: "complete-decision-sticky-rejected-count", metricType: Counter},
+		StickyDecisionFallbackCounter:                {metricName: "sticky-decision-fallback-count", metricType: Counter},
 		HistoryEventNotificationQueueingLatency:      {metricName: "history-event-notification-queueing-latency", metricType: Timer},
 		HistoryEventNotificationFanoutLatency:        {metricName: "history-event-notification-fanout-latency", metricType: Timer},
 		HistoryEventNotificationInFlightMessageGauge: {metricName: "history-event-notification-inflight-message-gauge", metricType: Gauge},
 		HistoryEventNotificationFailDeliveryCount:    {metricName: "history-event-notification-fail-delivery-count", metricType: Counter},
+		TaskFilterShadowDivergenceCounter:            {metricName: "task-filter-shadow-divergence", metricType: Counter},
+		TaskFilterShadowErrorCounter:                 {metricName: "task-filter-shadow-errors", metricType: Counter},
+		WorkflowMetricsEmittedCounter:                {metricName: "workflow-metrics-emitted", metricType: Counter},
+		WorkflowMetricsInvalidCounter:                {metricName: "workflow-metrics-invalid", metricType: Counter},
+		WorkflowMetricsDroppedCounter:                {metricName: "workflow-metrics-dropped", metricType: Counter},
 	},
 	Matching: {
 		PollSuccessCounter:            {metricName: "poll.success"},
@@ -765,11 +1074,30 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		RespondQueryTaskFailedCounter: {metricName: "respond-query-failed"},
 		SyncThrottleCounter:           {metricName: "sync.throttle.count"},
 		BufferThrottleCounter:         {metricName: "buffer.throttle.count"},
+		SyncMatchAfterWaitCounter:     {metricName: "sync.match.after-wait"},
+		SyncMatchWaitSpillCounter:     {metricName: "sync.match.wait-spill"},
+		QueryExpiredBeforePollCounter: {metricName: "query.expired-before-poll"},
+		QueryExpiredAfterMatchCounter: {metricName: "query.expired-after-match"},
 	},
 	Worker: {
-		ReplicatorMessages: {metricName: "replicator.messages"},
-		ReplicatorFailures: {metricName: "replicator.errors"},
-		ReplicatorLatency:  {metricName: "replicator.latency"},
+		ReplicatorMessages:          {metricName: "replicator.messages"},
+		ReplicatorFailures:          {metricName: "replicator.errors"},
+		ReplicatorLatency:           {metricName: "replicator.latency"},
+		DomainReplicationLag:        {metricName: "domain-replication.lag", metricType: Timer},
+		DomainOpenExecutions:        {metricName: "domain.open-executions", metricType: Gauge},
+		DomainTotalBytesAppended:    {metricName: "domain.total-bytes-appended", metricType: Gauge},
+		DomainEventsAppended:        {metricName: "domain.events-appended", metricType: Gauge},
+		DomainBytesAppended:         {metricName: "domain.bytes-appended", metricType: Gauge},
+		BenchWorkflowsStarted:       {metricName: "bench.workflows-started"},
+		BenchWorkflowsCompleted:     {metricName: "bench.workflows-completed"},
+		BenchWorkflowFailures:       {metricName: "bench.workflow-errors"},
+		BenchSignalsSent:            {metricName: "bench.signals-sent"},
+		BenchSignalFailures:         {metricName: "bench.signal-errors"},
+		BenchLatency:                {metricName: "bench.latency", metricType: Timer},
+		DomainStuckWorkflows:        {metricName: "domain.stuck-workflows", metricType: Gauge},
+		BulkDeleteExecutionsDeleted: {metricName: "bulk-delete.executions-deleted"},
+		BulkDeleteExecutionsSkipped: {metricName: "bulk-delete.executions-skipped"},
+		BulkDeleteExecutionFailures: {metricName: "bulk-delete.execution-errors"},
 	},
 }
 
@@ -0,0 +1,72 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import "sync"
+
+// DomainTagger builds metrics clients tagged with a domain and the tags mapped to the domain in config, such as
+// its team or cost center, so that metrics can be attributed to their owners without post-processing
+type DomainTagger struct {
+	client     Client
+	domainTags map[string]map[string]string
+
+	sync.RWMutex
+	clients map[string]Client
+}
+
+// NewDomainTagger creates a DomainTagger adding the given tags, by domain name, to the metrics of the client
+func NewDomainTagger(client Client, domainTags map[string]map[string]string) *DomainTagger {
+	return &DomainTagger{
+		client:     client,
+		domainTags: domainTags,
+		clients:    make(map[string]Client),
+	}
+}
+
+// Client returns the metrics client of the domain. Tagging a client creates all its scopes again, so the clients
+// are kept for reuse.
+func (t *DomainTagger) Client(domain string) Client {
+	t.RLock()
+	client, ok := t.clients[domain]
+	t.RUnlock()
+	if ok {
+		return client
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	if client, ok := t.clients[domain]; ok {
+		return client
+	}
+	client = t.client.Tagged(t.Tags(domain))
+	t.clients[domain] = client
+	return client
+}
+
+// Tags returns the tags added to the metrics of the domain, a mapped tag cannot replace the domain tag
+func (t *DomainTagger) Tags(domain string) map[string]string {
+	tags := map[string]string{}
+	for k, v := range t.domainTags[domain] {
+		tags[k] = v
+	}
+	tags[DomainTagName] = domain
+	return tags
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+)
+
+func TestDomainTagger(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	tagger := NewDomainTagger(NewClient(scope, Worker), map[string]map[string]string{
+		"payments": {"team": "billing", "cost_center": "cc-42", DomainTagName: "ignored"},
+	})
+
+	assert.Equal(t, map[string]string{"domain": "payments", "team": "billing", "cost_center": "cc-42"},
+		tagger.Tags("payments"))
+	assert.Equal(t, map[string]string{"domain": "orders"}, tagger.Tags("orders"))
+
+	client := tagger.Client("payments")
+	assert.True(t, client == tagger.Client("payments"))
+	client.UpdateGauge(DomainStatsScannerScope, DomainOpenExecutions, 3)
+
+	gauges := scope.Snapshot().Gauges()
+	var found bool
+	for _, gauge := range gauges {
+		tags := gauge.Tags()
+		if gauge.Name() == "domain.open-executions" && tags[OperationTagName] == "DomainStatsScanner" &&
+			tags[DomainTagName] != "" {
+			found = true
+			assert.Equal(t, float64(3), gauge.Value())
+			assert.Equal(t, "billing", tags["team"])
+			assert.Equal(t, "cc-42", tags["cost_center"])
+			assert.Equal(t, "payments", tags[DomainTagName])
+		}
+	}
+	assert.True(t, found)
+}
@@ -43,5 +43,7 @@ type (
 		UpdateGauge(scope int, gauge int, value float64)
 		// Tagged returns a client that adds the given tags to all metrics
 		Tagged(tags map[string]string) Client
+		// Scope returns the tally scope of the given scope, for the metrics whose names are only known at runtime
+		Scope(scope int) tally.Scope
 	}
 )
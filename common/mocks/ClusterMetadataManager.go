@@ -0,0 +1,122 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+import persistence "github.com/uber/cadence/common/persistence"
+
+// ClusterMetadataManager is an autogenerated mock type for the ClusterMetadataManager type
+type ClusterMetadataManager struct {
+	mock.Mock
+}
+
+// Close provides a mock function with given fields:
+func (_m *ClusterMetadataManager) Close() {
+	_m.Called()
+}
+
+// GetMaintenanceMode provides a mock function with given fields:
+func (_m *ClusterMetadataManager) GetMaintenanceMode() (*persistence.GetMaintenanceModeResponse, error) {
+	ret := _m.Called()
+
+	var r0 *persistence.GetMaintenanceModeResponse
+	if rf, ok := ret.Get(0).(func() *persistence.GetMaintenanceModeResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetMaintenanceModeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShardPlacement provides a mock function with given fields:
+func (_m *ClusterMetadataManager) GetShardPlacement() (*persistence.GetShardPlacementResponse, error) {
+	ret := _m.Called()
+
+	var r0 *persistence.GetShardPlacementResponse
+	if rf, ok := ret.Get(0).(func() *persistence.GetShardPlacementResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetShardPlacementResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetShardPlacement provides a mock function with given fields:
+func (_m *ClusterMetadataManager) ResetShardPlacement() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateMaintenanceMode provides a mock function with given fields: request
+func (_m *ClusterMetadataManager) UpdateMaintenanceMode(request *persistence.UpdateMaintenanceModeRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.UpdateMaintenanceModeRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateShardPlacement provides a mock function with given fields: request
+func (_m *ClusterMetadataManager) UpdateShardPlacement(request *persistence.UpdateShardPlacementRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.UpdateShardPlacementRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+import persistence "github.com/uber/cadence/common/persistence"
+
+// DomainStatsManager is an autogenerated mock type for the DomainStatsManager type
+type DomainStatsManager struct {
+	mock.Mock
+}
+
+// AddHistoryCounts provides a mock function with given fields: request
+func (_m *DomainStatsManager) AddHistoryCounts(request *persistence.AddHistoryCountsRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.AddHistoryCountsRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Close provides a mock function with given fields:
+func (_m *DomainStatsManager) Close() {
+	_m.Called()
+}
+
+// GetDomainStats provides a mock function with given fields: request
+func (_m *DomainStatsManager) GetDomainStats(request *persistence.GetDomainStatsRequest) (*persistence.GetDomainStatsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetDomainStatsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetDomainStatsRequest) *persistence.GetDomainStatsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetDomainStatsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetDomainStatsRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHistoryCounts provides a mock function with given fields: request
+func (_m *DomainStatsManager) GetHistoryCounts(request *persistence.GetHistoryCountsRequest) (*persistence.GetHistoryCountsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetHistoryCountsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetHistoryCountsRequest) *persistence.GetHistoryCountsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetHistoryCountsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetHistoryCountsRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHistoryCountDomains provides a mock function with given fields: request
+func (_m *DomainStatsManager) ListHistoryCountDomains(request *persistence.ListHistoryCountDomainsRequest) (*persistence.ListHistoryCountDomainsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ListHistoryCountDomainsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ListHistoryCountDomainsRequest) *persistence.ListHistoryCountDomainsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListHistoryCountDomainsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ListHistoryCountDomainsRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertDomainStats provides a mock function with given fields: request
+func (_m *DomainStatsManager) UpsertDomainStats(request *persistence.UpsertDomainStatsRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.UpsertDomainStatsRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -100,6 +100,98 @@ func (_m *HistoryClient) DescribeWorkflowExecution(ctx context.Context, request
 	return r0, r1
 }
 
+// GetWorkflowExecutionHistoryEvent provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetWorkflowExecutionHistoryEvent(ctx context.Context, request *history.GetWorkflowExecutionHistoryEventRequest, opts ...yarpc.CallOption) (*history.GetWorkflowExecutionHistoryEventResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.GetWorkflowExecutionHistoryEventResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.GetWorkflowExecutionHistoryEventRequest) *history.GetWorkflowExecutionHistoryEventResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.GetWorkflowExecutionHistoryEventResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.GetWorkflowExecutionHistoryEventRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCurrentExecution provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetCurrentExecution(ctx context.Context, request *history.GetCurrentExecutionRequest, opts ...yarpc.CallOption) (*history.GetCurrentExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.GetCurrentExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.GetCurrentExecutionRequest) *history.GetCurrentExecutionResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.GetCurrentExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.GetCurrentExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NukeWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) NukeWorkflowExecution(ctx context.Context, request *history.NukeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*history.NukeWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.NukeWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.NukeWorkflowExecutionRequest) *history.NukeWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.NukeWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.NukeWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWorkflowExecutionChain provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ListWorkflowExecutionChain(ctx context.Context, request *history.ListWorkflowExecutionChainRequest, opts ...yarpc.CallOption) (*history.ListWorkflowExecutionChainResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.ListWorkflowExecutionChainResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.ListWorkflowExecutionChainRequest) *history.ListWorkflowExecutionChainResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.ListWorkflowExecutionChainResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.ListWorkflowExecutionChainRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RecordActivityTaskHeartbeat provides a mock function with given fields: ctx, heartbeatRequest
 func (_m *HistoryClient) RecordActivityTaskHeartbeat(ctx context.Context, heartbeatRequest *history.RecordActivityTaskHeartbeatRequest, opts ...yarpc.CallOption) (*shared.RecordActivityTaskHeartbeatResponse, error) {
 	ret := _m.Called(ctx, heartbeatRequest)
@@ -343,6 +435,34 @@ func (_m *HistoryClient) TerminateWorkflowExecution(ctx context.Context, termina
 	return r0
 }
 
+// PauseWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) PauseWorkflowExecution(ctx context.Context, request *history.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ResumeWorkflowExecution(ctx context.Context, request *history.ResumeWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.ResumeWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ScheduleDecisionTask provides a mock function with given fields: ctx, request
 func (_m *HistoryClient) ScheduleDecisionTask(ctx context.Context, request *history.ScheduleDecisionTaskRequest, opts ...yarpc.CallOption) error {
 	ret := _m.Called(ctx, request)
@@ -384,3 +504,100 @@ func (_m *HistoryClient) ReplicateEvents(ctx context.Context, request *history.R
 
 	return r0
 }
+
+// ImportWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ImportWorkflowExecution(ctx context.Context, request *history.ImportWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.ImportWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeHistoryHost provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DescribeHistoryHost(ctx context.Context, request *history.DescribeHistoryHostRequest, opts ...yarpc.CallOption) (*history.DescribeHistoryHostResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.DescribeHistoryHostResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.DescribeHistoryHostRequest) *history.DescribeHistoryHostResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.DescribeHistoryHostResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.DescribeHistoryHostRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WarmHistoryHost provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) WarmHistoryHost(ctx context.Context, request *history.WarmHistoryHostRequest, opts ...yarpc.CallOption) (*history.WarmHistoryHostResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.WarmHistoryHostResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.WarmHistoryHostRequest) *history.WarmHistoryHostResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.WarmHistoryHostResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.WarmHistoryHostRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DrainHistoryHost provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DrainHistoryHost(ctx context.Context, request *history.DrainHistoryHostRequest, opts ...yarpc.CallOption) (*history.DrainHistoryHostResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.DrainHistoryHostResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.DrainHistoryHostRequest) *history.DrainHistoryHostResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.DrainHistoryHostResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.DrainHistoryHostRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshDomainCache provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RefreshDomainCache(ctx context.Context, request *history.RefreshDomainCacheRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.RefreshDomainCacheRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
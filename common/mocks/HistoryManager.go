@@ -85,4 +85,28 @@ func (_m *HistoryManager) GetWorkflowExecutionHistory(
 	return r0, r1
 }
 
+// GetWorkflowExecutionHistoryBatch provides a mock function with given fields: request
+func (_m *HistoryManager) GetWorkflowExecutionHistoryBatch(
+	request *persistence.GetWorkflowExecutionHistoryBatchRequest) (*persistence.GetWorkflowExecutionHistoryBatchResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetWorkflowExecutionHistoryBatchResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetWorkflowExecutionHistoryBatchRequest) *persistence.GetWorkflowExecutionHistoryBatchResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetWorkflowExecutionHistoryBatchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetWorkflowExecutionHistoryBatchRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 var _ persistence.HistoryManager = (*HistoryManager)(nil)
@@ -188,3 +188,33 @@ func (_m *MatchingClient) DescribeTaskList(ctx context.Context,
 
 	return r0, r1
 }
+
+// PauseTaskList provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) PauseTaskList(ctx context.Context,
+	request *matching.PauseTaskListRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.PauseTaskListRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeTaskList provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) ResumeTaskList(ctx context.Context,
+	request *matching.ResumeTaskListRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.ResumeTaskListRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
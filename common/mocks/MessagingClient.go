@@ -52,3 +52,14 @@ func (c *MessagingClient) NewConsumer(currentCluster, sourceCluster, consumerNam
 func (c *MessagingClient) NewProducer(sourceCluster string) (messaging.Producer, error) {
 	return c.publisherMock, nil
 }
+
+// NewDomainConsumer generates a dummy implementation of kafka consumer
+func (c *MessagingClient) NewDomainConsumer(currentCluster, sourceCluster, consumerName string,
+	concurrency int) (kafka.Consumer, error) {
+	return c.consumerMock, nil
+}
+
+// NewDomainProducer generates a dummy implementation of kafka producer
+func (c *MessagingClient) NewDomainProducer(sourceCluster string) (messaging.Producer, error) {
+	return c.publisherMock, nil
+}
@@ -84,6 +84,29 @@ func (_m *MetadataManager) DeleteDomainByName(request *persistence.DeleteDomainB
 	return r0
 }
 
+// ListDomains provides a mock function with given fields: request
+func (_m *MetadataManager) ListDomains(request *persistence.ListDomainsRequest) (*persistence.ListDomainsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ListDomainsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ListDomainsRequest) *persistence.ListDomainsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListDomainsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ListDomainsRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDomain provides a mock function with given fields: request
 func (_m *MetadataManager) GetDomain(request *persistence.GetDomainRequest) (*persistence.GetDomainResponse, error) {
 	ret := _m.Called(request)
@@ -51,6 +51,22 @@ func (_m *ServiceResolver) Lookup(key string) (*membership.HostInfo, error) {
 	return r0, r1
 }
 
+// Members is am mock implementation
+func (_m *ServiceResolver) Members() []*membership.HostInfo {
+	ret := _m.Called()
+
+	var r0 []*membership.HostInfo
+	if rf, ok := ret.Get(0).(func() []*membership.HostInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*membership.HostInfo)
+		}
+	}
+
+	return r0
+}
+
 // AddListener is am mock implementation
 func (_m *ServiceResolver) AddListener(name string, notifyChannel chan<- *membership.ChangedEvent) error {
 	ret := _m.Called(name, notifyChannel)
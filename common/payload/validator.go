@@ -0,0 +1,120 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payload
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	// Violation describes one reason a payload is rejected
+	Violation struct {
+		// Path of the offending field within the payload, empty when the payload is rejected as a whole
+		Path    string
+		Message string
+	}
+
+	// ValidationError is returned by a Validator rejecting a payload. Any other error returned by a Validator is
+	// considered a failure of the validator itself, and is reported to clients as an internal error.
+	ValidationError struct {
+		Violations []Violation
+	}
+
+	// Validator checks the payloads sent to the workflows of a domain, before the frontend passes them to history.
+	// The payloads are the raw bytes sent by clients, validators are expected to know their encoding.
+	Validator interface {
+		ValidateStartInput(domain string, workflowType string, input []byte) error
+		ValidateSignalInput(domain string, signalName string, input []byte) error
+		ValidateQueryArgs(domain string, queryType string, args []byte) error
+	}
+
+	noopValidator struct{}
+
+	// domainValidator dispatches to the validator registered for the domain of the payload
+	domainValidator struct {
+		validators map[string]Validator
+	}
+)
+
+var _ Validator = (*noopValidator)(nil)
+var _ Validator = (*domainValidator)(nil)
+
+// NewValidationError creates a ValidationError from its violations
+func NewValidationError(violations ...Violation) *ValidationError {
+	return &ValidationError{Violations: violations}
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		if violation.Path == "" {
+			messages = append(messages, violation.Message)
+		} else {
+			messages = append(messages, fmt.Sprintf("%v: %v", violation.Path, violation.Message))
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// NewNoopValidator returns a Validator accepting every payload
+func NewNoopValidator() Validator {
+	return &noopValidator{}
+}
+
+func (v *noopValidator) ValidateStartInput(domain string, workflowType string, input []byte) error {
+	return nil
+}
+
+func (v *noopValidator) ValidateSignalInput(domain string, signalName string, input []byte) error {
+	return nil
+}
+
+func (v *noopValidator) ValidateQueryArgs(domain string, queryType string, args []byte) error {
+	return nil
+}
+
+// NewDomainValidator returns a Validator delegating to the validator registered for the domain name of the
+// payload. Payloads of domains without a registered validator are accepted.
+func NewDomainValidator(validators map[string]Validator) Validator {
+	return &domainValidator{validators: validators}
+}
+
+func (v *domainValidator) ValidateStartInput(domain string, workflowType string, input []byte) error {
+	if validator, ok := v.validators[domain]; ok {
+		return validator.ValidateStartInput(domain, workflowType, input)
+	}
+	return nil
+}
+
+func (v *domainValidator) ValidateSignalInput(domain string, signalName string, input []byte) error {
+	if validator, ok := v.validators[domain]; ok {
+		return validator.ValidateSignalInput(domain, signalName, input)
+	}
+	return nil
+}
+
+func (v *domainValidator) ValidateQueryArgs(domain string, queryType string, args []byte) error {
+	if validator, ok := v.validators[domain]; ok {
+		return validator.ValidateQueryArgs(domain, queryType, args)
+	}
+	return nil
+}
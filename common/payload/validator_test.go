@@ -0,0 +1,71 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payload
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	validatorSuite struct {
+		suite.Suite
+	}
+
+	rejectingValidator struct{}
+)
+
+func TestValidatorSuite(t *testing.T) {
+	suite.Run(t, new(validatorSuite))
+}
+
+func (v *rejectingValidator) ValidateStartInput(domain string, workflowType string, input []byte) error {
+	return NewValidationError(Violation{Message: "start rejected"})
+}
+
+func (v *rejectingValidator) ValidateSignalInput(domain string, signalName string, input []byte) error {
+	return NewValidationError(Violation{Path: "amount", Message: "must be positive"})
+}
+
+func (v *rejectingValidator) ValidateQueryArgs(domain string, queryType string, args []byte) error {
+	return NewValidationError(Violation{Message: "query rejected"})
+}
+
+func (s *validatorSuite) TestValidationErrorMessage() {
+	err := NewValidationError(
+		Violation{Path: "orderId", Message: "is required"},
+		Violation{Message: "payload is not valid JSON"},
+	)
+	s.Equal("orderId: is required; payload is not valid JSON", err.Error())
+}
+
+func (s *validatorSuite) TestDomainValidator() {
+	validator := NewDomainValidator(map[string]Validator{"strict": &rejectingValidator{}})
+
+	s.Equal("start rejected", validator.ValidateStartInput("strict", "type", nil).Error())
+	s.Equal("amount: must be positive", validator.ValidateSignalInput("strict", "signal", nil).Error())
+	s.Equal("query rejected", validator.ValidateQueryArgs("strict", "query", nil).Error())
+
+	s.NoError(validator.ValidateStartInput("other", "type", nil))
+	s.NoError(validator.ValidateSignalInput("other", "signal", nil))
+	s.NoError(validator.ValidateQueryArgs("other", "query", nil))
+}
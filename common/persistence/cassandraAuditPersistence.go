@@ -0,0 +1,129 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+
+	"github.com/gocql/gocql"
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// all audit records are written to a single partition
+	auditPartition = 0
+
+	templateRecordAuditQuery = `INSERT INTO admin_audit (` +
+		`partition, id, operation, actor, reason, keys) ` +
+		`VALUES(?, ?, ?, ?, ?, ?)`
+
+	templateListAuditRecordsQuery = `SELECT id, operation, actor, reason, keys ` +
+		`FROM admin_audit ` +
+		`WHERE partition = ?`
+)
+
+type (
+	cassandraAuditPersistence struct {
+		session *gocql.Session
+		logger  bark.Logger
+	}
+)
+
+// NewCassandraAuditPersistence is used to create an instance of AuditManager implementation
+func NewCassandraAuditPersistence(hosts string, port int, user, password, dc string, keyspace string,
+	logger bark.Logger) (AuditManager, error) {
+	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
+	cluster.Keyspace = keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = gocql.LocalQuorum
+	cluster.SerialConsistency = gocql.LocalSerial
+	cluster.Timeout = defaultSessionTimeout
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cassandraAuditPersistence{session: session, logger: logger}, nil
+}
+
+// Close releases the resources held by this object
+func (m *cassandraAuditPersistence) Close() {
+	if m.session != nil {
+		m.session.Close()
+	}
+}
+
+func (m *cassandraAuditPersistence) RecordAudit(request *RecordAuditRequest) error {
+	record := request.Record
+	id := gocql.TimeUUID()
+	query := m.session.Query(templateRecordAuditQuery,
+		auditPartition,
+		id,
+		record.Operation,
+		record.Actor,
+		record.Reason,
+		record.Keys)
+	if err := query.Exec(); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RecordAudit operation failed. Error: %v", err),
+		}
+	}
+
+	record.ID = id.String()
+	record.Timestamp = id.Time()
+	return nil
+}
+
+func (m *cassandraAuditPersistence) ListAuditRecords(
+	request *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
+	query := m.session.Query(templateListAuditRecordsQuery, auditPartition)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListAuditRecords operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &ListAuditRecordsResponse{}
+	var id gocql.UUID
+	record := &AuditRecord{}
+	for iter.Scan(&id, &record.Operation, &record.Actor, &record.Reason, &record.Keys) {
+		record.ID = id.String()
+		record.Timestamp = id.Time()
+		response.Records = append(response.Records, record)
+		record = &AuditRecord{}
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListAuditRecords operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	auditPersistenceSuite struct {
+		suite.Suite
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestAuditPersistenceSuite(t *testing.T) {
+	s := new(auditPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *auditPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+
+	s.SetupWorkflowStore()
+}
+
+func (s *auditPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *auditPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+func (s *auditPersistenceSuite) TestRecordAndListAudit() {
+	workflowIDs := []string{"audit-wf-1", "audit-wf-2", "audit-wf-3"}
+	for _, workflowID := range workflowIDs {
+		record := &AuditRecord{
+			Operation: AuditOperationTerminateWorkflowExecution,
+			Actor:     "oncall",
+			Reason:    "stuck workflow",
+			Keys:      map[string]string{"workflowID": workflowID, "runID": uuid.New()},
+		}
+		s.NoError(s.AuditMgr.RecordAudit(&RecordAuditRequest{Record: record}))
+		s.NotEmpty(record.ID)
+		s.False(record.Timestamp.IsZero())
+	}
+
+	// records are returned newest first
+	var records []*AuditRecord
+	var token []byte
+	for {
+		resp, err := s.AuditMgr.ListAuditRecords(&ListAuditRecordsRequest{PageSize: 2, NextPageToken: token})
+		s.NoError(err)
+		records = append(records, resp.Records...)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		token = resp.NextPageToken
+	}
+	s.Len(records, len(workflowIDs))
+	for i, record := range records {
+		s.Equal(workflowIDs[len(workflowIDs)-1-i], record.Keys["workflowID"])
+		s.Equal("oncall", record.Actor)
+		s.Equal("stuck workflow", record.Reason)
+		s.Equal(AuditOperationTerminateWorkflowExecution, record.Operation)
+	}
+}
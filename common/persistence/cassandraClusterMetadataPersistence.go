@@ -0,0 +1,157 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+
+	"github.com/gocql/gocql"
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	templateGetMaintenanceModeQuery = `SELECT maintenance_mode, maintenance_reason ` +
+		`FROM cluster_metadata ` +
+		`WHERE cluster = ?`
+
+	templateUpdateMaintenanceModeQuery = `INSERT INTO cluster_metadata (` +
+		`cluster, maintenance_mode, maintenance_reason) ` +
+		`VALUES(?, ?, ?)`
+
+	templateGetShardPlacementQuery = `SELECT shard_placement ` +
+		`FROM cluster_metadata ` +
+		`WHERE cluster = ?`
+
+	templateUpdateShardPlacementQuery = `UPDATE cluster_metadata ` +
+		`SET shard_placement = shard_placement + ? ` +
+		`WHERE cluster = ?`
+
+	templateResetShardPlacementQuery = `DELETE shard_placement ` +
+		`FROM cluster_metadata ` +
+		`WHERE cluster = ?`
+)
+
+type (
+	cassandraClusterMetadataPersistence struct {
+		session            *gocql.Session
+		currentClusterName string
+		logger             bark.Logger
+	}
+)
+
+// NewCassandraClusterMetadataPersistence is used to create an instance of ClusterMetadataManager implementation
+func NewCassandraClusterMetadataPersistence(hosts string, port int, user, password, dc string, keyspace string,
+	currentClusterName string, logger bark.Logger) (ClusterMetadataManager, error) {
+	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
+	cluster.Keyspace = keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = gocql.LocalQuorum
+	cluster.SerialConsistency = gocql.LocalSerial
+	cluster.Timeout = defaultSessionTimeout
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cassandraClusterMetadataPersistence{
+		session:            session,
+		currentClusterName: currentClusterName,
+		logger:             logger,
+	}, nil
+}
+
+// Close releases the resources held by this object
+func (m *cassandraClusterMetadataPersistence) Close() {
+	if m.session != nil {
+		m.session.Close()
+	}
+}
+
+func (m *cassandraClusterMetadataPersistence) GetMaintenanceMode() (*GetMaintenanceModeResponse, error) {
+	maintenanceMode := &MaintenanceMode{}
+	query := m.session.Query(templateGetMaintenanceModeQuery, m.currentClusterName)
+	err := query.Scan(&maintenanceMode.Enabled, &maintenanceMode.Reason)
+	if err != nil && err != gocql.ErrNotFound {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetMaintenanceMode operation failed. Error: %v", err),
+		}
+	}
+
+	return &GetMaintenanceModeResponse{MaintenanceMode: maintenanceMode}, nil
+}
+
+func (m *cassandraClusterMetadataPersistence) UpdateMaintenanceMode(request *UpdateMaintenanceModeRequest) error {
+	query := m.session.Query(templateUpdateMaintenanceModeQuery,
+		m.currentClusterName,
+		request.MaintenanceMode.Enabled,
+		request.MaintenanceMode.Reason)
+	if err := query.Exec(); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateMaintenanceMode operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (m *cassandraClusterMetadataPersistence) GetShardPlacement() (*GetShardPlacementResponse, error) {
+	var overrides map[int]string
+	query := m.session.Query(templateGetShardPlacementQuery, m.currentClusterName)
+	err := query.Scan(&overrides)
+	if err != nil && err != gocql.ErrNotFound {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetShardPlacement operation failed. Error: %v", err),
+		}
+	}
+	if overrides == nil {
+		overrides = make(map[int]string)
+	}
+
+	return &GetShardPlacementResponse{Overrides: overrides}, nil
+}
+
+func (m *cassandraClusterMetadataPersistence) UpdateShardPlacement(request *UpdateShardPlacementRequest) error {
+	query := m.session.Query(templateUpdateShardPlacementQuery,
+		request.Overrides,
+		m.currentClusterName)
+	if err := query.Exec(); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateShardPlacement operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (m *cassandraClusterMetadataPersistence) ResetShardPlacement() error {
+	query := m.session.Query(templateResetShardPlacementQuery, m.currentClusterName)
+	if err := query.Exec(); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetShardPlacement operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"os"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	clusterMetadataPersistenceSuite struct {
+		suite.Suite
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestClusterMetadataPersistenceSuite(t *testing.T) {
+	s := new(clusterMetadataPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *clusterMetadataPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+
+	s.SetupWorkflowStore()
+}
+
+func (s *clusterMetadataPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *clusterMetadataPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+func (s *clusterMetadataPersistenceSuite) TestMaintenanceMode() {
+	resp, err := s.ClusterMetadataMgr.GetMaintenanceMode()
+	s.NoError(err)
+	s.Equal(&MaintenanceMode{}, resp.MaintenanceMode)
+
+	err = s.ClusterMetadataMgr.UpdateMaintenanceMode(&UpdateMaintenanceModeRequest{
+		MaintenanceMode: &MaintenanceMode{Enabled: true, Reason: "cassandra upgrade"},
+	})
+	s.NoError(err)
+	resp, err = s.ClusterMetadataMgr.GetMaintenanceMode()
+	s.NoError(err)
+	s.Equal(&MaintenanceMode{Enabled: true, Reason: "cassandra upgrade"}, resp.MaintenanceMode)
+
+	err = s.ClusterMetadataMgr.UpdateMaintenanceMode(&UpdateMaintenanceModeRequest{
+		MaintenanceMode: &MaintenanceMode{},
+	})
+	s.NoError(err)
+	resp, err = s.ClusterMetadataMgr.GetMaintenanceMode()
+	s.NoError(err)
+	s.Equal(&MaintenanceMode{}, resp.MaintenanceMode)
+}
+
+func (s *clusterMetadataPersistenceSuite) TestShardPlacement() {
+	resp, err := s.ClusterMetadataMgr.GetShardPlacement()
+	s.NoError(err)
+	s.Empty(resp.Overrides)
+
+	err = s.ClusterMetadataMgr.UpdateShardPlacement(&UpdateShardPlacementRequest{
+		Overrides: map[int]string{1: "127.0.0.1:7934", 2: "127.0.0.1:7934"},
+	})
+	s.NoError(err)
+	err = s.ClusterMetadataMgr.UpdateShardPlacement(&UpdateShardPlacementRequest{
+		Overrides: map[int]string{2: "127.0.0.2:7934"},
+	})
+	s.NoError(err)
+	resp, err = s.ClusterMetadataMgr.GetShardPlacement()
+	s.NoError(err)
+	s.Equal(map[int]string{1: "127.0.0.1:7934", 2: "127.0.0.2:7934"}, resp.Overrides)
+
+	// the maintenance mode is kept in the same row, and must not clear the placement
+	err = s.ClusterMetadataMgr.UpdateMaintenanceMode(&UpdateMaintenanceModeRequest{
+		MaintenanceMode: &MaintenanceMode{},
+	})
+	s.NoError(err)
+	resp, err = s.ClusterMetadataMgr.GetShardPlacement()
+	s.NoError(err)
+	s.Len(resp.Overrides, 2)
+
+	err = s.ClusterMetadataMgr.ResetShardPlacement()
+	s.NoError(err)
+	resp, err = s.ClusterMetadataMgr.GetShardPlacement()
+	s.NoError(err)
+	s.Empty(resp.Overrides)
+}
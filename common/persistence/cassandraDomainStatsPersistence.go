@@ -0,0 +1,214 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+
+	"github.com/gocql/gocql"
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	templateAddHistoryCountsQuery = `UPDATE domain_history_counts ` +
+		`SET events = events + ?, bytes = bytes + ? ` +
+		`WHERE domain_id = ? ` +
+		`and day = ?`
+
+	templateGetHistoryCountsQuery = `SELECT day, events, bytes ` +
+		`FROM domain_history_counts ` +
+		`WHERE domain_id = ? ` +
+		`and day >= ? ` +
+		`and day <= ? ` +
+		`ORDER BY day ASC`
+
+	templateListHistoryCountDomainsQuery = `SELECT DISTINCT domain_id ` +
+		`FROM domain_history_counts`
+
+	templateUpsertDomainStatsQuery = `INSERT INTO domain_stats (` +
+		`domain_id, day, open_executions, history_bytes, events_appended, bytes_appended, last_updated) ` +
+		`VALUES(?, ?, ?, ?, ?, ?, ?)`
+
+	templateGetDomainStatsQuery = `SELECT day, open_executions, history_bytes, events_appended, bytes_appended, last_updated ` +
+		`FROM domain_stats ` +
+		`WHERE domain_id = ? ` +
+		`LIMIT ?`
+)
+
+type (
+	cassandraDomainStatsPersistence struct {
+		session *gocql.Session
+		logger  bark.Logger
+	}
+)
+
+// NewCassandraDomainStatsPersistence is used to create an instance of DomainStatsManager implementation
+func NewCassandraDomainStatsPersistence(hosts string, port int, user, password, dc string, keyspace string,
+	logger bark.Logger) (DomainStatsManager, error) {
+	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
+	cluster.Keyspace = keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = gocql.LocalQuorum
+	cluster.SerialConsistency = gocql.LocalSerial
+	cluster.Timeout = defaultSessionTimeout
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cassandraDomainStatsPersistence{session: session, logger: logger}, nil
+}
+
+// Close releases the resources held by this object
+func (m *cassandraDomainStatsPersistence) Close() {
+	if m.session != nil {
+		m.session.Close()
+	}
+}
+
+func (m *cassandraDomainStatsPersistence) AddHistoryCounts(request *AddHistoryCountsRequest) error {
+	query := m.session.Query(templateAddHistoryCountsQuery,
+		request.Events,
+		request.Bytes,
+		request.DomainID,
+		request.Day)
+	if err := query.Exec(); err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("AddHistoryCounts operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("AddHistoryCounts operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (m *cassandraDomainStatsPersistence) GetHistoryCounts(
+	request *GetHistoryCountsRequest) (*GetHistoryCountsResponse, error) {
+	query := m.session.Query(templateGetHistoryCountsQuery,
+		request.DomainID,
+		request.FromDay,
+		request.ToDay)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetHistoryCounts operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &GetHistoryCountsResponse{}
+	count := &HistoryCount{}
+	for iter.Scan(&count.Day, &count.Events, &count.Bytes) {
+		response.Counts = append(response.Counts, count)
+		count = &HistoryCount{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetHistoryCounts operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (m *cassandraDomainStatsPersistence) ListHistoryCountDomains(
+	request *ListHistoryCountDomainsRequest) (*ListHistoryCountDomainsResponse, error) {
+	query := m.session.Query(templateListHistoryCountDomainsQuery)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListHistoryCountDomains operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &ListHistoryCountDomainsResponse{}
+	var domainID gocql.UUID
+	for iter.Scan(&domainID) {
+		response.DomainIDs = append(response.DomainIDs, domainID.String())
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListHistoryCountDomains operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (m *cassandraDomainStatsPersistence) UpsertDomainStats(request *UpsertDomainStatsRequest) error {
+	stats := request.Stats
+	query := m.session.Query(templateUpsertDomainStatsQuery,
+		stats.DomainID,
+		stats.Day,
+		stats.OpenExecutions,
+		stats.TotalBytesAppended,
+		stats.EventsAppended,
+		stats.BytesAppended,
+		stats.LastUpdated)
+	if err := query.Exec(); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpsertDomainStats operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (m *cassandraDomainStatsPersistence) GetDomainStats(
+	request *GetDomainStatsRequest) (*GetDomainStatsResponse, error) {
+	query := m.session.Query(templateGetDomainStatsQuery,
+		request.DomainID,
+		request.Days)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetDomainStats operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &GetDomainStatsResponse{}
+	stats := &DomainStats{DomainID: request.DomainID}
+	for iter.Scan(&stats.Day, &stats.OpenExecutions, &stats.TotalBytesAppended, &stats.EventsAppended,
+		&stats.BytesAppended, &stats.LastUpdated) {
+		response.Stats = append(response.Stats, stats)
+		stats = &DomainStats{DomainID: request.DomainID}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetDomainStats operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	domainStatsPersistenceSuite struct {
+		suite.Suite
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestDomainStatsPersistenceSuite(t *testing.T) {
+	s := new(domainStatsPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainStatsPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+
+	s.SetupWorkflowStore()
+}
+
+func (s *domainStatsPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *domainStatsPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+func (s *domainStatsPersistenceSuite) TestHistoryCounts() {
+	domainID := uuid.New()
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	yesterday := today.Add(-24 * time.Hour)
+
+	s.NoError(s.DomainStatsMgr.AddHistoryCounts(&AddHistoryCountsRequest{
+		DomainID: domainID, Day: yesterday, Events: 3, Bytes: 300,
+	}))
+	s.NoError(s.DomainStatsMgr.AddHistoryCounts(&AddHistoryCountsRequest{
+		DomainID: domainID, Day: today, Events: 1, Bytes: 100,
+	}))
+	s.NoError(s.DomainStatsMgr.AddHistoryCounts(&AddHistoryCountsRequest{
+		DomainID: domainID, Day: today, Events: 2, Bytes: 150,
+	}))
+
+	resp, err := s.DomainStatsMgr.GetHistoryCounts(&GetHistoryCountsRequest{
+		DomainID: domainID,
+		FromDay:  yesterday,
+		ToDay:    today,
+	})
+	s.NoError(err)
+	s.Equal(2, len(resp.Counts))
+	s.Equal(yesterday.Unix(), resp.Counts[0].Day.Unix())
+	s.Equal(int64(3), resp.Counts[0].Events)
+	s.Equal(int64(300), resp.Counts[0].Bytes)
+	s.Equal(today.Unix(), resp.Counts[1].Day.Unix())
+	s.Equal(int64(3), resp.Counts[1].Events)
+	s.Equal(int64(250), resp.Counts[1].Bytes)
+
+	resp, err = s.DomainStatsMgr.GetHistoryCounts(&GetHistoryCountsRequest{
+		DomainID: domainID,
+		FromDay:  today,
+		ToDay:    today,
+	})
+	s.NoError(err)
+	s.Equal(1, len(resp.Counts))
+
+	found := false
+	var token []byte
+	for {
+		domains, err := s.DomainStatsMgr.ListHistoryCountDomains(&ListHistoryCountDomainsRequest{
+			PageSize:      10,
+			NextPageToken: token,
+		})
+		s.NoError(err)
+		for _, id := range domains.DomainIDs {
+			found = found || id == domainID
+		}
+		if len(domains.NextPageToken) == 0 {
+			break
+		}
+		token = domains.NextPageToken
+	}
+	s.True(found)
+}
+
+func (s *domainStatsPersistenceSuite) TestDomainStats() {
+	domainID := uuid.New()
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	yesterday := today.Add(-24 * time.Hour)
+
+	resp, err := s.DomainStatsMgr.GetDomainStats(&GetDomainStatsRequest{DomainID: domainID, Days: 7})
+	s.NoError(err)
+	s.Equal(0, len(resp.Stats))
+
+	for i, day := range []time.Time{yesterday, today} {
+		s.NoError(s.DomainStatsMgr.UpsertDomainStats(&UpsertDomainStatsRequest{
+			Stats: &DomainStats{
+				DomainID:           domainID,
+				Day:                day,
+				OpenExecutions:     5,
+				TotalBytesAppended: int64(100 * (i + 1)),
+				EventsAppended:     10,
+				BytesAppended:      100,
+				LastUpdated:        time.Now(),
+			},
+		}))
+	}
+	// a later computation of the day overwrites the earlier one
+	s.NoError(s.DomainStatsMgr.UpsertDomainStats(&UpsertDomainStatsRequest{
+		Stats: &DomainStats{
+			DomainID:           domainID,
+			Day:                today,
+			OpenExecutions:     6,
+			TotalBytesAppended: 250,
+			EventsAppended:     15,
+			BytesAppended:      150,
+			LastUpdated:        time.Now(),
+		},
+	}))
+
+	resp, err = s.DomainStatsMgr.GetDomainStats(&GetDomainStatsRequest{DomainID: domainID, Days: 7})
+	s.NoError(err)
+	s.Equal(2, len(resp.Stats))
+	s.Equal(today.Unix(), resp.Stats[0].Day.Unix())
+	s.Equal(int64(6), resp.Stats[0].OpenExecutions)
+	s.Equal(int64(250), resp.Stats[0].TotalBytesAppended)
+	s.Equal(int64(15), resp.Stats[0].EventsAppended)
+	s.Equal(int64(150), resp.Stats[0].BytesAppended)
+	s.Equal(yesterday.Unix(), resp.Stats[1].Day.Unix())
+	s.Equal(int64(100), resp.Stats[1].TotalBytesAppended)
+
+	resp, err = s.DomainStatsMgr.GetDomainStats(&GetDomainStatsRequest{DomainID: domainID, Days: 1})
+	s.NoError(err)
+	s.Equal(1, len(resp.Stats))
+	s.Equal(today.Unix(), resp.Stats[0].Day.Unix())
+}
@@ -28,6 +28,7 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/config"
 )
 
 const (
@@ -47,6 +48,14 @@ const (
 		`AND first_event_id >= ? ` +
 		`AND first_event_id < ?`
 
+	templateGetWorkflowExecutionHistoryBatch = `SELECT first_event_id, data, data_encoding, data_version FROM events ` +
+		`WHERE domain_id = ? ` +
+		`AND workflow_id = ? ` +
+		`AND run_id = ? ` +
+		`AND first_event_id <= ? ` +
+		`ORDER BY first_event_id DESC ` +
+		`LIMIT 1`
+
 	templateDeleteWorkflowExecutionHistory = `DELETE FROM events ` +
 		`WHERE domain_id = ? ` +
 		`AND workflow_id = ? ` +
@@ -55,15 +64,23 @@ const (
 
 type (
 	cassandraHistoryPersistence struct {
-		session *gocql.Session
-		logger  bark.Logger
+		session     *gocql.Session
+		timeouts    config.CassandraTimeouts
+		compression string
+		encryptor   PayloadEncryptor
+		logger      bark.Logger
 	}
 )
 
-// NewCassandraHistoryPersistence is used to create an instance of HistoryManager implementation
+// NewCassandraHistoryPersistence is used to create an instance of HistoryManager implementation, history events
+// are encrypted when an encryptor is given
 func NewCassandraHistoryPersistence(hosts string, port int, user, password, dc string, keyspace string,
-	numConns int, logger bark.Logger) (HistoryManager,
-	error) {
+	numConns int, timeouts config.CassandraTimeouts, compression string, encryptor PayloadEncryptor,
+	logger bark.Logger) (HistoryManager, error) {
+	if err := ValidateHistoryCompression(compression); err != nil {
+		return nil, err
+	}
+
 	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
 	cluster.Keyspace = keyspace
 	cluster.ProtoVersion = cassandraProtoVersion
@@ -77,7 +94,13 @@ func NewCassandraHistoryPersistence(hosts string, port int, user, password, dc s
 		return nil, err
 	}
 
-	return &cassandraHistoryPersistence{session: session, logger: logger}, nil
+	return &cassandraHistoryPersistence{
+		session:     session,
+		timeouts:    timeouts,
+		compression: compression,
+		encryptor:   encryptor,
+		logger:      logger,
+	}, nil
 }
 
 // Close gracefully releases the resources held by this object
@@ -88,20 +111,31 @@ func (h *cassandraHistoryPersistence) Close() {
 }
 
 func (h *cassandraHistoryPersistence) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	data, encodingType, err := h.encodeHistoryData(request.DomainID, request.Events.Data,
+		request.Events.EncodingType)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("AppendHistoryEvents operation failed. Error: %v", err),
+		}
+	}
+
+	ctx, cancel := newOperationContext(h.timeouts.Write)
+	defer cancel()
+
 	var query *gocql.Query
 	if request.Overwrite {
 		query = h.session.Query(templateOverwriteHistoryEvents,
 			request.RangeID,
 			request.TransactionID,
-			request.Events.Data,
-			request.Events.EncodingType,
+			data,
+			encodingType,
 			request.Events.Version,
 			request.DomainID,
 			*request.Execution.WorkflowId,
 			*request.Execution.RunId,
 			request.FirstEventID,
 			request.RangeID,
-			request.TransactionID)
+			request.TransactionID).WithContext(ctx)
 	} else {
 		query = h.session.Query(templateAppendHistoryEvents,
 			request.DomainID,
@@ -110,9 +144,9 @@ func (h *cassandraHistoryPersistence) AppendHistoryEvents(request *AppendHistory
 			request.FirstEventID,
 			request.RangeID,
 			request.TransactionID,
-			request.Events.Data,
-			request.Events.EncodingType,
-			request.Events.Version)
+			data,
+			encodingType,
+			request.Events.Version).WithContext(ctx)
 	}
 
 	previous := make(map[string]interface{})
@@ -138,18 +172,22 @@ func (h *cassandraHistoryPersistence) AppendHistoryEvents(request *AppendHistory
 		}
 	}
 
+	request.StoredEncodingType = encodingType
+	request.StoredSize = len(data)
 	return nil
 }
 
 func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (
 	*GetWorkflowExecutionHistoryResponse, error) {
 	execution := request.Execution
+	ctx, cancel := newOperationContext(h.timeouts.List)
+	defer cancel()
 	query := h.session.Query(templateGetWorkflowExecutionHistory,
 		request.DomainID,
 		*execution.WorkflowId,
 		*execution.RunId,
 		request.FirstEventID,
-		request.NextEventID)
+		request.NextEventID).WithContext(ctx)
 
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
@@ -164,6 +202,15 @@ func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(request *GetWo
 	found := false
 	for iter.Scan(&firstEventID, &history.Data, &history.EncodingType, &history.Version) {
 		found = true
+		data, encodingType, err := h.decodeHistoryData(request.DomainID, history.Data, history.EncodingType)
+		if err != nil {
+			iter.Close()
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetWorkflowExecutionHistory operation failed. Error: %v", err),
+			}
+		}
+		history.Data = data
+		history.EncodingType = encodingType
 		response.Events = append(response.Events, history)
 		history = SerializedHistoryEventBatch{}
 	}
@@ -189,13 +236,56 @@ func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(request *GetWo
 	return response, nil
 }
 
+func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistoryBatch(request *GetWorkflowExecutionHistoryBatchRequest) (
+	*GetWorkflowExecutionHistoryBatchResponse, error) {
+	execution := request.Execution
+	ctx, cancel := newOperationContext(h.timeouts.Read)
+	defer cancel()
+	query := h.session.Query(templateGetWorkflowExecutionHistoryBatch,
+		request.DomainID,
+		*execution.WorkflowId,
+		*execution.RunId,
+		request.EventID).WithContext(ctx)
+
+	response := &GetWorkflowExecutionHistoryBatchResponse{}
+	err := query.Scan(&response.FirstEventID, &response.Events.Data, &response.Events.EncodingType,
+		&response.Events.Version)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Workflow execution history event not found.  WorkflowId: %v, RunId: %v, EventId: %v",
+					*execution.WorkflowId, *execution.RunId, request.EventID),
+			}
+		} else if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("GetWorkflowExecutionHistoryBatch operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetWorkflowExecutionHistoryBatch operation failed. Error: %v", err),
+		}
+	}
+
+	response.Events.Data, response.Events.EncodingType, err = h.decodeHistoryData(request.DomainID,
+		response.Events.Data, response.Events.EncodingType)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetWorkflowExecutionHistoryBatch operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
 func (h *cassandraHistoryPersistence) DeleteWorkflowExecutionHistory(
 	request *DeleteWorkflowExecutionHistoryRequest) error {
 	execution := request.Execution
+	ctx, cancel := newOperationContext(h.timeouts.RangeDelete)
+	defer cancel()
 	query := h.session.Query(templateDeleteWorkflowExecutionHistory,
 		request.DomainID,
 		*execution.WorkflowId,
-		*execution.RunId)
+		*execution.RunId).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -211,3 +301,23 @@ func (h *cassandraHistoryPersistence) DeleteWorkflowExecutionHistory(
 
 	return nil
 }
+
+// encodeHistoryData compresses, then encrypts, serialized history events
+func (h *cassandraHistoryPersistence) encodeHistoryData(domainID string, data []byte,
+	encodingType common.EncodingType) ([]byte, common.EncodingType, error) {
+	data, encodingType, err := compressHistoryData(h.compression, data, encodingType)
+	if err != nil {
+		return nil, "", err
+	}
+	return encryptHistoryData(h.encryptor, domainID, data, encodingType)
+}
+
+// decodeHistoryData reverts encodeHistoryData
+func (h *cassandraHistoryPersistence) decodeHistoryData(domainID string, data []byte,
+	encodingType common.EncodingType) ([]byte, common.EncodingType, error) {
+	data, encodingType, err := decryptHistoryData(h.encryptor, domainID, data, encodingType)
+	if err != nil {
+		return nil, "", err
+	}
+	return decompressHistoryData(data, encodingType)
+}
@@ -29,9 +29,12 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"fmt"
+	"github.com/golang/snappy"
 	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/config"
 )
 
 type (
@@ -239,6 +242,146 @@ func (s *historyPersistenceSuite) TestAppendAndGet() {
 	}
 }
 
+func (s *historyPersistenceSuite) TestGetHistoryBatch() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("get-history-batch-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	firstEventIDs := []int64{1, 3, 4, 7}
+	for i, firstEventID := range firstEventIDs {
+		batch := NewSerializedHistoryEventBatch([]byte(fmt.Sprintf("batch%v", i)), common.EncodingTypeJSON, 1)
+		err0 := s.AppendHistoryEvents(domainID, workflowExecution, firstEventID, 1, int64(i), batch, false)
+		s.Nil(err0)
+	}
+
+	for eventID, expectedFirstEventID := range map[int64]int64{1: 1, 2: 1, 3: 3, 5: 4, 6: 4, 7: 7, 9: 7} {
+		response, err1 := s.HistoryMgr.GetWorkflowExecutionHistoryBatch(&GetWorkflowExecutionHistoryBatchRequest{
+			DomainID:  domainID,
+			Execution: workflowExecution,
+			EventID:   eventID,
+		})
+		s.Nil(err1)
+		s.Equal(expectedFirstEventID, response.FirstEventID)
+		s.Equal(common.EncodingTypeJSON, response.Events.EncodingType)
+	}
+
+	_, err2 := s.HistoryMgr.GetWorkflowExecutionHistoryBatch(&GetWorkflowExecutionHistoryBatchRequest{
+		DomainID:  domainID,
+		Execution: workflowExecution,
+		EventID:   0,
+	})
+	s.IsType(&gen.EntityNotExistsError{}, err2)
+}
+
+func (s *historyPersistenceSuite) TestAppendAndGetSnappyCompressed() {
+	snappyHistoryMgr, err := NewCassandraHistoryPersistence(testWorkflowClusterHosts, testPort, testUser, testPassword,
+		testDatacenter, s.CassandraTestCluster.keyspace, 2, config.CassandraTimeouts{}, HistoryCompressionSnappy,
+		nil, bark.NewLoggerFromLogrus(log.New()))
+	s.Nil(err)
+	defer snappyHistoryMgr.Close()
+
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("append-and-get-snappy-compressed-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	historyList := []*SerializedHistoryEventBatch{
+		NewSerializedHistoryEventBatch([]byte("event1;event2"), common.EncodingTypeJSON, 1),
+		NewSerializedHistoryEventBatch([]byte("event3;event4"), common.EncodingTypeJSON, 1),
+	}
+
+	// the first batch is written before compression is turned on, the second one compressed
+	err0 := s.AppendHistoryEvents(domainID, workflowExecution, 1, 1, 1, historyList[0], false)
+	s.Nil(err0)
+	request := &AppendHistoryEventsRequest{
+		DomainID:      domainID,
+		Execution:     workflowExecution,
+		FirstEventID:  3,
+		RangeID:       1,
+		TransactionID: 2,
+		Events:        historyList[1],
+	}
+	err1 := snappyHistoryMgr.AppendHistoryEvents(request)
+	s.Nil(err1)
+	s.Equal(common.EncodingType("json/snappy"), request.StoredEncodingType)
+	s.Equal(len(snappy.Encode(nil, historyList[1].Data)), request.StoredSize)
+
+	// both batches read back the same with or without compression configured
+	for _, historyMgr := range []HistoryManager{s.HistoryMgr, snappyHistoryMgr} {
+		response, err2 := historyMgr.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+			DomainID:     domainID,
+			Execution:    workflowExecution,
+			FirstEventID: 1,
+			NextEventID:  5,
+			PageSize:     10,
+		})
+		s.Nil(err2)
+		s.Equal(len(historyList), len(response.Events))
+		for i, history := range response.Events {
+			s.Equal(historyList[i].Data, history.Data)
+			s.Equal(historyList[i].EncodingType, history.EncodingType)
+		}
+	}
+}
+
+func (s *historyPersistenceSuite) TestAppendAndGetEncrypted() {
+	encryptor, err := NewPayloadEncryptorFromKey(testPayloadEncryptionKey)
+	s.Nil(err)
+	encryptedHistoryMgr, err := NewCassandraHistoryPersistence(testWorkflowClusterHosts, testPort, testUser,
+		testPassword, testDatacenter, s.CassandraTestCluster.keyspace, 2, config.CassandraTimeouts{},
+		HistoryCompressionSnappy, encryptor, bark.NewLoggerFromLogrus(log.New()))
+	s.Nil(err)
+	defer encryptedHistoryMgr.Close()
+
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("append-and-get-encrypted-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	historyList := []*SerializedHistoryEventBatch{
+		NewSerializedHistoryEventBatch([]byte("event1;event2"), common.EncodingTypeJSON, 1),
+		NewSerializedHistoryEventBatch([]byte("event3;event4"), common.EncodingTypeJSON, 1),
+	}
+
+	// the first batch is written before encryption is turned on, the second one encrypted and compressed
+	err0 := s.AppendHistoryEvents(domainID, workflowExecution, 1, 1, 1, historyList[0], false)
+	s.Nil(err0)
+	err1 := encryptedHistoryMgr.AppendHistoryEvents(&AppendHistoryEventsRequest{
+		DomainID:      domainID,
+		Execution:     workflowExecution,
+		FirstEventID:  3,
+		RangeID:       1,
+		TransactionID: 2,
+		Events:        historyList[1],
+	})
+	s.Nil(err1)
+
+	response, err2 := encryptedHistoryMgr.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+		DomainID:     domainID,
+		Execution:    workflowExecution,
+		FirstEventID: 1,
+		NextEventID:  5,
+		PageSize:     10,
+	})
+	s.Nil(err2)
+	s.Equal(len(historyList), len(response.Events))
+	for i, history := range response.Events {
+		s.Equal(historyList[i].Data, history.Data)
+		s.Equal(historyList[i].EncodingType, history.EncodingType)
+	}
+
+	// the encrypted batch cannot be read without the key
+	_, err3 := s.HistoryMgr.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+		DomainID:     domainID,
+		Execution:    workflowExecution,
+		FirstEventID: 1,
+		NextEventID:  5,
+		PageSize:     10,
+	})
+	s.NotNil(err3)
+}
+
 func (s *historyPersistenceSuite) AppendHistoryEvents(domainID string, workflowExecution gen.WorkflowExecution,
 	firstEventID, rangeID, txID int64, eventsBatch *SerializedHistoryEventBatch, overwrite bool) error {
 
@@ -71,6 +71,15 @@ const (
 		`FROM domains_by_name ` +
 		`WHERE name = ?`
 
+	templateListDomainsQuery = `SELECT domain.id, domain.name, domain.status, domain.description, ` +
+		`domain.owner_email, config.retention, config.emit_metric, ` +
+		`replication_config.active_cluster_name, replication_config.clusters, ` +
+		`is_global_domain, ` +
+		`config_version, ` +
+		`failover_version, ` +
+		`db_version ` +
+		`FROM domains_by_name`
+
 	templateUpdateDomainByNameQuery = `UPDATE domains_by_name ` +
 		`SET domain = ` + templateDomainType + `, ` +
 		`config = ` + templateDomainConfigType + `, ` +
@@ -360,3 +369,58 @@ func deserializeClusterConfigs(replicationConfigs []map[string]interface{}) []*C
 	}
 	return deseriaizedReplicationConfigs
 }
+
+// ListDomains pages through the domains_by_name table, domains are returned in token order rather than by name
+func (m *cassandraMetadataPersistence) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
+	query := m.session.Query(templateListDomainsQuery)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListDomains operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &ListDomainsResponse{}
+	for {
+		domain := &GetDomainResponse{
+			Info:              &DomainInfo{},
+			Config:            &DomainConfig{},
+			ReplicationConfig: &DomainReplicationConfig{},
+		}
+		var replicationClusters []map[string]interface{}
+		if !iter.Scan(
+			&domain.Info.ID,
+			&domain.Info.Name,
+			&domain.Info.Status,
+			&domain.Info.Description,
+			&domain.Info.OwnerEmail,
+			&domain.Config.Retention,
+			&domain.Config.EmitMetric,
+			&domain.ReplicationConfig.ActiveClusterName,
+			&replicationClusters,
+			&domain.IsGlobalDomain,
+			&domain.ConfigVersion,
+			&domain.FailoverVersion,
+			&domain.DBVersion,
+		) {
+			break
+		}
+
+		domain.ReplicationConfig.ActiveClusterName = GetOrUseDefaultActiveCluster(m.currentClusterName,
+			domain.ReplicationConfig.ActiveClusterName)
+		domain.ReplicationConfig.Clusters = GetOrUseDefaultClusters(m.currentClusterName,
+			deserializeClusterConfigs(replicationClusters))
+		response.Domains = append(response.Domains, domain)
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListDomains operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
@@ -468,6 +469,60 @@ func (m *metadataPersistenceSuite) TestDeleteDomain() {
 	m.Nil(resp9)
 }
 
+func (m *metadataPersistenceSuite) TestListDomains() {
+	clusterActive := "some random active cluster name"
+	clusters := []*ClusterReplicationConfig{
+		&ClusterReplicationConfig{
+			ClusterName: clusterActive,
+		},
+	}
+
+	names := map[string]string{}
+	for i := 0; i < 3; i++ {
+		id := uuid.New()
+		name := fmt.Sprintf("list-domains-test-name-%v", i)
+		names[name] = id
+		_, err := m.CreateDomain(
+			&DomainInfo{
+				ID:     id,
+				Name:   name,
+				Status: DomainStatusRegistered,
+			},
+			&DomainConfig{
+				Retention: 1,
+			},
+			&DomainReplicationConfig{
+				ActiveClusterName: clusterActive,
+				Clusters:          clusters,
+			},
+			true,
+			int64(i),
+			int64(i),
+		)
+		m.Nil(err)
+	}
+
+	found := map[string]string{}
+	var token []byte
+	for {
+		resp, err := m.MetadataManager.ListDomains(&ListDomainsRequest{PageSize: 1, NextPageToken: token})
+		m.Nil(err)
+		for _, domain := range resp.Domains {
+			if _, ok := names[domain.Info.Name]; ok {
+				found[domain.Info.Name] = domain.Info.ID
+				m.True(domain.IsGlobalDomain)
+				m.Equal(clusterActive, domain.ReplicationConfig.ActiveClusterName)
+				m.Equal(1, len(domain.ReplicationConfig.Clusters))
+			}
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	m.Equal(names, found)
+}
+
 func (m *metadataPersistenceSuite) CreateDomain(info *DomainInfo, config *DomainConfig,
 	replicationConfig *DomainReplicationConfig, isGlobaldomain bool, configVersion int64, failoverVersion int64) (*CreateDomainResponse, error) {
 	return m.MetadataManager.CreateDomain(&CreateDomainRequest{
@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -31,6 +32,7 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/config"
 )
 
 // Guidelines for creating new special UUID constants
@@ -147,7 +149,20 @@ const (
 		`sticky_schedule_to_start_timeout: ?,` +
 		`client_library_version: ?, ` +
 		`client_feature_version: ?, ` +
-		`client_impl: ?` +
+		`client_impl: ?, ` +
+		`attempt: ?, ` +
+		`has_retry_policy: ?, ` +
+		`init_interval: ?, ` +
+		`backoff_coefficient: ?, ` +
+		`max_interval: ?, ` +
+		`expiration_time: ?, ` +
+		`max_attempts: ?, ` +
+		`non_retriable_errors: ?, ` +
+		`paused: ?, ` +
+		`signal_count: ?, ` +
+		`initiator: ?, ` +
+		`decision_backoff: ?, ` +
+		`buffered_signal_count: ?` +
 		`}`
 
 	templateReplicationStateType = `{` +
@@ -170,7 +185,8 @@ const (
 		`task_list: ?, ` +
 		`type: ?, ` +
 		`schedule_id: ?, ` +
-		`version: ?` +
+		`version: ?, ` +
+		`record_visibility: ?` +
 		`}`
 
 	templateReplicationTaskType = `{` +
@@ -226,7 +242,8 @@ const (
 		`max_interval: ?, ` +
 		`expiration_time: ?, ` +
 		`max_attempts: ?, ` +
-		`non_retriable_errors: ?` +
+		`non_retriable_errors: ?, ` +
+		`non_retriable_error_types: ?` +
 		`}`
 
 	templateTimerInfoType = `{` +
@@ -287,7 +304,8 @@ const (
 		`name: ?, ` +
 		`type: ?, ` +
 		`ack_level: ?, ` +
-		`kind: ? ` +
+		`kind: ?, ` +
+		`paused: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -368,7 +386,7 @@ const (
 		`and task_id = ? ` +
 		`IF range_id = ?`
 
-	templateGetWorkflowExecutionQuery = `SELECT execution, replication_state, activity_map, timer_map, child_executions_map, request_cancel_map, signal_map, signal_requested, buffered_events_list, buffered_replication_tasks_map ` +
+	templateGetWorkflowExecutionQuery = `SELECT execution, replication_state, activity_map, activity_details_map, timer_map, child_executions_map, request_cancel_map, signal_map, signal_requested, buffered_events_list, buffered_replication_tasks_map ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -421,8 +439,19 @@ const (
 		`and task_id = ? ` +
 		`IF next_event_id = ?`
 
+	templateUpdateActivityDetailsQuery = `UPDATE executions ` +
+		`SET activity_details_map[ ? ] = ? ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? ` +
+		`IF next_event_id = ?`
+
 	templateResetActivityInfoQuery = `UPDATE executions ` +
-		`SET activity_map = ?` +
+		`SET activity_map = ?, activity_details_map = ? ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
@@ -564,7 +593,7 @@ const (
 		`and task_id = ? ` +
 		`IF next_event_id = ?`
 
-	templateDeleteActivityInfoQuery = `DELETE activity_map[ ? ] ` +
+	templateDeleteActivityInfoQuery = `DELETE activity_map[ ? ], activity_details_map[ ? ] ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -665,6 +694,16 @@ const (
 		`and visibility_ts = ? ` +
 		`and task_id = ? `
 
+	templateDeleteCurrentWorkflowExecutionQuery = `DELETE FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? ` +
+		`IF current_run_id = ? `
+
 	templateDeleteWorkflowExecutionSignalRequestedQuery = `UPDATE executions ` +
 		`SET signal_requested = signal_requested - ? ` +
 		`WHERE shard_id = ? ` +
@@ -801,13 +840,15 @@ type (
 		session            *gocql.Session
 		shardID            int
 		currentClusterName string
+		timeouts           config.CassandraTimeouts
+		encryptor          PayloadEncryptor
 		logger             bark.Logger
 	}
 )
 
 // NewCassandraShardPersistence is used to create an instance of ShardManager implementation
 func NewCassandraShardPersistence(hosts string, port int, user, password, dc string, keyspace string,
-	currentClusterName string, logger bark.Logger) (ShardManager, error) {
+	currentClusterName string, timeouts config.CassandraTimeouts, logger bark.Logger) (ShardManager, error) {
 	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
 	cluster.Keyspace = keyspace
 	cluster.ProtoVersion = cassandraProtoVersion
@@ -820,18 +861,20 @@ func NewCassandraShardPersistence(hosts string, port int, user, password, dc str
 		return nil, err
 	}
 
-	return &cassandraPersistence{shardID: -1, session: session, currentClusterName: currentClusterName, logger: logger}, nil
+	return &cassandraPersistence{shardID: -1, session: session, currentClusterName: currentClusterName, timeouts: timeouts,
+		logger: logger}, nil
 }
 
 // NewCassandraWorkflowExecutionPersistence is used to create an instance of workflowExecutionManager implementation
-func NewCassandraWorkflowExecutionPersistence(shardID int, session *gocql.Session,
-	logger bark.Logger) (ExecutionManager, error) {
-	return &cassandraPersistence{shardID: shardID, session: session, logger: logger}, nil
+func NewCassandraWorkflowExecutionPersistence(shardID int, session *gocql.Session, timeouts config.CassandraTimeouts,
+	encryptor PayloadEncryptor, logger bark.Logger) (ExecutionManager, error) {
+	return &cassandraPersistence{shardID: shardID, session: session, timeouts: timeouts, encryptor: encryptor,
+		logger: logger}, nil
 }
 
 // NewCassandraTaskPersistence is used to create an instance of TaskManager implementation
 func NewCassandraTaskPersistence(hosts string, port int, user, password, dc string, keyspace string,
-	logger bark.Logger) (TaskManager, error) {
+	timeouts config.CassandraTimeouts, logger bark.Logger) (TaskManager, error) {
 	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
 	cluster.Keyspace = keyspace
 	cluster.ProtoVersion = cassandraProtoVersion
@@ -843,7 +886,7 @@ func NewCassandraTaskPersistence(hosts string, port int, user, password, dc stri
 	if err != nil {
 		return nil, err
 	}
-	return &cassandraPersistence{shardID: -1, session: session, logger: logger}, nil
+	return &cassandraPersistence{shardID: -1, session: session, timeouts: timeouts, logger: logger}, nil
 }
 
 // Close releases the underlying resources held by this object
@@ -856,6 +899,8 @@ func (d *cassandraPersistence) Close() {
 func (d *cassandraPersistence) CreateShard(request *CreateShardRequest) error {
 	cqlNowTimestamp := common.UnixNanoToCQLTimestamp(time.Now().UnixNano())
 	shardInfo := request.ShardInfo
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
 	query := d.session.Query(templateCreateShardQuery,
 		shardInfo.ShardID,
 		rowTypeShard,
@@ -874,7 +919,7 @@ func (d *cassandraPersistence) CreateShard(request *CreateShardRequest) error {
 		shardInfo.TimerAckLevel,
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
-		shardInfo.RangeID)
+		shardInfo.RangeID).WithContext(ctx)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -902,6 +947,8 @@ func (d *cassandraPersistence) CreateShard(request *CreateShardRequest) error {
 
 func (d *cassandraPersistence) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
 	shardID := request.ShardID
+	ctx, cancel := newOperationContext(d.timeouts.Read)
+	defer cancel()
 	query := d.session.Query(templateGetShardQuery,
 		shardID,
 		rowTypeShard,
@@ -909,7 +956,7 @@ func (d *cassandraPersistence) GetShard(request *GetShardRequest) (*GetShardResp
 		rowTypeShardWorkflowID,
 		rowTypeShardRunID,
 		defaultVisibilityTimestamp,
-		rowTypeShardTaskID)
+		rowTypeShardTaskID).WithContext(ctx)
 
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
@@ -937,6 +984,8 @@ func (d *cassandraPersistence) UpdateShard(request *UpdateShardRequest) error {
 	cqlNowTimestamp := common.UnixNanoToCQLTimestamp(time.Now().UnixNano())
 	shardInfo := request.ShardInfo
 
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
 	query := d.session.Query(templateUpdateShardQuery,
 		shardInfo.ShardID,
 		shardInfo.Owner,
@@ -956,7 +1005,7 @@ func (d *cassandraPersistence) UpdateShard(request *UpdateShardRequest) error {
 		rowTypeShardRunID,
 		defaultVisibilityTimestamp,
 		rowTypeShardTaskID,
-		request.PreviousRangeID)
+		request.PreviousRangeID).WithContext(ctx)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -991,7 +1040,9 @@ func (d *cassandraPersistence) CreateWorkflowExecution(request *CreateWorkflowEx
 	*CreateWorkflowExecutionResponse, error) {
 	transferTaskID := uuid.New()
 	cqlNowTimestamp := common.UnixNanoToCQLTimestamp(time.Now().UnixNano())
-	batch := d.session.NewBatch(gocql.LoggedBatch)
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
+	batch := d.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 
 	d.CreateWorkflowExecutionWithinBatch(request, batch, cqlNowTimestamp)
 
@@ -1120,7 +1171,10 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 		state = WorkflowStateCreated
 	}
 
-	if request.ContinueAsNew {
+	switch {
+	case request.SkipCurrentExecution:
+		// the run is created next to the current run of the workflow ID, which is left as is
+	case request.ContinueAsNew:
 		batch.Query(templateUpdateCurrentWorkflowExecutionQuery,
 			*request.Execution.RunId,
 			*request.Execution.RunId,
@@ -1136,7 +1190,7 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 			rowTypeExecutionTaskID,
 			request.PreviousRunID,
 		)
-	} else {
+	default:
 		batch.Query(templateCreateWorkflowExecutionQuery,
 			d.shardID,
 			rowTypeExecution,
@@ -1196,6 +1250,19 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 			"", // client_library_version
 			"", // client_feature_version
 			"", // client_impl
+			request.Attempt,
+			request.HasRetryPolicy,
+			request.InitialInterval,
+			request.BackoffCoefficient,
+			request.MaximumInterval,
+			request.ExpirationTime,
+			request.MaximumAttempts,
+			request.NonRetriableErrors,
+			false, // paused
+			0,     // signal_count
+			request.Initiator,
+			request.DecisionBackoffSeconds,
+			0, // buffered_signal_count
 			request.NextEventID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID)
@@ -1246,6 +1313,19 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 			"", // client_library_version
 			"", // client_feature_version
 			"", // client_impl
+			request.Attempt,
+			request.HasRetryPolicy,
+			request.InitialInterval,
+			request.BackoffCoefficient,
+			request.MaximumInterval,
+			request.ExpirationTime,
+			request.MaximumAttempts,
+			request.NonRetriableErrors,
+			false, // paused
+			0,     // signal_count
+			request.Initiator,
+			request.DecisionBackoffSeconds,
+			0, // buffered_signal_count
 			request.ReplicationState.CurrentVersion,
 			request.ReplicationState.StartVersion,
 			request.ReplicationState.LastWriteVersion,
@@ -1260,6 +1340,8 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
 	*GetWorkflowExecutionResponse, error) {
 	execution := request.Execution
+	ctx, cancel := newOperationContext(d.timeouts.Read)
+	defer cancel()
 	query := d.session.Query(templateGetWorkflowExecutionQuery,
 		d.shardID,
 		rowTypeExecution,
@@ -1267,7 +1349,7 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutio
 		*execution.WorkflowId,
 		*execution.RunId,
 		defaultVisibilityTimestamp,
-		rowTypeExecutionTaskID)
+		rowTypeExecutionTaskID).WithContext(ctx)
 
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
@@ -1296,8 +1378,21 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutio
 
 	activityInfos := make(map[int64]*ActivityInfo)
 	aMap := result["activity_map"].(map[int64]map[string]interface{})
+	detailsMap := result["activity_details_map"].(map[int64][]byte)
 	for key, value := range aMap {
 		info := createActivityInfo(value)
+		if details, ok := detailsMap[key]; ok {
+			details, err := decryptPayload(d.encryptor, request.DomainID, details)
+			if err != nil {
+				return nil, &workflow.InternalServiceError{
+					Message: fmt.Sprintf("GetWorkflowExecution operation failed. Error: %v", err),
+				}
+			}
+			info.Details = details
+		} else if info.Details != nil {
+			// written before heartbeat details were moved out of the activity info, move them on the next update
+			info.DetailsUpdated = true
+		}
 		activityInfos[key] = info
 	}
 	state.ActivitInfos = activityInfos
@@ -1345,6 +1440,15 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutio
 	bufferedEvents := make([]*SerializedHistoryEventBatch, 0, len(eList))
 	for _, v := range eList {
 		eventBatch := createSerializedHistoryEventBatch(v)
+		data, encodingType, err := decryptHistoryData(d.encryptor, request.DomainID, eventBatch.Data,
+			eventBatch.EncodingType)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		eventBatch.Data = data
+		eventBatch.EncodingType = encodingType
 		bufferedEvents = append(bufferedEvents, eventBatch)
 	}
 	state.BufferedEvents = bufferedEvents
@@ -1361,10 +1465,25 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutio
 }
 
 func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error {
-	batch := d.session.NewBatch(gocql.LoggedBatch)
-	cqlNowTimestamp := common.UnixNanoToCQLTimestamp(time.Now().UnixNano())
 	executionInfo := request.ExecutionInfo
 	replicationState := request.ReplicationState
+	upsertActivityInfos, err := d.encryptActivityDetails(executionInfo.DomainID, request.UpsertActivityInfos, false)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+	newBufferedEvents, err := d.encryptBufferedEvents(executionInfo.DomainID, request.NewBufferedEvents)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
+	batch := d.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	cqlNowTimestamp := common.UnixNanoToCQLTimestamp(time.Now().UnixNano())
 
 	if replicationState == nil {
 		// Updates will be called with null ReplicationState while the feature is disabled
@@ -1404,6 +1523,19 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 			executionInfo.ClientLibraryVersion,
 			executionInfo.ClientFeatureVersion,
 			executionInfo.ClientImpl,
+			executionInfo.Attempt,
+			executionInfo.HasRetryPolicy,
+			executionInfo.InitialInterval,
+			executionInfo.BackoffCoefficient,
+			executionInfo.MaximumInterval,
+			executionInfo.ExpirationTime,
+			executionInfo.MaximumAttempts,
+			executionInfo.NonRetriableErrors,
+			executionInfo.Paused,
+			executionInfo.SignalCount,
+			executionInfo.Initiator,
+			executionInfo.DecisionBackoffSeconds,
+			executionInfo.BufferedSignalCount,
 			executionInfo.NextEventID,
 			d.shardID,
 			rowTypeExecution,
@@ -1455,6 +1587,19 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 			executionInfo.ClientLibraryVersion,
 			executionInfo.ClientFeatureVersion,
 			executionInfo.ClientImpl,
+			executionInfo.Attempt,
+			executionInfo.HasRetryPolicy,
+			executionInfo.InitialInterval,
+			executionInfo.BackoffCoefficient,
+			executionInfo.MaximumInterval,
+			executionInfo.ExpirationTime,
+			executionInfo.MaximumAttempts,
+			executionInfo.NonRetriableErrors,
+			executionInfo.Paused,
+			executionInfo.SignalCount,
+			executionInfo.Initiator,
+			executionInfo.DecisionBackoffSeconds,
+			executionInfo.BufferedSignalCount,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -1480,7 +1625,7 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 	d.createTimerTasks(batch, request.TimerTasks, request.DeleteTimerTask, request.ExecutionInfo.DomainID,
 		executionInfo.WorkflowID, executionInfo.RunID, cqlNowTimestamp)
 
-	d.updateActivityInfos(batch, request.UpsertActivityInfos, request.DeleteActivityInfos, executionInfo.DomainID,
+	d.updateActivityInfos(batch, upsertActivityInfos, request.DeleteActivityInfos, executionInfo.DomainID,
 		executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
 
 	d.updateTimerInfos(batch, request.UpserTimerInfos, request.DeleteTimerInfos, executionInfo.DomainID,
@@ -1498,7 +1643,7 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 	d.updateSignalsRequested(batch, request.UpsertSignalRequestedIDs, request.DeleteSignalRequestedID,
 		executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
 
-	d.updateBufferedEvents(batch, request.NewBufferedEvents, request.ClearBufferedEvents,
+	d.updateBufferedEvents(batch, newBufferedEvents, request.ClearBufferedEvents,
 		executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
 
 	d.updateBufferedReplicationTasks(batch, request.NewBufferedReplicationTask, request.DeleteBufferedReplicationTask,
@@ -1596,6 +1741,7 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 					return &ConditionFailedError{
 						Msg: fmt.Sprintf("Failed to update workflow execution.  Request Condition: %v, Actual Value: %v",
 							request.Condition, nextEventID),
+						ActualNextEventID: nextEventID,
 					}
 				}
 			}
@@ -1625,10 +1771,19 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 }
 
 func (d *cassandraPersistence) ResetMutableState(request *ResetMutableStateRequest) error {
-	batch := d.session.NewBatch(gocql.LoggedBatch)
-	cqlNowTimestamp := common.UnixNanoToCQLTimestamp(time.Now().UnixNano())
 	executionInfo := request.ExecutionInfo
 	replicationState := request.ReplicationState
+	insertActivityInfos, err := d.encryptActivityDetails(executionInfo.DomainID, request.InsertActivityInfos, true)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetMutableState operation failed. Error: %v", err),
+		}
+	}
+
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
+	batch := d.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	cqlNowTimestamp := common.UnixNanoToCQLTimestamp(time.Now().UnixNano())
 
 	lastReplicationInfo := make(map[string]map[string]interface{})
 	for k, v := range replicationState.LastReplicationInfo {
@@ -1671,6 +1826,19 @@ func (d *cassandraPersistence) ResetMutableState(request *ResetMutableStateReque
 		executionInfo.ClientLibraryVersion,
 		executionInfo.ClientFeatureVersion,
 		executionInfo.ClientImpl,
+		executionInfo.Attempt,
+		executionInfo.HasRetryPolicy,
+		executionInfo.InitialInterval,
+		executionInfo.BackoffCoefficient,
+		executionInfo.MaximumInterval,
+		executionInfo.ExpirationTime,
+		executionInfo.MaximumAttempts,
+		executionInfo.NonRetriableErrors,
+		executionInfo.Paused,
+		executionInfo.SignalCount,
+		executionInfo.Initiator,
+		executionInfo.DecisionBackoffSeconds,
+		executionInfo.BufferedSignalCount,
 		replicationState.CurrentVersion,
 		replicationState.StartVersion,
 		replicationState.LastWriteVersion,
@@ -1686,7 +1854,7 @@ func (d *cassandraPersistence) ResetMutableState(request *ResetMutableStateReque
 		rowTypeExecutionTaskID,
 		request.Condition)
 
-	d.resetActivityInfos(batch, request.InsertActivityInfos, executionInfo.DomainID, executionInfo.WorkflowID,
+	d.resetActivityInfos(batch, insertActivityInfos, executionInfo.DomainID, executionInfo.WorkflowID,
 		executionInfo.RunID, request.Condition)
 
 	d.resetTimerInfos(batch, request.InsertTimerInfos, executionInfo.DomainID, executionInfo.WorkflowID,
@@ -1795,6 +1963,8 @@ func (d *cassandraPersistence) ResetMutableState(request *ResetMutableStateReque
 }
 
 func (d *cassandraPersistence) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
 	query := d.session.Query(templateDeleteWorkflowExecutionMutableStateQuery,
 		d.shardID,
 		rowTypeExecution,
@@ -1802,7 +1972,7 @@ func (d *cassandraPersistence) DeleteWorkflowExecution(request *DeleteWorkflowEx
 		request.WorkflowID,
 		request.RunID,
 		defaultVisibilityTimestamp,
-		rowTypeExecutionTaskID)
+		rowTypeExecutionTaskID).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -1819,8 +1989,46 @@ func (d *cassandraPersistence) DeleteWorkflowExecution(request *DeleteWorkflowEx
 	return nil
 }
 
+func (d *cassandraPersistence) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
+	query := d.session.Query(templateDeleteCurrentWorkflowExecutionQuery,
+		d.shardID,
+		rowTypeExecution,
+		request.DomainID,
+		request.WorkflowID,
+		permanentRunID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID,
+		request.RunID).WithContext(ctx)
+
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteCurrentWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteCurrentWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+
+	if !applied {
+		return &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to delete current execution.  Request RunID: %v, Actual Value: %v",
+				request.RunID, previous["current_run_id"]),
+		}
+	}
+
+	return nil
+}
+
 func (d *cassandraPersistence) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse,
 	error) {
+	ctx, cancel := newOperationContext(d.timeouts.Read)
+	defer cancel()
 	query := d.session.Query(templateGetCurrentExecutionQuery,
 		d.shardID,
 		rowTypeExecution,
@@ -1828,7 +2036,7 @@ func (d *cassandraPersistence) GetCurrentExecution(request *GetCurrentExecutionR
 		request.WorkflowID,
 		permanentRunID,
 		defaultVisibilityTimestamp,
-		rowTypeExecutionTaskID)
+		rowTypeExecutionTaskID).WithContext(ctx)
 
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
@@ -1861,6 +2069,8 @@ func (d *cassandraPersistence) GetCurrentExecution(request *GetCurrentExecutionR
 func (d *cassandraPersistence) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 
 	// Reading transfer tasks need to be quorum level consistent, otherwise we could loose task
+	ctx, cancel := newOperationContext(d.timeouts.List)
+	defer cancel()
 	query := d.session.Query(templateGetTransferTasksQuery,
 		d.shardID,
 		rowTypeTransferTask,
@@ -1870,7 +2080,7 @@ func (d *cassandraPersistence) GetTransferTasks(request *GetTransferTasksRequest
 		defaultVisibilityTimestamp,
 		request.ReadLevel,
 		request.MaxReadLevel,
-		request.BatchSize)
+		request.BatchSize).WithContext(ctx)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -1902,6 +2112,8 @@ func (d *cassandraPersistence) GetReplicationTasks(request *GetReplicationTasksR
 	error) {
 
 	// Reading replication tasks need to be quorum level consistent, otherwise we could loose task
+	ctx, cancel := newOperationContext(d.timeouts.List)
+	defer cancel()
 	query := d.session.Query(templateGetReplicationTasksQuery,
 		d.shardID,
 		rowTypeReplicationTask,
@@ -1911,7 +2123,7 @@ func (d *cassandraPersistence) GetReplicationTasks(request *GetReplicationTasksR
 		defaultVisibilityTimestamp,
 		request.ReadLevel,
 		request.MaxReadLevel,
-		request.BatchSize)
+		request.BatchSize).WithContext(ctx)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -1940,6 +2152,8 @@ func (d *cassandraPersistence) GetReplicationTasks(request *GetReplicationTasksR
 }
 
 func (d *cassandraPersistence) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
 	query := d.session.Query(templateCompleteTransferTaskQuery,
 		d.shardID,
 		rowTypeTransferTask,
@@ -1947,7 +2161,7 @@ func (d *cassandraPersistence) CompleteTransferTask(request *CompleteTransferTas
 		rowTypeTransferWorkflowID,
 		rowTypeTransferRunID,
 		defaultVisibilityTimestamp,
-		request.TaskID)
+		request.TaskID).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -1965,6 +2179,8 @@ func (d *cassandraPersistence) CompleteTransferTask(request *CompleteTransferTas
 }
 
 func (d *cassandraPersistence) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
 	query := d.session.Query(templateCompleteTransferTaskQuery,
 		d.shardID,
 		rowTypeReplicationTask,
@@ -1972,7 +2188,7 @@ func (d *cassandraPersistence) CompleteReplicationTask(request *CompleteReplicat
 		rowTypeReplicationWorkflowID,
 		rowTypeReplicationRunID,
 		defaultVisibilityTimestamp,
-		request.TaskID)
+		request.TaskID).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -1991,6 +2207,8 @@ func (d *cassandraPersistence) CompleteReplicationTask(request *CompleteReplicat
 
 func (d *cassandraPersistence) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
 	ts := common.UnixNanoToCQLTimestamp(request.VisibilityTimestamp.UnixNano())
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
 	query := d.session.Query(templateCompleteTimerTaskQuery,
 		d.shardID,
 		rowTypeTimerTask,
@@ -1998,7 +2216,7 @@ func (d *cassandraPersistence) CompleteTimerTask(request *CompleteTimerTaskReque
 		rowTypeTimerWorkflowID,
 		rowTypeTimerRunID,
 		ts,
-		request.TaskID)
+		request.TaskID).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -2022,14 +2240,17 @@ func (d *cassandraPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*Le
 			Message: fmt.Sprintf("LeaseTaskList requires non empty task list"),
 		}
 	}
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
 	query := d.session.Query(templateGetTaskList,
 		request.DomainID,
 		request.TaskList,
 		request.TaskType,
 		rowTypeTaskList,
 		taskListTaskID,
-	)
+	).WithContext(ctx)
 	var rangeID, ackLevel int64
+	var paused bool
 	var tlDB map[string]interface{}
 	err := query.Scan(&rangeID, &tlDB)
 	if err != nil {
@@ -2046,7 +2267,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*Le
 				request.TaskType,
 				0,
 				request.TaskListKind,
-			)
+				false,
+			).WithContext(ctx)
 		} else if isThrottlingError(err) {
 			return nil, &workflow.ServiceBusyError{
 				Message: fmt.Sprintf("LeaseTaskList operation failed. TaskList: %v, TaskType: %v, Error: %v",
@@ -2061,6 +2283,7 @@ func (d *cassandraPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*Le
 	} else {
 		ackLevel = tlDB["ack_level"].(int64)
 		taskListKind := tlDB["kind"].(int)
+		paused, _ = tlDB["paused"].(bool) // null for task lists created before the field was added
 		query = d.session.Query(templateUpdateTaskListQuery,
 			rangeID+1,
 			request.DomainID,
@@ -2068,13 +2291,14 @@ func (d *cassandraPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*Le
 			request.TaskType,
 			ackLevel,
 			taskListKind,
+			paused,
 			request.DomainID,
 			&request.TaskList,
 			request.TaskType,
 			rowTypeTaskList,
 			taskListTaskID,
 			rangeID,
-		)
+		).WithContext(ctx)
 	}
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -2094,12 +2318,15 @@ func (d *cassandraPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*Le
 			Msg: fmt.Sprintf("LeaseTaskList failed to apply. db rangeID %v", previousRangeID),
 		}
 	}
-	tli := &TaskListInfo{DomainID: request.DomainID, Name: request.TaskList, TaskType: request.TaskType, RangeID: rangeID + 1, AckLevel: ackLevel, Kind: request.TaskListKind}
+	tli := &TaskListInfo{DomainID: request.DomainID, Name: request.TaskList, TaskType: request.TaskType, RangeID: rangeID + 1, AckLevel: ackLevel, Kind: request.TaskListKind, Paused: paused}
 	return &LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
 
 // From TaskManager interface
 func (d *cassandraPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
+
 	tli := request.TaskListInfo
 
 	if tli.Kind == TaskListKindSticky { // if task_list is sticky, then update with TTL
@@ -2115,8 +2342,9 @@ func (d *cassandraPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*
 			tli.TaskType,
 			tli.AckLevel,
 			tli.Kind,
+			tli.Paused,
 			stickyTaskListTTL,
-		)
+		).WithContext(ctx)
 		err := query.Exec()
 		if err != nil {
 			if isThrottlingError(err) {
@@ -2138,13 +2366,14 @@ func (d *cassandraPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*
 		tli.TaskType,
 		tli.AckLevel,
 		tli.Kind,
+		tli.Paused,
 		tli.DomainID,
 		&tli.Name,
 		tli.TaskType,
 		rowTypeTaskList,
 		taskListTaskID,
 		tli.RangeID,
-	)
+	).WithContext(ctx)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -2176,12 +2405,15 @@ func (d *cassandraPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*
 
 // From TaskManager interface
 func (d *cassandraPersistence) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
-	batch := d.session.NewBatch(gocql.LoggedBatch)
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
+	batch := d.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 	domainID := request.TaskListInfo.DomainID
 	taskList := request.TaskListInfo.Name
 	taskListType := request.TaskListInfo.TaskType
 	taskListKind := request.TaskListInfo.Kind
 	ackLevel := request.TaskListInfo.AckLevel
+	paused := request.TaskListInfo.Paused
 
 	for _, task := range request.Tasks {
 		scheduleID := task.Data.ScheduleID
@@ -2219,6 +2451,7 @@ func (d *cassandraPersistence) CreateTasks(request *CreateTasksRequest) (*Create
 		taskListType,
 		ackLevel,
 		taskListKind,
+		paused,
 		domainID,
 		taskList,
 		taskListType,
@@ -2257,6 +2490,8 @@ func (d *cassandraPersistence) GetTasks(request *GetTasksRequest) (*GetTasksResp
 	}
 
 	// Reading tasklist tasks need to be quorum level consistent, otherwise we could loose task
+	ctx, cancel := newOperationContext(d.timeouts.List)
+	defer cancel()
 	query := d.session.Query(templateGetTasksQuery,
 		request.DomainID,
 		request.TaskList,
@@ -2264,7 +2499,7 @@ func (d *cassandraPersistence) GetTasks(request *GetTasksRequest) (*GetTasksResp
 		rowTypeTask,
 		request.ReadLevel,
 		request.MaxReadLevel,
-		request.BatchSize)
+		request.BatchSize).WithContext(ctx)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -2302,12 +2537,14 @@ PopulateTasks:
 // From TaskManager interface
 func (d *cassandraPersistence) CompleteTask(request *CompleteTaskRequest) error {
 	tli := request.TaskList
+	ctx, cancel := newOperationContext(d.timeouts.Write)
+	defer cancel()
 	query := d.session.Query(templateCompleteTaskQuery,
 		tli.DomainID,
 		tli.Name,
 		tli.TaskType,
 		rowTypeTask,
-		request.TaskID)
+		request.TaskID).WithContext(ctx)
 
 	err := query.Exec()
 	if err != nil {
@@ -2329,6 +2566,8 @@ func (d *cassandraPersistence) GetTimerIndexTasks(request *GetTimerIndexTasksReq
 	// Reading timer tasks need to be quorum level consistent, otherwise we could loose task
 	minTimestamp := common.UnixNanoToCQLTimestamp(request.MinTimestamp.UnixNano())
 	maxTimestamp := common.UnixNanoToCQLTimestamp(request.MaxTimestamp.UnixNano())
+	ctx, cancel := newOperationContext(d.timeouts.List)
+	defer cancel()
 	query := d.session.Query(templateGetTimerTasksQuery,
 		d.shardID,
 		rowTypeTimerTask,
@@ -2337,7 +2576,7 @@ func (d *cassandraPersistence) GetTimerIndexTasks(request *GetTimerIndexTasksReq
 		rowTypeTimerRunID,
 		minTimestamp,
 		maxTimestamp,
-		request.BatchSize)
+		request.BatchSize).WithContext(ctx)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -2376,6 +2615,7 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 	for _, task := range transferTasks {
 		var taskList string
 		var scheduleID int64
+		var recordVisibility bool
 		targetWorkflowID := transferTaskTransferTargetWorkflowID
 		targetRunID := transferTaskTypeTransferTargetRunID
 		targetChildWorkflowOnly := false
@@ -2390,6 +2630,7 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 			targetDomainID = task.(*DecisionTask).DomainID
 			taskList = task.(*DecisionTask).TaskList
 			scheduleID = task.(*DecisionTask).ScheduleID
+			recordVisibility = task.(*DecisionTask).RecordVisibility
 
 		case TransferTaskTypeCancelExecution:
 			targetDomainID = task.(*CancelExecutionTask).TargetDomainID
@@ -2441,6 +2682,7 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 			task.GetType(),
 			scheduleID,
 			task.GetVersion(),
+			recordVisibility,
 			defaultVisibilityTimestamp,
 			task.GetTaskID())
 	}
@@ -2565,7 +2807,7 @@ func (d *cassandraPersistence) updateActivityInfos(batch *gocql.Batch, activityI
 			a.StartedTime,
 			a.ActivityID,
 			a.RequestID,
-			a.Details,
+			nil, // details are written to activity_details_map
 			a.ScheduleToStartTimeout,
 			a.ScheduleToCloseTimeout,
 			a.StartToCloseTimeout,
@@ -2584,6 +2826,7 @@ func (d *cassandraPersistence) updateActivityInfos(batch *gocql.Batch, activityI
 			a.ExpirationTime,
 			a.MaximumAttempts,
 			a.NonRetriableErrors,
+			a.NonRetriableErrorTypes,
 			d.shardID,
 			rowTypeExecution,
 			domainID,
@@ -2592,10 +2835,25 @@ func (d *cassandraPersistence) updateActivityInfos(batch *gocql.Batch, activityI
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID,
 			condition)
+
+		if a.DetailsUpdated {
+			batch.Query(templateUpdateActivityDetailsQuery,
+				a.ScheduleID,
+				a.Details,
+				d.shardID,
+				rowTypeExecution,
+				domainID,
+				workflowID,
+				runID,
+				defaultVisibilityTimestamp,
+				rowTypeExecutionTaskID,
+				condition)
+		}
 	}
 
 	for _, deleteInfo := range deleteInfos {
 		batch.Query(templateDeleteActivityInfoQuery,
+			deleteInfo,
 			deleteInfo,
 			d.shardID,
 			rowTypeExecution,
@@ -2612,6 +2870,7 @@ func (d *cassandraPersistence) resetActivityInfos(batch *gocql.Batch, activityIn
 	workflowID, runID string, condition int64) {
 	batch.Query(templateResetActivityInfoQuery,
 		resetActivityInfoMap(activityInfos),
+		resetActivityDetailsMap(activityInfos),
 		d.shardID,
 		rowTypeExecution,
 		domainID,
@@ -2898,6 +3157,46 @@ func (d *cassandraPersistence) updateBufferedEvents(batch *gocql.Batch, newBuffe
 	}
 }
 
+// encryptActivityDetails returns the activity infos with their heartbeat details encrypted, the infos of the
+// request are left untouched as they are shared with the cached mutable state. Only the details which are written are
+// encrypted, which are all of them when the activity infos are reset and the updated ones otherwise.
+func (d *cassandraPersistence) encryptActivityDetails(domainID string, activityInfos []*ActivityInfo,
+	reset bool) ([]*ActivityInfo, error) {
+	if d.encryptor == nil {
+		return activityInfos, nil
+	}
+
+	encrypted := make([]*ActivityInfo, 0, len(activityInfos))
+	for _, a := range activityInfos {
+		if !reset && !a.DetailsUpdated {
+			encrypted = append(encrypted, a)
+			continue
+		}
+		details, err := encryptPayload(d.encryptor, domainID, a.Details)
+		if err != nil {
+			return nil, err
+		}
+		info := *a
+		info.Details = details
+		encrypted = append(encrypted, &info)
+	}
+	return encrypted, nil
+}
+
+func (d *cassandraPersistence) encryptBufferedEvents(domainID string,
+	bufferedEvents *SerializedHistoryEventBatch) (*SerializedHistoryEventBatch, error) {
+	if d.encryptor == nil || bufferedEvents == nil {
+		return bufferedEvents, nil
+	}
+
+	data, encodingType, err := encryptHistoryData(d.encryptor, domainID, bufferedEvents.Data,
+		bufferedEvents.EncodingType)
+	if err != nil {
+		return nil, err
+	}
+	return NewSerializedHistoryEventBatch(data, encodingType, bufferedEvents.Version), nil
+}
+
 func (d *cassandraPersistence) updateBufferedReplicationTasks(batch *gocql.Batch, newBufferedReplicationTask *BufferedReplicationTask,
 	deleteInfo *int64, domainID, workflowID, runID string, condition int64, rangeID int64) {
 
@@ -3072,6 +3371,32 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *WorkflowExecuti
 			info.ClientFeatureVersion = v.(string)
 		case "client_impl":
 			info.ClientImpl = v.(string)
+		case "attempt":
+			info.Attempt = int32(v.(int))
+		case "has_retry_policy":
+			info.HasRetryPolicy = v.(bool)
+		case "init_interval":
+			info.InitialInterval = int32(v.(int))
+		case "backoff_coefficient":
+			info.BackoffCoefficient = v.(float64)
+		case "max_interval":
+			info.MaximumInterval = int32(v.(int))
+		case "expiration_time":
+			info.ExpirationTime = v.(time.Time)
+		case "max_attempts":
+			info.MaximumAttempts = int32(v.(int))
+		case "non_retriable_errors":
+			info.NonRetriableErrors = v.([]string)
+		case "paused":
+			info.Paused = v.(bool)
+		case "signal_count":
+			info.SignalCount = v.(int64)
+		case "initiator":
+			info.Initiator = v.(int)
+		case "decision_backoff":
+			info.DecisionBackoffSeconds = int32(v.(int))
+		case "buffered_signal_count":
+			info.BufferedSignalCount = v.(int64)
 		}
 	}
 
@@ -3137,6 +3462,8 @@ func createTransferTaskInfo(result map[string]interface{}) *TransferTaskInfo {
 			info.ScheduleID = v.(int64)
 		case "version":
 			info.Version = v.(int64)
+		case "record_visibility":
+			info.RecordVisibility = v.(bool)
 		}
 	}
 
@@ -3235,6 +3562,8 @@ func createActivityInfo(result map[string]interface{}) *ActivityInfo {
 			info.ExpirationTime = v.(time.Time)
 		case "non_retriable_errors":
 			info.NonRetriableErrors = v.([]string)
+		case "non_retriable_error_types":
+			info.NonRetriableErrorTypes = v.([]string)
 		}
 	}
 
@@ -3355,7 +3684,7 @@ func resetActivityInfoMap(activityInfos []*ActivityInfo) map[int64]map[string]in
 		aInfo["started_time"] = a.StartedTime
 		aInfo["activity_id"] = a.ActivityID
 		aInfo["request_id"] = a.RequestID
-		aInfo["details"] = a.Details
+		aInfo["details"] = nil
 		aInfo["schedule_to_start_timeout"] = a.ScheduleToStartTimeout
 		aInfo["schedule_to_close_timeout"] = a.ScheduleToCloseTimeout
 		aInfo["start_to_close_timeout"] = a.StartToCloseTimeout
@@ -3374,6 +3703,7 @@ func resetActivityInfoMap(activityInfos []*ActivityInfo) map[int64]map[string]in
 		aInfo["expiration_time"] = a.ExpirationTime
 		aInfo["max_attempts"] = a.MaximumAttempts
 		aInfo["non_retriable_errors"] = a.NonRetriableErrors
+		aInfo["non_retriable_error_types"] = a.NonRetriableErrorTypes
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -3381,6 +3711,17 @@ func resetActivityInfoMap(activityInfos []*ActivityInfo) map[int64]map[string]in
 	return aMap
 }
 
+func resetActivityDetailsMap(activityInfos []*ActivityInfo) map[int64][]byte {
+	dMap := make(map[int64][]byte)
+	for _, a := range activityInfos {
+		if a.Details != nil {
+			dMap[a.ScheduleID] = a.Details
+		}
+	}
+
+	return dMap
+}
+
 func resetTimerInfoMap(timerInfos []*TimerInfo) map[string]map[string]interface{} {
 	tMap := make(map[string]map[string]interface{})
 	for _, t := range timerInfos {
@@ -3454,6 +3795,10 @@ func createSerializedHistoryEventBatch(result map[string]interface{}) *Serialize
 			eventBatch.Version = v.(int)
 		case "data":
 			eventBatch.Data = v.([]byte)
+		case "encoding_type":
+			if encodingType := v.(string); encodingType != "" {
+				eventBatch.EncodingType = common.EncodingType(encodingType)
+			}
 		}
 	}
 
@@ -3530,10 +3875,22 @@ func createReplicationInfoMap(info *ReplicationInfo) map[string]interface{} {
 	return rInfoMap
 }
 
+// newOperationContext returns the context bounding a single cassandra request, a zero timeout leaves the request
+// bounded only by the connection timeout
+func newOperationContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.Background(), func() {}
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
+
 func isTimeoutError(err error) bool {
 	if err == gocql.ErrTimeoutNoResponse {
 		return true
 	}
+	if err == context.DeadlineExceeded {
+		return true
+	}
 	if err == gocql.ErrConnectionClosed {
 		return true
 	}
@@ -3569,6 +3926,9 @@ func GetVisibilityTSFrom(task Task) time.Time {
 
 	case TaskTypeRetryTimer:
 		return task.(*RetryTimerTask).VisibilityTimestamp
+
+	case TaskTypeWorkflowBackoffTimer:
+		return task.(*WorkflowBackoffTimerTask).VisibilityTimestamp
 	}
 	return time.Time{}
 }
@@ -3593,5 +3953,8 @@ func SetVisibilityTSFrom(task Task, t time.Time) {
 
 	case TaskTypeRetryTimer:
 		task.(*RetryTimerTask).VisibilityTimestamp = t
+
+	case TaskTypeWorkflowBackoffTimer:
+		task.(*WorkflowBackoffTimerTask).VisibilityTimestamp = t
 	}
 }
@@ -25,11 +25,14 @@ import (
 	"github.com/uber-common/bark"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
 )
 
 type (
 	cassandraPersistenceClientFactory struct {
 		session       *gocql.Session
+		timeouts      config.CassandraTimeouts
+		encryptor     PayloadEncryptor
 		metricsClient metrics.Client
 		logger        bark.Logger
 	}
@@ -37,7 +40,8 @@ type (
 
 // NewCassandraPersistenceClientFactory is used to create an instance of ExecutionManagerFactory implementation
 func NewCassandraPersistenceClientFactory(hosts string, port int, user, password, dc string, keyspace string,
-	numConns int, logger bark.Logger, metricsClient metrics.Client) (ExecutionManagerFactory, error) {
+	numConns int, timeouts config.CassandraTimeouts, encryptor PayloadEncryptor, logger bark.Logger,
+	metricsClient metrics.Client) (ExecutionManagerFactory, error) {
 	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
 	cluster.Keyspace = keyspace
 	cluster.ProtoVersion = cassandraProtoVersion
@@ -51,12 +55,13 @@ func NewCassandraPersistenceClientFactory(hosts string, port int, user, password
 		return nil, err
 	}
 
-	return &cassandraPersistenceClientFactory{session: session, logger: logger, metricsClient: metricsClient}, nil
+	return &cassandraPersistenceClientFactory{session: session, timeouts: timeouts, encryptor: encryptor,
+		logger: logger, metricsClient: metricsClient}, nil
 }
 
 // CreateExecutionManager implements ExecutionManagerFactory interface
 func (f *cassandraPersistenceClientFactory) CreateExecutionManager(shardID int) (ExecutionManager, error) {
-	mgr, err := NewCassandraWorkflowExecutionPersistence(shardID, f.session, f.logger)
+	mgr, err := NewCassandraWorkflowExecutionPersistence(shardID, f.session, f.timeouts, f.encryptor, f.logger)
 
 	if err != nil {
 		return nil, err
@@ -30,9 +30,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/uber-common/bark"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/service/config"
 )
 
 type (
@@ -193,6 +195,11 @@ func (s *cassandraPersistenceSuite) TestUpdateWorkflow() {
 	s.Empty(info0.ClientLibraryVersion)
 	s.Empty(info0.ClientFeatureVersion)
 	s.Empty(info0.ClientImpl)
+	s.Equal(int32(0), info0.Attempt)
+	s.False(info0.HasRetryPolicy)
+	s.Empty(info0.NonRetriableErrors)
+	s.Equal(WorkflowInitiatorUnknown, info0.Initiator)
+	s.Equal(int32(0), info0.DecisionBackoffSeconds)
 
 	log.Infof("Workflow execution last updated: %v", info0.LastUpdatedTimestamp)
 
@@ -208,6 +215,19 @@ func (s *cassandraPersistenceSuite) TestUpdateWorkflow() {
 	updatedInfo.ClientLibraryVersion = "random client library version"
 	updatedInfo.ClientFeatureVersion = "random client feature version"
 	updatedInfo.ClientImpl = "random client impl"
+	updatedInfo.Attempt = 2
+	updatedInfo.HasRetryPolicy = true
+	updatedInfo.InitialInterval = 10
+	updatedInfo.BackoffCoefficient = 1.5
+	updatedInfo.MaximumInterval = 100
+	updatedInfo.ExpirationTime = timestampConvertor(time.Now().Add(time.Hour))
+	updatedInfo.MaximumAttempts = 5
+	updatedInfo.NonRetriableErrors = []string{"bad-input"}
+	updatedInfo.Paused = true
+	updatedInfo.SignalCount = 7
+	updatedInfo.Initiator = WorkflowInitiatorRetryPolicy
+	updatedInfo.DecisionBackoffSeconds = 10
+	updatedInfo.BufferedSignalCount = 3
 	err2 := s.UpdateWorkflowExecution(updatedInfo, []int64{int64(4)}, nil, int64(3), nil, nil, nil, nil, nil, nil)
 	s.Nil(err2, "No error expected.")
 
@@ -239,6 +259,19 @@ func (s *cassandraPersistenceSuite) TestUpdateWorkflow() {
 	s.Equal(updatedInfo.ClientLibraryVersion, info1.ClientLibraryVersion)
 	s.Equal(updatedInfo.ClientFeatureVersion, info1.ClientFeatureVersion)
 	s.Equal(updatedInfo.ClientImpl, info1.ClientImpl)
+	s.Equal(updatedInfo.Attempt, info1.Attempt)
+	s.True(info1.HasRetryPolicy)
+	s.Equal(updatedInfo.InitialInterval, info1.InitialInterval)
+	s.Equal(updatedInfo.BackoffCoefficient, info1.BackoffCoefficient)
+	s.Equal(updatedInfo.MaximumInterval, info1.MaximumInterval)
+	s.Equal(updatedInfo.ExpirationTime.Unix(), info1.ExpirationTime.Unix())
+	s.Equal(updatedInfo.MaximumAttempts, info1.MaximumAttempts)
+	s.Equal(updatedInfo.NonRetriableErrors, info1.NonRetriableErrors)
+	s.True(info1.Paused)
+	s.Equal(int64(7), info1.SignalCount)
+	s.Equal(WorkflowInitiatorRetryPolicy, info1.Initiator)
+	s.Equal(int32(10), info1.DecisionBackoffSeconds)
+	s.Equal(int64(3), info1.BufferedSignalCount)
 
 	log.Infof("Workflow execution last updated: %v", info1.LastUpdatedTimestamp)
 
@@ -394,6 +427,48 @@ func (s *cassandraPersistenceSuite) TestGetCurrentWorkflow() {
 	s.Empty(task1, "Expected empty task identifier.")
 }
 
+func (s *cassandraPersistenceSuite) TestCreateWorkflowExecution_SkipCurrentExecution() {
+	domainID := "0f4b4a2b-7c0f-4e4c-9c6e-9f0d3c2b6d1a"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("skip-current-execution-test"),
+		RunId:      common.StringPtr("1b2d1f4e-4c4f-4a49-9d5f-6a2f0c9e3b7d"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	workflowExecution2 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("skip-current-execution-test"),
+		RunId:      common.StringPtr("8e6c5a1d-2f3b-4d7e-a1c9-0b4e7f2d5c8a"),
+	}
+	_, err1 := s.WorkflowMgr.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{
+		RequestID:            uuid.New(),
+		DomainID:             domainID,
+		Execution:            workflowExecution2,
+		TaskList:             "queue1",
+		WorkflowTypeName:     "wType",
+		WorkflowTimeout:      20,
+		DecisionTimeoutValue: 13,
+		NextEventID:          3,
+		LastProcessedEvent:   0,
+		RangeID:              s.ShardInfo.RangeID,
+		DecisionScheduleID:   common.EmptyEventID,
+		DecisionStartedID:    common.EmptyEventID,
+		SkipCurrentExecution: true,
+	})
+	s.Nil(err1, "No error expected.")
+
+	runID, err2 := s.GetCurrentWorkflowRunID(domainID, *workflowExecution.WorkflowId)
+	s.Nil(err2, "No error expected.")
+	s.Equal(*workflowExecution.RunId, runID)
+
+	info, err3 := s.GetWorkflowExecutionInfo(domainID, workflowExecution2)
+	s.Nil(err3, "No error expected.")
+	s.Equal(*workflowExecution2.RunId, info.ExecutionInfo.RunID)
+	s.Equal(int64(3), info.ExecutionInfo.NextEventID)
+}
+
 func (s *cassandraPersistenceSuite) TestTransferTasksThroughUpdate() {
 	domainID := "b785a8ba-bd7d-4760-bb05-41b115f3e10a"
 	workflowExecution := gen.WorkflowExecution{
@@ -797,6 +872,35 @@ func (s *cassandraPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.Error(err)
 }
 
+func (s *cassandraPersistenceSuite) TestLeaseAndUpdateTaskList_Paused() {
+	domainID := uuid.New()
+	taskList := "paused-tasklist"
+	response, err := s.TaskMgr.LeaseTaskList(&LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: TaskListTypeActivity,
+	})
+	s.NoError(err)
+	tli := response.TaskListInfo
+	s.False(tli.Paused)
+
+	tli.Paused = true
+	_, err = s.TaskMgr.UpdateTaskList(&UpdateTaskListRequest{
+		TaskListInfo: tli,
+	})
+	s.NoError(err)
+
+	// paused state has to survive the lease of a new range
+	response, err = s.TaskMgr.LeaseTaskList(&LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.EqualValues(2, response.TaskListInfo.RangeID)
+	s.True(response.TaskListInfo.Paused)
+}
+
 func (s *cassandraPersistenceSuite) TestLeaseAndUpdateTaskList_Sticky() {
 	domainID := uuid.New()
 	taskList := "aaaaaaa"
@@ -869,7 +973,7 @@ func (s *cassandraPersistenceSuite) TestTransferTasks() {
 	currentTransferID := s.GetTransferReadLevel()
 	tasks := []Task{
 		&ActivityTask{currentTransferID + 10001, domainID, tasklist, scheduleID, 111},
-		&DecisionTask{currentTransferID + 10002, domainID, tasklist, scheduleID, 222},
+		&DecisionTask{currentTransferID + 10002, domainID, tasklist, scheduleID, 222, true},
 		&CloseExecutionTask{currentTransferID + 10003, 333},
 		&CancelExecutionTask{currentTransferID + 10004, targetDomainID, targetWorkflowID, targetRunID, true, scheduleID, 444},
 		&SignalExecutionTask{currentTransferID + 10005, targetDomainID, targetWorkflowID, targetRunID, true, scheduleID, 555},
@@ -894,6 +998,8 @@ func (s *cassandraPersistenceSuite) TestTransferTasks() {
 	s.Equal(int64(444), txTasks[3].Version)
 	s.Equal(int64(555), txTasks[4].Version)
 	s.Equal(int64(666), txTasks[5].Version)
+	s.False(txTasks[0].RecordVisibility)
+	s.True(txTasks[1].RecordVisibility)
 
 	err2 = s.CompleteTransferTask(txTasks[0].TaskID)
 	s.Nil(err2, "No error expected.")
@@ -1051,6 +1157,124 @@ func (s *cassandraPersistenceSuite) TestWorkflowMutableState_Activities() {
 	s.Equal(0, len(state.ActivitInfos))
 }
 
+func (s *cassandraPersistenceSuite) TestWorkflowMutableState_ActivityDetails() {
+	domainID := "3b7f5e1e-9e0c-4b52-a3d5-0c0f1c7a8e21"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-workflow-mutable-activity-details-test"),
+		RunId:      common.StringPtr("bbbbbbbb-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
+	}
+
+	_, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "taskList", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+
+	updatedInfo := copyWorkflowExecutionInfo(state0.ExecutionInfo)
+	updatedInfo.NextEventID = int64(5)
+	updatedInfo.LastProcessedEvent = int64(2)
+	activityInfo := &ActivityInfo{
+		ScheduleID:     1,
+		StartedID:      2,
+		Details:        []byte("heartbeat_details_1"),
+		DetailsUpdated: true,
+	}
+	err2 := s.UpdateWorkflowExecution(updatedInfo, []int64{int64(4)}, nil, int64(3), nil, nil,
+		[]*ActivityInfo{activityInfo}, nil, nil, nil)
+	s.Nil(err2, "No error expected.")
+
+	state, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.Equal([]byte("heartbeat_details_1"), state.ActivitInfos[1].Details)
+	s.False(state.ActivitInfos[1].DetailsUpdated)
+
+	// details which did not change are not written again
+	activityInfo.TimerTaskStatus = 1
+	activityInfo.Details = []byte("not_written")
+	activityInfo.DetailsUpdated = false
+	err2 = s.UpdateWorkflowExecution(updatedInfo, nil, nil, int64(5), nil, nil,
+		[]*ActivityInfo{activityInfo}, nil, nil, nil)
+	s.Nil(err2, "No error expected.")
+
+	state, err1 = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.Equal(int32(1), state.ActivitInfos[1].TimerTaskStatus)
+	s.Equal([]byte("heartbeat_details_1"), state.ActivitInfos[1].Details)
+
+	err2 = s.UpdateWorkflowExecution(updatedInfo, nil, nil, int64(5), nil, nil, nil, []int64{1}, nil, nil)
+	s.Nil(err2, "No error expected.")
+
+	state, err1 = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.Equal(0, len(state.ActivitInfos))
+}
+
+func (s *cassandraPersistenceSuite) TestWorkflowMutableState_EncryptedActivityDetails() {
+	encryptor, err := NewPayloadEncryptorFromKey(testPayloadEncryptionKey)
+	s.Nil(err)
+	encryptedMgrFactory, err := NewCassandraPersistenceClientFactory(testWorkflowClusterHosts, testPort, testUser,
+		testPassword, testDatacenter, s.CassandraTestCluster.keyspace, 2, config.CassandraTimeouts{}, encryptor,
+		bark.NewLoggerFromLogrus(log.New()), nil)
+	s.Nil(err)
+	defer encryptedMgrFactory.Close()
+	encryptedMgr, err := encryptedMgrFactory.CreateExecutionManager(s.ShardInfo.ShardID)
+	s.Nil(err)
+
+	domainID := "6d1f4a3c-2b7e-4f58-9a0d-3e5c7b9f1a24"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-workflow-mutable-encrypted-activity-details-test"),
+		RunId:      common.StringPtr("cccccccc-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
+	}
+
+	_, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "taskList", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+
+	// the details of the first activity are written before encryption is turned on
+	updatedInfo := copyWorkflowExecutionInfo(state0.ExecutionInfo)
+	updatedInfo.NextEventID = int64(5)
+	updatedInfo.LastProcessedEvent = int64(2)
+	plainActivityInfo := &ActivityInfo{
+		ScheduleID:     1,
+		StartedID:      2,
+		Details:        []byte("plain_details"),
+		DetailsUpdated: true,
+	}
+	err2 := s.UpdateWorkflowExecution(updatedInfo, nil, nil, int64(3), nil, nil,
+		[]*ActivityInfo{plainActivityInfo}, nil, nil, nil)
+	s.Nil(err2, "No error expected.")
+
+	// the first activity is upserted along with the second one, its details are neither written nor encrypted
+	plainActivityInfo.DetailsUpdated = false
+	encryptedActivityInfo := &ActivityInfo{
+		ScheduleID:     3,
+		StartedID:      4,
+		Details:        []byte("encrypted_details"),
+		DetailsUpdated: true,
+	}
+	err2 = encryptedMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo:       updatedInfo,
+		Condition:           int64(5),
+		RangeID:             s.ShardInfo.RangeID,
+		UpsertActivityInfos: []*ActivityInfo{plainActivityInfo, encryptedActivityInfo},
+	})
+	s.Nil(err2, "No error expected.")
+
+	response, err3 := encryptedMgr.GetWorkflowExecution(&GetWorkflowExecutionRequest{
+		DomainID:  domainID,
+		Execution: workflowExecution,
+	})
+	s.Nil(err3, "No error expected.")
+	s.Equal([]byte("plain_details"), response.State.ActivitInfos[1].Details)
+	s.Equal([]byte("encrypted_details"), response.State.ActivitInfos[3].Details)
+
+	// the encrypted details cannot be read without the key
+	_, err3 = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NotNil(err3)
+}
+
 func (s *cassandraPersistenceSuite) TestWorkflowMutableState_Timers() {
 	domainID := "025d178a-709b-4c07-8dd7-86dbf9bd2e06"
 	workflowExecution := gen.WorkflowExecution{
@@ -0,0 +1,160 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	// the schema_version table is written by the schema tool in each keyspace it manages
+	templateGetSchemaVersionQuery = `SELECT curr_version, creation_time ` +
+		`FROM %v.schema_version ` +
+		`WHERE keyspace_name = ?`
+)
+
+type (
+	// SchemaVersionReporter periodically reports the schema version of each keyspace as a gauge of value 1 tagged
+	// with the version, along with the time the keyspace was last migrated, so that dashboards can show which
+	// clusters lag behind on schema
+	SchemaVersionReporter struct {
+		session        *gocql.Session
+		keyspaces      []string
+		scope          tally.Scope
+		reportInterval time.Duration
+		logger         bark.Logger
+		shutdownCh     chan struct{}
+		shutdownWG     sync.WaitGroup
+		// readSchemaVersion returns the version of the keyspace and the time it was last migrated
+		readSchemaVersion func(keyspace string) (string, time.Time, error)
+		// last reported version by keyspace, reset to 0 once the keyspace is migrated to another version
+		versions map[string]string
+	}
+)
+
+// NewCassandraSchemaVersionReporter creates a reporter of the schema version of the given cassandra keyspaces
+func NewCassandraSchemaVersionReporter(hosts string, port int, user, password, dc string, keyspaces []string,
+	scope tally.Scope, reportInterval time.Duration, logger bark.Logger) (*SchemaVersionReporter, error) {
+	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = gocql.LocalQuorum
+	cluster.Timeout = defaultSessionTimeout
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+
+	r := newSchemaVersionReporter(keyspaces, scope, reportInterval, logger)
+	r.session = session
+	r.readSchemaVersion = r.readCassandraSchemaVersion
+	return r, nil
+}
+
+func newSchemaVersionReporter(keyspaces []string, scope tally.Scope, reportInterval time.Duration,
+	logger bark.Logger) *SchemaVersionReporter {
+	return &SchemaVersionReporter{
+		keyspaces:      keyspaces,
+		scope:          scope,
+		reportInterval: reportInterval,
+		logger:         logger,
+		shutdownCh:     make(chan struct{}),
+		versions:       make(map[string]string),
+	}
+}
+
+// Start reports the schema versions and keeps reporting them periodically until stopped
+func (r *SchemaVersionReporter) Start() {
+	r.report()
+	r.shutdownWG.Add(1)
+	go r.reportLoop()
+}
+
+// Stop stops reporting and releases the cassandra session
+func (r *SchemaVersionReporter) Stop() {
+	close(r.shutdownCh)
+	r.shutdownWG.Wait()
+	if r.session != nil {
+		r.session.Close()
+	}
+}
+
+func (r *SchemaVersionReporter) reportLoop() {
+	defer r.shutdownWG.Done()
+
+	ticker := time.NewTicker(r.reportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.report()
+		case <-r.shutdownCh:
+			return
+		}
+	}
+}
+
+func (r *SchemaVersionReporter) report() {
+	for _, keyspace := range r.keyspaces {
+		version, migrationTime, err := r.readSchemaVersion(keyspace)
+		if err != nil {
+			r.logger.WithFields(bark.Fields{
+				logging.TagErr: err,
+			}).Warnf("Failed to read the schema version of keyspace %v.", keyspace)
+			continue
+		}
+
+		if previous, ok := r.versions[keyspace]; ok && previous != version {
+			r.versionGauge(keyspace, previous).Update(0)
+		}
+		r.versions[keyspace] = version
+		r.versionGauge(keyspace, version).Update(1)
+		r.scope.Tagged(map[string]string{metrics.KeyspaceTagName: keyspace}).
+			Gauge(metrics.SchemaLastMigrationTimeGauge).Update(float64(migrationTime.Unix()))
+	}
+}
+
+func (r *SchemaVersionReporter) versionGauge(keyspace string, version string) tally.Gauge {
+	return r.scope.Tagged(map[string]string{
+		metrics.KeyspaceTagName:      keyspace,
+		metrics.SchemaVersionTagName: version,
+	}).Gauge(metrics.SchemaVersionGauge)
+}
+
+func (r *SchemaVersionReporter) readCassandraSchemaVersion(keyspace string) (string, time.Time, error) {
+	var version string
+	var migrationTime time.Time
+	query := r.session.Query(fmt.Sprintf(templateGetSchemaVersionQuery, keyspace), keyspace)
+	if err := query.Scan(&version, &migrationTime); err != nil {
+		return "", time.Time{}, err
+	}
+	return version, migrationTime, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	schemaVersionReporterSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestSchemaVersionReporterSuite(t *testing.T) {
+	s := new(schemaVersionReporterSuite)
+	suite.Run(t, s)
+}
+
+func (s *schemaVersionReporterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *schemaVersionReporterSuite) TestReport() {
+	migrationTime := time.Date(2018, 6, 3, 10, 0, 0, 0, time.UTC)
+	versions := map[string]string{"cadence": "0.3", "cadence_visibility": "0.1"}
+
+	scope := tally.NewTestScope("", nil)
+	reporter := newSchemaVersionReporter([]string{"cadence", "cadence_visibility", "missing"}, scope, time.Minute,
+		bark.NewLoggerFromLogrus(logrus.New()))
+	reporter.readSchemaVersion = func(keyspace string) (string, time.Time, error) {
+		version, ok := versions[keyspace]
+		if !ok {
+			return "", time.Time{}, errors.New("unconfigured table schema_version")
+		}
+		return version, migrationTime, nil
+	}
+
+	reporter.report()
+	s.Equal(map[string]float64{"cadence/0.3": 1, "cadence_visibility/0.1": 1}, s.versionGauges(scope))
+	s.Equal(map[string]float64{
+		"cadence":            float64(migrationTime.Unix()),
+		"cadence_visibility": float64(migrationTime.Unix()),
+	}, s.migrationTimeGauges(scope))
+
+	versions["cadence"] = "0.4"
+	reporter.report()
+	s.Equal(map[string]float64{"cadence/0.3": 0, "cadence/0.4": 1, "cadence_visibility/0.1": 1},
+		s.versionGauges(scope))
+}
+
+func (s *schemaVersionReporterSuite) versionGauges(scope tally.TestScope) map[string]float64 {
+	values := make(map[string]float64)
+	for _, gauge := range scope.Snapshot().Gauges() {
+		if gauge.Name() == metrics.SchemaVersionGauge {
+			tags := gauge.Tags()
+			values[tags[metrics.KeyspaceTagName]+"/"+tags[metrics.SchemaVersionTagName]] = gauge.Value()
+		}
+	}
+	return values
+}
+
+func (s *schemaVersionReporterSuite) migrationTimeGauges(scope tally.TestScope) map[string]float64 {
+	values := make(map[string]float64)
+	for _, gauge := range scope.Snapshot().Gauges() {
+		if gauge.Name() == metrics.SchemaLastMigrationTimeGauge {
+			values[gauge.Tags()[metrics.KeyspaceTagName]] = gauge.Value()
+		}
+	}
+	return values
+}
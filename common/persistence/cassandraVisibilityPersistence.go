@@ -29,6 +29,7 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/config"
 )
 
 // Fixed domain values for now
@@ -40,8 +41,8 @@ const (
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO open_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name) ` +
-		`VALUES (?, ?, ?, ?, ?, ?) using TTL ?`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name, initiator) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?) using TTL ?`
 
 	templateDeleteWorkflowExecutionStarted = `DELETE FROM open_executions ` +
 		`WHERE domain_id = ? ` +
@@ -49,25 +50,31 @@ const (
 		`AND start_time = ? ` +
 		`AND run_id = ?`
 
+	templateDeleteWorkflowExecutionClosed = `DELETE FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time = ? ` +
+		`AND run_id = ?`
+
 	templateCreateWorkflowExecutionClosed = `INSERT INTO closed_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, initiator) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
 
-	templateGetOpenWorkflowExecutions = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutions = `SELECT workflow_id, run_id, start_time, workflow_type_name, initiator ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition IN (?) ` +
 		`AND start_time >= ? ` +
 		`AND start_time <= ? `
 
-	templateGetClosedWorkflowExecutions = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutions = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, initiator ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition IN (?) ` +
 		`AND start_time >= ? ` +
 		`AND start_time <= ? `
 
-	templateGetOpenWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, workflow_type_name, initiator ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -75,7 +82,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_type_name = ? `
 
-	templateGetClosedWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, initiator ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -83,7 +90,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_type_name = ? `
 
-	templateGetOpenWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, workflow_type_name, initiator ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -91,7 +98,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_id = ? `
 
-	templateGetClosedWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, initiator ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -99,7 +106,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_id = ? `
 
-	templateGetClosedWorkflowExecutionsByStatus = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutionsByStatus = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, initiator ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -107,7 +114,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND status = ? `
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, initiator ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -119,13 +126,15 @@ type (
 	cassandraVisibilityPersistence struct {
 		session      *gocql.Session
 		lowConslevel gocql.Consistency
+		timeouts     config.CassandraTimeouts
 		logger       bark.Logger
 	}
 )
 
 // NewCassandraVisibilityPersistence is used to create an instance of VisibilityManager implementation
 func NewCassandraVisibilityPersistence(
-	hosts string, port int, user, password, dc string, keyspace string, logger bark.Logger) (VisibilityManager, error) {
+	hosts string, port int, user, password, dc string, keyspace string, timeouts config.CassandraTimeouts,
+	logger bark.Logger) (VisibilityManager, error) {
 	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
 	cluster.Keyspace = keyspace
 	cluster.ProtoVersion = cassandraProtoVersion
@@ -138,7 +147,8 @@ func NewCassandraVisibilityPersistence(
 		return nil, err
 	}
 
-	return &cassandraVisibilityPersistence{session: session, lowConslevel: gocql.One, logger: logger}, nil
+	return &cassandraVisibilityPersistence{session: session, lowConslevel: gocql.One, timeouts: timeouts,
+		logger: logger}, nil
 }
 
 // Close releases the resources held by this object
@@ -151,6 +161,8 @@ func (v *cassandraVisibilityPersistence) Close() {
 func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 	request *RecordWorkflowExecutionStartedRequest) error {
 	ttl := request.WorkflowTimeout + openExecutionTTLBuffer
+	ctx, cancel := newOperationContext(v.timeouts.Write)
+	defer cancel()
 	query := v.session.Query(templateCreateWorkflowExecutionStarted,
 		request.DomainUUID,
 		domainPartition,
@@ -158,8 +170,9 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 		*request.Execution.RunId,
 		common.UnixNanoToCQLTimestamp(request.StartTimestamp),
 		request.WorkflowTypeName,
+		request.Initiator,
 		ttl,
-	)
+	).WithContext(ctx)
 	query = query.WithTimestamp(common.UnixNanoToCQLTimestamp(request.StartTimestamp))
 	err := query.Exec()
 	if err != nil {
@@ -178,7 +191,9 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 
 func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 	request *RecordWorkflowExecutionClosedRequest) error {
-	batch := v.session.NewBatch(gocql.LoggedBatch)
+	ctx, cancel := newOperationContext(v.timeouts.Write)
+	defer cancel()
+	batch := v.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 
 	// First, remove execution from the open table
 	batch.Query(templateDeleteWorkflowExecutionStarted,
@@ -206,6 +221,7 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 		request.WorkflowTypeName,
 		request.Status,
 		request.HistoryLength,
+		request.Initiator,
 		retention,
 	)
 
@@ -226,11 +242,13 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 
 func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(
 	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	ctx, cancel := newOperationContext(v.timeouts.List)
+	defer cancel()
 	query := v.session.Query(templateGetOpenWorkflowExecutions,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
-		common.UnixNanoToCQLTimestamp(request.LatestStartTime)).Consistency(v.lowConslevel)
+		common.UnixNanoToCQLTimestamp(request.LatestStartTime)).WithContext(ctx).Consistency(v.lowConslevel)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -266,11 +284,13 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutions(
 	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	ctx, cancel := newOperationContext(v.timeouts.List)
+	defer cancel()
 	query := v.session.Query(templateGetClosedWorkflowExecutions,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
-		common.UnixNanoToCQLTimestamp(request.LatestStartTime)).Consistency(v.lowConslevel)
+		common.UnixNanoToCQLTimestamp(request.LatestStartTime)).WithContext(ctx).Consistency(v.lowConslevel)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -306,12 +326,14 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutions(
 
 func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByType(
 	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	ctx, cancel := newOperationContext(v.timeouts.List)
+	defer cancel()
 	query := v.session.Query(templateGetOpenWorkflowExecutionsByType,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
-		request.WorkflowTypeName).Consistency(v.lowConslevel)
+		request.WorkflowTypeName).WithContext(ctx).Consistency(v.lowConslevel)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -347,12 +369,14 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByType(
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByType(
 	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	ctx, cancel := newOperationContext(v.timeouts.List)
+	defer cancel()
 	query := v.session.Query(templateGetClosedWorkflowExecutionsByType,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
-		request.WorkflowTypeName).Consistency(v.lowConslevel)
+		request.WorkflowTypeName).WithContext(ctx).Consistency(v.lowConslevel)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -388,12 +412,14 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByType(
 
 func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
 	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	ctx, cancel := newOperationContext(v.timeouts.List)
+	defer cancel()
 	query := v.session.Query(templateGetOpenWorkflowExecutionsByID,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
-		request.WorkflowID).Consistency(v.lowConslevel)
+		request.WorkflowID).WithContext(ctx).Consistency(v.lowConslevel)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -429,12 +455,14 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowID(
 	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	ctx, cancel := newOperationContext(v.timeouts.List)
+	defer cancel()
 	query := v.session.Query(templateGetClosedWorkflowExecutionsByID,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
-		request.WorkflowID).Consistency(v.lowConslevel)
+		request.WorkflowID).WithContext(ctx).Consistency(v.lowConslevel)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -470,12 +498,14 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowI
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
 	request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	ctx, cancel := newOperationContext(v.timeouts.List)
+	defer cancel()
 	query := v.session.Query(templateGetClosedWorkflowExecutionsByStatus,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
-		request.Status).Consistency(v.lowConslevel)
+		request.Status).WithContext(ctx).Consistency(v.lowConslevel)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 	if iter == nil {
 		// TODO: should return a bad request error if the token is invalid
@@ -512,11 +542,13 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
 func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	execution := request.Execution
+	ctx, cancel := newOperationContext(v.timeouts.Read)
+	defer cancel()
 	query := v.session.Query(templateGetClosedWorkflowExecution,
 		request.DomainUUID,
 		domainPartition,
 		*execution.WorkflowId,
-		*execution.RunId)
+		*execution.RunId).WithContext(ctx)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -549,12 +581,45 @@ func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	}, nil
 }
 
+func (v *cassandraVisibilityPersistence) DeleteWorkflowExecution(
+	request *VisibilityDeleteWorkflowExecutionRequest) error {
+	ctx, cancel := newOperationContext(v.timeouts.Write)
+	defer cancel()
+	batch := v.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(templateDeleteWorkflowExecutionStarted,
+		request.DomainUUID,
+		domainPartition,
+		common.UnixNanoToCQLTimestamp(request.StartTimestamp),
+		request.RunID,
+	)
+	batch.Query(templateDeleteWorkflowExecutionClosed,
+		request.DomainUUID,
+		domainPartition,
+		common.UnixNanoToCQLTimestamp(request.StartTimestamp),
+		request.RunID,
+	)
+
+	err := v.session.ExecuteBatch(batch)
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExecutionInfo, bool) {
 	var workflowID string
 	var runID gocql.UUID
 	var typeName string
 	var startTime time.Time
-	if iter.Scan(&workflowID, &runID, &startTime, &typeName) {
+	var initiator *workflow.WorkflowExecutionInitiator
+	if iter.Scan(&workflowID, &runID, &startTime, &typeName, &initiator) {
 		execution := &workflow.WorkflowExecution{}
 		execution.WorkflowId = common.StringPtr(workflowID)
 		execution.RunId = common.StringPtr(runID.String())
@@ -566,6 +631,7 @@ func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExecut
 		record.Execution = execution
 		record.StartTime = common.Int64Ptr(startTime.UnixNano())
 		record.Type = wfType
+		record.Initiator = initiator
 		return record, true
 	}
 	return nil, false
@@ -579,7 +645,8 @@ func readClosedWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExec
 	var closeTime time.Time
 	var status workflow.WorkflowExecutionCloseStatus
 	var historyLength int64
-	if iter.Scan(&workflowID, &runID, &startTime, &closeTime, &typeName, &status, &historyLength) {
+	var initiator *workflow.WorkflowExecutionInitiator
+	if iter.Scan(&workflowID, &runID, &startTime, &closeTime, &typeName, &status, &historyLength, &initiator) {
 		execution := &workflow.WorkflowExecution{}
 		execution.WorkflowId = common.StringPtr(workflowID)
 		execution.RunId = common.StringPtr(runID.String())
@@ -594,6 +661,7 @@ func readClosedWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExec
 		record.Type = wfType
 		record.CloseStatus = &status
 		record.HistoryLength = common.Int64Ptr(historyLength)
+		record.Initiator = initiator
 		return record, true
 	}
 	return nil, false
@@ -80,6 +80,7 @@ func (s *visibilityPersistenceSuite) TestBasicVisibility() {
 		Execution:        workflowExecution,
 		WorkflowTypeName: "visibility-workflow",
 		StartTimestamp:   startTime,
+		Initiator:        gen.WorkflowExecutionInitiatorClient.Ptr(),
 	})
 	s.Nil(err0)
 
@@ -92,6 +93,7 @@ func (s *visibilityPersistenceSuite) TestBasicVisibility() {
 	s.Nil(err1)
 	s.Equal(1, len(resp.Executions))
 	s.Equal(workflowExecution.WorkflowId, resp.Executions[0].Execution.WorkflowId)
+	s.Equal(gen.WorkflowExecutionInitiatorClient, resp.Executions[0].GetInitiator())
 
 	err2 := s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
 		DomainUUID:       testDomainUUID,
@@ -99,6 +101,7 @@ func (s *visibilityPersistenceSuite) TestBasicVisibility() {
 		WorkflowTypeName: "visibility-workflow",
 		StartTimestamp:   startTime,
 		CloseTimestamp:   time.Now().UnixNano(),
+		Initiator:        gen.WorkflowExecutionInitiatorClient.Ptr(),
 	})
 	s.Nil(err2)
 
@@ -119,6 +122,7 @@ func (s *visibilityPersistenceSuite) TestBasicVisibility() {
 	})
 	s.Nil(err4)
 	s.Equal(1, len(resp.Executions))
+	s.Equal(gen.WorkflowExecutionInitiatorClient, resp.Executions[0].GetInitiator())
 }
 
 func (s *visibilityPersistenceSuite) TestVisibilityPagination() {
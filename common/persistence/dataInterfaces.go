@@ -35,6 +35,19 @@ const (
 	DomainStatusDeleted
 )
 
+// Operations recorded to the admin audit log
+const (
+	AuditOperationTerminateWorkflowExecution   = "TerminateWorkflowExecution"
+	AuditOperationUpdateDomainRetention        = "UpdateDomainRetention"
+	AuditOperationPauseWorkflowExecution       = "PauseWorkflowExecution"
+	AuditOperationResumeWorkflowExecution      = "ResumeWorkflowExecution"
+	AuditOperationNukeWorkflowExecution        = "NukeWorkflowExecution"
+	AuditOperationUpdateMaintenanceMode        = "UpdateMaintenanceMode"
+	AuditOperationBulkDeleteWorkflowExecutions = "BulkDeleteWorkflowExecutions"
+	AuditOperationDrainHistoryHost             = "DrainHistoryHost"
+	AuditOperationResetShardPlacement          = "ResetShardPlacement"
+)
+
 // Workflow execution states
 const (
 	WorkflowStateCreated = iota
@@ -53,6 +66,16 @@ const (
 	WorkflowCloseStatusTimedOut
 )
 
+// Initiators of workflow executions
+const (
+	// WorkflowInitiatorUnknown is used for runs started before the initiator was recorded
+	WorkflowInitiatorUnknown = iota
+	WorkflowInitiatorClient
+	WorkflowInitiatorParentWorkflow
+	WorkflowInitiatorContinueAsNew
+	WorkflowInitiatorRetryPolicy
+)
+
 // Types of task lists
 const (
 	TaskListTypeDecision = iota
@@ -89,12 +112,16 @@ const (
 	TaskTypeWorkflowTimeout
 	TaskTypeDeleteHistoryEvent
 	TaskTypeRetryTimer
+	TaskTypeWorkflowBackoffTimer
 )
 
 type (
 	// ConditionFailedError represents a failed conditional put
 	ConditionFailedError struct {
 		Msg string
+		// ActualNextEventID is the next event ID found in the store when the next event ID condition of a workflow
+		// execution update failed, 0 if unknown
+		ActualNextEventID int64
 	}
 
 	// ShardAlreadyExistError is returned when conditionally creating a shard fails
@@ -173,6 +200,25 @@ type (
 		ClientLibraryVersion         string
 		ClientFeatureVersion         string
 		ClientImpl                   string
+		// For retry
+		Attempt            int32
+		HasRetryPolicy     bool
+		InitialInterval    int32
+		BackoffCoefficient float64
+		MaximumInterval    int32
+		ExpirationTime     time.Time
+		MaximumAttempts    int32
+		NonRetriableErrors []string
+		// Set by operators to freeze the workflow, tasks are not dispatched and timers do not fire until resumed
+		Paused bool
+		// SignalCount is the number of signals received by the run, bounded by the max signals per execution
+		SignalCount int64
+		// Initiator records how the run was started, one of the WorkflowInitiator constants
+		Initiator int
+		// DecisionBackoffSeconds is the delay of the first decision of the run, scheduled by a backoff timer
+		DecisionBackoffSeconds int32
+		// BufferedSignalCount is the number of signals buffered behind the in-flight decision
+		BufferedSignalCount int64
 	}
 
 	// ReplicationState represents mutable state information for global domains.
@@ -199,6 +245,7 @@ type (
 		TaskType                int
 		ScheduleID              int64
 		Version                 int64
+		RecordVisibility        bool
 	}
 
 	// ReplicationTaskInfo describes the replication task created for replication of history events
@@ -236,6 +283,7 @@ type (
 		RangeID  int64
 		AckLevel int64
 		Kind     int
+		Paused   bool
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -273,6 +321,8 @@ type (
 		TaskList   string
 		ScheduleID int64
 		Version    int64
+		// RecordVisibility indicates the workflow started record should be written when the task is processed
+		RecordVisibility bool
 	}
 
 	// CloseExecutionTask identifies a transfer task for deletion of execution
@@ -305,6 +355,13 @@ type (
 		Version             int64
 	}
 
+	// WorkflowBackoffTimerTask to schedule the first decision of a workflow run started with a backoff
+	WorkflowBackoffTimerTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// CancelExecutionTask identifies a transfer task for cancel of execution
 	CancelExecutionTask struct {
 		TaskID                  int64
@@ -424,6 +481,11 @@ type (
 		ExpirationTime     time.Time
 		MaximumAttempts    int32
 		NonRetriableErrors []string
+		// NonRetriableErrorTypes are matched against the failure types reported with a failure
+		NonRetriableErrorTypes []string
+		// DetailsUpdated is set when Details changed since the activity was loaded or last written, the heartbeat
+		// details are stored apart from the activity info and only written when they change
+		DetailsUpdated bool
 	}
 
 	// TimerInfo details - metadata about user timer info.
@@ -518,6 +580,19 @@ type (
 		ContinueAsNew               bool
 		PreviousRunID               string
 		ReplicationState            *ReplicationState
+		// SkipCurrentExecution creates the run without making it the current run of the workflow ID
+		SkipCurrentExecution bool
+		// For retry
+		Attempt                int32
+		HasRetryPolicy         bool
+		InitialInterval        int32
+		BackoffCoefficient     float64
+		MaximumInterval        int32
+		ExpirationTime         time.Time
+		MaximumAttempts        int32
+		NonRetriableErrors     []string
+		Initiator              int
+		DecisionBackoffSeconds int32
 	}
 
 	// CreateWorkflowExecutionResponse is the response to CreateWorkflowExecutionRequest
@@ -606,6 +681,14 @@ type (
 		RunID      string
 	}
 
+	// DeleteCurrentWorkflowExecutionRequest is used to delete the current run of a workflow ID, the deletion fails
+	// with ConditionFailedError if RunID is not the current run anymore
+	DeleteCurrentWorkflowExecutionRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
 	// GetTransferTasksRequest is used to read tasks from the transfer task queue
 	GetTransferTasksRequest struct {
 		ReadLevel    int64
@@ -742,6 +825,12 @@ type (
 		TransactionID int64
 		Events        *SerializedHistoryEventBatch
 		Overwrite     bool
+		// EventCount is the number of events in the batch, it is not persisted and only used for statistics
+		EventCount int
+		// StoredEncodingType and StoredSize are set by the store once the batch is written, to the encoding and
+		// size the batch is stored with after compression and encryption, they are only used for statistics
+		StoredEncodingType common.EncodingType
+		StoredSize         int
 	}
 
 	// GetWorkflowExecutionHistoryRequest is used to retrieve history of a workflow execution
@@ -767,6 +856,21 @@ type (
 		NextPageToken []byte
 	}
 
+	// GetWorkflowExecutionHistoryBatchRequest is used to read the batch containing a single history event
+	GetWorkflowExecutionHistoryBatchRequest struct {
+		DomainID  string
+		Execution workflow.WorkflowExecution
+		// EventID of the event the batch has to contain
+		EventID int64
+	}
+
+	// GetWorkflowExecutionHistoryBatchResponse is the response to GetWorkflowExecutionHistoryBatchRequest
+	GetWorkflowExecutionHistoryBatchResponse struct {
+		// FirstEventID is the ID of the first event in the batch
+		FirstEventID int64
+		Events       SerializedHistoryEventBatch
+	}
+
 	// DeleteWorkflowExecutionHistoryRequest is used to delete workflow execution history
 	DeleteWorkflowExecutionHistoryRequest struct {
 		DomainID  string
@@ -852,6 +956,146 @@ type (
 		Name string
 	}
 
+	// ListDomainsRequest is used to page through all domains
+	ListDomainsRequest struct {
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListDomainsResponse is the response to ListDomains
+	ListDomainsResponse struct {
+		Domains       []*GetDomainResponse
+		NextPageToken []byte
+	}
+
+	// AddHistoryCountsRequest is used to add to the events and bytes appended to histories of a domain on a day
+	AddHistoryCountsRequest struct {
+		DomainID string
+		Day      time.Time
+		Events   int64
+		Bytes    int64
+	}
+
+	// GetHistoryCountsRequest is used to read the history counts of a domain within a range of days, inclusive
+	GetHistoryCountsRequest struct {
+		DomainID string
+		FromDay  time.Time
+		ToDay    time.Time
+	}
+
+	// GetHistoryCountsResponse is the response to GetHistoryCounts, counts are ordered by day ascending
+	GetHistoryCountsResponse struct {
+		Counts []*HistoryCount
+	}
+
+	// HistoryCount is the number of events and bytes appended to histories of a domain on a day
+	HistoryCount struct {
+		Day    time.Time
+		Events int64
+		Bytes  int64
+	}
+
+	// ListHistoryCountDomainsRequest is used to page through the domains with history counts
+	ListHistoryCountDomainsRequest struct {
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListHistoryCountDomainsResponse is the response to ListHistoryCountDomains
+	ListHistoryCountDomainsResponse struct {
+		DomainIDs     []string
+		NextPageToken []byte
+	}
+
+	// DomainStats contains the statistics of a domain for a day
+	DomainStats struct {
+		DomainID       string
+		Day            time.Time
+		OpenExecutions int64
+		// TotalBytesAppended is the running total of bytes appended to histories of the domain up to and including
+		// the day, it does not account for histories deleted after the retention period
+		TotalBytesAppended int64
+		EventsAppended     int64
+		BytesAppended      int64
+		LastUpdated        time.Time
+	}
+
+	// UpsertDomainStatsRequest is used to write the statistics of a domain for a day
+	UpsertDomainStatsRequest struct {
+		Stats *DomainStats
+	}
+
+	// GetDomainStatsRequest is used to read the statistics of a domain for the most recent days
+	GetDomainStatsRequest struct {
+		DomainID string
+		Days     int
+	}
+
+	// GetDomainStatsResponse is the response to GetDomainStats, stats are ordered by day descending
+	GetDomainStatsResponse struct {
+		Stats []*DomainStats
+	}
+
+	// AuditRecord is the record of a destructive operation invoked through an admin API or tool
+	AuditRecord struct {
+		ID        string
+		Timestamp time.Time
+		Operation string
+		Actor     string
+		Reason    string
+		// Keys identify the entities affected by the operation, like domain, workflow ID or table name
+		Keys map[string]string
+	}
+
+	// RecordAuditRequest is used to record a destructive admin operation, the ID and timestamp are assigned by
+	// persistence
+	RecordAuditRequest struct {
+		Record *AuditRecord
+	}
+
+	// ListAuditRecordsRequest is used to page through the audit records, most recent first
+	ListAuditRecordsRequest struct {
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListAuditRecordsResponse is the response to ListAuditRecords
+	ListAuditRecordsResponse struct {
+		Records       []*AuditRecord
+		NextPageToken []byte
+	}
+
+	// MaintenanceMode is the maintenance state of a cluster, while enabled the frontend rejects the APIs starting
+	// new work so that the persistence store can be maintained
+	MaintenanceMode struct {
+		Enabled bool
+		// Reason is announced to the callers of the rejected APIs
+		Reason string
+	}
+
+	// GetMaintenanceModeResponse is the response to GetMaintenanceMode, maintenance mode is disabled in a cluster
+	// which never enabled it
+	GetMaintenanceModeResponse struct {
+		MaintenanceMode *MaintenanceMode
+	}
+
+	// UpdateMaintenanceModeRequest is used to enable or disable maintenance mode in the current cluster
+	UpdateMaintenanceModeRequest struct {
+		MaintenanceMode *MaintenanceMode
+	}
+
+	// GetShardPlacementResponse is the response to GetShardPlacement
+	GetShardPlacementResponse struct {
+		// Overrides maps the ID of the history shards moved by the shard rebalancer to the address of the history
+		// host pinned to own them, the membership ring places the other shards
+		Overrides map[int]string
+	}
+
+	// UpdateShardPlacementRequest is used to pin history shards to a history host of the current cluster
+	UpdateShardPlacementRequest struct {
+		Overrides map[int]string
+	}
+
 	// Closeable is an interface for any entity that supports a close operation to release resources
 	Closeable interface {
 		Close()
@@ -873,6 +1117,7 @@ type (
 		UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error
 		ResetMutableState(request *ResetMutableStateRequest) error
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
+		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
 		CompleteTransferTask(request *CompleteTransferTaskRequest) error
@@ -909,6 +1154,10 @@ type (
 		// GetWorkflowExecutionHistory retrieves the paginated list of history events for given execution
 		GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse,
 			error)
+		// GetWorkflowExecutionHistoryBatch retrieves the single append transaction batch containing the given event,
+		// the batch may end before the event if the event does not exist
+		GetWorkflowExecutionHistoryBatch(request *GetWorkflowExecutionHistoryBatchRequest) (
+			*GetWorkflowExecutionHistoryBatchResponse, error)
 		DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error
 	}
 
@@ -920,6 +1169,34 @@ type (
 		UpdateDomain(request *UpdateDomainRequest) error
 		DeleteDomain(request *DeleteDomainRequest) error
 		DeleteDomainByName(request *DeleteDomainByNameRequest) error
+		ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error)
+	}
+
+	// DomainStatsManager is used to manage the history counts and statistics of domains
+	DomainStatsManager interface {
+		Closeable
+		AddHistoryCounts(request *AddHistoryCountsRequest) error
+		GetHistoryCounts(request *GetHistoryCountsRequest) (*GetHistoryCountsResponse, error)
+		ListHistoryCountDomains(request *ListHistoryCountDomainsRequest) (*ListHistoryCountDomainsResponse, error)
+		UpsertDomainStats(request *UpsertDomainStatsRequest) error
+		GetDomainStats(request *GetDomainStatsRequest) (*GetDomainStatsResponse, error)
+	}
+
+	// AuditManager is used to record and list destructive admin operations
+	AuditManager interface {
+		Closeable
+		RecordAudit(request *RecordAuditRequest) error
+		ListAuditRecords(request *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error)
+	}
+
+	// ClusterMetadataManager is used to manage the state shared by all the hosts of the current cluster
+	ClusterMetadataManager interface {
+		Closeable
+		GetMaintenanceMode() (*GetMaintenanceModeResponse, error)
+		UpdateMaintenanceMode(request *UpdateMaintenanceModeRequest) error
+		GetShardPlacement() (*GetShardPlacementResponse, error)
+		UpdateShardPlacement(request *UpdateShardPlacementRequest) error
+		ResetShardPlacement() error
 	}
 )
 
@@ -1218,6 +1495,41 @@ func (u *WorkflowTimeoutTask) SetVisibilityTimestamp(t time.Time) {
 	u.VisibilityTimestamp = t
 }
 
+// GetType returns the type of the workflow backoff timer task
+func (w *WorkflowBackoffTimerTask) GetType() int {
+	return TaskTypeWorkflowBackoffTimer
+}
+
+// GetVersion returns the version of the workflow backoff timer task
+func (w *WorkflowBackoffTimerTask) GetVersion() int64 {
+	return w.Version
+}
+
+// SetVersion returns the version of the workflow backoff timer task
+func (w *WorkflowBackoffTimerTask) SetVersion(version int64) {
+	w.Version = version
+}
+
+// GetTaskID returns the sequence ID.
+func (w *WorkflowBackoffTimerTask) GetTaskID() int64 {
+	return w.TaskID
+}
+
+// SetTaskID sets the sequence ID.
+func (w *WorkflowBackoffTimerTask) SetTaskID(id int64) {
+	w.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (w *WorkflowBackoffTimerTask) GetVisibilityTimestamp() time.Time {
+	return w.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp sets the visibility time stamp
+func (w *WorkflowBackoffTimerTask) SetVisibilityTimestamp(t time.Time) {
+	w.VisibilityTimestamp = t
+}
+
 // GetType returns the type of the cancel transfer task
 func (u *CancelExecutionTask) GetType() int {
 	return TransferTaskTypeCancelExecution
@@ -0,0 +1,85 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// HistoryCompressionNone leaves history event batches uncompressed
+	HistoryCompressionNone = ""
+	// HistoryCompressionSnappy compresses history event batches with snappy
+	HistoryCompressionSnappy = "snappy"
+
+	// the compression of a batch is appended to its encoding type, so that readers can tell compressed and
+	// uncompressed batches apart and the compression can be turned on or off at any time
+	compressionSeparator = "/"
+)
+
+// ValidateHistoryCompression returns an error if the compression is not supported
+func ValidateHistoryCompression(compression string) error {
+	switch compression {
+	case HistoryCompressionNone, HistoryCompressionSnappy:
+		return nil
+	default:
+		return fmt.Errorf("unsupported history compression: %v", compression)
+	}
+}
+
+// compressHistoryData compresses serialized history events, returning the encoding type to persist with them
+func compressHistoryData(compression string, data []byte, encodingType common.EncodingType) ([]byte,
+	common.EncodingType, error) {
+	switch compression {
+	case HistoryCompressionNone:
+		return data, encodingType, nil
+	case HistoryCompressionSnappy:
+		return snappy.Encode(nil, data), encodingType + compressionSeparator + HistoryCompressionSnappy, nil
+	default:
+		return nil, "", fmt.Errorf("unsupported history compression: %v", compression)
+	}
+}
+
+// decompressHistoryData reverts compressHistoryData, returning the events as they were serialized along with
+// their encoding type. Uncompressed events are returned as is.
+func decompressHistoryData(data []byte, encodingType common.EncodingType) ([]byte, common.EncodingType, error) {
+	index := strings.LastIndex(string(encodingType), compressionSeparator)
+	if index < 0 {
+		return data, encodingType, nil
+	}
+
+	compression := string(encodingType[index+len(compressionSeparator):])
+	encodingType = encodingType[:index]
+	switch compression {
+	case HistoryCompressionSnappy:
+		decoded, err := snappy.Decode(nil, data)
+		if err != nil {
+			return nil, "", err
+		}
+		return decoded, encodingType, nil
+	default:
+		return nil, "", fmt.Errorf("unsupported history compression: %v", compression)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	historyCompressionSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestHistoryCompressionSuite(t *testing.T) {
+	s := new(historyCompressionSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyCompressionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *historyCompressionSuite) TestValidate() {
+	s.NoError(ValidateHistoryCompression(HistoryCompressionNone))
+	s.NoError(ValidateHistoryCompression(HistoryCompressionSnappy))
+	s.Error(ValidateHistoryCompression("lz4"))
+}
+
+func (s *historyCompressionSuite) TestRoundTrip() {
+	data := []byte(`[{"eventId":1,"eventType":"WorkflowExecutionStarted"},{"eventId":2,"eventType":"DecisionTaskScheduled"}]`)
+
+	compressed, encodingType, err := compressHistoryData(HistoryCompressionSnappy, data, common.EncodingTypeJSON)
+	s.NoError(err)
+	s.Equal(common.EncodingType("json/snappy"), encodingType)
+	s.NotEqual(data, compressed)
+
+	decompressed, encodingType, err := decompressHistoryData(compressed, encodingType)
+	s.NoError(err)
+	s.Equal(common.EncodingTypeJSON, encodingType)
+	s.Equal(data, decompressed)
+}
+
+func (s *historyCompressionSuite) TestUncompressed() {
+	data := []byte(`[{"eventId":1}]`)
+
+	compressed, encodingType, err := compressHistoryData(HistoryCompressionNone, data, common.EncodingTypeJSON)
+	s.NoError(err)
+	s.Equal(common.EncodingTypeJSON, encodingType)
+	s.Equal(data, compressed)
+
+	// batches written before compression was turned on are read as is
+	decompressed, encodingType, err := decompressHistoryData(data, common.EncodingTypeJSON)
+	s.NoError(err)
+	s.Equal(common.EncodingTypeJSON, encodingType)
+	s.Equal(data, decompressed)
+}
+
+func (s *historyCompressionSuite) TestCorruptData() {
+	_, _, err := decompressHistoryData([]byte("not snappy"), "json/snappy")
+	s.Error(err)
+
+	_, _, err = decompressHistoryData([]byte("data"), "json/lz4")
+	s.Error(err)
+}
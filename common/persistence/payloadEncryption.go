@@ -0,0 +1,325 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// the encrypted suffix is appended to the encoding type of encrypted history batches, after the compression
+	// suffix, as history is compressed before it is encrypted
+	payloadEncryptedSuffix = compressionSeparator + "encrypted"
+
+	// payloadEnvelopeVersion is the version of the envelope format written by this package
+	payloadEnvelopeVersion = byte(1)
+	// payloadDataKeySize is the size of the AES-256 data keys
+	payloadDataKeySize = 32
+	// payloadDataKeyMaxUses is the number of payloads encrypted with a data key before a new one is generated, it
+	// keeps the chance of a random nonce being reused by AES-GCM negligible
+	payloadDataKeyMaxUses = 1 << 24
+	// payloadMaxCachedKeys bounds the data keys kept decrypted, the cache is emptied when it is full
+	payloadMaxCachedKeys = 10000
+)
+
+// payloadEnvelopeMagic starts every encrypted payload, it tells the payloads stored without an encoding type, like
+// activity heartbeat details, apart from the ones written before encryption was enabled
+var payloadEnvelopeMagic = []byte("cENV")
+
+var (
+	// ErrPayloadEncryptionNotConfigured is returned when reading an encrypted payload without an encryptor
+	ErrPayloadEncryptionNotConfigured = errors.New("payload is encrypted but payload encryption is not configured")
+	// ErrInvalidPayloadEnvelope is returned when an encrypted payload cannot be parsed
+	ErrInvalidPayloadEnvelope = errors.New("invalid encrypted payload envelope")
+)
+
+type (
+	// DataKeyProvider generates and decrypts the data keys payloads are encrypted with, it is implemented on top of
+	// a key management service which never hands out the key the data keys are encrypted with
+	DataKeyProvider interface {
+		// GenerateDataKey returns a new data key for the domain, in plaintext and encrypted
+		GenerateDataKey(domainID string) (key []byte, encryptedKey []byte, err error)
+		// DecryptDataKey returns the plaintext of a data key generated for the domain
+		DecryptDataKey(domainID string, encryptedKey []byte) ([]byte, error)
+	}
+
+	// PayloadEncryptor encrypts the payloads of a domain before they are persisted. Every payload is sealed in an
+	// envelope holding the encrypted data key it was encrypted with, so that data keys can be rotated freely.
+	PayloadEncryptor interface {
+		Encrypt(domainID string, data []byte) ([]byte, error)
+		Decrypt(domainID string, data []byte) ([]byte, error)
+	}
+
+	payloadEncryptorImpl struct {
+		provider DataKeyProvider
+
+		sync.Mutex
+		// dataKeys are the keys new payloads are encrypted with, by domain
+		dataKeys map[string]*payloadDataKey
+		// decryptedKeys are the keys payloads are decrypted with, by domain and encrypted key
+		decryptedKeys map[string]cipher.AEAD
+	}
+
+	payloadDataKey struct {
+		aead         cipher.AEAD
+		encryptedKey []byte
+		uses         int
+	}
+
+	// staticDataKeyProvider encrypts data keys with a key from the static config
+	staticDataKeyProvider struct {
+		aead cipher.AEAD
+	}
+)
+
+var _ PayloadEncryptor = (*payloadEncryptorImpl)(nil)
+var _ DataKeyProvider = (*staticDataKeyProvider)(nil)
+
+// NewPayloadEncryptor creates a PayloadEncryptor on top of the given data key provider
+func NewPayloadEncryptor(provider DataKeyProvider) PayloadEncryptor {
+	return &payloadEncryptorImpl{
+		provider:      provider,
+		dataKeys:      make(map[string]*payloadDataKey),
+		decryptedKeys: make(map[string]cipher.AEAD),
+	}
+}
+
+// NewStaticDataKeyProvider creates a DataKeyProvider which encrypts data keys with the given base64 encoded 32 byte
+// key, it is meant for clusters without a key management service
+func NewStaticDataKeyProvider(key string) (DataKeyProvider, error) {
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload encryption key: %v", err)
+	}
+	if len(decoded) != payloadDataKeySize {
+		return nil, fmt.Errorf("invalid payload encryption key: must be %v bytes, got %v", payloadDataKeySize,
+			len(decoded))
+	}
+	aead, err := newPayloadAEAD(decoded)
+	if err != nil {
+		return nil, err
+	}
+	return &staticDataKeyProvider{aead: aead}, nil
+}
+
+// NewPayloadEncryptorFromKey creates a PayloadEncryptor on top of a static data key provider with the given key,
+// no encryptor is returned for an empty key
+func NewPayloadEncryptorFromKey(key string) (PayloadEncryptor, error) {
+	if key == "" {
+		return nil, nil
+	}
+	provider, err := NewStaticDataKeyProvider(key)
+	if err != nil {
+		return nil, err
+	}
+	return NewPayloadEncryptor(provider), nil
+}
+
+// Encrypt seals the payload in an envelope: magic, version, encrypted data key length and encrypted data key,
+// followed by the nonce and the AES-GCM sealed payload, authenticated along with the domain ID
+func (e *payloadEncryptorImpl) Encrypt(domainID string, data []byte) ([]byte, error) {
+	aead, encryptedKey, err := e.getDataKey(domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	header := len(payloadEnvelopeMagic) + 3 + len(encryptedKey)
+	envelope := make([]byte, header, header+len(nonce)+len(data)+aead.Overhead())
+	copy(envelope, payloadEnvelopeMagic)
+	envelope[len(payloadEnvelopeMagic)] = payloadEnvelopeVersion
+	binary.BigEndian.PutUint16(envelope[len(payloadEnvelopeMagic)+1:], uint16(len(encryptedKey)))
+	copy(envelope[len(payloadEnvelopeMagic)+3:], encryptedKey)
+	envelope = append(envelope, nonce...)
+	return aead.Seal(envelope, nonce, data, []byte(domainID)), nil
+}
+
+// Decrypt opens an envelope written by Encrypt
+func (e *payloadEncryptorImpl) Decrypt(domainID string, data []byte) ([]byte, error) {
+	if !isEncryptedPayload(data) {
+		return nil, ErrInvalidPayloadEnvelope
+	}
+	data = data[len(payloadEnvelopeMagic):]
+	if len(data) < 3 || data[0] != payloadEnvelopeVersion {
+		return nil, ErrInvalidPayloadEnvelope
+	}
+	keyLen := int(binary.BigEndian.Uint16(data[1:]))
+	data = data[3:]
+	if len(data) < keyLen {
+		return nil, ErrInvalidPayloadEnvelope
+	}
+	encryptedKey, data := data[:keyLen], data[keyLen:]
+
+	aead, err := e.getDecryptedKey(domainID, encryptedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < aead.NonceSize() {
+		return nil, ErrInvalidPayloadEnvelope
+	}
+	return aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(domainID))
+}
+
+func (e *payloadEncryptorImpl) getDataKey(domainID string) (cipher.AEAD, []byte, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	dataKey, ok := e.dataKeys[domainID]
+	if !ok || dataKey.uses >= payloadDataKeyMaxUses {
+		key, encryptedKey, err := e.provider.GenerateDataKey(domainID)
+		if err != nil {
+			return nil, nil, err
+		}
+		aead, err := newPayloadAEAD(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		dataKey = &payloadDataKey{aead: aead, encryptedKey: encryptedKey}
+		e.dataKeys[domainID] = dataKey
+	}
+	dataKey.uses++
+	return dataKey.aead, dataKey.encryptedKey, nil
+}
+
+func (e *payloadEncryptorImpl) getDecryptedKey(domainID string, encryptedKey []byte) (cipher.AEAD, error) {
+	cacheKey := domainID + "/" + string(encryptedKey)
+	e.Lock()
+	aead, ok := e.decryptedKeys[cacheKey]
+	e.Unlock()
+	if ok {
+		return aead, nil
+	}
+
+	// the key management service is called without the lock, concurrent misses of the same key are harmless
+	key, err := e.provider.DecryptDataKey(domainID, encryptedKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err = newPayloadAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	if len(e.decryptedKeys) >= payloadMaxCachedKeys {
+		e.decryptedKeys = make(map[string]cipher.AEAD)
+	}
+	e.decryptedKeys[cacheKey] = aead
+	return aead, nil
+}
+
+func (p *staticDataKeyProvider) GenerateDataKey(domainID string) ([]byte, []byte, error) {
+	key := make([]byte, payloadDataKeySize)
+	nonce := make([]byte, p.aead.NonceSize())
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	return key, p.aead.Seal(nonce, nonce, key, []byte(domainID)), nil
+}
+
+func (p *staticDataKeyProvider) DecryptDataKey(domainID string, encryptedKey []byte) ([]byte, error) {
+	if len(encryptedKey) < p.aead.NonceSize() {
+		return nil, ErrInvalidPayloadEnvelope
+	}
+	nonceSize := p.aead.NonceSize()
+	return p.aead.Open(nil, encryptedKey[:nonceSize], encryptedKey[nonceSize:], []byte(domainID))
+}
+
+func newPayloadAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// isEncryptedPayload returns true if the payload was written by a PayloadEncryptor
+func isEncryptedPayload(data []byte) bool {
+	return bytes.HasPrefix(data, payloadEnvelopeMagic)
+}
+
+// encryptPayload encrypts a payload stored without an encoding type, it is written as is without an encryptor
+func encryptPayload(encryptor PayloadEncryptor, domainID string, data []byte) ([]byte, error) {
+	if encryptor == nil || data == nil {
+		return data, nil
+	}
+	return encryptor.Encrypt(domainID, data)
+}
+
+// decryptPayload reverts encryptPayload, payloads written before encryption was enabled are returned as is
+func decryptPayload(encryptor PayloadEncryptor, domainID string, data []byte) ([]byte, error) {
+	if !isEncryptedPayload(data) {
+		return data, nil
+	}
+	if encryptor == nil {
+		return nil, ErrPayloadEncryptionNotConfigured
+	}
+	return encryptor.Decrypt(domainID, data)
+}
+
+// encryptHistoryData encrypts serialized, and possibly compressed, history events, returning the encoding type to
+// persist with them
+func encryptHistoryData(encryptor PayloadEncryptor, domainID string, data []byte,
+	encodingType common.EncodingType) ([]byte, common.EncodingType, error) {
+	if encryptor == nil {
+		return data, encodingType, nil
+	}
+	encrypted, err := encryptor.Encrypt(domainID, data)
+	if err != nil {
+		return nil, "", err
+	}
+	return encrypted, encodingType + payloadEncryptedSuffix, nil
+}
+
+// decryptHistoryData reverts encryptHistoryData, history written unencrypted is returned as is
+func decryptHistoryData(encryptor PayloadEncryptor, domainID string, data []byte,
+	encodingType common.EncodingType) ([]byte, common.EncodingType, error) {
+	if !strings.HasSuffix(string(encodingType), payloadEncryptedSuffix) {
+		return data, encodingType, nil
+	}
+	if encryptor == nil {
+		return nil, "", ErrPayloadEncryptionNotConfigured
+	}
+	decrypted, err := encryptor.Decrypt(domainID, data)
+	if err != nil {
+		return nil, "", err
+	}
+	return decrypted, encodingType[:len(encodingType)-len(payloadEncryptedSuffix)], nil
+}
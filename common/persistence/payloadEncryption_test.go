@@ -0,0 +1,174 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	payloadEncryptionSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		encryptor PayloadEncryptor
+	}
+
+	// countingDataKeyProvider counts the calls made to the key management service
+	countingDataKeyProvider struct {
+		DataKeyProvider
+		generated int
+		decrypted int
+	}
+)
+
+func TestPayloadEncryptionSuite(t *testing.T) {
+	s := new(payloadEncryptionSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadEncryptionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	encryptor, err := NewPayloadEncryptorFromKey(testPayloadEncryptionKey)
+	s.NoError(err)
+	s.encryptor = encryptor
+}
+
+func (p *countingDataKeyProvider) GenerateDataKey(domainID string) ([]byte, []byte, error) {
+	p.generated++
+	return p.DataKeyProvider.GenerateDataKey(domainID)
+}
+
+func (p *countingDataKeyProvider) DecryptDataKey(domainID string, encryptedKey []byte) ([]byte, error) {
+	p.decrypted++
+	return p.DataKeyProvider.DecryptDataKey(domainID, encryptedKey)
+}
+
+func (s *payloadEncryptionSuite) TestInvalidKey() {
+	encryptor, err := NewPayloadEncryptorFromKey("")
+	s.NoError(err)
+	s.Nil(encryptor)
+
+	_, err = NewStaticDataKeyProvider("not base64")
+	s.Error(err)
+	_, err = NewStaticDataKeyProvider("c2hvcnQ=")
+	s.Error(err)
+}
+
+func (s *payloadEncryptionSuite) TestRoundTrip() {
+	data := []byte("heartbeat details")
+
+	encrypted, err := s.encryptor.Encrypt("domain1", data)
+	s.NoError(err)
+	s.True(isEncryptedPayload(encrypted))
+	s.NotContains(string(encrypted), string(data))
+
+	decrypted, err := s.encryptor.Decrypt("domain1", encrypted)
+	s.NoError(err)
+	s.Equal(data, decrypted)
+
+	// the payload is bound to its domain
+	_, err = s.encryptor.Decrypt("domain2", encrypted)
+	s.Error(err)
+
+	// tampering is detected
+	encrypted[len(encrypted)-1] ^= 1
+	_, err = s.encryptor.Decrypt("domain1", encrypted)
+	s.Error(err)
+}
+
+func (s *payloadEncryptionSuite) TestDataKeysAreCached() {
+	static, err := NewStaticDataKeyProvider(testPayloadEncryptionKey)
+	s.NoError(err)
+	provider := &countingDataKeyProvider{DataKeyProvider: static}
+	encryptor := NewPayloadEncryptor(provider)
+
+	for i := 0; i < 3; i++ {
+		encrypted, err := encryptor.Encrypt("domain1", []byte("data"))
+		s.NoError(err)
+		_, err = encryptor.Decrypt("domain1", encrypted)
+		s.NoError(err)
+	}
+	s.Equal(1, provider.generated)
+	s.Equal(1, provider.decrypted)
+
+	// payloads written by another host are decrypted with the key they were written with
+	other := NewPayloadEncryptor(static)
+	encrypted, err := other.Encrypt("domain1", []byte("data"))
+	s.NoError(err)
+	decrypted, err := encryptor.Decrypt("domain1", encrypted)
+	s.NoError(err)
+	s.Equal([]byte("data"), decrypted)
+	s.Equal(2, provider.decrypted)
+}
+
+func (s *payloadEncryptionSuite) TestPayload() {
+	data := []byte("heartbeat details")
+
+	// payloads written before encryption was enabled are read as is
+	decrypted, err := decryptPayload(s.encryptor, "domain1", data)
+	s.NoError(err)
+	s.Equal(data, decrypted)
+
+	stored, err := encryptPayload(nil, "domain1", data)
+	s.NoError(err)
+	s.Equal(data, stored)
+
+	stored, err = encryptPayload(s.encryptor, "domain1", data)
+	s.NoError(err)
+	_, err = decryptPayload(nil, "domain1", stored)
+	s.Equal(ErrPayloadEncryptionNotConfigured, err)
+	decrypted, err = decryptPayload(s.encryptor, "domain1", stored)
+	s.NoError(err)
+	s.Equal(data, decrypted)
+}
+
+func (s *payloadEncryptionSuite) TestHistoryData() {
+	data := []byte(`[{"eventId":1,"eventType":"WorkflowExecutionStarted"},{"eventId":2,"eventType":"DecisionTaskScheduled"}]`)
+
+	compressed, encodingType, err := compressHistoryData(HistoryCompressionSnappy, data, common.EncodingTypeJSON)
+	s.NoError(err)
+	encrypted, encodingType, err := encryptHistoryData(s.encryptor, "domain1", compressed, encodingType)
+	s.NoError(err)
+	s.Equal(common.EncodingType("json/snappy/encrypted"), encodingType)
+
+	_, _, err = decryptHistoryData(nil, "domain1", encrypted, encodingType)
+	s.Equal(ErrPayloadEncryptionNotConfigured, err)
+
+	decrypted, encodingType, err := decryptHistoryData(s.encryptor, "domain1", encrypted, encodingType)
+	s.NoError(err)
+	s.Equal(common.EncodingType("json/snappy"), encodingType)
+	decompressed, encodingType, err := decompressHistoryData(decrypted, encodingType)
+	s.NoError(err)
+	s.Equal(common.EncodingTypeJSON, encodingType)
+	s.Equal(data, decompressed)
+
+	// history written unencrypted is read as is
+	decrypted, encodingType, err = decryptHistoryData(s.encryptor, "domain1", data, common.EncodingTypeJSON)
+	s.NoError(err)
+	s.Equal(common.EncodingTypeJSON, encodingType)
+	s.Equal(data, decrypted)
+}
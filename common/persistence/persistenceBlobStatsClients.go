@@ -0,0 +1,143 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"math/rand"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	// blobStatsTableEvents is the table history event batches are written to
+	blobStatsTableEvents = "events"
+	// blobStatsTableExecutions is the table buffered events and buffered replication tasks are written to
+	blobStatsTableExecutions = "executions"
+	// blobStatsSizeHistogram is the size distribution of the sampled blobs, per table, encoding and domain
+	blobStatsSizeHistogram = "persistence.sampled-blob-size"
+)
+
+// blobStatsSizeBuckets range from 256B to 32MB
+var blobStatsSizeBuckets = tally.MustMakeExponentialValueBuckets(256, 2, 18)
+
+type (
+	// blobStatsSampler emits the encoding and size of a sample of the blobs written to persistence, to verify the
+	// rollout of a new encoding or compression and to spot the domains writing unusually large blobs
+	blobStatsSampler struct {
+		sampleRate   dynamicconfig.FloatPropertyFn
+		metricClient metrics.Client
+	}
+
+	historyPersistenceBlobStatsClient struct {
+		HistoryManager
+		sampler *blobStatsSampler
+	}
+
+	workflowExecutionPersistenceBlobStatsClient struct {
+		ExecutionManager
+		sampler *blobStatsSampler
+	}
+)
+
+var _ HistoryManager = (*historyPersistenceBlobStatsClient)(nil)
+var _ ExecutionManager = (*workflowExecutionPersistenceBlobStatsClient)(nil)
+
+// NewHistoryPersistenceBlobStatsClient creates a HistoryManager which samples the encoding and size of the history
+// event batches written, as reported by the store
+func NewHistoryPersistenceBlobStatsClient(persistence HistoryManager, sampleRate dynamicconfig.FloatPropertyFn,
+	metricClient metrics.Client) HistoryManager {
+	return &historyPersistenceBlobStatsClient{
+		HistoryManager: persistence,
+		sampler:        newBlobStatsSampler(sampleRate, metricClient),
+	}
+}
+
+// NewWorkflowExecutionPersistenceBlobStatsClient creates an ExecutionManager which samples the encoding and size of
+// the buffered events and buffered replication tasks written
+func NewWorkflowExecutionPersistenceBlobStatsClient(persistence ExecutionManager,
+	sampleRate dynamicconfig.FloatPropertyFn, metricClient metrics.Client) ExecutionManager {
+	return &workflowExecutionPersistenceBlobStatsClient{
+		ExecutionManager: persistence,
+		sampler:          newBlobStatsSampler(sampleRate, metricClient),
+	}
+}
+
+func (p *historyPersistenceBlobStatsClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	err := p.HistoryManager.AppendHistoryEvents(request)
+	// a store which does not report what it wrote leaves the stored encoding unset
+	if err != nil || request.StoredEncodingType == "" || !p.sampler.sample() {
+		return err
+	}
+
+	p.sampler.record(metrics.PersistenceAppendHistoryEventsScope, blobStatsTableEvents, request.DomainID,
+		request.StoredEncodingType, request.StoredSize)
+	return nil
+}
+
+func (p *workflowExecutionPersistenceBlobStatsClient) UpdateWorkflowExecution(
+	request *UpdateWorkflowExecutionRequest) error {
+	err := p.ExecutionManager.UpdateWorkflowExecution(request)
+	if err != nil || !p.sampler.sample() {
+		return err
+	}
+
+	domainID := request.ExecutionInfo.DomainID
+	batches := []*SerializedHistoryEventBatch{request.NewBufferedEvents}
+	if task := request.NewBufferedReplicationTask; task != nil {
+		batches = append(batches, task.History, task.NewRunHistory)
+	}
+	for _, batch := range batches {
+		if batch != nil {
+			p.sampler.record(metrics.PersistenceUpdateWorkflowExecutionScope, blobStatsTableExecutions, domainID,
+				batch.EncodingType, len(batch.Data))
+		}
+	}
+	return nil
+}
+
+func newBlobStatsSampler(sampleRate dynamicconfig.FloatPropertyFn, metricClient metrics.Client) *blobStatsSampler {
+	return &blobStatsSampler{
+		sampleRate:   sampleRate,
+		metricClient: metricClient,
+	}
+}
+
+func (s *blobStatsSampler) sample() bool {
+	sampleRate := s.sampleRate()
+	return sampleRate > 0 && rand.Float64() < sampleRate
+}
+
+func (s *blobStatsSampler) record(scope int, table, domainID string, encodingType common.EncodingType, size int) {
+	tags := map[string]string{
+		metrics.TableTagName:    table,
+		metrics.EncodingTagName: string(encodingType),
+	}
+	client := s.metricClient.Tagged(tags)
+	client.IncCounter(scope, metrics.PersistenceSampledBlobs)
+	client.AddCounter(scope, metrics.PersistenceSampledBlobBytes, int64(size))
+
+	tags[metrics.DomainIDTagName] = domainID
+	s.metricClient.Scope(scope).Tagged(tags).Histogram(blobStatsSizeHistogram, blobStatsSizeBuckets).
+		RecordValue(float64(size))
+}
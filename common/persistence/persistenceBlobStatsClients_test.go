@@ -0,0 +1,149 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	blobStatsClientSuite struct {
+		suite.Suite
+		sampleRate float64
+		scope      tally.TestScope
+		client     metrics.Client
+	}
+
+	// nopHistoryManager accepts every write, and reports it stored the batch compressed with snappy
+	nopHistoryManager struct {
+		HistoryManager
+	}
+
+	// nopExecutionManager accepts every write
+	nopExecutionManager struct {
+		ExecutionManager
+	}
+)
+
+func TestBlobStatsClientSuite(t *testing.T) {
+	s := new(blobStatsClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *blobStatsClientSuite) SetupTest() {
+	s.sampleRate = 1
+	s.scope = tally.NewTestScope("test", nil)
+	s.client = metrics.NewClient(s.scope, metrics.Common)
+}
+
+func (m *nopHistoryManager) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	request.StoredEncodingType = common.EncodingType(string(request.Events.EncodingType) + "/snappy")
+	request.StoredSize = len(request.Events.Data) / 2
+	return nil
+}
+
+func (m *nopExecutionManager) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error {
+	return nil
+}
+
+func (s *blobStatsClientSuite) getSampleRate(...dynamicconfig.FilterOption) float64 {
+	return s.sampleRate
+}
+
+// sampledBlobs returns the sampled blob count and bytes per table and encoding
+func (s *blobStatsClientSuite) sampledBlobs() (map[string]int64, map[string]int64) {
+	counts := make(map[string]int64)
+	bytes := make(map[string]int64)
+	for _, c := range s.scope.Snapshot().Counters() {
+		key := c.Tags()[metrics.TableTagName] + ":" + c.Tags()[metrics.EncodingTagName]
+		switch c.Name() {
+		case "test.persistence.sampled-blobs":
+			counts[key] += c.Value()
+		case "test.persistence.sampled-blob-bytes":
+			bytes[key] += c.Value()
+		}
+	}
+	return counts, bytes
+}
+
+func (s *blobStatsClientSuite) TestAppendHistoryEvents() {
+	data := []byte(`[{"eventId":1},{"eventId":2},{"eventId":3},{"eventId":4}]`)
+	client := NewHistoryPersistenceBlobStatsClient(&nopHistoryManager{}, s.getSampleRate, s.client)
+
+	err := client.AppendHistoryEvents(&AppendHistoryEventsRequest{
+		DomainID: "domain1",
+		Events:   &SerializedHistoryEventBatch{EncodingType: common.EncodingTypeJSON, Data: data},
+	})
+	s.NoError(err)
+
+	counts, bytes := s.sampledBlobs()
+	s.Equal(map[string]int64{"events:json/snappy": 1}, counts)
+	s.Equal(map[string]int64{"events:json/snappy": int64(len(data) / 2)}, bytes)
+
+	var histograms int
+	for _, h := range s.scope.Snapshot().Histograms() {
+		if h.Name() == "test."+blobStatsSizeHistogram {
+			s.Equal("domain1", h.Tags()[metrics.DomainIDTagName])
+			histograms++
+		}
+	}
+	s.Equal(1, histograms)
+}
+
+func (s *blobStatsClientSuite) TestUpdateWorkflowExecution() {
+	client := NewWorkflowExecutionPersistenceBlobStatsClient(&nopExecutionManager{}, s.getSampleRate, s.client)
+
+	err := client.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo: &WorkflowExecutionInfo{DomainID: "domain1"},
+		NewBufferedEvents: &SerializedHistoryEventBatch{
+			EncodingType: common.EncodingTypeJSON,
+			Data:         []byte("buffered"),
+		},
+		NewBufferedReplicationTask: &BufferedReplicationTask{
+			History: &SerializedHistoryEventBatch{EncodingType: common.EncodingTypeGob, Data: []byte("history")},
+		},
+	})
+	s.NoError(err)
+
+	counts, bytes := s.sampledBlobs()
+	s.Equal(map[string]int64{"executions:json": 1, "executions:gob": 1}, counts)
+	s.Equal(map[string]int64{"executions:json": 8, "executions:gob": 7}, bytes)
+}
+
+func (s *blobStatsClientSuite) TestNotSampled() {
+	s.sampleRate = 0
+	client := NewWorkflowExecutionPersistenceBlobStatsClient(&nopExecutionManager{}, s.getSampleRate, s.client)
+
+	err := client.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo:     &WorkflowExecutionInfo{DomainID: "domain1"},
+		NewBufferedEvents: &SerializedHistoryEventBatch{EncodingType: common.EncodingTypeJSON, Data: []byte("a")},
+	})
+	s.NoError(err)
+
+	counts, _ := s.sampledBlobs()
+	s.Empty(counts)
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	// hedgingWindowSize is the number of recent read latencies the hedge delay is computed from
+	hedgingWindowSize = 1000
+	// hedgingMinSamples is the number of latencies recorded before any read is hedged, so that a cold
+	// window does not hedge every read
+	hedgingMinSamples = 100
+	// hedgingRecomputeInterval is the number of recorded latencies after which the hedge delay is recomputed
+	hedgingRecomputeInterval = 100
+	// hedgingPercentile is the percentile of recent latencies a read may take before it is hedged
+	hedgingPercentile = 0.99
+	// hedgingMaxBudget is the max number of hedges the budget accumulates, which bounds the burst of hedges
+	// sent when the store slows down after a quiet period
+	hedgingMaxBudget = 10
+)
+
+type (
+	// HedgingConfig configures hedged reads, a read which has not completed after the p99 of recent read
+	// latencies, bounded below by MinDelay, is sent a second time and the first successful response is returned.
+	// At most MaxHedgedPercentage percent of reads are hedged, so a slow store does not get twice the load.
+	HedgingConfig struct {
+		Enabled             dynamicconfig.BoolPropertyFn
+		MinDelay            dynamicconfig.DurationPropertyFn
+		MaxHedgedPercentage dynamicconfig.FloatPropertyFn
+	}
+
+	readHedger struct {
+		config       *HedgingConfig
+		metricClient metrics.Client
+
+		sync.Mutex
+		latencies []time.Duration
+		next      int
+		recorded  int
+		delay     time.Duration
+		budget    float64
+	}
+
+	hedgedResult struct {
+		response interface{}
+		err      error
+	}
+
+	historyPersistenceHedgingClient struct {
+		HistoryManager
+		hedger *readHedger
+	}
+
+	visibilityPersistenceHedgingClient struct {
+		VisibilityManager
+		hedger *readHedger
+	}
+)
+
+var _ HistoryManager = (*historyPersistenceHedgingClient)(nil)
+var _ VisibilityManager = (*visibilityPersistenceHedgingClient)(nil)
+
+// NewHistoryPersistenceHedgingClient creates a HistoryManager which hedges history reads
+func NewHistoryPersistenceHedgingClient(persistence HistoryManager, config *HedgingConfig,
+	metricClient metrics.Client) HistoryManager {
+	return &historyPersistenceHedgingClient{
+		HistoryManager: persistence,
+		hedger:         newReadHedger(config, metricClient),
+	}
+}
+
+// NewVisibilityPersistenceHedgingClient creates a VisibilityManager which hedges closed workflow execution reads
+func NewVisibilityPersistenceHedgingClient(persistence VisibilityManager, config *HedgingConfig,
+	metricClient metrics.Client) VisibilityManager {
+	return &visibilityPersistenceHedgingClient{
+		VisibilityManager: persistence,
+		hedger:            newReadHedger(config, metricClient),
+	}
+}
+
+func (p *historyPersistenceHedgingClient) GetWorkflowExecutionHistory(
+	request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+	response, err := p.hedger.read(metrics.PersistenceGetWorkflowExecutionHistoryScope, func() (interface{}, error) {
+		return p.HistoryManager.GetWorkflowExecutionHistory(request)
+	})
+	if response == nil {
+		return nil, err
+	}
+	return response.(*GetWorkflowExecutionHistoryResponse), err
+}
+
+func (p *historyPersistenceHedgingClient) GetWorkflowExecutionHistoryBatch(
+	request *GetWorkflowExecutionHistoryBatchRequest) (*GetWorkflowExecutionHistoryBatchResponse, error) {
+	response, err := p.hedger.read(metrics.PersistenceGetWorkflowExecutionHistoryBatchScope, func() (interface{}, error) {
+		return p.HistoryManager.GetWorkflowExecutionHistoryBatch(request)
+	})
+	if response == nil {
+		return nil, err
+	}
+	return response.(*GetWorkflowExecutionHistoryBatchResponse), err
+}
+
+func (p *visibilityPersistenceHedgingClient) GetClosedWorkflowExecution(
+	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	response, err := p.hedger.read(metrics.PersistenceGetClosedWorkflowExecutionScope, func() (interface{}, error) {
+		return p.VisibilityManager.GetClosedWorkflowExecution(request)
+	})
+	if response == nil {
+		return nil, err
+	}
+	return response.(*GetClosedWorkflowExecutionResponse), err
+}
+
+func newReadHedger(config *HedgingConfig, metricClient metrics.Client) *readHedger {
+	return &readHedger{
+		config:       config,
+		metricClient: metricClient,
+		latencies:    make([]time.Duration, hedgingWindowSize),
+	}
+}
+
+// read calls op, and calls it a second time if it has not returned after the hedge delay and the hedge budget
+// allows it. The response of whichever call succeeds first is used, the other call is left to complete in the
+// background, the error of the call which failed last is returned if both fail.
+func (h *readHedger) read(scope int, op func() (interface{}, error)) (interface{}, error) {
+	if !h.config.Enabled() {
+		return op()
+	}
+
+	startTime := time.Now()
+	delay, ok := h.hedgeDelay()
+	if !ok {
+		response, err := op()
+		h.record(time.Since(startTime))
+		return response, err
+	}
+
+	// buffered for both calls so the slower one never blocks
+	results := make(chan hedgedResult, 2)
+	call := func() {
+		response, err := op()
+		results <- hedgedResult{response: response, err: err}
+	}
+
+	go call()
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	var result hedgedResult
+	select {
+	case result = <-results:
+	case <-timer.C:
+		if !h.spendBudget() {
+			result = <-results
+			break
+		}
+		h.metricClient.IncCounter(scope, metrics.PersistenceHedgedRequests)
+		go call()
+		if result = <-results; result.err != nil {
+			result = <-results
+		}
+	}
+	h.record(time.Since(startTime))
+	return result.response, result.err
+}
+
+// spendBudget takes a hedge from the budget, it returns false if the budget is used up
+func (h *readHedger) spendBudget() bool {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.budget < 1 {
+		return false
+	}
+	h.budget--
+	return true
+}
+
+func (h *readHedger) hedgeDelay() (time.Duration, bool) {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.recorded < hedgingMinSamples {
+		return 0, false
+	}
+	if minDelay := h.config.MinDelay(); h.delay < minDelay {
+		return minDelay, true
+	}
+	return h.delay, true
+}
+
+func (h *readHedger) record(latency time.Duration) {
+	h.Lock()
+	defer h.Unlock()
+
+	// every read adds its share of a hedge to the budget
+	h.budget = math.Min(h.budget+h.config.MaxHedgedPercentage()/100, hedgingMaxBudget)
+	h.latencies[h.next] = latency
+	h.next = (h.next + 1) % len(h.latencies)
+	h.recorded++
+	if h.recorded%hedgingRecomputeInterval != 0 {
+		return
+	}
+
+	count := h.recorded
+	if count > len(h.latencies) {
+		count = len(h.latencies)
+	}
+	sorted := make([]time.Duration, count)
+	copy(sorted, h.latencies[:count])
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	h.delay = sorted[int(float64(count-1)*hedgingPercentile)]
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	hedgingClientSuite struct {
+		suite.Suite
+		enabled    bool
+		percentage float64
+		config     *HedgingConfig
+	}
+
+	// slowHistoryManager answers the first read after the given delay and every later read right away
+	slowHistoryManager struct {
+		HistoryManager
+		delay time.Duration
+		calls int32
+	}
+
+	// failingHedgeHistoryManager answers the first read after the given delay and fails every later read right away
+	failingHedgeHistoryManager struct {
+		HistoryManager
+		delay time.Duration
+		calls int32
+	}
+)
+
+func TestHedgingClientSuite(t *testing.T) {
+	s := new(hedgingClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *hedgingClientSuite) SetupTest() {
+	s.enabled = true
+	s.percentage = 100
+	s.config = &HedgingConfig{
+		Enabled:             func(...dynamicconfig.FilterOption) bool { return s.enabled },
+		MinDelay:            func(...dynamicconfig.FilterOption) time.Duration { return 10 * time.Millisecond },
+		MaxHedgedPercentage: func(...dynamicconfig.FilterOption) float64 { return s.percentage },
+	}
+}
+
+func (m *slowHistoryManager) GetWorkflowExecutionHistory(
+	request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+	if atomic.AddInt32(&m.calls, 1) == 1 {
+		time.Sleep(m.delay)
+		return nil, errors.New("slow read")
+	}
+	return &GetWorkflowExecutionHistoryResponse{NextPageToken: []byte("fast")}, nil
+}
+
+func (m *failingHedgeHistoryManager) GetWorkflowExecutionHistory(
+	request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+	if atomic.AddInt32(&m.calls, 1) == 1 {
+		time.Sleep(m.delay)
+		return &GetWorkflowExecutionHistoryResponse{NextPageToken: []byte("slow")}, nil
+	}
+	return nil, errors.New("fast read")
+}
+
+func (s *hedgingClientSuite) newHedger() *readHedger {
+	return newReadHedger(s.config, metrics.NewClient(tally.NoopScope, metrics.Common))
+}
+
+func (s *hedgingClientSuite) TestHedgeDelay() {
+	hedger := s.newHedger()
+
+	_, ok := hedger.hedgeDelay()
+	s.False(ok)
+
+	for i := 1; i <= hedgingMinSamples; i++ {
+		hedger.record(time.Duration(i) * time.Millisecond)
+	}
+	delay, ok := hedger.hedgeDelay()
+	s.True(ok)
+	s.Equal(99*time.Millisecond, delay)
+
+	for i := 0; i < hedgingWindowSize; i++ {
+		hedger.record(time.Millisecond)
+	}
+	delay, ok = hedger.hedgeDelay()
+	s.True(ok)
+	s.Equal(10*time.Millisecond, delay)
+}
+
+func (s *hedgingClientSuite) TestSlowReadIsHedged() {
+	persistence := &slowHistoryManager{delay: time.Second}
+	client := NewHistoryPersistenceHedgingClient(persistence, s.config, metrics.NewClient(tally.NoopScope, metrics.Common))
+	hedger := client.(*historyPersistenceHedgingClient).hedger
+	for i := 0; i < hedgingMinSamples; i++ {
+		hedger.record(time.Millisecond)
+	}
+
+	startTime := time.Now()
+	response, err := client.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{})
+	s.NoError(err)
+	s.Equal([]byte("fast"), response.NextPageToken)
+	s.True(time.Since(startTime) < persistence.delay)
+	s.Equal(int32(2), atomic.LoadInt32(&persistence.calls))
+}
+
+func (s *hedgingClientSuite) TestHedgingDisabled() {
+	s.enabled = false
+	persistence := &slowHistoryManager{delay: 50 * time.Millisecond}
+	client := NewHistoryPersistenceHedgingClient(persistence, s.config, metrics.NewClient(tally.NoopScope, metrics.Common))
+	hedger := client.(*historyPersistenceHedgingClient).hedger
+	for i := 0; i < hedgingMinSamples; i++ {
+		hedger.record(time.Millisecond)
+	}
+
+	_, err := client.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{})
+	s.Error(err)
+	s.Equal(int32(1), atomic.LoadInt32(&persistence.calls))
+}
+
+func (s *hedgingClientSuite) TestFailedHedgeFallsBackToSlowRead() {
+	persistence := &failingHedgeHistoryManager{delay: 50 * time.Millisecond}
+	client := NewHistoryPersistenceHedgingClient(persistence, s.config, metrics.NewClient(tally.NoopScope, metrics.Common))
+	hedger := client.(*historyPersistenceHedgingClient).hedger
+	for i := 0; i < hedgingMinSamples; i++ {
+		hedger.record(time.Millisecond)
+	}
+
+	response, err := client.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{})
+	s.NoError(err)
+	s.Equal([]byte("slow"), response.NextPageToken)
+	s.Equal(int32(2), atomic.LoadInt32(&persistence.calls))
+}
+
+func (s *hedgingClientSuite) TestHedgeBudget() {
+	s.percentage = 1
+	hedger := s.newHedger()
+	for i := 0; i < 150; i++ {
+		hedger.record(time.Millisecond)
+	}
+
+	s.True(hedger.spendBudget())
+	s.False(hedger.spendBudget())
+
+	s.percentage = 100
+	for i := 0; i < hedgingMaxBudget*2; i++ {
+		hedger.record(time.Millisecond)
+	}
+	for i := 0; i < hedgingMaxBudget; i++ {
+		s.True(hedger.spendBudget())
+	}
+	s.False(hedger.spendBudget())
+}
+
+func (s *hedgingClientSuite) TestHedgeBudgetUsedUp() {
+	s.percentage = 0
+	persistence := &slowHistoryManager{delay: 50 * time.Millisecond}
+	client := NewHistoryPersistenceHedgingClient(persistence, s.config, metrics.NewClient(tally.NoopScope, metrics.Common))
+	hedger := client.(*historyPersistenceHedgingClient).hedger
+	for i := 0; i < hedgingMinSamples; i++ {
+		hedger.record(time.Millisecond)
+	}
+
+	_, err := client.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{})
+	s.Error(err)
+	s.Equal(int32(1), atomic.LoadInt32(&persistence.calls))
+}
@@ -55,6 +55,21 @@ type (
 		metricClient metrics.Client
 		persistence  VisibilityManager
 	}
+
+	domainStatsPersistenceClient struct {
+		metricClient metrics.Client
+		persistence  DomainStatsManager
+	}
+
+	auditPersistenceClient struct {
+		metricClient metrics.Client
+		persistence  AuditManager
+	}
+
+	clusterMetadataPersistenceClient struct {
+		metricClient metrics.Client
+		persistence  ClusterMetadataManager
+	}
 )
 
 var _ ShardManager = (*shardPersistenceClient)(nil)
@@ -63,6 +78,9 @@ var _ TaskManager = (*taskPersistenceClient)(nil)
 var _ HistoryManager = (*historyPersistenceClient)(nil)
 var _ MetadataManager = (*metadataPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityPersistenceClient)(nil)
+var _ DomainStatsManager = (*domainStatsPersistenceClient)(nil)
+var _ AuditManager = (*auditPersistenceClient)(nil)
+var _ ClusterMetadataManager = (*clusterMetadataPersistenceClient)(nil)
 
 // NewShardPersistenceClient creates a client to manage shards
 func NewShardPersistenceClient(persistence ShardManager, metricClient metrics.Client) ShardManager {
@@ -112,6 +130,31 @@ func NewVisibilityPersistenceClient(persistence VisibilityManager, metricClient
 	}
 }
 
+// NewDomainStatsPersistenceClient creates a client to manage domain statistics
+func NewDomainStatsPersistenceClient(persistence DomainStatsManager, metricClient metrics.Client) DomainStatsManager {
+	return &domainStatsPersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+	}
+}
+
+// NewAuditPersistenceClient creates a client to manage admin audit records
+func NewAuditPersistenceClient(persistence AuditManager, metricClient metrics.Client) AuditManager {
+	return &auditPersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+	}
+}
+
+// NewClusterMetadataPersistenceClient creates a client to manage the state shared by the hosts of the cluster
+func NewClusterMetadataPersistenceClient(persistence ClusterMetadataManager,
+	metricClient metrics.Client) ClusterMetadataManager {
+	return &clusterMetadataPersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+	}
+}
+
 func (p *shardPersistenceClient) CreateShard(request *CreateShardRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCreateShardScope, metrics.PersistenceRequests)
 
@@ -242,6 +285,20 @@ func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *De
 	return err
 }
 
+func (p *workflowExecutionPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteCurrentWorkflowExecution(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, err)
+	}
+
+	return err
+}
+
 func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceRequests)
 
@@ -478,6 +535,21 @@ func (p *historyPersistenceClient) GetWorkflowExecutionHistory(
 	return response, err
 }
 
+func (p *historyPersistenceClient) GetWorkflowExecutionHistoryBatch(
+	request *GetWorkflowExecutionHistoryBatchRequest) (*GetWorkflowExecutionHistoryBatchResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionHistoryBatchScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionHistoryBatchScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetWorkflowExecutionHistoryBatch(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionHistoryBatchScope, err)
+	}
+
+	return response, err
+}
+
 func (p *historyPersistenceClient) DeleteWorkflowExecutionHistory(
 	request *DeleteWorkflowExecutionHistoryRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
@@ -581,6 +653,20 @@ func (p *metadataPersistenceClient) DeleteDomainByName(request *DeleteDomainByNa
 	return err
 }
 
+func (p *metadataPersistenceClient) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListDomainsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListDomainsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListDomains(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListDomainsScope, err)
+	}
+
+	return response, err
+}
+
 func (p *metadataPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -738,6 +824,20 @@ func (p *visibilityPersistenceClient) GetClosedWorkflowExecution(request *GetClo
 	return response, err
 }
 
+func (p *visibilityPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteWorkflowExecution(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, err)
+	}
+
+	return err
+}
+
 func (p *visibilityPersistenceClient) updateErrorMetric(scope int, err error) {
 	switch err.(type) {
 	case *ConditionFailedError:
@@ -758,3 +858,193 @@ func (p *visibilityPersistenceClient) updateErrorMetric(scope int, err error) {
 func (p *visibilityPersistenceClient) Close() {
 	p.persistence.Close()
 }
+
+func (p *domainStatsPersistenceClient) AddHistoryCounts(request *AddHistoryCountsRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceAddHistoryCountsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceAddHistoryCountsScope, metrics.PersistenceLatency)
+	err := p.persistence.AddHistoryCounts(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceAddHistoryCountsScope, err)
+	}
+
+	return err
+}
+
+func (p *domainStatsPersistenceClient) GetHistoryCounts(request *GetHistoryCountsRequest) (*GetHistoryCountsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetHistoryCountsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetHistoryCountsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetHistoryCounts(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetHistoryCountsScope, err)
+	}
+
+	return response, err
+}
+
+func (p *domainStatsPersistenceClient) ListHistoryCountDomains(request *ListHistoryCountDomainsRequest) (*ListHistoryCountDomainsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListHistoryCountDomainsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListHistoryCountDomainsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListHistoryCountDomains(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListHistoryCountDomainsScope, err)
+	}
+
+	return response, err
+}
+
+func (p *domainStatsPersistenceClient) UpsertDomainStats(request *UpsertDomainStatsRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpsertDomainStatsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpsertDomainStatsScope, metrics.PersistenceLatency)
+	err := p.persistence.UpsertDomainStats(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceUpsertDomainStatsScope, err)
+	}
+
+	return err
+}
+
+func (p *domainStatsPersistenceClient) GetDomainStats(request *GetDomainStatsRequest) (*GetDomainStatsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetDomainStatsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetDomainStatsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetDomainStats(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetDomainStatsScope, err)
+	}
+
+	return response, err
+}
+
+func (p *domainStatsPersistenceClient) updateErrorMetric(scope int, err error) {
+	switch err.(type) {
+	case *workflow.ServiceBusyError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	default:
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	}
+}
+
+func (p *domainStatsPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *auditPersistenceClient) RecordAudit(request *RecordAuditRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRecordAuditScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRecordAuditScope, metrics.PersistenceLatency)
+	err := p.persistence.RecordAudit(request)
+	sw.Stop()
+
+	if err != nil {
+		p.metricClient.IncCounter(metrics.PersistenceRecordAuditScope, metrics.PersistenceFailures)
+	}
+
+	return err
+}
+
+func (p *auditPersistenceClient) ListAuditRecords(request *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListAuditRecordsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListAuditRecordsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListAuditRecords(request)
+	sw.Stop()
+
+	if err != nil {
+		p.metricClient.IncCounter(metrics.PersistenceListAuditRecordsScope, metrics.PersistenceFailures)
+	}
+
+	return response, err
+}
+
+func (p *auditPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *clusterMetadataPersistenceClient) GetMaintenanceMode() (*GetMaintenanceModeResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetMaintenanceModeScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetMaintenanceModeScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetMaintenanceMode()
+	sw.Stop()
+
+	if err != nil {
+		p.metricClient.IncCounter(metrics.PersistenceGetMaintenanceModeScope, metrics.PersistenceFailures)
+	}
+
+	return response, err
+}
+
+func (p *clusterMetadataPersistenceClient) UpdateMaintenanceMode(request *UpdateMaintenanceModeRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpdateMaintenanceModeScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateMaintenanceModeScope, metrics.PersistenceLatency)
+	err := p.persistence.UpdateMaintenanceMode(request)
+	sw.Stop()
+
+	if err != nil {
+		p.metricClient.IncCounter(metrics.PersistenceUpdateMaintenanceModeScope, metrics.PersistenceFailures)
+	}
+
+	return err
+}
+
+func (p *clusterMetadataPersistenceClient) GetShardPlacement() (*GetShardPlacementResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetShardPlacementScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetShardPlacementScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetShardPlacement()
+	sw.Stop()
+
+	if err != nil {
+		p.metricClient.IncCounter(metrics.PersistenceGetShardPlacementScope, metrics.PersistenceFailures)
+	}
+
+	return response, err
+}
+
+func (p *clusterMetadataPersistenceClient) UpdateShardPlacement(request *UpdateShardPlacementRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpdateShardPlacementScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateShardPlacementScope, metrics.PersistenceLatency)
+	err := p.persistence.UpdateShardPlacement(request)
+	sw.Stop()
+
+	if err != nil {
+		p.metricClient.IncCounter(metrics.PersistenceUpdateShardPlacementScope, metrics.PersistenceFailures)
+	}
+
+	return err
+}
+
+func (p *clusterMetadataPersistenceClient) ResetShardPlacement() error {
+	p.metricClient.IncCounter(metrics.PersistenceResetShardPlacementScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceResetShardPlacementScope, metrics.PersistenceLatency)
+	err := p.persistence.ResetShardPlacement()
+	sw.Stop()
+
+	if err != nil {
+		p.metricClient.IncCounter(metrics.PersistenceResetShardPlacementScope, metrics.PersistenceFailures)
+	}
+
+	return err
+}
+
+func (p *clusterMetadataPersistenceClient) Close() {
+	p.persistence.Close()
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/service/config"
 
 	"github.com/gocql/gocql"
 	"github.com/pborman/uuid"
@@ -45,6 +46,9 @@ const (
 	testPassword             = ""
 	testDatacenter           = ""
 	testSchemaDir            = "../.."
+	// testPayloadEncryptionKey is the key of the managers encrypting payloads in the encryption tests, the managers
+	// of the test base write payloads unencrypted
+	testPayloadEncryptionKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
 )
 
 type (
@@ -78,6 +82,9 @@ type (
 		HistoryMgr           HistoryManager
 		MetadataManager      MetadataManager
 		VisibilityMgr        VisibilityManager
+		DomainStatsMgr       DomainStatsManager
+		AuditMgr             AuditManager
+		ClusterMetadataMgr   ClusterMetadataManager
 		ShardInfo            *ShardInfo
 		TaskIDGenerator      TransferTaskIDGenerator
 		ClusterMetadata      cluster.Metadata
@@ -123,12 +130,14 @@ func (s *TestBase) SetupWorkflowStoreWithOptions(options TestBaseOptions) {
 	shardID := 0
 	var err error
 	s.ShardMgr, err = NewCassandraShardPersistence(options.ClusterHost, options.ClusterPort, options.ClusterUser,
-		options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, s.ClusterMetadata.GetCurrentClusterName(), log)
+		options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, s.ClusterMetadata.GetCurrentClusterName(),
+		config.CassandraTimeouts{}, log)
 	if err != nil {
 		log.Fatal(err)
 	}
 	s.ExecutionMgrFactory, err = NewCassandraPersistenceClientFactory(options.ClusterHost, options.ClusterPort,
-		options.ClusterUser, options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, 2, log, nil)
+		options.ClusterUser, options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, 2,
+		config.CassandraTimeouts{}, nil, log, nil)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -139,13 +148,14 @@ func (s *TestBase) SetupWorkflowStoreWithOptions(options TestBaseOptions) {
 	}
 	s.TaskMgr, err = NewCassandraTaskPersistence(options.ClusterHost, options.ClusterPort, options.ClusterUser,
 		options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace,
-		log)
+		config.CassandraTimeouts{}, log)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	s.HistoryMgr, err = NewCassandraHistoryPersistence(options.ClusterHost, options.ClusterPort, options.ClusterUser,
-		options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, 2, log)
+		options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, 2,
+		config.CassandraTimeouts{}, HistoryCompressionNone, nil, log)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -157,11 +167,31 @@ func (s *TestBase) SetupWorkflowStoreWithOptions(options TestBaseOptions) {
 	}
 
 	s.VisibilityMgr, err = NewCassandraVisibilityPersistence(options.ClusterHost, options.ClusterPort,
+		options.ClusterUser, options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace,
+		config.CassandraTimeouts{}, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s.DomainStatsMgr, err = NewCassandraDomainStatsPersistence(options.ClusterHost, options.ClusterPort,
+		options.ClusterUser, options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s.AuditMgr, err = NewCassandraAuditPersistence(options.ClusterHost, options.ClusterPort,
 		options.ClusterUser, options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, log)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	s.ClusterMetadataMgr, err = NewCassandraClusterMetadataPersistence(options.ClusterHost, options.ClusterPort,
+		options.ClusterUser, options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace,
+		s.ClusterMetadata.GetCurrentClusterName(), log)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	s.TaskIDGenerator = &testTransferTaskIDGenerator{}
 
 	// Create a shard for test
@@ -20,7 +20,11 @@
 
 package persistence
 
-import s "github.com/uber/cadence/.gen/go/shared"
+import (
+	"time"
+
+	s "github.com/uber/cadence/.gen/go/shared"
+)
 
 // Interfaces for the Visibility Store.
 // This is a secondary store that is eventually consistent with the main
@@ -37,6 +41,7 @@ type (
 		WorkflowTypeName string
 		StartTimestamp   int64
 		WorkflowTimeout  int64
+		Initiator        *s.WorkflowExecutionInitiator
 	}
 
 	// RecordWorkflowExecutionClosedRequest is used to add a record of a newly
@@ -50,6 +55,7 @@ type (
 		Status           s.WorkflowExecutionCloseStatus
 		HistoryLength    int64
 		RetentionSeconds int64
+		Initiator        *s.WorkflowExecutionInitiator
 	}
 
 	// ListWorkflowExecutionsRequest is used to list executions in a domain
@@ -104,6 +110,13 @@ type (
 		Execution *s.WorkflowExecutionInfo
 	}
 
+	// VisibilityDeleteWorkflowExecutionRequest is used to delete the open and closed records of an execution
+	VisibilityDeleteWorkflowExecutionRequest struct {
+		DomainUUID     string
+		RunID          string
+		StartTimestamp int64
+	}
+
 	// VisibilityManager is used to manage the visibility store
 	VisibilityManager interface {
 		Closeable
@@ -117,5 +130,19 @@ type (
 		ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error)
 		GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error)
+		DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error
 	}
 )
+
+// ListOpenWorkflowExecutionsOlderThan lists a page of the executions of a domain which are still open and were started
+// before the given time
+func ListOpenWorkflowExecutionsOlderThan(visibilityMgr VisibilityManager, domainID string, olderThan time.Time,
+	pageSize int, nextPageToken []byte) (*ListWorkflowExecutionsResponse, error) {
+	return visibilityMgr.ListOpenWorkflowExecutions(&ListWorkflowExecutionsRequest{
+		DomainUUID:        domainID,
+		EarliestStartTime: 0,
+		LatestStartTime:   olderThan.UnixNano(),
+		PageSize:          pageSize,
+		NextPageToken:     nextPageToken,
+	})
+}
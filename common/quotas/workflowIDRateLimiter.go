@@ -0,0 +1,93 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package quotas
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// WorkflowIDRateLimiter throttles a single kind of operation per workflow ID, so that one hot
+	// workflow instance cannot consume the capacity of the hosts serving it
+	WorkflowIDRateLimiter struct {
+		rps        dynamicconfig.IntPropertyFn
+		buckets    cache.Cache
+		timeSource common.TimeSource
+	}
+
+	workflowIDTokenBucket struct {
+		rps int
+		common.TokenBucket
+	}
+
+	workflowIDRateLimiterKey struct {
+		domainID   string
+		workflowID string
+	}
+)
+
+// NewWorkflowIDRateLimiter creates a WorkflowIDRateLimiter, the token buckets of at most maxSize workflow IDs are
+// kept, each for ttl after it was last used
+func NewWorkflowIDRateLimiter(rps dynamicconfig.IntPropertyFn, maxSize int, ttl time.Duration,
+	timeSource common.TimeSource) *WorkflowIDRateLimiter {
+	return &WorkflowIDRateLimiter{
+		rps: rps,
+		buckets: cache.New(maxSize, &cache.Options{
+			TTL: ttl,
+		}),
+		timeSource: timeSource,
+	}
+}
+
+// Allow returns true if the operation on the given workflow ID is within the configured rate,
+// a non-positive rate disables the limit
+func (l *WorkflowIDRateLimiter) Allow(domainID, workflowID string) bool {
+	rps := l.rps()
+	if rps <= 0 {
+		return true
+	}
+
+	key := workflowIDRateLimiterKey{domainID: domainID, workflowID: workflowID}
+	bucket, ok := l.buckets.Get(key).(*workflowIDTokenBucket)
+	if !ok {
+		newBucket := l.newBucket(rps)
+		existing, err := l.buckets.PutIfNotExist(key, newBucket)
+		if err != nil {
+			// cache is full, do not reject the request because of the limiter itself
+			return true
+		}
+		bucket = existing.(*workflowIDTokenBucket)
+	} else if bucket.rps != rps {
+		// the rate was changed through dynamic config, start over with a fresh bucket
+		bucket = l.newBucket(rps)
+		l.buckets.Put(key, bucket)
+	}
+
+	allowed, _ := bucket.TryConsume(1)
+	return allowed
+}
+
+func (l *WorkflowIDRateLimiter) newBucket(rps int) *workflowIDTokenBucket {
+	return &workflowIDTokenBucket{rps: rps, TokenBucket: common.NewTokenBucket(rps, l.timeSource)}
+}
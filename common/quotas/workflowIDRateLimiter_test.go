@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package quotas
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	workflowIDRateLimiterSuite struct {
+		suite.Suite
+		rps        int
+		timeSource *common.FakeTimeSource
+		limiter    *WorkflowIDRateLimiter
+	}
+)
+
+func TestWorkflowIDRateLimiterSuite(t *testing.T) {
+	s := new(workflowIDRateLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowIDRateLimiterSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+func (s *workflowIDRateLimiterSuite) SetupTest() {
+	s.rps = 10
+	s.timeSource = common.NewFakeTimeSource()
+	s.timeSource.Update(time.Now())
+	rps := func(opts ...dynamicconfig.FilterOption) int {
+		return s.rps
+	}
+	s.limiter = NewWorkflowIDRateLimiter(rps, 100, time.Minute, s.timeSource)
+}
+
+func (s *workflowIDRateLimiterSuite) TestDisabled() {
+	s.rps = 0
+	for i := 0; i < 100; i++ {
+		s.True(s.limiter.Allow("domain", "wId"))
+	}
+}
+
+func (s *workflowIDRateLimiterSuite) TestThrottlePerWorkflowID() {
+	s.True(s.limiter.Allow("domain", "wId"))
+	s.True(s.exhaust("domain", "wId"))
+
+	// other workflow IDs, including the same ID in another domain, are not affected
+	s.True(s.limiter.Allow("domain", "wId2"))
+	s.True(s.limiter.Allow("domain2", "wId"))
+
+	// tokens are refilled as time moves on
+	s.timeSource.Update(s.timeSource.Now().Add(time.Second))
+	s.True(s.limiter.Allow("domain", "wId"))
+}
+
+func (s *workflowIDRateLimiterSuite) TestRateUpdated() {
+	s.True(s.exhaust("domain", "wId"))
+
+	s.rps = 20
+	s.True(s.limiter.Allow("domain", "wId"))
+}
+
+// exhaust consumes tokens of the workflow ID until the limiter starts rejecting
+func (s *workflowIDRateLimiterSuite) exhaust(domainID, workflowID string) bool {
+	for i := 0; i < 100; i++ {
+		if !s.limiter.Allow(domainID, workflowID) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"fmt"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+const (
+	// MaxFailureTypes is the maximum number of failure types that can be attached to a failure or a retry policy
+	MaxFailureTypes = 32
+	// MaxFailureTypeLength is the maximum length of a single failure type
+	MaxFailureTypeLength = 256
+)
+
+// ValidateRetryPolicy validates a retry policy attached to a decision or a request
+func ValidateRetryPolicy(policy *workflow.RetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.GetInitialIntervalInSeconds() <= 0 {
+		return &workflow.BadRequestError{Message: "A valid InitialIntervalInSeconds is not set on retry policy."}
+	}
+	if policy.GetBackoffCoefficient() < 1 {
+		return &workflow.BadRequestError{Message: "BackoffCoefficient cannot be less than 1 on retry policy."}
+	}
+	if policy.GetMaximumIntervalInSeconds() < policy.GetInitialIntervalInSeconds() {
+		return &workflow.BadRequestError{Message: "MaximumIntervalInSeconds cannot be less than InitialIntervalInSeconds on retry policy."}
+	}
+	if err := validateFailureTypes(policy.NonRetriableErrorTypes, "NonRetriableErrorTypes"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateWorkflowRetryPolicy validates a retry policy attached to a workflow start request
+func ValidateWorkflowRetryPolicy(policy *workflow.RetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if err := ValidateRetryPolicy(policy); err != nil {
+		return err
+	}
+	if policy.GetExpirationIntervalInSeconds() < 0 {
+		return &workflow.BadRequestError{Message: "ExpirationIntervalInSeconds cannot be less than 0 on retry policy."}
+	}
+	if policy.GetMaximumAttempts() <= 0 && policy.GetExpirationIntervalInSeconds() == 0 {
+		return &workflow.BadRequestError{
+			Message: "MaximumAttempts or ExpirationIntervalInSeconds must be set on workflow retry policy.",
+		}
+	}
+	if len(policy.NonRetriableErrorTypes) > 0 {
+		// workflow failures only carry a reason
+		return &workflow.BadRequestError{Message: "NonRetriableErrorTypes is not supported on workflow retry policy."}
+	}
+	return nil
+}
+
+// ValidateFailureTypes validates the failure types reported together with an activity failure
+func ValidateFailureTypes(failureTypes []string) error {
+	return validateFailureTypes(failureTypes, "FailureTypes")
+}
+
+func validateFailureTypes(failureTypes []string, field string) error {
+	if len(failureTypes) > MaxFailureTypes {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("%v cannot have more than %v entries.", field, MaxFailureTypes),
+		}
+	}
+	for _, t := range failureTypes {
+		if t == "" {
+			return &workflow.BadRequestError{Message: fmt.Sprintf("%v cannot contain empty entries.", field)}
+		}
+		if len(t) > MaxFailureTypeLength {
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("%v entries cannot be longer than %v characters.", field, MaxFailureTypeLength),
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	retryPolicySuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestRetryPolicyValidationSuite(t *testing.T) {
+	suite.Run(t, new(retryPolicySuite))
+}
+
+func (s *retryPolicySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *retryPolicySuite) TestValidateRetryPolicy() {
+	s.NoError(ValidateRetryPolicy(nil))
+
+	policy := &workflow.RetryPolicy{
+		InitialIntervalInSeconds: Int32Ptr(1),
+		BackoffCoefficient:       Float64Ptr(2),
+		MaximumIntervalInSeconds: Int32Ptr(10),
+		NonRetriableErrorTypes:   []string{"InvalidArgumentError"},
+	}
+	s.NoError(ValidateRetryPolicy(policy))
+
+	policy.InitialIntervalInSeconds = Int32Ptr(0)
+	s.IsType(&workflow.BadRequestError{}, ValidateRetryPolicy(policy))
+	policy.InitialIntervalInSeconds = Int32Ptr(1)
+
+	policy.BackoffCoefficient = Float64Ptr(0.5)
+	s.IsType(&workflow.BadRequestError{}, ValidateRetryPolicy(policy))
+	policy.BackoffCoefficient = Float64Ptr(2)
+
+	policy.MaximumIntervalInSeconds = Int32Ptr(0)
+	s.IsType(&workflow.BadRequestError{}, ValidateRetryPolicy(policy))
+	policy.MaximumIntervalInSeconds = Int32Ptr(10)
+
+	policy.NonRetriableErrorTypes = []string{"InvalidArgumentError", ""}
+	s.IsType(&workflow.BadRequestError{}, ValidateRetryPolicy(policy))
+}
+
+func (s *retryPolicySuite) TestValidateWorkflowRetryPolicy() {
+	s.NoError(ValidateWorkflowRetryPolicy(nil))
+
+	policy := &workflow.RetryPolicy{
+		InitialIntervalInSeconds: Int32Ptr(1),
+		BackoffCoefficient:       Float64Ptr(2),
+		MaximumIntervalInSeconds: Int32Ptr(10),
+		MaximumAttempts:          Int32Ptr(3),
+	}
+	s.NoError(ValidateWorkflowRetryPolicy(policy))
+
+	policy.MaximumAttempts = nil
+	s.IsType(&workflow.BadRequestError{}, ValidateWorkflowRetryPolicy(policy))
+	policy.ExpirationIntervalInSeconds = Int32Ptr(60)
+	s.NoError(ValidateWorkflowRetryPolicy(policy))
+
+	policy.ExpirationIntervalInSeconds = Int32Ptr(-1)
+	s.IsType(&workflow.BadRequestError{}, ValidateWorkflowRetryPolicy(policy))
+	policy.ExpirationIntervalInSeconds = Int32Ptr(60)
+
+	policy.NonRetriableErrorTypes = []string{"InvalidArgumentError"}
+	s.IsType(&workflow.BadRequestError{}, ValidateWorkflowRetryPolicy(policy))
+}
+
+func (s *retryPolicySuite) TestValidateFailureTypes() {
+	s.NoError(ValidateFailureTypes(nil))
+	s.NoError(ValidateFailureTypes([]string{"IOError", "Error"}))
+
+	s.IsType(&workflow.BadRequestError{}, ValidateFailureTypes([]string{strings.Repeat("a", MaxFailureTypeLength+1)}))
+
+	tooMany := make([]string, MaxFailureTypes+1)
+	for i := range tooMany {
+		tooMany[i] = "Error"
+	}
+	s.IsType(&workflow.BadRequestError{}, ValidateFailureTypes(tooMany))
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"sort"
+)
+
+// The stores which can be placed on a datastore of their own
+const (
+	StoreShard      = "shard"
+	StoreExecution  = "execution"
+	StoreHistory    = "history"
+	StoreTask       = "task"
+	StoreMetadata   = "metadata"
+	StoreVisibility = "visibility"
+)
+
+var stores = map[string]bool{
+	StoreShard:      true,
+	StoreExecution:  true,
+	StoreHistory:    true,
+	StoreTask:       true,
+	StoreMetadata:   true,
+	StoreVisibility: true,
+}
+
+// ForStore returns the cassandra config to connect to the given store with. The connection settings and keyspace
+// are replaced by those of the datastore of the store, if it is placed on another cluster; the keyspace is then
+// used for visibility as well.
+func (c Cassandra) ForStore(store string) Cassandra {
+	name, ok := c.Stores[store]
+	if !ok {
+		return c
+	}
+
+	datastore := c.Datastores[name]
+	c.Hosts = datastore.Hosts
+	c.Port = datastore.Port
+	c.User = datastore.User
+	c.Password = datastore.Password
+	c.Datacenter = datastore.Datacenter
+	c.Keyspace = datastore.Keyspace
+	c.VisibilityKeyspace = datastore.Keyspace
+	return c
+}
+
+// ValidateStores checks that each store is placed on a datastore which is configured, and that the shard and
+// execution stores are placed together since the shard row is part of the executions table: every update of an
+// execution is a batch conditioned on the range ID of its shard.
+func (c Cassandra) ValidateStores() error {
+	if c.Stores[StoreShard] != c.Stores[StoreExecution] {
+		return fmt.Errorf("cassandra stores %q and %q must be placed on the same datastore", StoreShard, StoreExecution)
+	}
+	for _, store := range sortedKeys(c.Stores) {
+		name := c.Stores[store]
+		if !stores[store] {
+			return fmt.Errorf("cassandra store %q is unknown", store)
+		}
+		datastore, ok := c.Datastores[name]
+		if !ok {
+			return fmt.Errorf("cassandra datastore %q of store %q is not configured", name, store)
+		}
+		if datastore.Hosts == "" || datastore.Keyspace == "" {
+			return fmt.Errorf("cassandra datastore %q must have hosts and a keyspace", name)
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CassandraSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestCassandraSuite(t *testing.T) {
+	suite.Run(t, new(CassandraSuite))
+}
+
+func (s *CassandraSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *CassandraSuite) TestForStore() {
+	cfg := Cassandra{
+		Hosts:              "10.0.0.1",
+		Keyspace:           "cadence",
+		VisibilityKeyspace: "cadence_visibility",
+		Consistency:        "One",
+		Datastores: map[string]CassandraDatastore{
+			"local": {Hosts: "127.0.0.1", Port: 9043, Keyspace: "cadence_tasks"},
+		},
+		Stores: map[string]string{StoreTask: "local"},
+	}
+	s.NoError(cfg.ValidateStores())
+
+	s.Equal(cfg, cfg.ForStore(StoreHistory))
+
+	taskCfg := cfg.ForStore(StoreTask)
+	s.Equal("127.0.0.1", taskCfg.Hosts)
+	s.Equal(9043, taskCfg.Port)
+	s.Equal("cadence_tasks", taskCfg.Keyspace)
+	s.Equal("cadence_tasks", taskCfg.VisibilityKeyspace)
+	s.Equal("One", taskCfg.Consistency)
+	s.Equal("10.0.0.1", cfg.Hosts)
+}
+
+func (s *CassandraSuite) TestValidateStores() {
+	cfg := Cassandra{
+		Datastores: map[string]CassandraDatastore{
+			"local":      {Hosts: "127.0.0.1", Keyspace: "cadence_tasks"},
+			"incomplete": {Hosts: "127.0.0.1"},
+		},
+	}
+	s.NoError(cfg.ValidateStores())
+
+	cfg.Stores = map[string]string{"tasks": "local"}
+	s.EqualError(cfg.ValidateStores(), `cassandra store "tasks" is unknown`)
+
+	cfg.Stores = map[string]string{StoreTask: "remote"}
+	s.EqualError(cfg.ValidateStores(), `cassandra datastore "remote" of store "task" is not configured`)
+
+	cfg.Stores = map[string]string{StoreTask: "incomplete"}
+	s.EqualError(cfg.ValidateStores(), `cassandra datastore "incomplete" must have hosts and a keyspace`)
+
+	cfg.Stores = map[string]string{StoreExecution: "local"}
+	s.EqualError(cfg.ValidateStores(), `cassandra stores "shard" and "execution" must be placed on the same datastore`)
+
+	cfg.Stores = map[string]string{StoreShard: "local", StoreExecution: "local"}
+	s.NoError(cfg.ValidateStores())
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/uber-go/tally/m3"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/ringpop-go/discovery"
 )
@@ -44,6 +45,60 @@ type (
 		Services map[string]Service `yaml:"services"`
 		// Kafka is the config for connecting to kafka
 		Kafka messaging.KafkaConfig `yaml:"kafka"`
+		// Blobstore is the config for the blobstore used by archival and large payloads
+		Blobstore blobstore.Config `yaml:"blobstore"`
+		// StartupWait is the config for waiting on dependencies to become reachable at startup
+		StartupWait StartupWait `yaml:"startupWait"`
+		// Bench is the config for the synthetic load generated by the worker service
+		Bench Bench `yaml:"bench"`
+		// Canary is the config for the canary workflows run by the worker service
+		Canary Canary `yaml:"canary"`
+		// BulkDelete is the config for the bulk deletions of closed workflow executions run by the worker service
+		BulkDelete BulkDelete `yaml:"bulkDelete"`
+	}
+
+	// Canary describes the suite of canary workflows the worker service runs continuously against its own cluster,
+	// exercising timers, signals, child workflows and queries in each of the configured domains
+	Canary struct {
+		// Enabled is true if the worker service should run the canary workflows
+		Enabled bool `yaml:"enabled"`
+		// Domains are the registered domains the canary workflows run in
+		Domains []string `yaml:"domains"`
+		// TaskList is the task list of the canary workflows, defaults to cadence-canary
+		TaskList string `yaml:"taskList"`
+		// Interval is the interval between runs of the suite in a domain, defaults to a minute
+		Interval time.Duration `yaml:"interval"`
+	}
+
+	// BulkDelete describes where the worker service runs the workflows deleting the closed workflow executions of a
+	// domain, which are started through the admin API
+	BulkDelete struct {
+		// Enabled is true if the worker service should run the bulk delete workflows
+		Enabled bool `yaml:"enabled"`
+		// Domain is the registered domain the bulk delete workflows run in
+		Domain string `yaml:"domain"`
+		// TaskList is the task list of the bulk delete workflows, defaults to cadence-bulk-delete
+		TaskList string `yaml:"taskList"`
+	}
+
+	// Bench describes the synthetic load the worker service generates against its own cluster, to measure
+	// end to end latencies, for example when accepting a new persistence backend
+	Bench struct {
+		// Enabled is true if the worker service should generate load
+		Enabled bool `yaml:"enabled"`
+		// Domain is the registered domain the bench workflows run in
+		Domain string `yaml:"domain"`
+		// TaskList is the task list of the bench workflows, defaults to cadence-bench
+		TaskList string `yaml:"taskList"`
+		// StartRPS is the number of bench workflows started per second
+		StartRPS float64 `yaml:"startRPS"`
+		// SignalRPS is the number of signals per second sent to running bench workflows
+		SignalRPS float64 `yaml:"signalRPS"`
+		// MinHistorySize is the min number of events of a bench workflow history, not counting signals
+		MinHistorySize int `yaml:"minHistorySize"`
+		// MaxHistorySize is the max number of events of a bench workflow history, not counting signals, the size
+		// of each workflow is picked uniformly between the min and the max
+		MaxHistorySize int `yaml:"maxHistorySize"`
 	}
 
 	// Service contains the service specific config items
@@ -110,6 +165,65 @@ type (
 		Datacenter string `yaml:"datacenter"`
 		// NumHistoryShards is the desired number of history shards
 		NumHistoryShards int `yaml:"numHistoryShards" validate:"nonzero"`
+		// Timeouts are the per operation class request timeouts
+		Timeouts CassandraTimeouts `yaml:"timeouts"`
+		// HistoryCompression is the compression of the history events written, snappy or empty for none. History
+		// written with any compression remains readable after it is changed.
+		HistoryCompression string `yaml:"historyCompression"`
+		// PayloadEncryptionKey is the base64 encoded 32 byte key which the per domain keys encrypting history events
+		// and activity heartbeat details are encrypted with, empty for no encryption. Payloads written encrypted
+		// can only be read while the key is configured.
+		PayloadEncryptionKey string `yaml:"payloadEncryptionKey"`
+		// Datastores are other cassandra clusters, by name, which some of the stores can be placed on
+		Datastores map[string]CassandraDatastore `yaml:"datastores"`
+		// Stores is the name of the datastore of each store placed on another cluster, by store: shard,
+		// execution, history, task, metadata or visibility. The stores not listed are kept on this cluster. The shard
+		// and execution stores share the executions table and must be placed on the same datastore.
+		Stores map[string]string `yaml:"stores"`
+	}
+
+	// CassandraDatastore is a cassandra cluster holding some of the stores, it is connected to with the
+	// consistency and timeouts of the main cluster
+	CassandraDatastore struct {
+		// Hosts is a csv of cassandra endpoints
+		Hosts string `yaml:"hosts"`
+		// Port is the cassandra port used for connection by gocql client
+		Port int `yaml:"port"`
+		// User is the cassandra user used for authentication by gocql client
+		User string `yaml:"user"`
+		// Password is the cassandra password used for authentication by gocql client
+		Password string `yaml:"password"`
+		// Keyspace is the cassandra keyspace of the stores placed on this datastore
+		Keyspace string `yaml:"keyspace"`
+		// Datacenter is the data center filter arg for cassandra
+		Datacenter string `yaml:"datacenter"`
+	}
+
+	// CassandraTimeouts bounds each class of cassandra request separately, so slow scans cannot hold up point
+	// reads; a zero timeout leaves the request bounded only by the connection timeout, which also caps the
+	// timeouts set here
+	CassandraTimeouts struct {
+		// Read is the timeout for point reads, like loading a workflow execution
+		Read time.Duration `yaml:"read"`
+		// Write is the timeout for inserts, updates and single row deletes
+		Write time.Duration `yaml:"write"`
+		// RangeDelete is the timeout for deleting a range of rows, like the history of a run
+		RangeDelete time.Duration `yaml:"rangeDelete"`
+		// List is the timeout for paginated scans, like reading tasks, history events or visibility records
+		List time.Duration `yaml:"list"`
+	}
+
+	// StartupWait describes how long a service waits for its dependencies
+	// (cassandra, kafka) to become reachable before giving up on startup
+	StartupWait struct {
+		// Enabled is true if the service should wait instead of failing on the first attempt
+		Enabled bool `yaml:"enabled"`
+		// MaxDuration is the max total time to wait, defaults to 5 minutes
+		MaxDuration time.Duration `yaml:"maxDuration"`
+		// InitialInterval is the backoff after the first failed attempt, defaults to 1 second
+		InitialInterval time.Duration `yaml:"initialInterval"`
+		// MaxInterval is the max backoff between attempts, defaults to 30 seconds
+		MaxInterval time.Duration `yaml:"maxInterval"`
 	}
 
 	// Replicator describes the configuration of replicator
@@ -151,6 +265,10 @@ type (
 		// Tags is the set of key-value pairs to be reported
 		// as part of every metric
 		Tags map[string]string `yaml:"tags"`
+		// DomainTags maps domain names to key-value pairs, such as
+		// the owning team or cost center, reported as part of the
+		// metrics emitted for the domain
+		DomainTags map[string]map[string]string `yaml:"domainTags"`
 	}
 
 	// Statsd contains the config items for statsd metrics reporter
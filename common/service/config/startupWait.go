@@ -0,0 +1,75 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/backoff"
+)
+
+const (
+	defaultStartupWaitMaxDuration     = 5 * time.Minute
+	defaultStartupWaitInitialInterval = time.Second
+	defaultStartupWaitMaxInterval     = 30 * time.Second
+)
+
+// WaitFor calls check until it succeeds, backing off between attempts. When
+// waiting is disabled check is not called, so that startup behaves as if
+// there was no startupWait section. The last error is returned if the
+// dependency is still unreachable after MaxDuration
+func (w *StartupWait) WaitFor(dependency string, logger bark.Logger, check func() error) error {
+	if !w.Enabled {
+		return nil
+	}
+
+	policy := backoff.NewExponentialRetryPolicy(durationOrDefault(w.InitialInterval, defaultStartupWaitInitialInterval))
+	policy.SetMaximumInterval(durationOrDefault(w.MaxInterval, defaultStartupWaitMaxInterval))
+	policy.SetExpirationInterval(durationOrDefault(w.MaxDuration, defaultStartupWaitMaxDuration))
+
+	start := time.Now()
+	attempt := 0
+	err := backoff.Retry(func() error {
+		attempt++
+		err := check()
+		if err != nil {
+			logger.WithFields(bark.Fields{
+				"dependency": dependency,
+				"attempt":    attempt,
+				"elapsed":    time.Since(start).String(),
+			}).Warnf("Waiting for %v to become reachable: %v", dependency, err)
+		}
+		return err
+	}, policy, nil)
+	if err != nil {
+		return err
+	}
+	logger.Infof("%v is reachable after %v attempt(s)", dependency, attempt)
+	return nil
+}
+
+func durationOrDefault(d time.Duration, def time.Duration) time.Duration {
+	if d <= 0 {
+		return def
+	}
+	return d
+}
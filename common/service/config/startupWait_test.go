@@ -0,0 +1,82 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+)
+
+type StartupWaitSuite struct {
+	*require.Assertions
+	suite.Suite
+	logger bark.Logger
+}
+
+func TestStartupWaitSuite(t *testing.T) {
+	suite.Run(t, new(StartupWaitSuite))
+}
+
+func (s *StartupWaitSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = bark.NewLoggerFromLogrus(logrus.New())
+}
+
+func (s *StartupWaitSuite) TestDisabled() {
+	calls := 0
+	w := &StartupWait{}
+	err := w.WaitFor("cassandra", s.logger, func() error {
+		calls++
+		return errors.New("unreachable")
+	})
+	s.Nil(err)
+	s.Equal(0, calls)
+}
+
+func (s *StartupWaitSuite) TestEventuallyReachable() {
+	calls := 0
+	w := &StartupWait{Enabled: true, InitialInterval: time.Millisecond, MaxInterval: time.Millisecond}
+	err := w.WaitFor("cassandra", s.logger, func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("unreachable")
+		}
+		return nil
+	})
+	s.Nil(err)
+	s.Equal(3, calls)
+}
+
+func (s *StartupWaitSuite) TestMaxDuration() {
+	w := &StartupWait{Enabled: true, MaxDuration: 20 * time.Millisecond, InitialInterval: time.Millisecond}
+	start := time.Now()
+	err := w.WaitFor("kafka", s.logger, func() error {
+		return errors.New("unreachable")
+	})
+	s.NotNil(err)
+	s.True(time.Since(start) < time.Second)
+}
@@ -26,8 +26,14 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/metrics"
 )
 
+// ErrKeyNotFound is returned by Client when the key is not in the config source, any other error means the value in
+// the config source cannot be used, e.g. it has the wrong type
+var ErrKeyNotFound = errors.New("unable to find key")
+
 // Client allows fetching values from a dynamic configuration system NOTE: This does not have async
 // options right now. In the interest of keeping it minimal, we can add when requirement arises.
 type Client interface {
@@ -46,44 +52,49 @@ type Client interface {
 	) (time.Duration, error)
 }
 
+// KeyLister can be implemented by a Client to list the keys present in its config source
+type KeyLister interface {
+	ListKeys() []string
+}
+
 type nopClient struct{}
 
 func (mc *nopClient) GetValue(name Key, defaultValue interface{}) (interface{}, error) {
-	return nil, errors.New("unable to find key")
+	return nil, ErrKeyNotFound
 }
 
 func (mc *nopClient) GetValueWithFilters(
 	name Key, filters map[Filter]interface{}, defaultValue interface{},
 ) (interface{}, error) {
-	return nil, errors.New("unable to find key")
+	return nil, ErrKeyNotFound
 }
 
 func (mc *nopClient) GetIntValue(name Key, filters map[Filter]interface{}, defaultValue int) (int, error) {
-	return defaultValue, errors.New("unable to find key")
+	return defaultValue, ErrKeyNotFound
 }
 
 func (mc *nopClient) GetFloatValue(name Key, filters map[Filter]interface{}, defaultValue float64) (float64, error) {
-	return defaultValue, errors.New("unable to find key")
+	return defaultValue, ErrKeyNotFound
 }
 
 func (mc *nopClient) GetBoolValue(name Key, filters map[Filter]interface{}, defaultValue bool) (bool, error) {
-	return defaultValue, errors.New("unable to find key")
+	return defaultValue, ErrKeyNotFound
 }
 
 func (mc *nopClient) GetStringValue(name Key, filters map[Filter]interface{}, defaultValue string) (string, error) {
-	return defaultValue, errors.New("unable to find key")
+	return defaultValue, ErrKeyNotFound
 }
 
 func (mc *nopClient) GetMapValue(
 	name Key, filters map[Filter]interface{}, defaultValue map[string]interface{},
 ) (map[string]interface{}, error) {
-	return defaultValue, errors.New("unable to find key")
+	return defaultValue, ErrKeyNotFound
 }
 
 func (mc *nopClient) GetDurationValue(
 	name Key, filters map[Filter]interface{}, defaultValue time.Duration,
 ) (time.Duration, error) {
-	return defaultValue, errors.New("unable to find key")
+	return defaultValue, ErrKeyNotFound
 }
 
 // NewNopClient creates a nop client
@@ -93,5 +104,6 @@ func NewNopClient() Client {
 
 // NewNopCollection creates a new nop collection
 func NewNopCollection() *Collection {
-	return NewCollection(&nopClient{}, bark.NewLoggerFromLogrus(logrus.New()))
+	return NewCollection(&nopClient{}, bark.NewLoggerFromLogrus(logrus.New()),
+		metrics.NewClient(tally.NoopScope, metrics.Common))
 }
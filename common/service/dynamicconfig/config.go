@@ -21,28 +21,91 @@
 package dynamicconfig
 
 import (
+	"sync"
 	"time"
 
 	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/metrics"
 )
 
-// NewCollection creates a new collection
-func NewCollection(client Client, logger bark.Logger) *Collection {
-	return &Collection{client, logger}
+const invalidValueLogInterval = time.Minute
+
+// NewCollection creates a new collection, if the client implements KeyLister the keys of its config source
+// which are unknown to cadence are logged
+func NewCollection(client Client, logger bark.Logger, metricsClient metrics.Client) *Collection {
+	c := &Collection{
+		client:          client,
+		logger:          logger,
+		metricsClient:   metricsClient,
+		invalidValueLog: make(map[Key]time.Time),
+	}
+	if lister, ok := client.(KeyLister); ok {
+		c.logUnknownKeys(lister.ListKeys())
+	}
+	return c
 }
 
 // Collection wraps dynamic config client with a closure so that across the code, the config values
 // can be directly accessed by calling the function without propagating the client everywhere in
 // code
 type Collection struct {
-	client Client
-	logger bark.Logger
+	client        Client
+	logger        bark.Logger
+	metricsClient metrics.Client
+
+	sync.Mutex
+	// last time an invalid value was logged for a key
+	invalidValueLog map[Key]time.Time
+}
+
+func (c *Collection) logUnknownKeys(sourceKeys []string) {
+	known := make(map[string]struct{}, len(keys))
+	for _, k := range keys[unknownKey+1:] {
+		known[k] = struct{}{}
+	}
+	for _, k := range sourceKeys {
+		if _, ok := known[k]; !ok {
+			c.logger.Warnf("Unknown key: %s in dynamic config source, it is ignored", k)
+		}
+	}
+}
+
+func (c *Collection) keyMetrics(key Key) metrics.Client {
+	return c.metricsClient.Tagged(map[string]string{metrics.ConfigKeyTagName: key.String()})
+}
+
+// recordLookup records whether the value of a lookup came from the config source or is the default value
+func (c *Collection) recordLookup(key Key, m metrics.Client, err error) {
+	if err == nil {
+		m.IncCounter(metrics.DynamicConfigScope, metrics.DynamicConfigBackendValueCounter)
+		return
+	}
+	m.IncCounter(metrics.DynamicConfigScope, metrics.DynamicConfigDefaultValueCounter)
+	if err == ErrKeyNotFound {
+		c.logNoValue(key, err)
+		return
+	}
+	// the key is in the config source but its value cannot be used, e.g. it has the wrong type
+	c.logInvalidValue(key, err)
 }
 
 func (c *Collection) logNoValue(key Key, err error) {
 	c.logger.Debugf("Failed to fetch key: %s from dynamic config with err: %s", key.String(), err.Error())
 }
 
+func (c *Collection) logInvalidValue(key Key, err error) {
+	now := time.Now()
+	c.Lock()
+	if now.Sub(c.invalidValueLog[key]) < invalidValueLogInterval {
+		c.Unlock()
+		return
+	}
+	c.invalidValueLog[key] = now
+	c.Unlock()
+	c.logger.Warnf("Invalid value of key: %s in dynamic config, using default value. Error: %s",
+		key.String(), err.Error())
+}
+
 // PropertyFn is a wrapper to get property from dynamic config
 type PropertyFn func() interface{}
 
@@ -58,13 +121,15 @@ type DurationPropertyFn func(opts ...FilterOption) time.Duration
 // BoolPropertyFn is a wrapper to get bool property from dynamic config
 type BoolPropertyFn func(opts ...FilterOption) bool
 
+// StringPropertyFn is a wrapper to get string property from dynamic config
+type StringPropertyFn func(opts ...FilterOption) string
+
 // GetProperty gets a eface property and returns defaultValue if property is not found
 func (c *Collection) GetProperty(key Key, defaultValue interface{}) PropertyFn {
+	m := c.keyMetrics(key)
 	return func() interface{} {
 		val, err := c.client.GetValue(key, defaultValue)
-		if err != nil {
-			c.logNoValue(key, err)
-		}
+		c.recordLookup(key, m, err)
 		return val
 	}
 }
@@ -80,44 +145,50 @@ func getFilterMap(opts ...FilterOption) map[Filter]interface{} {
 
 // GetIntProperty gets property and asserts that it's an integer
 func (c *Collection) GetIntProperty(key Key, defaultValue int) IntPropertyFn {
+	m := c.keyMetrics(key)
 	return func(opts ...FilterOption) int {
 		val, err := c.client.GetIntValue(key, getFilterMap(opts...), defaultValue)
-		if err != nil {
-			c.logNoValue(key, err)
-		}
+		c.recordLookup(key, m, err)
 		return val
 	}
 }
 
 // GetFloat64Property gets property and asserts that it's a float64
 func (c *Collection) GetFloat64Property(key Key, defaultValue float64) FloatPropertyFn {
+	m := c.keyMetrics(key)
 	return func(opts ...FilterOption) float64 {
 		val, err := c.client.GetFloatValue(key, getFilterMap(opts...), defaultValue)
-		if err != nil {
-			c.logNoValue(key, err)
-		}
+		c.recordLookup(key, m, err)
 		return val
 	}
 }
 
 // GetDurationProperty gets property and asserts that it's a duration
 func (c *Collection) GetDurationProperty(key Key, defaultValue time.Duration) DurationPropertyFn {
+	m := c.keyMetrics(key)
 	return func(opts ...FilterOption) time.Duration {
 		val, err := c.client.GetDurationValue(key, getFilterMap(opts...), defaultValue)
-		if err != nil {
-			c.logNoValue(key, err)
-		}
+		c.recordLookup(key, m, err)
 		return val
 	}
 }
 
 // GetBoolProperty gets property and asserts that it's an bool
 func (c *Collection) GetBoolProperty(key Key, defaultValue bool) BoolPropertyFn {
+	m := c.keyMetrics(key)
 	return func(opts ...FilterOption) bool {
 		val, err := c.client.GetBoolValue(key, getFilterMap(opts...), defaultValue)
-		if err != nil {
-			c.logNoValue(key, err)
-		}
+		c.recordLookup(key, m, err)
+		return val
+	}
+}
+
+// GetStringProperty gets property and asserts that it's a string
+func (c *Collection) GetStringProperty(key Key, defaultValue string) StringPropertyFn {
+	m := c.keyMetrics(key)
+	return func(opts ...FilterOption) string {
+		val, err := c.client.GetStringValue(key, getFilterMap(opts...), defaultValue)
+		c.recordLookup(key, m, err)
 		return val
 	}
 }
@@ -26,11 +26,13 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/metrics"
 )
 
 func BenchmarkGetIntProperty(b *testing.B) {
 	client := newInMemoryClient()
-	cln := NewCollection(client, bark.NewLoggerFromLogrus(logrus.New()))
+	cln := NewCollection(client, bark.NewLoggerFromLogrus(logrus.New()), metrics.NewClient(tally.NoopScope, metrics.Common))
 	key := MatchingMaxTaskBatchSize
 	for i := 0; i < b.N; i++ {
 		size := cln.GetIntProperty(key, 10)
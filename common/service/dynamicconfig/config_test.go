@@ -27,8 +27,11 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/metrics"
 )
 
 type inMemoryClient struct {
@@ -52,7 +55,7 @@ func (mc *inMemoryClient) GetValue(key Key, defaultValue interface{}) (interface
 	if val, ok := v[key]; ok {
 		return val, nil
 	}
-	return defaultValue, errors.New("unable to find key")
+	return defaultValue, ErrKeyNotFound
 }
 
 func (mc *inMemoryClient) GetValueWithFilters(
@@ -62,31 +65,54 @@ func (mc *inMemoryClient) GetValueWithFilters(
 }
 
 func (mc *inMemoryClient) GetIntValue(name Key, filters map[Filter]interface{}, defaultValue int) (int, error) {
-	return defaultValue, errors.New("unable to find key")
+	val, err := mc.GetValue(name, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+	if intVal, ok := val.(int); ok {
+		return intVal, nil
+	}
+	return defaultValue, errors.New("value type is not int")
 }
 
 func (mc *inMemoryClient) GetFloatValue(name Key, filters map[Filter]interface{}, defaultValue float64) (float64, error) {
-	return defaultValue, errors.New("unable to find key")
+	return defaultValue, ErrKeyNotFound
 }
 
 func (mc *inMemoryClient) GetBoolValue(name Key, filters map[Filter]interface{}, defaultValue bool) (bool, error) {
-	return defaultValue, errors.New("unable to find key")
+	return defaultValue, ErrKeyNotFound
 }
 
 func (mc *inMemoryClient) GetStringValue(name Key, filters map[Filter]interface{}, defaultValue string) (string, error) {
-	return defaultValue, errors.New("unable to find key")
+	val, err := mc.GetValue(name, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+	if stringVal, ok := val.(string); ok {
+		return stringVal, nil
+	}
+	return defaultValue, errors.New("value type is not string")
 }
 
 func (mc *inMemoryClient) GetMapValue(
 	name Key, filters map[Filter]interface{}, defaultValue map[string]interface{},
 ) (map[string]interface{}, error) {
-	return defaultValue, errors.New("unable to find key")
+	return defaultValue, ErrKeyNotFound
 }
 
 func (mc *inMemoryClient) GetDurationValue(
 	name Key, filters map[Filter]interface{}, defaultValue time.Duration,
 ) (time.Duration, error) {
-	return defaultValue, errors.New("unable to find key")
+	return defaultValue, ErrKeyNotFound
+}
+
+type listingClient struct {
+	*inMemoryClient
+	keys []string
+}
+
+func (mc *listingClient) ListKeys() []string {
+	return mc.keys
 }
 
 type configSuite struct {
@@ -102,7 +128,7 @@ func TestConfigSuite(t *testing.T) {
 
 func (s *configSuite) SetupSuite() {
 	s.client = newInMemoryClient()
-	s.cln = NewCollection(s.client, bark.NewLoggerFromLogrus(logrus.New()))
+	s.cln = NewCollection(s.client, bark.NewLoggerFromLogrus(logrus.New()), metrics.NewClient(tally.NoopScope, metrics.Common))
 }
 
 func (s *configSuite) TestGetPropertyInt() {
@@ -118,3 +144,57 @@ func (s *configSuite) TestGetDurationProperty() {
 	interval := s.cln.GetDurationProperty(key, time.Second)
 	s.Equal(time.Second, interval())
 }
+
+func (s *configSuite) TestGetStringProperty() {
+	key := FrontendWorkflowIDBlockList
+	blockList := s.cln.GetStringProperty(key, "")
+	s.Equal("", blockList())
+	s.client.SetValue(key, "^hot-.*")
+	s.Equal("^hot-.*", blockList(DomainFilter("domain")))
+}
+
+func (s *configSuite) TestLookupMetrics() {
+	client := newInMemoryClient()
+	scope := tally.NewTestScope("", nil)
+	logger, hook := test.NewNullLogger()
+	cln := NewCollection(client, bark.NewLoggerFromLogrus(logger), metrics.NewClient(scope, metrics.Common))
+
+	key := MatchingMaxTaskBatchSize
+	size := cln.GetIntProperty(key, 10)
+	s.Equal(10, size())
+	s.Equal(int64(1), counterValue(scope, "dynamic-config.default-values"))
+	s.Empty(hook.Entries)
+
+	// invalid value is logged only once within the log interval
+	client.SetValue(key, "50")
+	s.Equal(10, size())
+	s.Equal(10, size())
+	s.Equal(int64(3), counterValue(scope, "dynamic-config.default-values"))
+	s.Equal(1, len(hook.Entries))
+	s.Equal(logrus.WarnLevel, hook.LastEntry().Level)
+
+	client.SetValue(key, 50)
+	s.Equal(50, size())
+	s.Equal(int64(1), counterValue(scope, "dynamic-config.backend-values"))
+}
+
+func (s *configSuite) TestUnknownKeysLogged() {
+	client := &listingClient{
+		inMemoryClient: newInMemoryClient(),
+		keys:           []string{MatchingMaxTaskBatchSize.String(), "matching.domain.taskList.maxTaskBatchSizee"},
+	}
+	logger, hook := test.NewNullLogger()
+	NewCollection(client, bark.NewLoggerFromLogrus(logger), metrics.NewClient(tally.NoopScope, metrics.Common))
+	s.Equal(1, len(hook.Entries))
+	s.Contains(hook.LastEntry().Message, "matching.domain.taskList.maxTaskBatchSizee")
+}
+
+func counterValue(scope tally.TestScope, name string) int64 {
+	var value int64
+	for _, c := range scope.Snapshot().Counters() {
+		if c.Name() == name {
+			value += c.Value()
+		}
+	}
+	return value
+}
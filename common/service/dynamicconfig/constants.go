@@ -34,6 +34,7 @@ const (
 	_matchingRoot               = "matching."
 	_matchingDomainTaskListRoot = _matchingRoot + "domain." + "taskList."
 	_historyRoot                = "history."
+	_frontendRoot               = "frontend."
 )
 
 var keys = []string{
@@ -44,7 +45,42 @@ var keys = []string{
 	_matchingDomainTaskListRoot + "enableSyncMatch",
 	_matchingDomainTaskListRoot + "updateAckInterval",
 	_matchingDomainTaskListRoot + "idleTasklistCheckInterval",
+	_matchingDomainTaskListRoot + "syncMatchWaitDuration",
+	_matchingDomainTaskListRoot + "maxSyncMatchWaitingTasks",
 	_historyRoot + "longPollExpirationInterval",
+	_historyRoot + "maximumBufferedSignals",
+	_historyRoot + "workflowIDSignalRPS",
+	_historyRoot + "workflowIDStartRPS",
+	_historyRoot + "enableDomainStats",
+	_historyRoot + "taskFilterShadowMode",
+	_historyRoot + "minUserTimerDuration",
+	_historyRoot + "userTimerResolution",
+	_historyRoot + "maxWorkflowChainLength",
+	_frontendRoot + "minRetentionDays",
+	_frontendRoot + "maxRetentionDays",
+	_historyRoot + "enableHedgedReads",
+	_historyRoot + "hedgedReadMinDelay",
+	_historyRoot + "hedgedReadMaxPercentage",
+	_frontendRoot + "enableHedgedReads",
+	_frontendRoot + "hedgedReadMinDelay",
+	_frontendRoot + "hedgedReadMaxPercentage",
+	_frontendRoot + "workflowIDBlockList",
+	_frontendRoot + "workflowIDAllowList",
+	_frontendRoot + "workflowIDQueryRPS",
+	_historyRoot + "maximumSignalsPerExecution",
+	_historyRoot + "historyCountSuggestContinueAsNew",
+	_historyRoot + "runIDType",
+	_historyRoot + "conflictDiffLogSampleRate",
+	_frontendRoot + "maintenanceModeRefreshInterval",
+	_frontendRoot + "enableRequestDedupe",
+	_historyRoot + "enableWorkflowMetrics",
+	_historyRoot + "workflowMetricsMaxSeries",
+	_historyRoot + "enableStickyExecution",
+	_historyRoot + "stickyScheduleToStartTimeout",
+	_historyRoot + "blobStatsSampleRate",
+	_matchingDomainTaskListRoot + "enableStrictFIFO",
+	_historyRoot + "shardPlacementRefreshInterval",
+	_historyRoot + "shardDrainShardsPerMinute",
 }
 
 const (
@@ -64,8 +100,100 @@ const (
 	MatchingUpdateAckInterval
 	// MatchingIdleTasklistCheckInterval is the IdleTasklistCheckInterval
 	MatchingIdleTasklistCheckInterval
+	// MatchingSyncMatchWaitDuration is how long a new task is held in memory waiting for a poller before it is
+	// written to the database, 0 means tasks are written right away when no poller is waiting
+	MatchingSyncMatchWaitDuration
+	// MatchingMaxSyncMatchWaitingTasks is the max number of tasks of a task list held in memory waiting for a
+	// poller, further tasks are written to the database right away
+	MatchingMaxSyncMatchWaitingTasks
 	// HistoryLongPollExpirationInterval is the long poll expiration interval in the history service
 	HistoryLongPollExpirationInterval
+	// HistoryMaximumBufferedSignals is the max number of signals which can be buffered for a single workflow run
+	// while its decision task is in flight
+	HistoryMaximumBufferedSignals
+	// HistoryWorkflowIDSignalRPS is the max rate of signals to a single workflow ID, 0 means unlimited
+	HistoryWorkflowIDSignalRPS
+	// HistoryWorkflowIDStartRPS is the max rate of start requests for a single workflow ID, 0 means unlimited
+	HistoryWorkflowIDStartRPS
+	// HistoryEnableDomainStats is to enable counting the events and bytes appended to histories per domain
+	HistoryEnableDomainStats
+	// HistoryTaskFilterShadowMode is to enable evaluating candidate queue task filters next to the current ones
+	HistoryTaskFilterShadowMode
+	// HistoryMinUserTimerDuration is the minimum duration of a user timer, shorter timers fire after this duration
+	HistoryMinUserTimerDuration
+	// HistoryUserTimerResolution is the resolution user timer expiry times are rounded up to, 0 means no rounding
+	HistoryUserTimerResolution
+	// HistoryMaxWorkflowChainLength is the max number of runs returned when listing the runs chained by
+	// continue as new, earlier runs are left out
+	HistoryMaxWorkflowChainLength
+	// FrontendMinRetentionDays is the min workflow execution retention period a domain can be registered or
+	// updated with
+	FrontendMinRetentionDays
+	// FrontendMaxRetentionDays is the max workflow execution retention period a domain can be registered or
+	// updated with, the admin API can set a longer retention period
+	FrontendMaxRetentionDays
+	// HistoryEnableHedgedReads is to enable hedging history reads from persistence in the history service, a
+	// second request is sent when the first one takes longer than the recent p99 latency
+	HistoryEnableHedgedReads
+	// HistoryHedgedReadMinDelay is the min delay before a hedged read is sent in the history service
+	HistoryHedgedReadMinDelay
+	// HistoryHedgedReadMaxPercentage is the max percentage of history reads which are hedged in the history service
+	HistoryHedgedReadMaxPercentage
+	// FrontendEnableHedgedReads is to enable hedging history and visibility reads from persistence in the
+	// frontend service
+	FrontendEnableHedgedReads
+	// FrontendHedgedReadMinDelay is the min delay before a hedged read is sent in the frontend service
+	FrontendHedgedReadMinDelay
+	// FrontendHedgedReadMaxPercentage is the max percentage of reads which are hedged in the frontend service
+	FrontendHedgedReadMaxPercentage
+	// FrontendWorkflowIDBlockList is a regular expression, workflows whose ID matches it cannot be started in the
+	// domain, empty means no workflow ID is blocked
+	FrontendWorkflowIDBlockList
+	// FrontendWorkflowIDAllowList is a regular expression, only workflows whose ID matches it can be started in the
+	// domain, empty means every workflow ID is allowed
+	FrontendWorkflowIDAllowList
+	// FrontendWorkflowIDQueryRPS is the max rate of queries to a single workflow ID, 0 means unlimited
+	FrontendWorkflowIDQueryRPS
+	// HistoryMaximumSignalsPerExecution is the max number of signals a single run can receive, 0 means no limit
+	HistoryMaximumSignalsPerExecution
+	// HistoryCountSuggestContinueAsNew is the history event count above which decision tasks suggest the workflow
+	// to continue as new, 0 means never
+	HistoryCountSuggestContinueAsNew
+	// HistoryRunIDType is the type of ID given to new workflow runs, uuidv4 or the time ordered uuidv7
+	HistoryRunIDType
+	// HistoryConflictDiffLogSampleRate is the rate of workflow execution update conflicts logged along with the
+	// expected and actual state of the run, 0 means none
+	HistoryConflictDiffLogSampleRate
+	// FrontendMaintenanceModeRefreshInterval is the interval at which the frontend reloads the maintenance mode of
+	// the cluster, which bounds how long a frontend host keeps serving after maintenance is enabled through another
+	FrontendMaintenanceModeRefreshInterval
+	// FrontendEnableRequestDedupe is to return the response of a recent StartWorkflowExecution or
+	// SignalWithStartWorkflowExecution to the retries of the request with the same request ID in the domain
+	FrontendEnableRequestDedupe
+	// HistoryEnableWorkflowMetrics is to emit the metrics recorded by the workflows of the domain in metrics markers
+	HistoryEnableWorkflowMetrics
+	// HistoryWorkflowMetricsMaxSeries is the max number of distinct series, a metric name and its tags, the workflows
+	// of the domain can emit on a history host, metrics of further series are dropped
+	HistoryWorkflowMetricsMaxSeries
+	// HistoryEnableStickyExecution is to honour the sticky task list requested by workers of the domain and task
+	// list, when disabled every decision is dispatched to the normal task list
+	HistoryEnableStickyExecution
+	// HistoryStickyScheduleToStartTimeout is the schedule to start timeout of decisions dispatched to a sticky task
+	// list of the domain and task list, after which they fall back to the normal task list, 0 means the timeout
+	// requested by the worker is used
+	HistoryStickyScheduleToStartTimeout
+	// HistoryBlobStatsSampleRate is the rate of history and execution writes for which the encoding and size of the
+	// blobs written are emitted as metrics, 0 means none
+	HistoryBlobStatsSampleRate
+	// MatchingEnableStrictFIFO is to dispatch the tasks of the task list strictly in the order they were added,
+	// at the cost of throughput, by writing every task to the database instead of matching it to a waiting poller
+	MatchingEnableStrictFIFO
+	// HistoryShardPlacementRefreshInterval is the interval at which the history hosts reload the history shards
+	// pinned by the shard rebalancer, which bounds how long a moved shard stays unowned
+	HistoryShardPlacementRefreshInterval
+	// HistoryShardDrainShardsPerMinute is the number of shards a drained history host moves to other hosts per
+	// minute, when the drain request does not set it
+	HistoryShardDrainShardsPerMinute
 )
 
 // Filter represents a filter on the dynamic config key
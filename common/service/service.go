@@ -27,11 +27,14 @@ import (
 
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 
@@ -41,6 +44,8 @@ import (
 	"go.uber.org/yarpc"
 )
 
+const schemaVersionReportInterval = 10 * time.Minute
+
 var cadenceServices = []string{
 	common.FrontendServiceName,
 	common.HistoryServiceName,
@@ -63,6 +68,17 @@ type (
 		ReplicatorConfig config.Replicator
 		MessagingClient  messaging.Client
 		DynamicConfig    dynamicconfig.Client
+		BlobstoreClient  blobstore.Client
+		// PayloadValidator checks the payloads received by the frontend, optional
+		PayloadValidator payload.Validator
+		// DomainMetricTags are the tags, by domain name, added to the metrics emitted for a domain
+		DomainMetricTags map[string]map[string]string
+		// BenchConfig is the synthetic load generated by the worker service
+		BenchConfig config.Bench
+		// CanaryConfig is the canary workflows run by the worker service
+		CanaryConfig config.Canary
+		// BulkDeleteConfig is the bulk deletions of closed workflow executions run by the worker service
+		BulkDeleteConfig config.BulkDelete
 	}
 
 	// RingpopFactory provides a bootstrapped ringpop
@@ -87,10 +103,12 @@ type (
 		logger                 bark.Logger
 		metricsScope           tally.Scope
 		runtimeMetricsReporter *metrics.RuntimeMetricsReporter
+		schemaVersionReporter  *persistence.SchemaVersionReporter
 		metricsClient          metrics.Client
 		clusterMetadata        cluster.Metadata
 		messagingClient        messaging.Client
 		dynamicCollection      *dynamicconfig.Collection
+		blobstoreClient        blobstore.Client
 	}
 )
 
@@ -107,10 +125,12 @@ func New(params *BootstrapParams) Service {
 		numberOfHistoryShards: params.CassandraConfig.NumHistoryShards,
 		clusterMetadata:       params.ClusterMetadata,
 		messagingClient:       params.MessagingClient,
-		dynamicCollection:     dynamicconfig.NewCollection(params.DynamicConfig, params.Logger),
+		blobstoreClient:       params.BlobstoreClient,
 	}
 	sVice.runtimeMetricsReporter = metrics.NewRuntimeMetricsReporter(params.MetricScope, time.Minute, sVice.logger)
+	sVice.schemaVersionReporter = newSchemaVersionReporter(params.CassandraConfig, params.MetricScope, sVice.logger)
 	sVice.metricsClient = metrics.NewClient(params.MetricScope, getMetricsServiceIdx(params.Name, params.Logger))
+	sVice.dynamicCollection = dynamicconfig.NewCollection(params.DynamicConfig, params.Logger, sVice.metricsClient)
 	sVice.dispatcher = sVice.rpcFactory.CreateDispatcher()
 	if sVice.dispatcher == nil {
 		sVice.logger.Fatal("Unable to create yarpc dispatcher")
@@ -136,6 +156,9 @@ func (h *serviceImpl) Start() {
 
 	h.metricsScope.Counter(metrics.RestartCount).Inc(1)
 	h.runtimeMetricsReporter.Start()
+	if h.schemaVersionReporter != nil {
+		h.schemaVersionReporter.Start()
+	}
 
 	if err := h.pprofInitializer.Start(); err != nil {
 		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("Failed to start pprof")
@@ -197,6 +220,30 @@ func (h *serviceImpl) Stop() {
 	}
 
 	h.runtimeMetricsReporter.Stop()
+	if h.schemaVersionReporter != nil {
+		h.schemaVersionReporter.Stop()
+	}
+}
+
+// newSchemaVersionReporter returns nil when cassandra is not configured or not reachable, the schema versions are
+// only reported for dashboards and are not worth failing the service for
+func newSchemaVersionReporter(cfg config.Cassandra, scope tally.Scope,
+	logger bark.Logger) *persistence.SchemaVersionReporter {
+	if cfg.Hosts == "" {
+		return nil
+	}
+
+	keyspaces := []string{cfg.Keyspace}
+	if cfg.VisibilityKeyspace != "" && cfg.VisibilityKeyspace != cfg.Keyspace {
+		keyspaces = append(keyspaces, cfg.VisibilityKeyspace)
+	}
+	reporter, err := persistence.NewCassandraSchemaVersionReporter(cfg.Hosts, cfg.Port, cfg.User, cfg.Password,
+		cfg.Datacenter, keyspaces, scope, schemaVersionReportInterval, logger)
+	if err != nil {
+		logger.WithFields(bark.Fields{logging.TagErr: err}).Warn("Failed to create schema version reporter")
+		return nil
+	}
+	return reporter
 }
 
 // GetLogger returns the service logger
@@ -234,6 +281,11 @@ func (h *serviceImpl) GetMessagingClient() messaging.Client {
 	return h.messagingClient
 }
 
+// GetBlobstoreClient returns the blobstore client, nil if blobstore is not configured
+func (h *serviceImpl) GetBlobstoreClient() blobstore.Client {
+	return h.blobstoreClient
+}
+
 func getMetricsServiceIdx(serviceName string, logger bark.Logger) metrics.ServiceIdx {
 	switch serviceName {
 	case common.FrontendServiceName:
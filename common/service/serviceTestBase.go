@@ -22,6 +22,7 @@ package service
 
 import (
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
@@ -120,3 +121,8 @@ func (s *serviceTestBase) GetClusterMetadata() cluster.Metadata {
 func (s *serviceTestBase) GetMessagingClient() messaging.Client {
 	return s.messagingClient
 }
+
+// GetBlobstoreClient returns the blobstore client
+func (s *serviceTestBase) GetBlobstoreClient() blobstore.Client {
+	return nil
+}
@@ -23,6 +23,7 @@ package service
 import (
 	"github.com/uber-common/bark"
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
@@ -59,5 +60,8 @@ type (
 
 		// GetMessagingClient returns the messaging client against Kafka
 		GetMessagingClient() messaging.Client
+
+		// GetBlobstoreClient returns the blobstore client, nil if blobstore is not configured
+		GetBlobstoreClient() blobstore.Client
 	}
 )
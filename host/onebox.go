@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
@@ -89,6 +90,7 @@ type (
 		historyMgr            persistence.HistoryManager
 		taskMgr               persistence.TaskManager
 		visibilityMgr         persistence.VisibilityManager
+		clusterMetadataMgr    persistence.ClusterMetadataManager
 		executionMgrFactory   persistence.ExecutionManagerFactory
 		shutdownCh            chan struct{}
 		shutdownWG            sync.WaitGroup
@@ -105,7 +107,8 @@ type (
 func NewCadence(clusterMetadata cluster.Metadata, messagingClient messaging.Client,
 	metadataMgr persistence.MetadataManager, shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager,
 	executionMgrFactory persistence.ExecutionManagerFactory, taskMgr persistence.TaskManager,
-	visibilityMgr persistence.VisibilityManager, numberOfHistoryShards, numberOfHistoryHosts int,
+	visibilityMgr persistence.VisibilityManager, clusterMetadataMgr persistence.ClusterMetadataManager,
+	numberOfHistoryShards, numberOfHistoryHosts int,
 	logger bark.Logger) Cadence {
 
 	return &cadenceImpl{
@@ -116,6 +119,7 @@ func NewCadence(clusterMetadata cluster.Metadata, messagingClient messaging.Clie
 		messagingClient:       messagingClient,
 		metadataMgr:           metadataMgr,
 		visibilityMgr:         visibilityMgr,
+		clusterMetadataMgr:    clusterMetadataMgr,
 		shardMgr:              shardMgr,
 		historyMgr:            historyMgr,
 		taskMgr:               taskMgr,
@@ -222,7 +226,8 @@ func (c *cadenceImpl) startFrontend(logger bark.Logger, rpHosts []string, startW
 
 	c.frontEndService = service.New(params)
 	c.frontendHandler = frontend.NewWorkflowHandler(
-		c.frontEndService, frontend.NewConfig(), c.metadataMgr, c.historyMgr, c.visibilityMgr, kafkaProducer)
+		c.frontEndService, frontend.NewConfig(dynamicconfig.NewNopCollection()), c.metadataMgr, c.historyMgr, c.visibilityMgr, c.clusterMetadataMgr, kafkaProducer,
+		payload.NewNoopValidator())
 	err := c.frontendHandler.Start()
 	if err != nil {
 		c.logger.WithField("error", err).Fatal("Failed to start frontend")
@@ -253,7 +258,7 @@ func (c *cadenceImpl) startHistory(logger bark.Logger, shardMgr persistence.Shar
 		historyConfig.HistoryMgrNumConns = c.numberOfHistoryShards
 		historyConfig.ExecutionMgrNumConns = c.numberOfHistoryShards
 		handler := history.NewHandler(service, historyConfig, shardMgr, metadataMgr,
-			visibilityMgr, historyMgr, executionMgrFactory)
+			visibilityMgr, historyMgr, executionMgrFactory, c.clusterMetadataMgr)
 		handler.Start()
 		c.historyHandlers = append(c.historyHandlers, handler)
 	}
@@ -0,0 +1,566 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+include "shared.thrift"
+
+namespace java com.uber.cadence.admin
+
+/**
+* AdminService provides advanced APIs for debugging and analysis with admin privilege
+**/
+service AdminService {
+  /**
+  * ImportWorkflowExecution recreates a workflow execution, including its history and mutable state, from a history
+  * previously exported from a cluster. The execution must not exist in the cluster.
+  **/
+  void ImportWorkflowExecution(1: ImportWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,
+    )
+
+  /**
+  * PauseTaskList stops dispatching tasks of a task list to pollers. New tasks are still accepted and persisted,
+  * and are dispatched once the task list is resumed. Outstanding polls on the task list are drained.
+  **/
+  void PauseTaskList(1: PauseTaskListRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ResumeTaskList resumes dispatching tasks of a task list previously paused with PauseTaskList.
+  **/
+  void ResumeTaskList(1: ResumeTaskListRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * GetDomainStats returns the daily statistics of a domain, most recent day first. The statistics are computed
+  * periodically by the worker service, so the current day is only as recent as the last computation.
+  **/
+  GetDomainStatsResponse GetDomainStats(1: GetDomainStatsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * TerminateWorkflowExecution terminates a workflow execution on behalf of an operator. Unlike the frontend API the
+  * actor and reason are required, and the operation is recorded to the audit log before the execution is terminated.
+  **/
+  void TerminateWorkflowExecution(1: TerminateWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * PauseWorkflowExecution freezes a misbehaving workflow execution during an incident instead of terminating it: no
+  * decision or activity task is dispatched and its timers are held until it is resumed, while signals and other
+  * requests are still accepted. The actor and reason are required, and the operation is recorded to the audit log.
+  **/
+  void PauseWorkflowExecution(1: PauseWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ResumeWorkflowExecution lets a paused workflow execution make progress again. The actor and reason are required,
+  * and the operation is recorded to the audit log.
+  **/
+  void ResumeWorkflowExecution(1: ResumeWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * NukeWorkflowExecution purges a corrupted run which cannot be terminated: its visibility records, history, current
+  * execution record and mutable state are deleted, and its queued tasks are dropped when processed. The run ID, actor
+  * and reason are required, and the operation is recorded to the audit log. If dryRun is set nothing is deleted, the
+  * response reports what would be and the audit record is flagged as a dry run.
+  **/
+  NukeWorkflowExecutionResponse NukeWorkflowExecution(1: NukeWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ResyncDomains publishes the current state of the global domains replicated to a remote cluster, or of a single
+  * one of them, as domain update replication tasks. Remote clusters only apply the tasks which are newer than their
+  * own copy of a domain, and create the domains they are missing.
+  **/
+  ResyncDomainsResponse ResyncDomains(1: ResyncDomainsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ListAuditRecords returns the destructive operations invoked through admin APIs and tools, most recent first.
+  **/
+  ListAuditRecordsResponse ListAuditRecords(1: ListAuditRecordsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * GetWorkflowExecutionHistoryEvent returns a single history event of a workflow execution without paginating
+  * through the full history.
+  **/
+  GetWorkflowExecutionHistoryEventResponse GetWorkflowExecutionHistoryEvent(1: GetWorkflowExecutionHistoryEventRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * RefreshDomainCache makes the domain cache of the frontend serving the request and of all history hosts reload a
+  * domain from the metadata store, instead of waiting for the cached entry to expire. Meant to be used right after
+  * an emergency domain update. The other frontend hosts and the matching hosts are not refreshed, they reload the
+  * domain on its first use after its cached entry is older than the refresh interval of the domain cache (10s).
+  **/
+  void RefreshDomainCache(1: RefreshDomainCacheRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * GetCurrentExecution returns which run is current for a workflow ID, and its state and close status. The current
+  * run is the one targeted by APIs called without a run ID, which is not always obvious after resets.
+  **/
+  GetCurrentExecutionResponse GetCurrentExecution(1: GetCurrentExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ListWorkflowExecutionChain returns the runs of a workflow ID chained by continue as new, retries and cron
+  * schedules, from the first run to the specified run, or to the current run if no run ID is specified.
+  **/
+  ListWorkflowExecutionChainResponse ListWorkflowExecutionChain(1: ListWorkflowExecutionChainRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * DescribeHistoryHost returns a snapshot of the load of a history host: for each shard it owns, the ack and read
+  * levels of its transfer, timer and replication queues, the number of tasks being processed and the size of its
+  * history cache. The host is selected by address, shard ID or workflow execution.
+  **/
+  DescribeHistoryHostResponse DescribeHistoryHost(1: DescribeHistoryHostRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * WarmHistoryHost pre-loads the caches of a history host before it takes traffic, typically right after it is
+  * restarted during a rolling deploy. The host acquires every shard the membership ring assigns to it, and loads the
+  * mutable state of the executions with queued tasks on each shard, which are the executions about to be processed.
+  **/
+  WarmHistoryHostResponse WarmHistoryHost(1: WarmHistoryHostRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * DescribeShardDistribution returns the history host owning each history shard, and whether the shard was pinned to
+  * the host by the shard rebalancer rather than placed by the membership ring.
+  **/
+  DescribeShardDistributionResponse DescribeShardDistribution(1: DescribeShardDistributionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * DrainHistoryHost gracefully moves the shards owned by a history host to the other history hosts before it is taken
+  * down for maintenance, instead of relying on the membership ring to move all of them at once when the host leaves.
+  * The host moves its shards one at a time at the given rate, each to the history host owning the fewest shards, and
+  * the shards stay pinned to their new owner until ResetShardPlacement. The call returns once the drain is started.
+  * The actor and reason are required, and the request is recorded to the audit log.
+  **/
+  DrainHistoryHostResponse DrainHistoryHost(1: DrainHistoryHostRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * ResetShardPlacement unpins the history shards pinned by DrainHistoryHost, so that the membership ring places all
+  * the shards again, typically once the drained hosts are back. The actor and reason are required, and the request is
+  * recorded to the audit log.
+  **/
+  void ResetShardPlacement(1: ResetShardPlacementRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * UpdateDomainRetention sets the workflow execution retention period of a domain without enforcing the retention
+  * bounds of the cluster. The actor and reason are required, and the update is recorded to the audit log.
+  **/
+  void UpdateDomainRetention(1: UpdateDomainRetentionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * UpdateMaintenanceMode enables or disables the maintenance mode of the cluster, for planned persistence maintenance.
+  * While enabled, the frontend rejects the APIs which register or update domains and start, signal, cancel or
+  * terminate workflow executions with a retryable ServiceBusyError announcing the reason, while polls and task
+  * completions are still served so that outstanding work drains. Frontend hosts other than the one serving the request
+  * pick the change up within the maintenance mode refresh interval. The actor and reason are required, and the update
+  * is recorded to the audit log.
+  **/
+  void UpdateMaintenanceMode(1: UpdateMaintenanceModeRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * DescribeMaintenanceMode returns whether the cluster is in maintenance mode, and why.
+  **/
+  DescribeMaintenanceModeResponse DescribeMaintenanceMode()
+    throws (
+      1: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * BulkDeleteWorkflowExecutions deletes the histories and executions of a domain which closed before the given time,
+  * for example after its retention period is shortened. The deletion is carried out by a workflow run by the worker
+  * service of the cluster, which lists the closed executions from visibility and deletes them at the given rate. Only
+  * one bulk deletion runs per domain at a time. The actor and reason are required, and the request is recorded to the
+  * audit log.
+  **/
+  BulkDeleteWorkflowExecutionsResponse BulkDeleteWorkflowExecutions(1: BulkDeleteWorkflowExecutionsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * DescribeBulkDeleteWorkflowExecutions returns the progress of the last bulk deletion of a domain.
+  **/
+  DescribeBulkDeleteWorkflowExecutionsResponse DescribeBulkDeleteWorkflowExecutions(1: DescribeBulkDeleteWorkflowExecutionsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+}
+
+struct ImportWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional shared.History history
+}
+
+struct PauseTaskListRequest {
+  10: optional string domain
+  20: optional shared.TaskList taskList
+  30: optional shared.TaskListType taskListType
+}
+
+struct ResumeTaskListRequest {
+  10: optional string domain
+  20: optional shared.TaskList taskList
+  30: optional shared.TaskListType taskListType
+}
+
+struct GetDomainStatsRequest {
+  10: optional string domain
+  // number of most recent days to return
+  20: optional i32 days
+}
+
+struct DomainDailyStats {
+  // start of the day in UTC, in nanoseconds since epoch
+  10: optional i64 dayTimestamp
+  // number of open executions when the statistics of the day were last computed
+  20: optional i64 openExecutions
+  // total bytes appended to histories of the domain up to and including the day, histories deleted after the
+  // retention period are not subtracted
+  30: optional i64 totalBytesAppended
+  40: optional i64 eventsAppended
+  50: optional i64 bytesAppended
+  60: optional i64 lastUpdatedTimestamp
+}
+
+struct GetDomainStatsResponse {
+  10: optional list<DomainDailyStats> stats
+}
+
+struct TerminateWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional string reason
+  40: optional binary details
+  50: optional string actor
+}
+
+struct PauseWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional string reason
+  40: optional string actor
+}
+
+struct ResumeWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional string reason
+  40: optional string actor
+}
+
+struct ResyncDomainsRequest {
+  10: optional string clusterName
+  20: optional string domain
+}
+
+struct ResyncDomainsResponse {
+  10: optional list<string> domains
+}
+
+struct AuditRecord {
+  10: optional string id
+  20: optional i64 timestamp
+  30: optional string operation
+  40: optional string actor
+  50: optional string reason
+  60: optional map<string, string> keys
+}
+
+struct ListAuditRecordsRequest {
+  10: optional i32 pageSize
+  20: optional binary nextPageToken
+}
+
+struct ListAuditRecordsResponse {
+  10: optional list<AuditRecord> records
+  20: optional binary nextPageToken
+}
+
+struct GetWorkflowExecutionHistoryEventRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional i64 eventId
+}
+
+struct GetWorkflowExecutionHistoryEventResponse {
+  10: optional shared.HistoryEvent event
+}
+
+struct NukeWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional string reason
+  40: optional string actor
+  50: optional bool dryRun
+}
+
+struct NukeWorkflowExecutionResponse {
+  10: optional bool mutableStateFound
+  20: optional bool isCurrentRun
+  30: optional bool historyFound
+  40: optional bool visibilityRecordFound
+}
+
+struct RefreshDomainCacheRequest {
+  // domain name, all domains are refreshed if not set
+  10: optional string domain
+}
+
+struct GetCurrentExecutionRequest {
+  10: optional string domain
+  20: optional string workflowId
+}
+
+struct GetCurrentExecutionResponse {
+  10: optional string runId
+  20: optional string startRequestId
+  30: optional shared.WorkflowExecutionState state
+  // only set once the current run is completed
+  40: optional shared.WorkflowExecutionCloseStatus closeStatus
+}
+
+struct ListWorkflowExecutionChainRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+}
+
+struct WorkflowExecutionChainEntry {
+  10: optional string runId
+  20: optional i64 startTimestamp
+  30: optional i32 attempt
+}
+
+struct ListWorkflowExecutionChainResponse {
+  10: optional list<WorkflowExecutionChainEntry> runs
+  20: optional bool truncated
+}
+
+struct UpdateDomainRetentionRequest {
+  10: optional string domain
+  20: optional i32 retentionDays
+  30: optional string reason
+  40: optional string actor
+}
+
+struct DescribeHistoryHostRequest {
+  10: optional string hostAddress
+  20: optional i32 shardIdForHost
+  30: optional shared.WorkflowExecution executionForHost
+}
+
+struct HistoryShardStatus {
+  10: optional i32 shardId
+  20: optional i64 transferAckLevel
+  30: optional i64 transferMaxReadLevel
+  40: optional i64 transferTaskIDLag
+  50: optional i32 transferTasksInFlight
+  60: optional i64 timerAckLevel
+  70: optional i32 timerTasksInFlight
+  80: optional i64 replicatorAckLevel
+  90: optional i64 replicationTaskIDLag
+  100: optional i32 replicationTasksInFlight
+  110: optional i32 historyCacheSize
+}
+
+struct DescribeHistoryHostResponse {
+  10: optional string address
+  20: optional i32 numberOfShards
+  30: optional list<HistoryShardStatus> shards
+}
+
+struct WarmHistoryHostRequest {
+  10: optional string hostAddress
+  20: optional i32 maximumExecutionsPerShard
+}
+
+struct WarmHistoryHostResponse {
+  10: optional string address
+  20: optional i32 numberOfShards
+  30: optional i32 executionsLoaded
+}
+
+struct DescribeShardDistributionRequest {
+  // only the shards owned by the host are returned if set
+  10: optional string hostAddress
+}
+
+struct ShardOwner {
+  10: optional i32 shardId
+  20: optional string hostAddress
+  // whether the shard is pinned to the host by the shard rebalancer
+  30: optional bool pinned
+}
+
+struct DescribeShardDistributionResponse {
+  10: optional i32 numberOfShards
+  20: optional list<ShardOwner> shards
+}
+
+struct DrainHistoryHostRequest {
+  10: optional string hostAddress
+  // the default rate of the cluster is used if not set
+  20: optional i32 shardsPerMinute
+  30: optional string reason
+  40: optional string actor
+}
+
+struct DrainHistoryHostResponse {
+  10: optional string address
+  // number of shards the host owned when the drain started
+  20: optional i32 numberOfShards
+}
+
+struct ResetShardPlacementRequest {
+  10: optional string reason
+  20: optional string actor
+}
+
+struct UpdateMaintenanceModeRequest {
+  10: optional bool enabled
+  20: optional string reason
+  30: optional string actor
+}
+
+struct DescribeMaintenanceModeResponse {
+  10: optional bool enabled
+  20: optional string reason
+}
+
+struct BulkDeleteWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional i64 closedBeforeTime
+  30: optional i32 deletesPerSecond
+  40: optional string reason
+  50: optional string actor
+}
+
+struct BulkDeleteWorkflowExecutionsResponse {
+  10: optional string workflowId
+  20: optional string runId
+}
+
+struct DescribeBulkDeleteWorkflowExecutionsRequest {
+  10: optional string domain
+}
+
+struct DescribeBulkDeleteWorkflowExecutionsResponse {
+  10: optional bool running
+  20: optional i64 closedBeforeTime
+  30: optional i32 deletesPerSecond
+  40: optional i64 scannedCount
+  50: optional i64 deletedCount
+  60: optional i64 skippedCount
+  70: optional i64 failedCount
+}
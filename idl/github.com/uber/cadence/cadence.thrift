@@ -406,4 +406,13 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
     )
 
+  /**
+  * DescribeCluster returns the server version and the features enabled on the cluster, so that clients can
+  * adapt their behavior to the cluster they are connected to.
+  **/
+  shared.DescribeClusterResponse DescribeCluster()
+    throws (
+      1: shared.InternalServiceError internalServiceError,
+    )
+
 }
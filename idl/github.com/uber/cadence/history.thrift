@@ -42,6 +42,9 @@ struct StartWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.StartWorkflowExecutionRequest startRequest
   30: optional ParentExecutionInfo parentExecutionInfo
+  40: optional i32 attempt
+  50: optional i64 (js.type = "Long") expirationTimestamp
+  60: optional i32 firstDecisionTaskBackoffSeconds
 }
 
 struct GetMutableStateRequest {
@@ -138,6 +141,7 @@ struct RecordDecisionTaskStartedResponse {
   60: optional i64 (js.type = "Long") attempt
   70: optional bool stickyExecutionEnabled
   80: optional shared.TransientDecisionInfo decisionInfo
+  90: optional bool suggestContinueAsNew
 }
 
 struct SignalWorkflowExecutionRequest {
@@ -163,6 +167,29 @@ struct TerminateWorkflowExecutionRequest {
   20: optional shared.TerminateWorkflowExecutionRequest terminateRequest
 }
 
+struct PauseWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution workflowExecution
+}
+
+struct ResumeWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution workflowExecution
+}
+
+struct NukeWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution workflowExecution
+  30: optional bool dryRun
+}
+
+struct NukeWorkflowExecutionResponse {
+  10: optional bool mutableStateFound
+  20: optional bool isCurrentRun
+  30: optional bool historyFound
+  40: optional bool visibilityRecordFound
+}
+
 struct RequestCancelWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.RequestCancelWorkflowExecutionRequest cancelRequest
@@ -181,6 +208,106 @@ struct DescribeWorkflowExecutionRequest {
   20: optional shared.DescribeWorkflowExecutionRequest request
 }
 
+struct GetWorkflowExecutionHistoryEventRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+  30: optional i64 (js.type = "Long") eventId
+}
+
+struct GetWorkflowExecutionHistoryEventResponse {
+  10: optional shared.HistoryEvent event
+}
+
+struct GetCurrentExecutionRequest {
+  10: optional string domainUUID
+  20: optional string workflowId
+}
+
+struct GetCurrentExecutionResponse {
+  10: optional string runId
+  20: optional string startRequestId
+  30: optional shared.WorkflowExecutionState state
+  // only set once the current run is completed
+  40: optional shared.WorkflowExecutionCloseStatus closeStatus
+}
+
+struct ListWorkflowExecutionChainRequest {
+  10: optional string domainUUID
+  // the run ending the chain, the current run is used if runId is not set
+  20: optional shared.WorkflowExecution execution
+}
+
+struct WorkflowExecutionChainEntry {
+  10: optional string runId
+  20: optional i64 (js.type = "Long") startTimestamp
+  30: optional i32 attempt
+}
+
+struct ListWorkflowExecutionChainResponse {
+  // first run first
+  10: optional list<WorkflowExecutionChainEntry> runs
+  // set when earlier runs are not listed, because the chain is too long or their histories are deleted
+  20: optional bool truncated
+}
+
+struct DescribeHistoryHostRequest {
+  // the host is selected by its address, or else by one of its shards, or else by the owner of a workflow
+  10: optional string hostAddress
+  20: optional i32 shardIdForHost
+  30: optional shared.WorkflowExecution executionForHost
+}
+
+struct HistoryShardStatus {
+  10: optional i32 shardId
+  // the transfer and timer ack levels are those of the queues of the current cluster
+  20: optional i64 (js.type = "Long") transferAckLevel
+  30: optional i64 (js.type = "Long") transferMaxReadLevel
+  // transfer max read level minus ack level, a difference of task IDs and not a count of tasks since the IDs of
+  // the tasks of a shard are not contiguous
+  40: optional i64 (js.type = "Long") transferTaskIDLag
+  50: optional i32 transferTasksInFlight
+  60: optional i64 (js.type = "Long") timerAckLevel
+  70: optional i32 timerTasksInFlight
+  80: optional i64 (js.type = "Long") replicatorAckLevel
+  // transfer max read level minus replicator ack level, replication tasks take their IDs from the transfer sequence
+  90: optional i64 (js.type = "Long") replicationTaskIDLag
+  100: optional i32 replicationTasksInFlight
+  110: optional i32 historyCacheSize
+}
+
+struct DescribeHistoryHostResponse {
+  10: optional string address
+  20: optional i32 numberOfShards
+  30: optional list<HistoryShardStatus> shards
+}
+
+struct WarmHistoryHostRequest {
+  10: optional string hostAddress
+  // the history cache size of the host is used if not set or larger
+  20: optional i32 maximumExecutionsPerShard
+}
+
+struct WarmHistoryHostResponse {
+  10: optional string address
+  20: optional i32 numberOfShards
+  30: optional i32 executionsLoaded
+}
+
+struct DrainHistoryHostRequest {
+  10: optional string hostAddress
+  20: optional i32 shardsPerMinute
+}
+
+struct DrainHistoryHostResponse {
+  10: optional string address
+  20: optional i32 numberOfShards
+}
+
+struct RefreshDomainCacheRequest {
+  // domain name, all domains are refreshed if not set
+  10: optional string domain
+}
+
 /**
 * RecordChildExecutionCompletedRequest is used for reporting the completion of child execution to parent workflow
 * execution which started it.  When a child execution is completed it creates this request and calls the
@@ -213,6 +340,12 @@ struct ReplicateEventsRequest {
   90: optional shared.History newRunHistory
 }
 
+struct ImportWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+  30: optional shared.History history
+}
+
 /**
 * HistoryService provides API to start a new long running workflow instance, as well as query and update the history
 * of workflow instances already created.
@@ -440,6 +573,48 @@ service HistoryService {
       5: shared.DomainNotActiveError domainNotActiveError,
     )
 
+  /**
+  * PauseWorkflowExecution marks a running workflow execution as paused in its mutable state. While paused, its
+  * decision and activity tasks are not dispatched, and its user timers and activity and decision timeouts do not
+  * fire. The workflow execution timeout still applies. Pausing a paused workflow execution has no effect.
+  **/
+  void PauseWorkflowExecution(1: PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+    )
+
+  /**
+  * ResumeWorkflowExecution clears the paused mark of a workflow execution, and recreates the tasks and timers held
+  * while it was paused. Timers which expired in the meantime fire right away.
+  **/
+  void ResumeWorkflowExecution(1: ResumeWorkflowExecutionRequest resumeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+    )
+
+  /**
+  * NukeWorkflowExecution deletes a run whose state is too inconsistent to be terminated: its visibility records, its
+  * history, its current execution record if it is the current run, and its mutable state, in this order so that a
+  * failed attempt can be retried. The transfer and timer tasks still queued for the run are dropped by the queue
+  * processors since the run is missing. The run does not have to be closed and the domain does not have to be active.
+  * With dryRun set, nothing is deleted and the response reports what would be.
+  **/
+  NukeWorkflowExecutionResponse NukeWorkflowExecution(1: NukeWorkflowExecutionRequest nukeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
   /**
   * RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
   * It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
@@ -495,6 +670,57 @@ service HistoryService {
       4: ShardOwnershipLostError shardOwnershipLostError,
     )
 
+  /**
+  * GetWorkflowExecutionHistoryEvent returns a single history event of the specified workflow execution, only the
+  * batch of events containing it is read.
+  **/
+  GetWorkflowExecutionHistoryEventResponse GetWorkflowExecutionHistoryEvent(1: GetWorkflowExecutionHistoryEventRequest getRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * GetCurrentExecution returns the run currently pointed to by the workflow ID, along with its state and close
+  * status, as recorded in the current execution record of the workflow.
+  **/
+  GetCurrentExecutionResponse GetCurrentExecution(1: GetCurrentExecutionRequest getRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * ListWorkflowExecutionChain returns the runs chained by continue as new, retries and cron schedules up to the
+  * specified run, by following the links recorded in the started event of each run.
+  **/
+  ListWorkflowExecutionChainResponse ListWorkflowExecutionChain(1: ListWorkflowExecutionChainRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * ImportWorkflowExecution recreates a run from its exported history.  The mutable state is rebuilt from the history
+  * and written along with the close status of the run, and retention is counted from the import.  The run only
+  * becomes the current run of its workflow ID if it is newer than the current run, which must be closed.  An open
+  * run can only be imported as the current run.
+  **/
+  void ImportWorkflowExecution(1: ImportWorkflowExecutionRequest importRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,
+    )
+
   void ReplicateEvents(1: ReplicateEventsRequest replicateRequest)
     throws (
       1: shared.BadRequestError badRequestError,
@@ -502,4 +728,49 @@ service HistoryService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: ShardOwnershipLostError shardOwnershipLostError,
     )
+
+  /**
+  * DescribeHistoryHost returns the queue levels, in-flight task counts and cache size of every shard owned by the
+  * history host receiving the request.
+  **/
+  DescribeHistoryHostResponse DescribeHistoryHost(1: DescribeHistoryHostRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * WarmHistoryHost acquires every shard the membership ring assigns to the history host receiving the request, and
+  * loads the mutable state of the executions with queued transfer or timer tasks into the history cache of each
+  * shard.  Shards which cannot be acquired are skipped.  Unlike other APIs it is not routed by shard, callers have to
+  * send it to the host to warm.
+  **/
+  WarmHistoryHostResponse WarmHistoryHost(1: WarmHistoryHostRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * DrainHistoryHost makes the history host receiving the request move the shards it owns to the other history hosts,
+  * one at a time at the given rate, by pinning each shard to the history host owning the fewest shards.  The call
+  * returns once the drain is started.  Unlike other APIs it is not routed by shard, callers have to send it to the
+  * host to drain.
+  **/
+  DrainHistoryHostResponse DrainHistoryHost(1: DrainHistoryHostRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * RefreshDomainCache expires the cached entries of a domain on the history host receiving the request, so they are
+  * reloaded from the metadata store on next use.  Unlike other APIs it is not routed by shard, callers have to send
+  * it to every history host.
+  **/
+  void RefreshDomainCache(1: RefreshDomainCacheRequest refreshRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
 }
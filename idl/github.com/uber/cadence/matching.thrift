@@ -40,6 +40,7 @@ struct PollForDecisionTaskResponse {
   70: optional bool stickyExecutionEnabled
   80: optional shared.WorkflowQuery query
   90: optional shared.TransientDecisionInfo decisionInfo
+  100: optional bool suggestContinueAsNew
 }
 
 struct PollForActivityTaskRequest {
@@ -90,6 +91,18 @@ struct DescribeTaskListRequest {
   20: optional shared.DescribeTaskListRequest descRequest
 }
 
+struct PauseTaskListRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional shared.TaskListType taskListType
+}
+
+struct ResumeTaskListRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional shared.TaskListType taskListType
+}
+
 /**
 * MatchingService API is exposed to provide support for polling from long running applications.
 * Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
@@ -186,4 +199,23 @@ service MatchingService {
         2: shared.InternalServiceError internalServiceError,
         3: shared.EntityNotExistsError entityNotExistError,
       )
+
+  /**
+  * PauseTaskList stops dispatching tasks of the target tasklist to pollers, while new tasks keep being persisted.
+  * The paused state is persisted with the tasklist so it survives tasklist reloads.
+  **/
+  void PauseTaskList(1: PauseTaskListRequest request)
+    throws (
+        1: shared.BadRequestError badRequestError,
+        2: shared.InternalServiceError internalServiceError,
+      )
+
+  /**
+  * ResumeTaskList resumes dispatching tasks of the target tasklist.
+  **/
+  void ResumeTaskList(1: ResumeTaskListRequest request)
+    throws (
+        1: shared.BadRequestError badRequestError,
+        2: shared.InternalServiceError internalServiceError,
+      )
 }
@@ -196,6 +196,12 @@ enum WorkflowExecutionCloseStatus {
   TIMED_OUT,
 }
 
+enum WorkflowExecutionState {
+  CREATED,
+  RUNNING,
+  COMPLETED,
+}
+
 enum ChildPolicy {
   TERMINATE,
   REQUEST_CANCEL,
@@ -223,6 +229,18 @@ enum TaskListKind {
   STICKY,
 }
 
+enum ContinueAsNewInitiator {
+  Decider,
+  RetryPolicy,
+}
+
+enum WorkflowExecutionInitiator {
+  Client,
+  ParentWorkflow,
+  ContinueAsNew,
+  RetryPolicy,
+}
+
 struct WorkflowType {
   10: optional string name
 }
@@ -252,6 +270,12 @@ struct WorkflowExecutionInfo {
   40: optional i64 (js.type = "Long") closeTime
   50: optional WorkflowExecutionCloseStatus closeStatus
   60: optional i64 (js.type = "Long") historyLength
+  // Attempt of the run when the workflow is retried by its retry policy, starts from 0
+  70: optional i32 attempt
+  // Time of the last update of the mutable state of the run, only set by DescribeWorkflowExecution
+  80: optional i64 (js.type = "Long") lastUpdateTime
+  // How the run was started, unset for runs started before it was recorded
+  90: optional WorkflowExecutionInitiator initiator
 }
 
 struct WorkflowExecutionConfiguration {
@@ -292,9 +316,19 @@ struct CompleteWorkflowExecutionDecisionAttributes {
   10: optional binary result
 }
 
+// Failure describes an error together with the chain of errors which caused it, letting a failure keep its shape
+// as it propagates from an activity to its workflow and from a child workflow to its parent
+struct Failure {
+  10: optional string type
+  20: optional string message
+  30: optional string stackTrace
+  40: optional Failure cause
+}
+
 struct FailWorkflowExecutionDecisionAttributes {
   10: optional string reason
   20: optional binary details
+  30: optional Failure failure
 }
 
 struct CancelTimerDecisionAttributes {
@@ -333,6 +367,12 @@ struct ContinueAsNewWorkflowExecutionDecisionAttributes {
   30: optional binary input
   40: optional i32 executionStartToCloseTimeoutSeconds
   50: optional i32 taskStartToCloseTimeoutSeconds
+  60: optional i32 backoffStartIntervalInSeconds
+  70: optional RetryPolicy retryPolicy
+  80: optional ContinueAsNewInitiator initiator
+  90: optional string failureReason
+  100: optional binary failureDetails
+  110: optional Failure failure
 }
 
 struct StartChildWorkflowExecutionDecisionAttributes {
@@ -376,6 +416,10 @@ struct WorkflowExecutionStartedEventAttributes {
   52: optional ChildPolicy childPolicy
   54: optional string continuedExecutionRunId
   60: optional string identity
+  70: optional i32 attempt
+  80: optional i64 (js.type = "Long") expirationTimestamp
+  90: optional i32 firstDecisionTaskBackoffSeconds
+  100: optional RetryPolicy retryPolicy
 }
 
 struct WorkflowExecutionCompletedEventAttributes {
@@ -387,6 +431,7 @@ struct WorkflowExecutionFailedEventAttributes {
   10: optional string reason
   20: optional binary details
   30: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  40: optional Failure failure
 }
 
 struct WorkflowExecutionTimedOutEventAttributes {
@@ -401,6 +446,11 @@ struct WorkflowExecutionContinuedAsNewEventAttributes {
   50: optional i32 executionStartToCloseTimeoutSeconds
   60: optional i32 taskStartToCloseTimeoutSeconds
   70: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  80: optional i32 backoffStartIntervalInSeconds
+  90: optional ContinueAsNewInitiator initiator
+  100: optional string failureReason
+  110: optional binary failureDetails
+  120: optional Failure failure
 }
 
 struct DecisionTaskScheduledEventAttributes {
@@ -470,6 +520,8 @@ struct ActivityTaskFailedEventAttributes {
   30: optional i64 (js.type = "Long") scheduledEventId
   40: optional i64 (js.type = "Long") startedEventId
   50: optional string identity
+  60: optional list<string> failureTypes
+  70: optional Failure failure
 }
 
 struct ActivityTaskTimedOutEventAttributes {
@@ -650,6 +702,7 @@ struct ChildWorkflowExecutionFailedEventAttributes {
   50: optional WorkflowType workflowType
   60: optional i64 (js.type = "Long") initiatedEventId
   70: optional i64 (js.type = "Long") startedEventId
+  80: optional Failure failure
 }
 
 struct ChildWorkflowExecutionCanceledEventAttributes {
@@ -822,6 +875,7 @@ struct StartWorkflowExecutionRequest {
   90: optional string requestId
   100: optional WorkflowIdReusePolicy workflowIdReusePolicy
   110: optional ChildPolicy childPolicy
+  120: optional RetryPolicy retryPolicy
 }
 
 struct StartWorkflowExecutionResponse {
@@ -845,6 +899,7 @@ struct PollForDecisionTaskResponse {
   60: optional History history
   70: optional binary nextPageToken
   80: optional WorkflowQuery query
+  90: optional bool suggestContinueAsNew
 }
 
 struct StickyExecutionAttributes {
@@ -919,6 +974,8 @@ struct RespondActivityTaskFailedRequest {
   20: optional string reason
   30: optional binary details
   40: optional string identity
+  50: optional list<string> failureTypes
+  60: optional Failure failure
 }
 
 struct RespondActivityTaskCanceledRequest {
@@ -944,6 +1001,8 @@ struct RespondActivityTaskFailedByIDRequest {
   50: optional string reason
   60: optional binary details
   70: optional string identity
+  80: optional list<string> failureTypes
+  90: optional Failure failure
 }
 
 struct RespondActivityTaskCanceledByIDRequest {
@@ -1000,6 +1059,7 @@ struct SignalWithStartWorkflowExecutionRequest {
   110: optional string signalName
   120: optional binary signalInput
   130: optional binary control
+  140: optional RetryPolicy retryPolicy
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -1090,6 +1150,17 @@ struct DescribeTaskListResponse {
   10: optional list<PollerInfo> pollers
 }
 
+struct DescribeClusterResponse {
+  10: optional string serverVersion
+  20: optional string clusterName
+  30: optional string persistenceBackend
+  40: optional string visibilityBackend
+  50: optional bool globalDomainEnabled
+  60: optional bool advancedVisibilityEnabled
+  70: optional bool archivalEnabled
+  80: optional list<string> supportedClientFeatures
+}
+
 enum TaskListType {
   /*
    * Decision type of tasklist
@@ -1126,4 +1197,12 @@ struct RetryPolicy {
 
   // Non-Retriable errors. Will stop retrying if error matches this list.
   50: optional list<string> nonRetriableErrorReasons
+
+  // Non-Retriable error types. Will stop retrying if any of the failure types reported with the failure
+  // matches this list. Unlike reasons, a failure can carry several types, e.g. an error type and its parents.
+  60: optional list<string> nonRetriableErrorTypes
+
+  // Expiration interval for the whole retry process, measured from the start of the first attempt.
+  // Only used by workflow retry policies, activity retries expire with the ScheduleToClose timeout.
+  70: optional i32 expirationIntervalInSeconds
 }
@@ -0,0 +1,1311 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
+	h "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/replicator"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/bulkdelete"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
+)
+
+var _ adminserviceserver.Interface = (*AdminHandler)(nil)
+
+type (
+	// AdminHandler - Thrift handler inteface for admin service
+	AdminHandler struct {
+		domainCache           cache.DomainCache
+		domainHandler         *WorkflowHandler
+		domainStats           persistence.DomainStatsManager
+		audit                 persistence.AuditManager
+		bulkDelete            config.BulkDelete
+		clusterMetadataMgr    persistence.ClusterMetadataManager
+		numberOfHistoryShards int
+		history               history.Client
+		matching              matching.Client
+		metricsClient         metrics.Client
+		startWG               sync.WaitGroup
+		service.Service
+	}
+)
+
+const (
+	defaultDomainStatsDays = 7
+	maxDomainStatsDays     = 366
+	resyncDomainsPageSize  = 100
+
+	bulkDeleteWorkflowTimeout = 7 * 24 * time.Hour
+	bulkDeleteDecisionTimeout = 10 * time.Second
+)
+
+var (
+	errHistoryNotSet           = &gen.BadRequestError{Message: "History is not set on request."}
+	errHistoryEventsNotSet     = &gen.BadRequestError{Message: "History has no events."}
+	errHistoryNotStartedEvent  = &gen.BadRequestError{Message: "History does not begin with WorkflowExecutionStarted event."}
+	errInvalidStatsDays        = &gen.BadRequestError{Message: "Days must be between 1 and 366."}
+	errReasonNotSet            = &gen.BadRequestError{Message: "Reason is not set on request."}
+	errActorNotSet             = &gen.BadRequestError{Message: "Actor is not set on request."}
+	errInvalidPageSize         = &gen.BadRequestError{Message: "PageSize must be greater than 0."}
+	errInvalidEventID          = &gen.BadRequestError{Message: "EventId must be greater than 0."}
+	errInvalidRetentionDays    = &gen.BadRequestError{Message: "RetentionDays must not be negative."}
+	errHostNotSet              = &gen.BadRequestError{Message: "HostAddress, ShardIdForHost or ExecutionForHost must be set on request."}
+	errInvalidShardID          = &gen.BadRequestError{Message: "ShardIdForHost must not be negative."}
+	errClusterNameNotSet       = &gen.BadRequestError{Message: "ClusterName is not set on request."}
+	errInvalidClusterName      = &gen.BadRequestError{Message: "ClusterName must be a remote cluster."}
+	errGlobalDomainNotEnabled  = &gen.BadRequestError{Message: "Global domains are not enabled on this cluster."}
+	errEnabledNotSet           = &gen.BadRequestError{Message: "Enabled is not set on request."}
+	errHostAddressNotSet       = &gen.BadRequestError{Message: "HostAddress is not set on request."}
+	errInvalidMaxExecutions    = &gen.BadRequestError{Message: "MaximumExecutionsPerShard must not be negative."}
+	errBulkDeleteNotEnabled    = &gen.BadRequestError{Message: "Bulk delete is not enabled on this cluster."}
+	errInvalidClosedBeforeTime = &gen.BadRequestError{Message: "ClosedBeforeTime must be set to a time in the past."}
+	errInvalidDeletesPerSecond = &gen.BadRequestError{Message: "DeletesPerSecond must not be negative."}
+	errBulkDeleteRunning       = &gen.BadRequestError{Message: "A bulk delete of the domain is already running."}
+	errBulkDeleteNotStarted    = &gen.EntityNotExistsError{Message: "No bulk delete of the domain was started."}
+	errInvalidShardsPerMinute  = &gen.BadRequestError{Message: "ShardsPerMinute must not be negative."}
+)
+
+// NewAdminHandler creates a thrift handler for the cadence admin service, it shares the domain cache of the workflow
+// handler of the same host so that refreshing it takes effect on both, and updates domains and starts workflows
+// through it
+func NewAdminHandler(sVice service.Service, domainHandler *WorkflowHandler,
+	domainStats persistence.DomainStatsManager, audit persistence.AuditManager,
+	clusterMetadataMgr persistence.ClusterMetadataManager, numberOfHistoryShards int,
+	bulkDelete config.BulkDelete) *AdminHandler {
+	if bulkDelete.TaskList == "" {
+		bulkDelete.TaskList = bulkdelete.DefaultTaskList
+	}
+	handler := &AdminHandler{
+		Service:               sVice,
+		domainCache:           domainHandler.domainCache,
+		domainHandler:         domainHandler,
+		domainStats:           domainStats,
+		audit:                 audit,
+		bulkDelete:            bulkDelete,
+		clusterMetadataMgr:    clusterMetadataMgr,
+		numberOfHistoryShards: numberOfHistoryShards,
+	}
+	// prevent us from trying to serve requests before handler's Start() is complete
+	handler.startWG.Add(1)
+	return handler
+}
+
+// RegisterHandler registers the admin service on the dispatcher, it has to be called before the dispatcher is started
+func (adh *AdminHandler) RegisterHandler() {
+	adh.Service.GetDispatcher().Register(adminserviceserver.New(adh))
+}
+
+// Start starts the handler, it expects the underlying service to be started already
+func (adh *AdminHandler) Start() error {
+	var err error
+	adh.history, err = adh.Service.GetClientFactory().NewHistoryClient()
+	if err != nil {
+		return err
+	}
+	adh.matching, err = adh.Service.GetClientFactory().NewMatchingClient()
+	if err != nil {
+		return err
+	}
+	adh.metricsClient = adh.Service.GetMetricsClient()
+	adh.startWG.Done()
+	return nil
+}
+
+// ImportWorkflowExecution recreates a workflow execution from an exported history, the history service writes the
+// final state of the run and only makes it the current run of the workflow ID if it is the newest run
+func (adh *AdminHandler) ImportWorkflowExecution(ctx context.Context, request *admin.ImportWorkflowExecutionRequest) error {
+	scope := metrics.AdminImportWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateImportExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.History == nil {
+		return adh.error(errHistoryNotSet, scope)
+	}
+	events := request.History.Events
+	if len(events) == 0 {
+		return adh.error(errHistoryEventsNotSet, scope)
+	}
+	firstEvent := events[0]
+	if firstEvent.GetEventType() != gen.EventTypeWorkflowExecutionStarted || firstEvent.GetEventId() != common.FirstEventID {
+		return adh.error(errHistoryNotStartedEvent, scope)
+	}
+
+	domainEntry, err := adh.domainCache.GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	err = adh.history.ImportWorkflowExecution(ctx, &h.ImportWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+		History:    request.History,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	adh.GetLogger().WithFields(bark.Fields{
+		logging.TagDomainID:            domainID,
+		logging.TagWorkflowExecutionID: request.Execution.GetWorkflowId(),
+		logging.TagWorkflowRunID:       request.Execution.GetRunId(),
+	}).Infof("Imported workflow execution with %v events.", len(events))
+	return nil
+}
+
+// PauseTaskList stops dispatching tasks of a task list to pollers, new tasks are still accepted and persisted
+func (adh *AdminHandler) PauseTaskList(ctx context.Context, request *admin.PauseTaskListRequest) error {
+	scope := metrics.AdminPauseTaskListScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	domainID, err := adh.validateTaskListRequest(request.GetDomain(), request.TaskList, request.TaskListType)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.matching.PauseTaskList(ctx, &m.PauseTaskListRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		TaskList:     request.TaskList,
+		TaskListType: request.TaskListType,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	adh.logTaskListStateChange(domainID, request.TaskList, request.GetTaskListType(), "paused")
+	return nil
+}
+
+// ResumeTaskList resumes dispatching tasks of a task list paused by PauseTaskList
+func (adh *AdminHandler) ResumeTaskList(ctx context.Context, request *admin.ResumeTaskListRequest) error {
+	scope := metrics.AdminResumeTaskListScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	domainID, err := adh.validateTaskListRequest(request.GetDomain(), request.TaskList, request.TaskListType)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.matching.ResumeTaskList(ctx, &m.ResumeTaskListRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		TaskList:     request.TaskList,
+		TaskListType: request.TaskListType,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	adh.logTaskListStateChange(domainID, request.TaskList, request.GetTaskListType(), "resumed")
+	return nil
+}
+
+// GetDomainStats returns the daily statistics of a domain computed by the worker service
+func (adh *AdminHandler) GetDomainStats(ctx context.Context,
+	request *admin.GetDomainStatsRequest) (*admin.GetDomainStatsResponse, error) {
+	scope := metrics.AdminGetDomainStatsScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	days := int(request.GetDays())
+	if days == 0 {
+		days = defaultDomainStatsDays
+	}
+	if days < 0 || days > maxDomainStatsDays {
+		return nil, adh.error(errInvalidStatsDays, scope)
+	}
+
+	domainEntry, err := adh.domainCache.GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.domainStats.GetDomainStats(&persistence.GetDomainStatsRequest{
+		DomainID: domainEntry.GetInfo().ID,
+		Days:     days,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	stats := make([]*admin.DomainDailyStats, 0, len(resp.Stats))
+	for _, s := range resp.Stats {
+		stats = append(stats, &admin.DomainDailyStats{
+			DayTimestamp:         common.Int64Ptr(s.Day.UnixNano()),
+			OpenExecutions:       common.Int64Ptr(s.OpenExecutions),
+			TotalBytesAppended:   common.Int64Ptr(s.TotalBytesAppended),
+			EventsAppended:       common.Int64Ptr(s.EventsAppended),
+			BytesAppended:        common.Int64Ptr(s.BytesAppended),
+			LastUpdatedTimestamp: common.Int64Ptr(s.LastUpdated.UnixNano()),
+		})
+	}
+	return &admin.GetDomainStatsResponse{Stats: stats}, nil
+}
+
+// TerminateWorkflowExecution records the termination to the audit log and terminates the workflow execution
+func (adh *AdminHandler) TerminateWorkflowExecution(ctx context.Context,
+	request *admin.TerminateWorkflowExecutionRequest) error {
+	scope := metrics.AdminTerminateWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	domainID, err := adh.auditWorkflowExecutionOperation(persistence.AuditOperationTerminateWorkflowExecution,
+		request.GetDomain(), request.Execution, request.GetActor(), request.GetReason(), nil)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.history.TerminateWorkflowExecution(ctx, &h.TerminateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TerminateRequest: &gen.TerminateWorkflowExecutionRequest{
+			Domain:            request.Domain,
+			WorkflowExecution: request.Execution,
+			Reason:            request.Reason,
+			Details:           request.Details,
+			Identity:          request.Actor,
+		},
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// PauseWorkflowExecution holds the tasks and timers of a workflow execution until it is resumed
+func (adh *AdminHandler) PauseWorkflowExecution(ctx context.Context,
+	request *admin.PauseWorkflowExecutionRequest) error {
+	scope := metrics.AdminPauseWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	domainID, err := adh.auditWorkflowExecutionOperation(persistence.AuditOperationPauseWorkflowExecution, request.GetDomain(),
+		request.Execution, request.GetActor(), request.GetReason(), nil)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.history.PauseWorkflowExecution(ctx, &h.PauseWorkflowExecutionRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: request.Execution,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ResumeWorkflowExecution lets a paused workflow execution make progress again
+func (adh *AdminHandler) ResumeWorkflowExecution(ctx context.Context,
+	request *admin.ResumeWorkflowExecutionRequest) error {
+	scope := metrics.AdminResumeWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	domainID, err := adh.auditWorkflowExecutionOperation(persistence.AuditOperationResumeWorkflowExecution, request.GetDomain(),
+		request.Execution, request.GetActor(), request.GetReason(), nil)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.history.ResumeWorkflowExecution(ctx, &h.ResumeWorkflowExecutionRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: request.Execution,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// NukeWorkflowExecution purges a corrupted run which cannot be terminated, a dry run only reports what would be
+// deleted and is recorded to the audit log flagged as a dry run
+func (adh *AdminHandler) NukeWorkflowExecution(ctx context.Context,
+	request *admin.NukeWorkflowExecutionRequest) (*admin.NukeWorkflowExecutionResponse, error) {
+	scope := metrics.AdminNukeWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	// a run ID is required, nuking whichever run happens to be current is never what is meant
+	if request.Execution != nil && request.Execution.GetRunId() == "" {
+		return nil, adh.error(errRunIDNotSet, scope)
+	}
+	if request.Execution != nil && uuid.Parse(request.Execution.GetRunId()) == nil {
+		return nil, adh.error(errInvalidRunID, scope)
+	}
+
+	// dry runs are recorded as well, they show who looked into purging which run
+	domainID, err := adh.auditWorkflowExecutionOperation(persistence.AuditOperationNukeWorkflowExecution,
+		request.GetDomain(), request.Execution, request.GetActor(), request.GetReason(),
+		map[string]string{"dryRun": strconv.FormatBool(request.GetDryRun())})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.NukeWorkflowExecution(ctx, &h.NukeWorkflowExecutionRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: request.Execution,
+		DryRun:            request.DryRun,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &admin.NukeWorkflowExecutionResponse{
+		MutableStateFound:     resp.MutableStateFound,
+		IsCurrentRun:          resp.IsCurrentRun,
+		HistoryFound:          resp.HistoryFound,
+		VisibilityRecordFound: resp.VisibilityRecordFound,
+	}, nil
+}
+
+// auditWorkflowExecutionOperation validates an operation on a single workflow execution and records it to the audit
+// log along with the given keys, it returns the ID of the domain of the workflow execution
+func (adh *AdminHandler) auditWorkflowExecutionOperation(operation string, domain string,
+	execution *gen.WorkflowExecution, actor string, reason string, keys map[string]string) (string, error) {
+	if domain == "" {
+		return "", errDomainNotSet
+	}
+	if execution == nil {
+		return "", errExecutionNotSet
+	}
+	if execution.GetWorkflowId() == "" {
+		return "", errWorkflowIDNotSet
+	}
+	if execution.GetRunId() != "" && uuid.Parse(execution.GetRunId()) == nil {
+		return "", errInvalidRunID
+	}
+	if reason == "" {
+		return "", errReasonNotSet
+	}
+	if actor == "" {
+		return "", errActorNotSet
+	}
+
+	domainEntry, err := adh.domainCache.GetDomain(domain)
+	if err != nil {
+		return "", err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	recordKeys := map[string]string{
+		"domain":     domain,
+		"domainID":   domainID,
+		"workflowID": execution.GetWorkflowId(),
+		"runID":      execution.GetRunId(),
+	}
+	for k, v := range keys {
+		recordKeys[k] = v
+	}
+	err = adh.audit.RecordAudit(&persistence.RecordAuditRequest{
+		Record: &persistence.AuditRecord{
+			Operation: operation,
+			Actor:     actor,
+			Reason:    reason,
+			Keys:      recordKeys,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return domainID, nil
+}
+
+// ResyncDomains publishes the global domains replicated to a remote cluster again, to repair a cluster which missed
+// domain replication tasks
+func (adh *AdminHandler) ResyncDomains(ctx context.Context,
+	request *admin.ResyncDomainsRequest) (*admin.ResyncDomainsResponse, error) {
+	scope := metrics.AdminResyncDomainsScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	clusterMetadata := adh.GetClusterMetadata()
+	if !clusterMetadata.IsGlobalDomainEnabled() {
+		return nil, adh.error(errGlobalDomainNotEnabled, scope)
+	}
+	if request.GetClusterName() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+	if _, ok := clusterMetadata.GetAllClusterFailoverVersions()[request.GetClusterName()]; !ok ||
+		request.GetClusterName() == clusterMetadata.GetCurrentClusterName() {
+		return nil, adh.error(errInvalidClusterName, scope)
+	}
+
+	var domains []*persistence.GetDomainResponse
+	if request.GetDomain() != "" {
+		domain, err := adh.domainHandler.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: request.GetDomain()})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		domains = append(domains, domain)
+	} else {
+		var token []byte
+		for {
+			resp, err := adh.domainHandler.metadataMgr.ListDomains(&persistence.ListDomainsRequest{
+				PageSize:      resyncDomainsPageSize,
+				NextPageToken: token,
+			})
+			if err != nil {
+				return nil, adh.error(err, scope)
+			}
+			domains = append(domains, resp.Domains...)
+			token = resp.NextPageToken
+			if len(token) == 0 {
+				break
+			}
+		}
+	}
+
+	response := &admin.ResyncDomainsResponse{}
+	for _, domain := range domains {
+		if !domain.IsGlobalDomain || !isDomainReplicatedTo(domain.ReplicationConfig, request.GetClusterName()) {
+			continue
+		}
+		err := adh.domainHandler.domainReplicator.HandleTransmissionTask(replicator.DomainOperationUpdate,
+			domain.Info, domain.Config, domain.ReplicationConfig, domain.ConfigVersion, domain.FailoverVersion)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		response.Domains = append(response.Domains, domain.Info.Name)
+	}
+	return response, nil
+}
+
+func isDomainReplicatedTo(replicationConfig *persistence.DomainReplicationConfig, clusterName string) bool {
+	for _, cluster := range replicationConfig.Clusters {
+		if cluster.ClusterName == clusterName {
+			return true
+		}
+	}
+	return false
+}
+
+// ListAuditRecords returns the destructive admin operations recorded to the audit log, most recent first
+func (adh *AdminHandler) ListAuditRecords(ctx context.Context,
+	request *admin.ListAuditRecordsRequest) (*admin.ListAuditRecordsResponse, error) {
+	scope := metrics.AdminListAuditRecordsScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetPageSize() <= 0 {
+		return nil, adh.error(errInvalidPageSize, scope)
+	}
+
+	resp, err := adh.audit.ListAuditRecords(&persistence.ListAuditRecordsRequest{
+		PageSize:      int(request.GetPageSize()),
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	records := make([]*admin.AuditRecord, 0, len(resp.Records))
+	for _, r := range resp.Records {
+		records = append(records, &admin.AuditRecord{
+			ID:        common.StringPtr(r.ID),
+			Timestamp: common.Int64Ptr(r.Timestamp.UnixNano()),
+			Operation: common.StringPtr(r.Operation),
+			Actor:     common.StringPtr(r.Actor),
+			Reason:    common.StringPtr(r.Reason),
+			Keys:      r.Keys,
+		})
+	}
+	return &admin.ListAuditRecordsResponse{
+		Records:       records,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// GetWorkflowExecutionHistoryEvent returns a single history event of a workflow execution
+func (adh *AdminHandler) GetWorkflowExecutionHistoryEvent(ctx context.Context,
+	request *admin.GetWorkflowExecutionHistoryEventRequest) (*admin.GetWorkflowExecutionHistoryEventResponse, error) {
+	scope := metrics.AdminGetWorkflowExecutionHistoryEventScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.Execution == nil {
+		return nil, adh.error(errExecutionNotSet, scope)
+	}
+	if request.Execution.GetWorkflowId() == "" {
+		return nil, adh.error(errWorkflowIDNotSet, scope)
+	}
+	if request.Execution.GetRunId() != "" && uuid.Parse(request.Execution.GetRunId()) == nil {
+		return nil, adh.error(errInvalidRunID, scope)
+	}
+	if request.GetEventId() <= 0 {
+		return nil, adh.error(errInvalidEventID, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.GetWorkflowExecutionHistoryEvent(ctx, &h.GetWorkflowExecutionHistoryEventRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+		EventId:    request.EventId,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &admin.GetWorkflowExecutionHistoryEventResponse{Event: resp.Event}, nil
+}
+
+// RefreshDomainCache reloads a domain, or all domains if none is specified, into the domain cache of this host and
+// of all history hosts. The other frontend hosts and the matching hosts are not refreshed, they reload the domain
+// once their cached entry is older than the refresh interval of the domain cache.
+func (adh *AdminHandler) RefreshDomainCache(ctx context.Context, request *admin.RefreshDomainCacheRequest) error {
+	scope := metrics.AdminRefreshDomainCacheScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	adh.domainCache.Invalidate(request.GetDomain())
+	if request.GetDomain() != "" {
+		// reload right away, which also fails the request if the domain does not exist
+		if _, err := adh.domainCache.GetDomain(request.GetDomain()); err != nil {
+			return adh.error(err, scope)
+		}
+	}
+
+	if err := adh.history.RefreshDomainCache(ctx, &h.RefreshDomainCacheRequest{Domain: request.Domain}); err != nil {
+		return adh.error(err, scope)
+	}
+
+	adh.GetLogger().WithField(logging.TagDomainName, request.GetDomain()).Info("Domain cache refreshed.")
+	return nil
+}
+
+// GetCurrentExecution returns the current run of a workflow ID
+func (adh *AdminHandler) GetCurrentExecution(ctx context.Context,
+	request *admin.GetCurrentExecutionRequest) (*admin.GetCurrentExecutionResponse, error) {
+	scope := metrics.AdminGetCurrentExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetWorkflowId() == "" {
+		return nil, adh.error(errWorkflowIDNotSet, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.GetCurrentExecution(ctx, &h.GetCurrentExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		WorkflowId: request.WorkflowId,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &admin.GetCurrentExecutionResponse{
+		RunId:          resp.RunId,
+		StartRequestId: resp.StartRequestId,
+		State:          resp.State,
+		CloseStatus:    resp.CloseStatus,
+	}, nil
+}
+
+// ListWorkflowExecutionChain returns the runs of a workflow ID chained by continue as new, first run first
+func (adh *AdminHandler) ListWorkflowExecutionChain(ctx context.Context,
+	request *admin.ListWorkflowExecutionChainRequest) (*admin.ListWorkflowExecutionChainResponse, error) {
+	scope := metrics.AdminListWorkflowExecutionChainScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.Execution == nil {
+		return nil, adh.error(errExecutionNotSet, scope)
+	}
+	if request.Execution.GetWorkflowId() == "" {
+		return nil, adh.error(errWorkflowIDNotSet, scope)
+	}
+	if request.Execution.GetRunId() != "" && uuid.Parse(request.Execution.GetRunId()) == nil {
+		return nil, adh.error(errInvalidRunID, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.ListWorkflowExecutionChain(ctx, &h.ListWorkflowExecutionChainRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	runs := make([]*admin.WorkflowExecutionChainEntry, 0, len(resp.Runs))
+	for _, run := range resp.Runs {
+		runs = append(runs, &admin.WorkflowExecutionChainEntry{
+			RunId:          run.RunId,
+			StartTimestamp: run.StartTimestamp,
+			Attempt:        run.Attempt,
+		})
+	}
+	return &admin.ListWorkflowExecutionChainResponse{
+		Runs:      runs,
+		Truncated: resp.Truncated,
+	}, nil
+}
+
+// DescribeHistoryHost returns the status of the shards owned by a history host
+func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context,
+	request *admin.DescribeHistoryHostRequest) (*admin.DescribeHistoryHostResponse, error) {
+	scope := metrics.AdminDescribeHistoryHostScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetHostAddress() == "" && request.ShardIdForHost == nil && request.ExecutionForHost == nil {
+		return nil, adh.error(errHostNotSet, scope)
+	}
+	if request.GetShardIdForHost() < 0 {
+		return nil, adh.error(errInvalidShardID, scope)
+	}
+	if request.ExecutionForHost != nil && request.ExecutionForHost.GetWorkflowId() == "" {
+		return nil, adh.error(errWorkflowIDNotSet, scope)
+	}
+
+	resp, err := adh.history.DescribeHistoryHost(ctx, &h.DescribeHistoryHostRequest{
+		HostAddress:      request.HostAddress,
+		ShardIdForHost:   request.ShardIdForHost,
+		ExecutionForHost: request.ExecutionForHost,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	shards := make([]*admin.HistoryShardStatus, 0, len(resp.Shards))
+	for _, shard := range resp.Shards {
+		shards = append(shards, &admin.HistoryShardStatus{
+			ShardId:                  shard.ShardId,
+			TransferAckLevel:         shard.TransferAckLevel,
+			TransferMaxReadLevel:     shard.TransferMaxReadLevel,
+			TransferTaskIDLag:        shard.TransferTaskIDLag,
+			TransferTasksInFlight:    shard.TransferTasksInFlight,
+			TimerAckLevel:            shard.TimerAckLevel,
+			TimerTasksInFlight:       shard.TimerTasksInFlight,
+			ReplicatorAckLevel:       shard.ReplicatorAckLevel,
+			ReplicationTaskIDLag:     shard.ReplicationTaskIDLag,
+			ReplicationTasksInFlight: shard.ReplicationTasksInFlight,
+			HistoryCacheSize:         shard.HistoryCacheSize,
+		})
+	}
+	return &admin.DescribeHistoryHostResponse{
+		Address:        resp.Address,
+		NumberOfShards: resp.NumberOfShards,
+		Shards:         shards,
+	}, nil
+}
+
+// WarmHistoryHost makes a history host acquire its shards and load the executions they are about to process into
+// its history cache, so that it does not serve its first requests from a cold cache
+func (adh *AdminHandler) WarmHistoryHost(ctx context.Context,
+	request *admin.WarmHistoryHostRequest) (*admin.WarmHistoryHostResponse, error) {
+	scope := metrics.AdminWarmHistoryHostScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetHostAddress() == "" {
+		return nil, adh.error(errHostAddressNotSet, scope)
+	}
+	if request.GetMaximumExecutionsPerShard() < 0 {
+		return nil, adh.error(errInvalidMaxExecutions, scope)
+	}
+
+	resp, err := adh.history.WarmHistoryHost(ctx, &h.WarmHistoryHostRequest{
+		HostAddress:               request.HostAddress,
+		MaximumExecutionsPerShard: request.MaximumExecutionsPerShard,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &admin.WarmHistoryHostResponse{
+		Address:          resp.Address,
+		NumberOfShards:   resp.NumberOfShards,
+		ExecutionsLoaded: resp.ExecutionsLoaded,
+	}, nil
+}
+
+// DescribeShardDistribution returns the history host owning each history shard, resolved the way the history hosts
+// resolve it: shards pinned to a host of the membership ring are owned by that host, the ring places the others
+func (adh *AdminHandler) DescribeShardDistribution(ctx context.Context,
+	request *admin.DescribeShardDistributionRequest) (*admin.DescribeShardDistributionResponse, error) {
+	scope := metrics.AdminDescribeShardDistributionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resolver, err := adh.GetMembershipMonitor().GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	placement, err := adh.clusterMetadataMgr.GetShardPlacement()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	members := make(map[string]bool)
+	for _, host := range resolver.Members() {
+		members[host.GetAddress()] = true
+	}
+
+	var shards []*admin.ShardOwner
+	for shardID := 0; shardID < adh.numberOfHistoryShards; shardID++ {
+		address, pinned := placement.Overrides[shardID]
+		pinned = pinned && members[address]
+		if !pinned {
+			host, err := resolver.Lookup(string(shardID))
+			if err != nil {
+				return nil, adh.error(err, scope)
+			}
+			address = host.GetAddress()
+		}
+		if request.GetHostAddress() != "" && request.GetHostAddress() != address {
+			continue
+		}
+		shards = append(shards, &admin.ShardOwner{
+			ShardId:     common.Int32Ptr(int32(shardID)),
+			HostAddress: common.StringPtr(address),
+			Pinned:      common.BoolPtr(pinned),
+		})
+	}
+	return &admin.DescribeShardDistributionResponse{
+		NumberOfShards: common.Int32Ptr(int32(adh.numberOfHistoryShards)),
+		Shards:         shards,
+	}, nil
+}
+
+// DrainHistoryHost makes a history host move its shards to the other history hosts, so that it can be taken down
+// without the ring moving all of its shards at once
+func (adh *AdminHandler) DrainHistoryHost(ctx context.Context,
+	request *admin.DrainHistoryHostRequest) (*admin.DrainHistoryHostResponse, error) {
+	scope := metrics.AdminDrainHistoryHostScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetHostAddress() == "" {
+		return nil, adh.error(errHostAddressNotSet, scope)
+	}
+	if request.GetShardsPerMinute() < 0 {
+		return nil, adh.error(errInvalidShardsPerMinute, scope)
+	}
+	if request.GetReason() == "" {
+		return nil, adh.error(errReasonNotSet, scope)
+	}
+	if request.GetActor() == "" {
+		return nil, adh.error(errActorNotSet, scope)
+	}
+
+	err := adh.audit.RecordAudit(&persistence.RecordAuditRequest{
+		Record: &persistence.AuditRecord{
+			Operation: persistence.AuditOperationDrainHistoryHost,
+			Actor:     request.GetActor(),
+			Reason:    request.GetReason(),
+			Keys: map[string]string{
+				"hostAddress":     request.GetHostAddress(),
+				"shardsPerMinute": strconv.Itoa(int(request.GetShardsPerMinute())),
+			},
+		},
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.DrainHistoryHost(ctx, &h.DrainHistoryHostRequest{
+		HostAddress:     request.HostAddress,
+		ShardsPerMinute: request.ShardsPerMinute,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &admin.DrainHistoryHostResponse{
+		Address:        resp.Address,
+		NumberOfShards: resp.NumberOfShards,
+	}, nil
+}
+
+// ResetShardPlacement unpins the history shards pinned by the shard rebalancer, the history hosts hand the shards
+// back to the owners given by the membership ring within the shard placement refresh interval
+func (adh *AdminHandler) ResetShardPlacement(ctx context.Context, request *admin.ResetShardPlacementRequest) error {
+	scope := metrics.AdminResetShardPlacementScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetReason() == "" {
+		return adh.error(errReasonNotSet, scope)
+	}
+	if request.GetActor() == "" {
+		return adh.error(errActorNotSet, scope)
+	}
+
+	err := adh.audit.RecordAudit(&persistence.RecordAuditRequest{
+		Record: &persistence.AuditRecord{
+			Operation: persistence.AuditOperationResetShardPlacement,
+			Actor:     request.GetActor(),
+			Reason:    request.GetReason(),
+			Keys: map[string]string{
+				"cluster": adh.GetClusterMetadata().GetCurrentClusterName(),
+			},
+		},
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.clusterMetadataMgr.ResetShardPlacement(); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// UpdateDomainRetention sets the retention period of a domain, bypassing the retention bounds of the cluster
+func (adh *AdminHandler) UpdateDomainRetention(ctx context.Context, request *admin.UpdateDomainRetentionRequest) error {
+	scope := metrics.AdminUpdateDomainRetentionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if request.RetentionDays == nil || request.GetRetentionDays() < 0 {
+		return adh.error(errInvalidRetentionDays, scope)
+	}
+	if request.GetReason() == "" {
+		return adh.error(errReasonNotSet, scope)
+	}
+	if request.GetActor() == "" {
+		return adh.error(errActorNotSet, scope)
+	}
+
+	domainEntry, err := adh.domainCache.GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.audit.RecordAudit(&persistence.RecordAuditRequest{
+		Record: &persistence.AuditRecord{
+			Operation: persistence.AuditOperationUpdateDomainRetention,
+			Actor:     request.GetActor(),
+			Reason:    request.GetReason(),
+			Keys: map[string]string{
+				"domain":            request.GetDomain(),
+				"domainID":          domainEntry.GetInfo().ID,
+				"previousRetention": strconv.Itoa(int(domainEntry.GetConfig().Retention)),
+				"retention":         strconv.Itoa(int(request.GetRetentionDays())),
+			},
+		},
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	// errors are already counted under the admin scope by the workflow handler
+	_, err = adh.domainHandler.updateDomain(&gen.UpdateDomainRequest{
+		Name: request.Domain,
+		Configuration: &gen.DomainConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: request.RetentionDays,
+		},
+	}, scope, false)
+	return err
+}
+
+// UpdateMaintenanceMode enables or disables the maintenance mode of the cluster, it applies to this host right away
+// and to the other frontend hosts once they reload it
+func (adh *AdminHandler) UpdateMaintenanceMode(ctx context.Context, request *admin.UpdateMaintenanceModeRequest) error {
+	scope := metrics.AdminUpdateMaintenanceModeScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.Enabled == nil {
+		return adh.error(errEnabledNotSet, scope)
+	}
+	if request.GetReason() == "" {
+		return adh.error(errReasonNotSet, scope)
+	}
+	if request.GetActor() == "" {
+		return adh.error(errActorNotSet, scope)
+	}
+
+	err := adh.audit.RecordAudit(&persistence.RecordAuditRequest{
+		Record: &persistence.AuditRecord{
+			Operation: persistence.AuditOperationUpdateMaintenanceMode,
+			Actor:     request.GetActor(),
+			Reason:    request.GetReason(),
+			Keys: map[string]string{
+				"cluster": adh.GetClusterMetadata().GetCurrentClusterName(),
+				"enabled": strconv.FormatBool(request.GetEnabled()),
+			},
+		},
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.domainHandler.maintenanceMode.update(&persistence.MaintenanceMode{
+		Enabled: request.GetEnabled(),
+		Reason:  request.GetReason(),
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DescribeMaintenanceMode returns the maintenance mode of the cluster as last loaded by this host
+func (adh *AdminHandler) DescribeMaintenanceMode(ctx context.Context) (*admin.DescribeMaintenanceModeResponse, error) {
+	scope := metrics.AdminDescribeMaintenanceModeScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	mode := adh.domainHandler.maintenanceMode.get()
+	return &admin.DescribeMaintenanceModeResponse{
+		Enabled: common.BoolPtr(mode.Enabled),
+		Reason:  common.StringPtr(mode.Reason),
+	}, nil
+}
+
+// BulkDeleteWorkflowExecutions starts the workflow deleting the executions of a domain which closed before the
+// requested time, in the domain the worker service runs the bulk delete workflows in
+func (adh *AdminHandler) BulkDeleteWorkflowExecutions(ctx context.Context,
+	request *admin.BulkDeleteWorkflowExecutionsRequest) (*admin.BulkDeleteWorkflowExecutionsResponse, error) {
+	scope := metrics.AdminBulkDeleteWorkflowExecutionsScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if !adh.bulkDelete.Enabled {
+		return nil, adh.error(errBulkDeleteNotEnabled, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetClosedBeforeTime() <= 0 || request.GetClosedBeforeTime() > time.Now().UnixNano() {
+		return nil, adh.error(errInvalidClosedBeforeTime, scope)
+	}
+	if request.GetDeletesPerSecond() < 0 {
+		return nil, adh.error(errInvalidDeletesPerSecond, scope)
+	}
+	if request.GetReason() == "" {
+		return nil, adh.error(errReasonNotSet, scope)
+	}
+	if request.GetActor() == "" {
+		return nil, adh.error(errActorNotSet, scope)
+	}
+
+	domainEntry, err := adh.domainCache.GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	domainID := domainEntry.GetInfo().ID
+	deletesPerSecond := int(request.GetDeletesPerSecond())
+	if deletesPerSecond == 0 {
+		deletesPerSecond = bulkdelete.DefaultDeletesPerSecond
+	}
+
+	err = adh.audit.RecordAudit(&persistence.RecordAuditRequest{
+		Record: &persistence.AuditRecord{
+			Operation: persistence.AuditOperationBulkDeleteWorkflowExecutions,
+			Actor:     request.GetActor(),
+			Reason:    request.GetReason(),
+			Keys: map[string]string{
+				"domain":           request.GetDomain(),
+				"domainID":         domainID,
+				"closedBeforeTime": time.Unix(0, request.GetClosedBeforeTime()).UTC().Format(time.RFC3339),
+				"deletesPerSecond": strconv.Itoa(deletesPerSecond),
+			},
+		},
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	input, err := json.Marshal(bulkdelete.Progress{
+		DomainID:         domainID,
+		ClosedBeforeTime: request.GetClosedBeforeTime(),
+		DeletesPerSecond: deletesPerSecond,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	workflowID := bulkdelete.WorkflowID(domainID)
+	// errors are already counted under the frontend scope by the workflow handler
+	resp, err := adh.domainHandler.StartWorkflowExecution(ctx, &gen.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(adh.bulkDelete.Domain),
+		WorkflowId:                          common.StringPtr(workflowID),
+		WorkflowType:                        &gen.WorkflowType{Name: common.StringPtr(bulkdelete.WorkflowName)},
+		TaskList:                            &gen.TaskList{Name: common.StringPtr(adh.bulkDelete.TaskList)},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(bulkDeleteWorkflowTimeout / time.Second)),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(bulkDeleteDecisionTimeout / time.Second)),
+		Identity:                            common.StringPtr(request.GetActor()),
+		RequestId:                           common.StringPtr(uuid.New()),
+		WorkflowIdReusePolicy:               gen.WorkflowIdReusePolicyAllowDuplicate.Ptr(),
+	})
+	if err != nil {
+		if _, ok := err.(*gen.WorkflowExecutionAlreadyStartedError); ok {
+			return nil, adh.error(errBulkDeleteRunning, scope)
+		}
+		return nil, err
+	}
+	return &admin.BulkDeleteWorkflowExecutionsResponse{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      resp.RunId,
+	}, nil
+}
+
+// DescribeBulkDeleteWorkflowExecutions returns the progress of the last bulk delete of a domain, as reported by the
+// bulk delete workflow
+func (adh *AdminHandler) DescribeBulkDeleteWorkflowExecutions(ctx context.Context,
+	request *admin.DescribeBulkDeleteWorkflowExecutionsRequest) (*admin.DescribeBulkDeleteWorkflowExecutionsResponse,
+	error) {
+	scope := metrics.AdminDescribeBulkDeleteWorkflowExecutionsScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if !adh.bulkDelete.Enabled {
+		return nil, adh.error(errBulkDeleteNotEnabled, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	execution := &gen.WorkflowExecution{WorkflowId: common.StringPtr(bulkdelete.WorkflowID(domainID))}
+	// errors are already counted under the frontend scope by the workflow handler
+	describeResp, err := adh.domainHandler.DescribeWorkflowExecution(ctx, &gen.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(adh.bulkDelete.Domain),
+		Execution: execution,
+	})
+	if err != nil {
+		if _, ok := err.(*gen.EntityNotExistsError); ok {
+			return nil, adh.error(errBulkDeleteNotStarted, scope)
+		}
+		return nil, err
+	}
+	queryResp, err := adh.domainHandler.QueryWorkflow(ctx, &gen.QueryWorkflowRequest{
+		Domain:    common.StringPtr(adh.bulkDelete.Domain),
+		Execution: execution,
+		Query:     &gen.WorkflowQuery{QueryType: common.StringPtr(bulkdelete.ProgressQueryType)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var progress bulkdelete.Progress
+	if err := json.Unmarshal(queryResp.QueryResult, &progress); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return &admin.DescribeBulkDeleteWorkflowExecutionsResponse{
+		Running:          common.BoolPtr(describeResp.WorkflowExecutionInfo.CloseStatus == nil),
+		ClosedBeforeTime: common.Int64Ptr(progress.ClosedBeforeTime),
+		DeletesPerSecond: common.Int32Ptr(int32(progress.DeletesPerSecond)),
+		ScannedCount:     common.Int64Ptr(progress.Scanned),
+		DeletedCount:     common.Int64Ptr(progress.Deleted),
+		SkippedCount:     common.Int64Ptr(progress.Skipped),
+		FailedCount:      common.Int64Ptr(progress.Failed),
+	}, nil
+}
+
+// validateTaskListRequest validates the task list of a request and resolves the domain ID
+func (adh *AdminHandler) validateTaskListRequest(domain string, taskList *gen.TaskList,
+	taskListType *gen.TaskListType) (string, error) {
+	if domain == "" {
+		return "", errDomainNotSet
+	}
+	if taskList == nil || taskList.GetName() == "" {
+		return "", errTaskListNotSet
+	}
+	if taskListType == nil {
+		return "", errTaskListTypeNotSet
+	}
+	domainEntry, err := adh.domainCache.GetDomain(domain)
+	if err != nil {
+		return "", err
+	}
+	return domainEntry.GetInfo().ID, nil
+}
+
+func (adh *AdminHandler) logTaskListStateChange(domainID string, taskList *gen.TaskList,
+	taskListType gen.TaskListType, state string) {
+	adh.GetLogger().WithFields(bark.Fields{
+		logging.TagDomainID:     domainID,
+		logging.TagTaskListName: taskList.GetName(),
+		logging.TagTaskListType: taskListType,
+	}).Infof("Task list %v.", state)
+}
+
+func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
+	adh.startWG.Wait()
+	sw := adh.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	adh.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	return sw
+}
+
+func (adh *AdminHandler) error(err error, scope int) error {
+	switch err.(type) {
+	case *gen.InternalServiceError:
+		logging.LogInternalServiceError(adh.Service.GetLogger(), err)
+		adh.metricsClient.IncCounter(scope, metrics.CadenceFailures)
+		return err
+	case *gen.BadRequestError:
+		adh.metricsClient.IncCounter(scope, metrics.CadenceErrBadRequestCounter)
+		return err
+	case *gen.ServiceBusyError:
+		adh.metricsClient.IncCounter(scope, metrics.CadenceErrServiceBusyCounter)
+		return err
+	case *gen.EntityNotExistsError:
+		adh.metricsClient.IncCounter(scope, metrics.CadenceErrEntityNotExistsCounter)
+		return err
+	case *gen.WorkflowExecutionAlreadyStartedError:
+		adh.metricsClient.IncCounter(scope, metrics.CadenceErrExecutionAlreadyStartedCounter)
+		return err
+	default:
+		logging.LogUncategorizedError(adh.Service.GetLogger(), err)
+		adh.metricsClient.IncCounter(scope, metrics.CadenceFailures)
+		return &gen.InternalServiceError{Message: err.Error()}
+	}
+}
+
+// validateImportExecution checks the execution to import, unlike most APIs the run ID is required
+func validateImportExecution(w *gen.WorkflowExecution) error {
+	if w == nil {
+		return errExecutionNotSet
+	}
+	if w.GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+	if w.GetRunId() == "" {
+		return errRunIDNotSet
+	}
+	if uuid.Parse(w.GetRunId()) == nil {
+		return errInvalidRunID
+	}
+	return nil
+}
@@ -30,7 +30,6 @@ import (
 	"github.com/uber/cadence/common/messaging"
 
 	"github.com/pborman/uuid"
-	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
 	"github.com/uber/cadence/.gen/go/health"
@@ -47,7 +46,9 @@ import (
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service"
 	"go.uber.org/yarpc/yarpcerrors"
 )
@@ -70,6 +71,11 @@ type (
 		rateLimiter        common.TokenBucket
 		config             *Config
 		domainReplicator   DomainReplicator
+		payloadValidator   payload.Validator
+		workflowIDFilter   *workflowIDFilter
+		maintenanceMode    *maintenanceMode
+		requestDeduper     *requestDeduper
+		queryRateLimiter   *quotas.WorkflowIDRateLimiter
 		service.Service
 	}
 
@@ -101,6 +107,8 @@ var (
 	errQueryTypeNotSet            = &gen.BadRequestError{Message: "QueryType is not set on request."}
 	errRequestNotSet              = &gen.BadRequestError{Message: "Request is nil."}
 
+	errWorkflowIDQueryRateLimitExceeded = &gen.ServiceBusyError{Message: "Workflow ID query rate limit exceeded."}
+
 	// err indicating that this cluster is not the master, so cannot do domain registration or update
 	errNotMasterCluster                = &gen.BadRequestError{Message: "Cluster is not master cluster, cannot do domain registration or domain update."}
 	errCannotAddClusterToLocalDomain   = &gen.BadRequestError{Message: "Cannot add more replicated cluster to local domain."}
@@ -109,13 +117,26 @@ var (
 	errCannotDoDomainFailoverAndUpdate = &gen.BadRequestError{Message: "Cannot set active cluster to current cluster when other parameters are set."}
 
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
+
+	// supportedClientFeatures are the optional features clients can rely on this server to implement
+	supportedClientFeatures = []string{
+		"sticky-execution",
+		"signal-with-start",
+		"query-workflow",
+		"describe-task-list",
+	}
+)
+
+const (
+	persistenceBackendCassandra = "cassandra"
 )
 
 // NewWorkflowHandler creates a thrift handler for the cadence service
 func NewWorkflowHandler(
 	sVice service.Service, config *Config, metadataMgr persistence.MetadataManager,
 	historyMgr persistence.HistoryManager, visibilityMgr persistence.VisibilityManager,
-	kafkaProducer messaging.Producer) *WorkflowHandler {
+	clusterMetadataMgr persistence.ClusterMetadataManager, kafkaProducer messaging.Producer,
+	payloadValidator payload.Validator) *WorkflowHandler {
 	handler := &WorkflowHandler{
 		Service:            sVice,
 		config:             config,
@@ -127,6 +148,12 @@ func NewWorkflowHandler(
 		domainCache:        cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetLogger()),
 		rateLimiter:        common.NewTokenBucket(config.RPS, common.NewRealTimeSource()),
 		domainReplicator:   NewDomainReplicator(kafkaProducer, sVice.GetLogger()),
+		payloadValidator:   payloadValidator,
+		workflowIDFilter:   newWorkflowIDFilter(config, sVice.GetLogger()),
+		maintenanceMode:    newMaintenanceMode(clusterMetadataMgr, config, sVice.GetLogger()),
+		requestDeduper:     newRequestDeduper(config),
+		queryRateLimiter: quotas.NewWorkflowIDRateLimiter(config.WorkflowIDQueryRPS,
+			config.WorkflowIDRateLimiterCacheSize, config.WorkflowIDRateLimiterCacheTTL, common.NewRealTimeSource()),
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -148,12 +175,14 @@ func (wh *WorkflowHandler) Start() error {
 		return err
 	}
 	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.maintenanceMode.start()
 	wh.startWG.Done()
 	return nil
 }
 
 // Stop stops the handler
 func (wh *WorkflowHandler) Stop() {
+	wh.maintenanceMode.stop()
 	wh.metadataMgr.Close()
 	wh.visibitiltyMgr.Close()
 	wh.historyMgr.Close()
@@ -181,6 +210,10 @@ func (wh *WorkflowHandler) RegisterDomain(ctx context.Context, registerRequest *
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.maintenanceMode.check(); err != nil {
+		return wh.error(err, scope)
+	}
+
 	clusterMetadata := wh.GetClusterMetadata()
 	// TODO remove the IsGlobalDomainEnabled check once cross DC is public
 	if clusterMetadata.IsGlobalDomainEnabled() && !clusterMetadata.IsMasterCluster() {
@@ -194,6 +227,9 @@ func (wh *WorkflowHandler) RegisterDomain(ctx context.Context, registerRequest *
 	if registerRequest.GetName() == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
+	if err := wh.validateRetentionDays(registerRequest.GetWorkflowExecutionRetentionPeriodInDays()); err != nil {
+		return wh.error(err, scope)
+	}
 
 	activeClusterName := clusterMetadata.GetCurrentClusterName()
 	// input validation on cluster names
@@ -307,6 +343,16 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 	sw := wh.startRequestProfile(scope)
 	defer sw.Stop()
 
+	if err := wh.maintenanceMode.check(); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return wh.updateDomain(updateRequest, scope, true)
+}
+
+// updateDomain updates a domain, the retention period bounds are only skipped for updates made through the admin API
+func (wh *WorkflowHandler) updateDomain(updateRequest *gen.UpdateDomainRequest, scope int,
+	enforceRetentionBounds bool) (*gen.UpdateDomainResponse, error) {
 	if updateRequest == nil {
 		return nil, wh.error(errRequestNotSet, scope)
 	}
@@ -408,8 +454,14 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 			config.EmitMetric = updatedConfig.GetEmitMetric()
 		}
 		if updatedConfig.WorkflowExecutionRetentionPeriodInDays != nil {
+			retention := updatedConfig.GetWorkflowExecutionRetentionPeriodInDays()
+			if enforceRetentionBounds {
+				if err := wh.validateRetentionDays(retention); err != nil {
+					return nil, wh.error(err, scope)
+				}
+			}
 			configurationChanged = true
-			config.Retention = updatedConfig.GetWorkflowExecutionRetentionPeriodInDays()
+			config.Retention = retention
 		}
 	}
 	if updateRequest.ReplicationConfiguration != nil {
@@ -483,6 +535,10 @@ func (wh *WorkflowHandler) DeprecateDomain(ctx context.Context, deprecateRequest
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.maintenanceMode.check(); err != nil {
+		return wh.error(err, scope)
+	}
+
 	clusterMetadata := wh.GetClusterMetadata()
 	// TODO remove the IsGlobalDomainEnabled check once cross DC is public
 	if clusterMetadata.IsGlobalDomainEnabled() && !clusterMetadata.IsMasterCluster() {
@@ -547,6 +603,7 @@ func (wh *WorkflowHandler) PollForActivityTask(
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	ctx = logging.ContextWithWorkflowTags(ctx, domainID, "", "")
 
 	pollerID := uuid.New()
 	var resp *gen.PollForActivityTaskResponse
@@ -565,7 +622,7 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		err = wh.cancelOutstandingPoll(ctx, err, domainID, persistence.TaskListTypeActivity, pollRequest.TaskList, pollerID)
 		if err != nil {
 			// For all other errors log an error and return it back to client.
-			wh.Service.GetLogger().Errorf(
+			logging.LoggerFromContext(ctx, wh.GetLogger()).Errorf(
 				"PollForActivityTask failed. TaskList: %v, Error: %v", pollRequest.TaskList.GetName(), err)
 			return nil, wh.error(err, scope)
 		}
@@ -605,7 +662,8 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return nil, wh.error(err, scope)
 	}
 
-	wh.Service.GetLogger().Debugf("Poll for decision. DomainName: %v, DomainID: %v", domainName, domainID)
+	ctx = logging.ContextWithWorkflowTags(ctx, domainID, "", "")
+	logging.LoggerFromContext(ctx, wh.GetLogger()).Debugf("Poll for decision. DomainName: %v", domainName)
 
 	pollerID := uuid.New()
 	var matchingResp *m.PollForDecisionTaskResponse
@@ -624,7 +682,7 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		err = wh.cancelOutstandingPoll(ctx, err, domainID, persistence.TaskListTypeDecision, pollRequest.TaskList, pollerID)
 		if err != nil {
 			// For all other errors log an error and return it back to client.
-			wh.Service.GetLogger().Errorf(
+			logging.LoggerFromContext(ctx, wh.GetLogger()).Errorf(
 				"PollForDecisionTask failed. TaskList: %v, Error: %v", pollRequest.TaskList.GetName(), err)
 			return nil, wh.error(err, scope)
 		}
@@ -654,7 +712,7 @@ func (wh *WorkflowHandler) cancelOutstandingPoll(ctx context.Context, err error,
 		})
 		// We can not do much if this call fails.  Just log the error and move on
 		if err != nil {
-			wh.Service.GetLogger().Warnf("Failed to cancel outstanding poller.  Tasklist: %v, Error: %v,",
+			logging.LoggerFromContext(ctx, wh.GetLogger()).Warnf("Failed to cancel outstanding poller.  Tasklist: %v, Error: %v,",
 				taskList.GetName(), err)
 		}
 
@@ -891,6 +949,12 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	if taskToken.DomainID == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
+	if err := common.ValidateFailureTypes(failedRequest.FailureTypes); err != nil {
+		return wh.error(err, scope)
+	}
+	if err := common.ValidateFailure(failedRequest.Failure); err != nil {
+		return wh.error(err, scope)
+	}
 
 	err = wh.history.RespondActivityTaskFailed(ctx, &h.RespondActivityTaskFailedRequest{
 		DomainUUID:    common.StringPtr(taskToken.DomainID),
@@ -935,6 +999,12 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 	if activityID == "" {
 		return wh.error(errActivityIDNotSet, scope)
 	}
+	if err := common.ValidateFailureTypes(failedRequest.FailureTypes); err != nil {
+		return wh.error(err, scope)
+	}
+	if err := common.ValidateFailure(failedRequest.Failure); err != nil {
+		return wh.error(err, scope)
+	}
 
 	taskToken := &common.TaskToken{
 		DomainID:   domainID,
@@ -949,10 +1019,12 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 	}
 
 	req := &gen.RespondActivityTaskFailedRequest{
-		TaskToken: token,
-		Reason:    failedRequest.Reason,
-		Details:   failedRequest.Details,
-		Identity:  failedRequest.Identity,
+		TaskToken:    token,
+		Reason:       failedRequest.Reason,
+		Details:      failedRequest.Details,
+		Identity:     failedRequest.Identity,
+		FailureTypes: failedRequest.FailureTypes,
+		Failure:      failedRequest.Failure,
 	}
 
 	err = wh.history.RespondActivityTaskFailed(ctx, &h.RespondActivityTaskFailedRequest{
@@ -1090,6 +1162,9 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 	if taskToken.DomainID == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
+	if err := validateDecisionRetryPolicies(completeRequest.Decisions); err != nil {
+		return wh.error(err, scope)
+	}
 
 	err = wh.history.RespondDecisionTaskCompleted(ctx, &h.RespondDecisionTaskCompletedRequest{
 		DomainUUID:      common.StringPtr(taskToken.DomainID),
@@ -1192,6 +1267,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.maintenanceMode.check(); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
@@ -1204,9 +1283,8 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(&gen.BadRequestError{Message: "WorkflowId is not set on request."}, scope)
 	}
 
-	wh.Service.GetLogger().Debugf(
-		"Received StartWorkflowExecution. WorkflowID: %v",
-		startRequest.GetWorkflowId())
+	ctx = logging.ContextWithWorkflowTags(ctx, "", startRequest.GetWorkflowId(), "")
+	logging.LoggerFromContext(ctx, wh.GetLogger()).Debug("Received StartWorkflowExecution")
 
 	if startRequest.WorkflowType == nil || startRequest.WorkflowType.GetName() == "" {
 		return nil, wh.error(&gen.BadRequestError{Message: "WorkflowType is not set on request."}, scope)
@@ -1227,21 +1305,36 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	}
 
 	domainName := startRequest.GetDomain()
-	wh.Service.GetLogger().Debugf("Start workflow execution request domain: %v", domainName)
+	if err := wh.workflowIDFilter.validate(domainName, startRequest.GetWorkflowId()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
 
-	wh.Service.GetLogger().Debugf("Start workflow execution request domainID: %v", domainID)
+	err = wh.payloadValidator.ValidateStartInput(domainName, startRequest.WorkflowType.GetName(), startRequest.Input)
+	if err != nil {
+		return nil, wh.error(convertPayloadValidationError("Input", err), scope)
+	}
+
+	ctx = logging.ContextWithWorkflowTags(ctx, domainID, "", "")
+	logging.LoggerFromContext(ctx, wh.GetLogger()).Debugf("Start workflow execution request domain: %v", domainName)
 
-	resp, err := wh.history.StartWorkflowExecution(ctx, &h.StartWorkflowExecutionRequest{
-		DomainUUID:   common.StringPtr(domainID),
-		StartRequest: startRequest,
-	})
+	resp, deduped, err := wh.requestDeduper.start(ctx, domainName, domainID, "StartWorkflowExecution",
+		startRequest.GetRequestId(), func() (*gen.StartWorkflowExecutionResponse, error) {
+			return wh.history.StartWorkflowExecution(ctx, &h.StartWorkflowExecutionRequest{
+				DomainUUID:   common.StringPtr(domainID),
+				StartRequest: startRequest,
+			})
+		})
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if deduped {
+		wh.metricsClient.IncCounter(scope, metrics.DedupedRequestCounter)
+	}
 	return resp, nil
 }
 
@@ -1416,6 +1509,10 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.maintenanceMode.check(); err != nil {
+		return wh.error(err, scope)
+	}
+
 	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
 		return wh.error(createServiceBusyError(), scope)
 	}
@@ -1437,6 +1534,12 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 		return wh.error(err, scope)
 	}
 
+	err = wh.payloadValidator.ValidateSignalInput(signalRequest.GetDomain(), signalRequest.GetSignalName(),
+		signalRequest.Input)
+	if err != nil {
+		return wh.error(convertPayloadValidationError("Input", err), scope)
+	}
+
 	err = wh.history.SignalWorkflowExecution(ctx, &h.SignalWorkflowExecutionRequest{
 		DomainUUID:    common.StringPtr(domainID),
 		SignalRequest: signalRequest,
@@ -1464,6 +1567,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.maintenanceMode.check(); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
@@ -1498,18 +1605,41 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
 	}
 
+	err := wh.workflowIDFilter.validate(signalWithStartRequest.GetDomain(), signalWithStartRequest.GetWorkflowId())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(signalWithStartRequest.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
 
-	resp, err := wh.history.SignalWithStartWorkflowExecution(ctx, &h.SignalWithStartWorkflowExecutionRequest{
-		DomainUUID:             common.StringPtr(domainID),
-		SignalWithStartRequest: signalWithStartRequest,
-	})
+	err = wh.payloadValidator.ValidateStartInput(signalWithStartRequest.GetDomain(),
+		signalWithStartRequest.WorkflowType.GetName(), signalWithStartRequest.Input)
+	if err != nil {
+		return nil, wh.error(convertPayloadValidationError("Input", err), scope)
+	}
+	err = wh.payloadValidator.ValidateSignalInput(signalWithStartRequest.GetDomain(),
+		signalWithStartRequest.GetSignalName(), signalWithStartRequest.SignalInput)
+	if err != nil {
+		return nil, wh.error(convertPayloadValidationError("SignalInput", err), scope)
+	}
+
+	resp, deduped, err := wh.requestDeduper.start(ctx, signalWithStartRequest.GetDomain(), domainID,
+		"SignalWithStartWorkflowExecution", signalWithStartRequest.GetRequestId(),
+		func() (*gen.StartWorkflowExecutionResponse, error) {
+			return wh.history.SignalWithStartWorkflowExecution(ctx, &h.SignalWithStartWorkflowExecutionRequest{
+				DomainUUID:             common.StringPtr(domainID),
+				SignalWithStartRequest: signalWithStartRequest,
+			})
+		})
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if deduped {
+		wh.metricsClient.IncCounter(scope, metrics.DedupedRequestCounter)
+	}
 
 	return resp, nil
 }
@@ -1527,6 +1657,10 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.maintenanceMode.check(); err != nil {
+		return wh.error(err, scope)
+	}
+
 	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
 		return wh.error(createServiceBusyError(), scope)
 	}
@@ -1568,6 +1702,10 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.maintenanceMode.check(); err != nil {
+		return wh.error(err, scope)
+	}
+
 	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
 		return wh.error(createServiceBusyError(), scope)
 	}
@@ -1805,6 +1943,17 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context,
 		return nil, wh.error(err, scope)
 	}
 
+	// each query is dispatched to a worker of the workflow, which a burst of queries to one workflow can overwhelm
+	if !wh.queryRateLimiter.Allow(domainID, queryRequest.Execution.GetWorkflowId()) {
+		return nil, wh.error(errWorkflowIDQueryRateLimitExceeded, scope)
+	}
+
+	err = wh.payloadValidator.ValidateQueryArgs(queryRequest.GetDomain(), queryRequest.Query.GetQueryType(),
+		queryRequest.Query.QueryArgs)
+	if err != nil {
+		return nil, wh.error(convertPayloadValidationError("QueryArgs", err), scope)
+	}
+
 	matchingRequest := &m.QueryWorkflowRequest{
 		DomainUUID:   common.StringPtr(domainID),
 		QueryRequest: queryRequest,
@@ -1999,20 +2148,31 @@ func (wh *WorkflowHandler) getHistory(domainID string, execution gen.WorkflowExe
 	return executionHistory, nextPageToken, nil
 }
 
-func (wh *WorkflowHandler) getLoggerForTask(taskToken []byte) bark.Logger {
-	logger := wh.Service.GetLogger()
-	task, err := wh.tokenSerializer.Deserialize(taskToken)
-	if err == nil {
-		logger = logger.WithFields(bark.Fields{
-			"WorkflowID": task.WorkflowID,
-			"RunID":      task.RunID,
-			"ScheduleID": task.ScheduleID,
-		})
+// startRequestProfile initiates recording of request metrics
+// DescribeCluster returns the server version and the features enabled on the cluster
+func (wh *WorkflowHandler) DescribeCluster(ctx context.Context) (*gen.DescribeClusterResponse, error) {
+
+	scope := metrics.FrontendDescribeClusterScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
 	}
-	return logger
+
+	clusterMetadata := wh.GetClusterMetadata()
+	return &gen.DescribeClusterResponse{
+		ServerVersion:             common.StringPtr(common.ServerVersion),
+		ClusterName:               common.StringPtr(clusterMetadata.GetCurrentClusterName()),
+		PersistenceBackend:        common.StringPtr(persistenceBackendCassandra),
+		VisibilityBackend:         common.StringPtr(persistenceBackendCassandra),
+		GlobalDomainEnabled:       common.BoolPtr(clusterMetadata.IsGlobalDomainEnabled()),
+		AdvancedVisibilityEnabled: common.BoolPtr(false),
+		ArchivalEnabled:           common.BoolPtr(wh.GetBlobstoreClient() != nil),
+		SupportedClientFeatures:   supportedClientFeatures,
+	}, nil
 }
 
-// startRequestProfile initiates recording of request metrics
 func (wh *WorkflowHandler) startRequestProfile(scope int) tally.Stopwatch {
 	wh.startWG.Wait()
 	sw := wh.metricsClient.StartTimer(scope, metrics.CadenceLatency)
@@ -2020,6 +2180,15 @@ func (wh *WorkflowHandler) startRequestProfile(scope int) tally.Stopwatch {
 	return sw
 }
 
+// convertPayloadValidationError turns a payload rejected by the payload validator into a bad request error
+// naming the rejected field, errors of the validator itself are returned unchanged.
+func convertPayloadValidationError(field string, err error) error {
+	if validationErr, ok := err.(*payload.ValidationError); ok {
+		return &gen.BadRequestError{Message: fmt.Sprintf("%v is invalid: %v", field, validationErr.Error())}
+	}
+	return err
+}
+
 func (wh *WorkflowHandler) error(err error, scope int) error {
 	switch err.(type) {
 	case *gen.InternalServiceError:
@@ -2081,6 +2250,19 @@ func (wh *WorkflowHandler) validateExecution(w *gen.WorkflowExecution, scope int
 	return nil
 }
 
+// validateDecisionRetryPolicies rejects invalid activity retry policies before the decisions reach history
+func validateDecisionRetryPolicies(decisions []*gen.Decision) error {
+	for _, d := range decisions {
+		if d.GetDecisionType() != gen.DecisionTypeScheduleActivityTask || d.ScheduleActivityTaskDecisionAttributes == nil {
+			continue
+		}
+		if err := common.ValidateRetryPolicy(d.ScheduleActivityTaskDecisionAttributes.RetryPolicy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getDomainStatus(info *persistence.DomainInfo) *gen.DomainStatus {
 	switch info.Status {
 	case persistence.DomainStatusRegistered:
@@ -2198,6 +2380,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(ctx context.Context
 		Attempt:                matchingResp.Attempt,
 		History:                history,
 		NextPageToken:          continuation,
+		SuggestContinueAsNew:   matchingResp.SuggestContinueAsNew,
 	}
 
 	return resp, nil
@@ -2240,3 +2423,13 @@ func (wh *WorkflowHandler) validateClusterName(clusterName string) error {
 	}
 	return nil
 }
+
+func (wh *WorkflowHandler) validateRetentionDays(retentionDays int32) error {
+	minDays := wh.config.MinRetentionDays()
+	maxDays := wh.config.MaxRetentionDays()
+	if int(retentionDays) < minDays || int(retentionDays) > maxDays {
+		return &gen.BadRequestError{Message: fmt.Sprintf(
+			"Invalid retention period: %v days, it must be between %v and %v days.", retentionDays, minDays, maxDays)}
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/payload"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+func TestValidateRetentionDays(t *testing.T) {
+	config := NewConfig(dynamicconfig.NewNopCollection())
+	config.MinRetentionDays = func(...dynamicconfig.FilterOption) int { return 1 }
+	config.MaxRetentionDays = func(...dynamicconfig.FilterOption) int { return 30 }
+	wh := &WorkflowHandler{config: config}
+
+	assert.NoError(t, wh.validateRetentionDays(1))
+	assert.NoError(t, wh.validateRetentionDays(30))
+	assert.IsType(t, &gen.BadRequestError{}, wh.validateRetentionDays(0))
+	assert.IsType(t, &gen.BadRequestError{}, wh.validateRetentionDays(3650))
+}
+
+func TestConvertPayloadValidationError(t *testing.T) {
+	err := convertPayloadValidationError("SignalInput", payload.NewValidationError(
+		payload.Violation{Path: "orderId", Message: "is required"},
+	))
+	assert.Equal(t, &gen.BadRequestError{Message: "SignalInput is invalid: orderId: is required"}, err)
+
+	validatorErr := errors.New("schema registry unavailable")
+	assert.Equal(t, validatorErr, convertPayloadValidationError("Input", validatorErr))
+}
+
+func TestWorkflowIDFilter(t *testing.T) {
+	config := NewConfig(dynamicconfig.NewNopCollection())
+	filter := newWorkflowIDFilter(config, bark.NewLoggerFromLogrus(logrus.New()))
+	assert.NoError(t, filter.validate("domain", "any-id"))
+
+	blockList := map[string]string{"domain": "^hot-"}
+	config.WorkflowIDBlockList = func(opts ...dynamicconfig.FilterOption) string {
+		filters := make(map[dynamicconfig.Filter]interface{})
+		for _, opt := range opts {
+			opt(filters)
+		}
+		return blockList[filters[dynamicconfig.DomainName].(string)]
+	}
+	config.WorkflowIDAllowList = func(...dynamicconfig.FilterOption) string { return "^(hot|order)-" }
+	filter = newWorkflowIDFilter(config, bark.NewLoggerFromLogrus(logrus.New()))
+
+	assert.NoError(t, filter.validate("domain", "order-1"))
+	assert.IsType(t, &gen.BadRequestError{}, filter.validate("domain", "hot-1"))
+	assert.IsType(t, &gen.BadRequestError{}, filter.validate("domain", "invoice-1"))
+	assert.NoError(t, filter.validate("other-domain", "hot-1"))
+
+	// an invalid list is ignored
+	blockList["domain"] = "(hot"
+	assert.NoError(t, filter.validate("domain", "hot-1"))
+}
+
+func TestDescribeCluster(t *testing.T) {
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	wh := &WorkflowHandler{
+		Service: service.NewTestService(cluster.GetTestClusterMetadata(true, true), nil, metricsClient,
+			bark.NewLoggerFromLogrus(logrus.New())),
+		metricsClient: metricsClient,
+		rateLimiter:   common.NewTokenBucket(10, common.NewRealTimeSource()),
+	}
+
+	resp, err := wh.DescribeCluster(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, common.ServerVersion, resp.GetServerVersion())
+	assert.Equal(t, cluster.TestCurrentClusterName, resp.GetClusterName())
+	assert.Equal(t, "cassandra", resp.GetPersistenceBackend())
+	assert.True(t, resp.GetGlobalDomainEnabled())
+	assert.False(t, resp.GetAdvancedVisibilityEnabled())
+	assert.False(t, resp.GetArchivalEnabled())
+	assert.Contains(t, resp.SupportedClientFeatures, "signal-with-start")
+}
+
+func TestMaintenanceMode(t *testing.T) {
+	clusterMetadataMgr := &mocks.ClusterMetadataManager{}
+	mode := newMaintenanceMode(clusterMetadataMgr, NewConfig(dynamicconfig.NewNopCollection()),
+		bark.NewLoggerFromLogrus(logrus.New()))
+	assert.NoError(t, mode.check())
+
+	clusterMetadataMgr.On("GetMaintenanceMode").Return(&persistence.GetMaintenanceModeResponse{
+		MaintenanceMode: &persistence.MaintenanceMode{Enabled: true, Reason: "cassandra upgrade"},
+	}, nil).Once()
+	mode.refresh()
+	assert.Equal(t, &gen.ServiceBusyError{Message: "Cluster is in maintenance: cassandra upgrade"}, mode.check())
+
+	// the last known mode is kept while persistence is unavailable
+	clusterMetadataMgr.On("GetMaintenanceMode").Return(nil, &gen.InternalServiceError{}).Once()
+	mode.refresh()
+	assert.IsType(t, &gen.ServiceBusyError{}, mode.check())
+
+	clusterMetadataMgr.On("UpdateMaintenanceMode", &persistence.UpdateMaintenanceModeRequest{
+		MaintenanceMode: &persistence.MaintenanceMode{Reason: "upgrade done"},
+	}).Return(nil).Once()
+	assert.NoError(t, mode.update(&persistence.MaintenanceMode{Reason: "upgrade done"}))
+	assert.NoError(t, mode.check())
+	clusterMetadataMgr.AssertExpectations(t)
+}
+
+func TestStartWorkflowExecution_MaintenanceMode(t *testing.T) {
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	logger := bark.NewLoggerFromLogrus(logrus.New())
+	wh := &WorkflowHandler{
+		Service: service.NewTestService(cluster.GetTestClusterMetadata(false, false), nil, metricsClient,
+			logger),
+		metricsClient:   metricsClient,
+		rateLimiter:     common.NewTokenBucket(10, common.NewRealTimeSource()),
+		maintenanceMode: newMaintenanceMode(nil, NewConfig(dynamicconfig.NewNopCollection()), logger),
+	}
+	wh.maintenanceMode.set(&persistence.MaintenanceMode{Enabled: true, Reason: "cassandra upgrade"})
+
+	_, err := wh.StartWorkflowExecution(context.Background(), &gen.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("domain"),
+		WorkflowId: common.StringPtr("workflow-id"),
+	})
+	assert.IsType(t, &gen.ServiceBusyError{}, err)
+}
+
+func TestQueryWorkflow_WorkflowIDRateLimit(t *testing.T) {
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	logger := bark.NewLoggerFromLogrus(logrus.New())
+	clusterMetadata := cluster.GetTestClusterMetadata(false, false)
+	metadataMgr := &mocks.MetadataManager{}
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: "domain"}).Return(&persistence.GetDomainResponse{
+		Info:              &persistence.DomainInfo{ID: "domain-id", Name: "domain"},
+		Config:            &persistence.DomainConfig{Retention: 1},
+		ReplicationConfig: &persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName},
+	}, nil)
+	config := NewConfig(dynamicconfig.NewNopCollection())
+	config.WorkflowIDQueryRPS = func(...dynamicconfig.FilterOption) int { return 1 }
+	wh := &WorkflowHandler{
+		Service:       service.NewTestService(clusterMetadata, nil, metricsClient, logger),
+		metricsClient: metricsClient,
+		domainCache:   cache.NewDomainCache(metadataMgr, clusterMetadata, logger),
+		queryRateLimiter: quotas.NewWorkflowIDRateLimiter(config.WorkflowIDQueryRPS,
+			config.WorkflowIDRateLimiterCacheSize, config.WorkflowIDRateLimiterCacheTTL, common.NewRealTimeSource()),
+	}
+	// the only query allowed this second
+	assert.True(t, wh.queryRateLimiter.Allow("domain-id", "workflow-id"))
+
+	_, err := wh.QueryWorkflow(context.Background(), &gen.QueryWorkflowRequest{
+		Domain:    common.StringPtr("domain"),
+		Execution: &gen.WorkflowExecution{WorkflowId: common.StringPtr("workflow-id")},
+		Query:     &gen.WorkflowQuery{QueryType: common.StringPtr("state")},
+	})
+	assert.Equal(t, errWorkflowIDQueryRateLimitExceeded, err)
+}
+
+func TestRequestDeduper(t *testing.T) {
+	config := NewConfig(dynamicconfig.NewNopCollection())
+	config.EnableRequestDedupe = func(opts ...dynamicconfig.FilterOption) bool {
+		filters := make(map[dynamicconfig.Filter]interface{})
+		for _, opt := range opts {
+			opt(filters)
+		}
+		return filters[dynamicconfig.DomainName] == "dedupe-domain"
+	}
+	deduper := newRequestDeduper(config)
+	calls := 0
+	startFn := func() (*gen.StartWorkflowExecutionResponse, error) {
+		calls++
+		return &gen.StartWorkflowExecutionResponse{RunId: common.StringPtr("run-id")}, nil
+	}
+	start := func(domain string, operation string, requestID string) bool {
+		resp, deduped, err := deduper.start(context.Background(), domain, domain+"-id", operation, requestID,
+			startFn)
+		assert.NoError(t, err)
+		assert.Equal(t, "run-id", resp.GetRunId())
+		return deduped
+	}
+
+	assert.False(t, start("dedupe-domain", "StartWorkflowExecution", "request-1"))
+	assert.True(t, start("dedupe-domain", "StartWorkflowExecution", "request-1"))
+	assert.Equal(t, 1, calls)
+
+	assert.False(t, start("dedupe-domain", "SignalWithStartWorkflowExecution", "request-1"))
+	assert.False(t, start("dedupe-domain", "StartWorkflowExecution", "request-2"))
+	assert.False(t, start("dedupe-domain", "StartWorkflowExecution", ""))
+	assert.False(t, start("other-domain", "StartWorkflowExecution", "request-1"))
+	assert.False(t, start("other-domain", "StartWorkflowExecution", "request-1"))
+	assert.Equal(t, 6, calls)
+
+	// failed requests are sent again
+	_, _, err := deduper.start(context.Background(), "dedupe-domain", "dedupe-domain-id", "StartWorkflowExecution",
+		"request-3", func() (*gen.StartWorkflowExecutionResponse, error) {
+			return nil, &gen.InternalServiceError{}
+		})
+	assert.IsType(t, &gen.InternalServiceError{}, err)
+	assert.False(t, start("dedupe-domain", "StartWorkflowExecution", "request-3"))
+	assert.Equal(t, 7, calls)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// maintenanceMode keeps the maintenance mode of the cluster. It is stored in the cluster metadata so that it
+	// applies to all frontend hosts, each of which reloads it periodically. The last known mode is kept when it
+	// cannot be reloaded, persistence being unavailable is expected during maintenance.
+	maintenanceMode struct {
+		clusterMetadataMgr persistence.ClusterMetadataManager
+		refreshInterval    dynamicconfig.DurationPropertyFn
+		logger             bark.Logger
+		shutdownCh         chan struct{}
+		shutdownWG         sync.WaitGroup
+
+		sync.RWMutex
+		mode *persistence.MaintenanceMode
+	}
+)
+
+func newMaintenanceMode(clusterMetadataMgr persistence.ClusterMetadataManager, config *Config,
+	logger bark.Logger) *maintenanceMode {
+	return &maintenanceMode{
+		clusterMetadataMgr: clusterMetadataMgr,
+		refreshInterval:    config.MaintenanceModeRefreshInterval,
+		logger:             logger,
+		shutdownCh:         make(chan struct{}),
+		mode:               &persistence.MaintenanceMode{},
+	}
+}
+
+func (m *maintenanceMode) start() {
+	m.refresh()
+	m.shutdownWG.Add(1)
+	go m.refreshLoop()
+}
+
+func (m *maintenanceMode) stop() {
+	close(m.shutdownCh)
+	m.shutdownWG.Wait()
+}
+
+func (m *maintenanceMode) refreshLoop() {
+	defer m.shutdownWG.Done()
+
+	for {
+		select {
+		case <-time.After(m.refreshInterval()):
+			m.refresh()
+		case <-m.shutdownCh:
+			return
+		}
+	}
+}
+
+func (m *maintenanceMode) refresh() {
+	resp, err := m.clusterMetadataMgr.GetMaintenanceMode()
+	if err != nil {
+		m.logger.WithField(logging.TagErr, err).Warn("Failed to reload maintenance mode, keeping the last known mode.")
+		return
+	}
+	m.set(resp.MaintenanceMode)
+}
+
+// update persists the maintenance mode, and applies it to this host right away
+func (m *maintenanceMode) update(mode *persistence.MaintenanceMode) error {
+	err := m.clusterMetadataMgr.UpdateMaintenanceMode(&persistence.UpdateMaintenanceModeRequest{
+		MaintenanceMode: mode,
+	})
+	if err != nil {
+		return err
+	}
+	m.set(mode)
+	return nil
+}
+
+func (m *maintenanceMode) set(mode *persistence.MaintenanceMode) {
+	m.Lock()
+	previous := m.mode
+	m.mode = mode
+	m.Unlock()
+
+	if previous.Enabled != mode.Enabled {
+		if mode.Enabled {
+			m.logger.Infof("Maintenance mode enabled: %v", mode.Reason)
+		} else {
+			m.logger.Info("Maintenance mode disabled.")
+		}
+	}
+}
+
+func (m *maintenanceMode) get() *persistence.MaintenanceMode {
+	m.RLock()
+	defer m.RUnlock()
+	return m.mode
+}
+
+// check returns a retryable error while the cluster is in maintenance, for the APIs starting new work
+func (m *maintenanceMode) check() error {
+	if mode := m.get(); mode.Enabled {
+		return &gen.ServiceBusyError{
+			Message: fmt.Sprintf("Cluster is in maintenance: %v", mode.Reason),
+		}
+	}
+	return nil
+}
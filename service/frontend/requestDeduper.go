@@ -0,0 +1,103 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// requestDeduper returns the response of a recent start request to the retries of that request, identified by
+	// the request ID set by the client, instead of sending them to the history service again. Clients retrying
+	// aggressively while persistence is slow otherwise multiply the load on it. A retry arriving while the first
+	// request is still in flight waits for its response.
+	requestDeduper struct {
+		enabled dynamicconfig.BoolPropertyFn
+		// dedupedRequest by domain ID, operation and request ID
+		requests cache.Cache
+	}
+
+	dedupedRequest struct {
+		done chan struct{}
+		resp *gen.StartWorkflowExecutionResponse
+		err  error
+	}
+
+	dedupedRequestKey struct {
+		domainID  string
+		operation string
+		requestID string
+	}
+)
+
+func newRequestDeduper(config *Config) *requestDeduper {
+	return &requestDeduper{
+		enabled: config.EnableRequestDedupe,
+		requests: cache.New(config.RequestDedupeCacheSize, &cache.Options{
+			TTL: config.RequestDedupeTTL,
+		}),
+	}
+}
+
+// start calls startFn, unless a request with the same ID was started in the domain recently, in which case the
+// response of that request is returned and deduped is true. Failed requests are not remembered, so that their
+// retries are sent again.
+func (d *requestDeduper) start(ctx context.Context, domainName string, domainID string, operation string,
+	requestID string, startFn func() (*gen.StartWorkflowExecutionResponse, error)) (
+	resp *gen.StartWorkflowExecutionResponse, deduped bool, err error) {
+
+	if requestID == "" || !d.enabled(dynamicconfig.DomainFilter(domainName)) {
+		resp, err = startFn()
+		return resp, false, err
+	}
+
+	key := dedupedRequestKey{domainID: domainID, operation: operation, requestID: requestID}
+	request := &dedupedRequest{done: make(chan struct{})}
+	existing, err := d.requests.PutIfNotExist(key, request)
+	if err != nil {
+		resp, err = startFn()
+		return resp, false, err
+	}
+
+	if prior := existing.(*dedupedRequest); prior != request {
+		select {
+		case <-prior.done:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+		if prior.err == nil {
+			return prior.resp, true, nil
+		}
+		resp, err = startFn()
+		return resp, false, err
+	}
+
+	request.resp, request.err = startFn()
+	if request.err != nil && d.requests.Get(key) == request {
+		d.requests.Delete(key)
+	}
+	close(request.done)
+	return request.resp, false, request.err
+}
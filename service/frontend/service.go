@@ -21,11 +21,17 @@
 package frontend
 
 import (
+	"time"
+
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 // Config represents configuration for cadence-frontend service
@@ -36,15 +42,76 @@ type Config struct {
 
 	// Persistence settings
 	HistoryMgrNumConns int
+
+	// Bounds of the retention period of domains, guarding against typos committing the cluster to keep
+	// histories for years
+	MinRetentionDays dynamicconfig.IntPropertyFn
+	MaxRetentionDays dynamicconfig.IntPropertyFn
+
+	// Per domain workflow ID block and allow lists, regular expressions checked when a workflow is started
+	WorkflowIDBlockList dynamicconfig.StringPropertyFn
+	WorkflowIDAllowList dynamicconfig.StringPropertyFn
+
+	// Per workflow ID rate limit of queries, protecting the workers of a single hot workflow
+	WorkflowIDQueryRPS             dynamicconfig.IntPropertyFn
+	WorkflowIDRateLimiterCacheSize int
+	WorkflowIDRateLimiterCacheTTL  time.Duration
+
+	// HedgedReads configures sending a second history or visibility read when the first one is slower than usual
+	HedgedReads *persistence.HedgingConfig
+
+	// MaintenanceModeRefreshInterval is the interval at which the maintenance mode of the cluster is reloaded
+	MaintenanceModeRefreshInterval dynamicconfig.DurationPropertyFn
+
+	// Per domain dedupe of start requests retried with the same request ID, within the TTL of the response
+	EnableRequestDedupe    dynamicconfig.BoolPropertyFn
+	RequestDedupeTTL       time.Duration
+	RequestDedupeCacheSize int
 }
 
 // NewConfig returns new service config with default values
-func NewConfig() *Config {
+func NewConfig(dc *dynamicconfig.Collection) *Config {
 	return &Config{
 		DefaultVisibilityMaxPageSize: 1000,
 		DefaultHistoryMaxPageSize:    1000,
 		RPS:                1200, // This limit is based on experimental runs.
 		HistoryMgrNumConns: 10,
+		MinRetentionDays: dc.GetIntProperty(
+			dynamicconfig.FrontendMinRetentionDays, 0,
+		),
+		MaxRetentionDays: dc.GetIntProperty(
+			dynamicconfig.FrontendMaxRetentionDays, 365,
+		),
+		WorkflowIDBlockList: dc.GetStringProperty(
+			dynamicconfig.FrontendWorkflowIDBlockList, "",
+		),
+		WorkflowIDAllowList: dc.GetStringProperty(
+			dynamicconfig.FrontendWorkflowIDAllowList, "",
+		),
+		WorkflowIDQueryRPS: dc.GetIntProperty(
+			dynamicconfig.FrontendWorkflowIDQueryRPS, 0,
+		),
+		WorkflowIDRateLimiterCacheSize: 10000,
+		WorkflowIDRateLimiterCacheTTL:  time.Minute,
+		HedgedReads: &persistence.HedgingConfig{
+			Enabled: dc.GetBoolProperty(
+				dynamicconfig.FrontendEnableHedgedReads, false,
+			),
+			MinDelay: dc.GetDurationProperty(
+				dynamicconfig.FrontendHedgedReadMinDelay, 20*time.Millisecond,
+			),
+			MaxHedgedPercentage: dc.GetFloat64Property(
+				dynamicconfig.FrontendHedgedReadMaxPercentage, 5,
+			),
+		},
+		MaintenanceModeRefreshInterval: dc.GetDurationProperty(
+			dynamicconfig.FrontendMaintenanceModeRefreshInterval, 10*time.Second,
+		),
+		EnableRequestDedupe: dc.GetBoolProperty(
+			dynamicconfig.FrontendEnableRequestDedupe, false,
+		),
+		RequestDedupeTTL:       10 * time.Second,
+		RequestDedupeCacheSize: 10000,
 	}
 }
 
@@ -57,9 +124,11 @@ type Service struct {
 
 // NewService builds a new cadence-frontend service
 func NewService(params *service.BootstrapParams) common.Daemon {
+	dc := dynamicconfig.NewCollection(params.DynamicConfig, params.Logger,
+		metrics.NewClient(params.MetricScope, metrics.Frontend))
 	return &Service{
 		params: params,
-		config: NewConfig(),
+		config: NewConfig(dc),
 		stopC:  make(chan struct{}),
 	}
 }
@@ -74,52 +143,106 @@ func (s *Service) Start() {
 
 	base := service.New(p)
 
-	metadata, err := persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
+	metadataCfg := p.CassandraConfig.ForStore(config.StoreMetadata)
+	metadata, err := persistence.NewCassandraMetadataPersistence(metadataCfg.Hosts,
+		metadataCfg.Port,
+		metadataCfg.User,
+		metadataCfg.Password,
+		metadataCfg.Datacenter,
+		metadataCfg.Keyspace,
+		p.ClusterMetadata.GetCurrentClusterName(),
+		p.Logger)
+
+	if err != nil {
+		log.Fatalf("failed to create metadata manager: %v", err)
+	}
+	metadata = persistence.NewMetadataPersistenceClient(metadata, base.GetMetricsClient())
+
+	visibilityCfg := p.CassandraConfig.ForStore(config.StoreVisibility)
+	visibility, err := persistence.NewCassandraVisibilityPersistence(visibilityCfg.Hosts,
+		visibilityCfg.Port,
+		visibilityCfg.User,
+		visibilityCfg.Password,
+		visibilityCfg.Datacenter,
+		visibilityCfg.VisibilityKeyspace,
+		visibilityCfg.Timeouts,
+		p.Logger)
+
+	if err != nil {
+		log.Fatalf("failed to create visiblity manager: %v", err)
+	}
+	visibility = persistence.NewVisibilityPersistenceHedgingClient(visibility, s.config.HedgedReads, base.GetMetricsClient())
+	visibility = persistence.NewVisibilityPersistenceClient(visibility, base.GetMetricsClient())
+
+	encryptor, err := persistence.NewPayloadEncryptorFromKey(p.CassandraConfig.PayloadEncryptionKey)
+	if err != nil {
+		log.Fatalf("failed to create payload encryptor: %v", err)
+	}
+
+	historyCfg := p.CassandraConfig.ForStore(config.StoreHistory)
+	history, err := persistence.NewCassandraHistoryPersistence(historyCfg.Hosts,
+		historyCfg.Port,
+		historyCfg.User,
+		historyCfg.Password,
+		historyCfg.Datacenter,
+		historyCfg.Keyspace,
+		s.config.HistoryMgrNumConns,
+		historyCfg.Timeouts,
+		historyCfg.HistoryCompression,
+		encryptor,
+		p.Logger)
+
+	if err != nil {
+		log.Fatalf("Creating Cassandra history manager persistence failed: %v", err)
+	}
+
+	history = persistence.NewHistoryPersistenceHedgingClient(history, s.config.HedgedReads, base.GetMetricsClient())
+	history = persistence.NewHistoryPersistenceClient(history, base.GetMetricsClient())
+
+	domainStats, err := persistence.NewCassandraDomainStatsPersistence(p.CassandraConfig.Hosts,
 		p.CassandraConfig.Port,
 		p.CassandraConfig.User,
 		p.CassandraConfig.Password,
 		p.CassandraConfig.Datacenter,
 		p.CassandraConfig.Keyspace,
-		p.ClusterMetadata.GetCurrentClusterName(),
 		p.Logger)
 
 	if err != nil {
-		log.Fatalf("failed to create metadata manager: %v", err)
+		log.Fatalf("failed to create domain stats manager: %v", err)
 	}
-	metadata = persistence.NewMetadataPersistenceClient(metadata, base.GetMetricsClient())
+	domainStats = persistence.NewDomainStatsPersistenceClient(domainStats, base.GetMetricsClient())
 
-	visibility, err := persistence.NewCassandraVisibilityPersistence(p.CassandraConfig.Hosts,
+	audit, err := persistence.NewCassandraAuditPersistence(p.CassandraConfig.Hosts,
 		p.CassandraConfig.Port,
 		p.CassandraConfig.User,
 		p.CassandraConfig.Password,
 		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.VisibilityKeyspace,
+		p.CassandraConfig.Keyspace,
 		p.Logger)
 
 	if err != nil {
-		log.Fatalf("failed to create visiblity manager: %v", err)
+		log.Fatalf("failed to create audit manager: %v", err)
 	}
-	visibility = persistence.NewVisibilityPersistenceClient(visibility, base.GetMetricsClient())
+	audit = persistence.NewAuditPersistenceClient(audit, base.GetMetricsClient())
 
-	history, err := persistence.NewCassandraHistoryPersistence(p.CassandraConfig.Hosts,
+	clusterMetadata, err := persistence.NewCassandraClusterMetadataPersistence(p.CassandraConfig.Hosts,
 		p.CassandraConfig.Port,
 		p.CassandraConfig.User,
 		p.CassandraConfig.Password,
 		p.CassandraConfig.Datacenter,
 		p.CassandraConfig.Keyspace,
-		s.config.HistoryMgrNumConns,
+		p.ClusterMetadata.GetCurrentClusterName(),
 		p.Logger)
 
 	if err != nil {
-		log.Fatalf("Creating Cassandra history manager persistence failed: %v", err)
+		log.Fatalf("failed to create cluster metadata manager: %v", err)
 	}
-
-	history = persistence.NewHistoryPersistenceClient(history, base.GetMetricsClient())
+	clusterMetadata = persistence.NewClusterMetadataPersistenceClient(clusterMetadata, base.GetMetricsClient())
 
 	// TODO when global domain is enabled, uncomment the line below and remove the line after
 	var kafkaProducer messaging.Producer
 	if base.GetClusterMetadata().IsGlobalDomainEnabled() {
-		kafkaProducer, err = base.GetMessagingClient().NewProducer(base.GetClusterMetadata().GetCurrentClusterName())
+		kafkaProducer, err = base.GetMessagingClient().NewDomainProducer(base.GetClusterMetadata().GetCurrentClusterName())
 		if err != nil {
 			log.Fatalf("Creating kafka producer failed: %v", err)
 		}
@@ -127,9 +250,24 @@ func (s *Service) Start() {
 		kafkaProducer = &mocks.KafkaProducer{}
 	}
 
-	handler := NewWorkflowHandler(base, s.config, metadata, history, visibility, kafkaProducer)
+	payloadValidator := p.PayloadValidator
+	if payloadValidator == nil {
+		payloadValidator = payload.NewNoopValidator()
+	}
+
+	handler := NewWorkflowHandler(base, s.config, metadata, history, visibility, clusterMetadata, kafkaProducer,
+		payloadValidator)
+
+	adminHandler := NewAdminHandler(base, handler, domainStats, audit, clusterMetadata,
+		p.CassandraConfig.NumHistoryShards, p.BulkDeleteConfig)
+	adminHandler.RegisterHandler()
+
 	handler.Start()
 
+	if err := adminHandler.Start(); err != nil {
+		log.Fatalf("Starting admin handler failed: %v", err)
+	}
+
 	log.Infof("%v started", common.FrontendServiceName)
 
 	<-s.stopC
@@ -0,0 +1,94 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/uber-common/bark"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// workflowIDFilter rejects starting workflows whose ID is on the block list, or not on the allow list, of
+	// their domain. Both lists are regular expressions read from dynamic config, so a hot or abusive workflow ID
+	// can be blocked without a deployment.
+	workflowIDFilter struct {
+		blockList dynamicconfig.StringPropertyFn
+		allowList dynamicconfig.StringPropertyFn
+		logger    bark.Logger
+
+		sync.RWMutex
+		// compiled patterns by expression, nil for an expression which does not compile
+		patterns map[string]*regexp.Regexp
+	}
+)
+
+func newWorkflowIDFilter(config *Config, logger bark.Logger) *workflowIDFilter {
+	return &workflowIDFilter{
+		blockList: config.WorkflowIDBlockList,
+		allowList: config.WorkflowIDAllowList,
+		logger:    logger,
+		patterns:  make(map[string]*regexp.Regexp),
+	}
+}
+
+func (f *workflowIDFilter) validate(domain string, workflowID string) error {
+	domainFilter := dynamicconfig.DomainFilter(domain)
+	if pattern := f.pattern(f.blockList(domainFilter)); pattern != nil && pattern.MatchString(workflowID) {
+		return &gen.BadRequestError{
+			Message: fmt.Sprintf("WorkflowId %v is blocked in domain %v.", workflowID, domain),
+		}
+	}
+	if pattern := f.pattern(f.allowList(domainFilter)); pattern != nil && !pattern.MatchString(workflowID) {
+		return &gen.BadRequestError{
+			Message: fmt.Sprintf("WorkflowId %v is not allowed in domain %v.", workflowID, domain),
+		}
+	}
+	return nil
+}
+
+// pattern returns the compiled expression, or nil when the expression is empty or invalid. An invalid list is
+// ignored rather than blocking every start in the domain.
+func (f *workflowIDFilter) pattern(expr string) *regexp.Regexp {
+	if expr == "" {
+		return nil
+	}
+
+	f.RLock()
+	pattern, ok := f.patterns[expr]
+	f.RUnlock()
+	if ok {
+		return pattern
+	}
+
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		f.logger.Warnf("Ignoring invalid workflow ID list %q in dynamic config: %v", expr, err)
+	}
+	f.Lock()
+	f.patterns[expr] = pattern
+	f.Unlock()
+	return pattern
+}
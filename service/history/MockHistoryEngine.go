@@ -135,6 +135,105 @@ func (_m *MockHistoryEngine) DescribeWorkflowExecution(request *gohistory.Descri
 	return r0, r1
 }
 
+// GetWorkflowExecutionHistoryEvent is mock implementation for GetWorkflowExecutionHistoryEvent of HistoryEngine
+func (_m *MockHistoryEngine) GetWorkflowExecutionHistoryEvent(request *gohistory.GetWorkflowExecutionHistoryEventRequest) (*gohistory.GetWorkflowExecutionHistoryEventResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *gohistory.GetWorkflowExecutionHistoryEventResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.GetWorkflowExecutionHistoryEventRequest) *gohistory.GetWorkflowExecutionHistoryEventResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.GetWorkflowExecutionHistoryEventResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.GetWorkflowExecutionHistoryEventRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCurrentExecution is mock implementation for GetCurrentExecution of HistoryEngine
+func (_m *MockHistoryEngine) GetCurrentExecution(request *gohistory.GetCurrentExecutionRequest) (*gohistory.GetCurrentExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *gohistory.GetCurrentExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.GetCurrentExecutionRequest) *gohistory.GetCurrentExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.GetCurrentExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.GetCurrentExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWorkflowExecutionChain is mock implementation for ListWorkflowExecutionChain of HistoryEngine
+func (_m *MockHistoryEngine) ListWorkflowExecutionChain(request *gohistory.ListWorkflowExecutionChainRequest) (*gohistory.ListWorkflowExecutionChainResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *gohistory.ListWorkflowExecutionChainResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.ListWorkflowExecutionChainRequest) *gohistory.ListWorkflowExecutionChainResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.ListWorkflowExecutionChainResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.ListWorkflowExecutionChainRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeShard is mock implementation for DescribeShard of HistoryEngine
+func (_m *MockHistoryEngine) DescribeShard() *gohistory.HistoryShardStatus {
+	ret := _m.Called()
+
+	var r0 *gohistory.HistoryShardStatus
+	if rf, ok := ret.Get(0).(func() *gohistory.HistoryShardStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.HistoryShardStatus)
+		}
+	}
+
+	return r0
+}
+
+// WarmHistoryCache is mock implementation for WarmHistoryCache of HistoryEngine
+func (_m *MockHistoryEngine) WarmHistoryCache(maxExecutions int) int {
+	ret := _m.Called(maxExecutions)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(maxExecutions)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // RecordDecisionTaskStarted is mock implementation for RecordDecisionTaskStarted of HistoryEngine
 func (_m *MockHistoryEngine) RecordDecisionTaskStarted(request *gohistory.RecordDecisionTaskStartedRequest) (*gohistory.RecordDecisionTaskStartedResponse, error) {
 	ret := _m.Called(request)
@@ -354,6 +453,57 @@ func (_m *MockHistoryEngine) TerminateWorkflowExecution(request *gohistory.Termi
 	return r0
 }
 
+// PauseWorkflowExecution is mock implementation for PauseWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) PauseWorkflowExecution(request *gohistory.PauseWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution is mock implementation for ResumeWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ResumeWorkflowExecution(request *gohistory.ResumeWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.ResumeWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NukeWorkflowExecution is mock implementation for NukeWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) NukeWorkflowExecution(request *gohistory.NukeWorkflowExecutionRequest) (*gohistory.NukeWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *gohistory.NukeWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.NukeWorkflowExecutionRequest) *gohistory.NukeWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.NukeWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.NukeWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ScheduleDecisionTask is mock implementation for ScheduleDecisionTask of HistoryEngine
 func (_m *MockHistoryEngine) ScheduleDecisionTask(request *gohistory.ScheduleDecisionTaskRequest) error {
 	ret := _m.Called(request)
@@ -396,4 +546,18 @@ func (_m *MockHistoryEngine) ReplicateEvents(request *gohistory.ReplicateEventsR
 	return r0
 }
 
+// ImportWorkflowExecution is mock implementation for ImportWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ImportWorkflowExecution(request *gohistory.ImportWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.ImportWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 var _ Engine = (*MockHistoryEngine)(nil)
@@ -94,6 +94,19 @@ func (_m *MockQueueAckMgr) getAckLevel() int64 {
 	return r0
 }
 
+// getOutstandingTaskCount is mock implementation for getOutstandingTaskCount of QueueAckMgr
+func (_m *MockQueueAckMgr) getOutstandingTaskCount() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
 // updateAckLevel is mock implementation for updateAckLevel of QueueAckMgr
 func (_m *MockQueueAckMgr) updateAckLevel() {
 	_m.Called()
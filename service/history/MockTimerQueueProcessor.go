@@ -51,3 +51,16 @@ func (_m *MockTimerQueueProcessor) FailoverDomain(domainID string, standbyCluste
 func (_m *MockTimerQueueProcessor) NotifyNewTimers(clusterName string, currentTime time.Time, timerTask []persistence.Task) {
 	_m.Called(clusterName, currentTime, timerTask)
 }
+
+// getOutstandingTaskCount is mock implementation for getOutstandingTaskCount of Processor
+func (_m *MockTimerQueueProcessor) getOutstandingTaskCount() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
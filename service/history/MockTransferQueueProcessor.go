@@ -51,3 +51,16 @@ func (_m *MockTransferQueueProcessor) FailoverDomain(domainID string, standbyClu
 func (_m *MockTransferQueueProcessor) NotifyNewTask(clusterName string, currentTime time.Time, transferTask []persistence.Task) {
 	_m.Called(clusterName, currentTime, transferTask)
 }
+
+// getOutstandingTaskCount is mock implementation for getOutstandingTaskCount of Processor
+func (_m *MockTransferQueueProcessor) getOutstandingTaskCount() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	domainStatsKey struct {
+		domainID string
+		day      time.Time
+	}
+
+	domainStatsCounts struct {
+		events int64
+		bytes  int64
+	}
+
+	// domainStatsRecorder buffers the number of events and bytes appended to histories per domain and day, and
+	// periodically adds them to the history counts the worker service computes the domain statistics from
+	domainStatsRecorder struct {
+		sync.Mutex
+		statsMgr   persistence.DomainStatsManager
+		config     *Config
+		timeSource common.TimeSource
+		logger     bark.Logger
+		counts     map[domainStatsKey]*domainStatsCounts
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+	}
+
+	// historyStatsManager is a HistoryManager recording the appended history events with a domainStatsRecorder
+	historyStatsManager struct {
+		persistence.HistoryManager
+		recorder *domainStatsRecorder
+	}
+)
+
+func newDomainStatsRecorder(statsMgr persistence.DomainStatsManager, config *Config,
+	logger bark.Logger) *domainStatsRecorder {
+	return &domainStatsRecorder{
+		statsMgr:   statsMgr,
+		config:     config,
+		timeSource: common.NewRealTimeSource(),
+		logger:     logger,
+		counts:     make(map[domainStatsKey]*domainStatsCounts),
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+func newHistoryStatsManager(historyMgr persistence.HistoryManager,
+	recorder *domainStatsRecorder) persistence.HistoryManager {
+	return &historyStatsManager{
+		HistoryManager: historyMgr,
+		recorder:       recorder,
+	}
+}
+
+func (m *historyStatsManager) AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) error {
+	err := m.HistoryManager.AppendHistoryEvents(request)
+	// an overwrite replaces the tail left by an earlier attempt of the same append, which was counted when it
+	// was first written
+	if err == nil && !request.Overwrite {
+		m.recorder.record(request.DomainID, request.EventCount, len(request.Events.Data))
+	}
+	return err
+}
+
+func (r *domainStatsRecorder) Start() {
+	r.shutdownWG.Add(1)
+	go r.flushLoop()
+}
+
+// Stop stops the flush loop and flushes the counts buffered since the last flush
+func (r *domainStatsRecorder) Stop() {
+	close(r.shutdownCh)
+	r.shutdownWG.Wait()
+}
+
+func (r *domainStatsRecorder) record(domainID string, events int, bytes int) {
+	if !r.config.EnableDomainStats() {
+		return
+	}
+
+	key := domainStatsKey{domainID: domainID, day: r.timeSource.Now().UTC().Truncate(24 * time.Hour)}
+	r.Lock()
+	defer r.Unlock()
+	counts, ok := r.counts[key]
+	if !ok {
+		counts = &domainStatsCounts{}
+		r.counts[key] = counts
+	}
+	counts.events += int64(events)
+	counts.bytes += int64(bytes)
+}
+
+func (r *domainStatsRecorder) flushLoop() {
+	defer r.shutdownWG.Done()
+
+	ticker := time.NewTicker(r.config.DomainStatsFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.flush()
+		case <-r.shutdownCh:
+			r.flush()
+			return
+		}
+	}
+}
+
+func (r *domainStatsRecorder) flush() {
+	r.Lock()
+	counts := r.counts
+	r.counts = make(map[domainStatsKey]*domainStatsCounts)
+	r.Unlock()
+
+	for key, c := range counts {
+		err := r.statsMgr.AddHistoryCounts(&persistence.AddHistoryCountsRequest{
+			DomainID: key.domainID,
+			Day:      key.day,
+			Events:   c.events,
+			Bytes:    c.bytes,
+		})
+		if err != nil {
+			// counter updates are not idempotent, the counts are dropped rather than risking to add them twice
+			r.logger.WithFields(bark.Fields{
+				logging.TagDomainID: key.domainID,
+				logging.TagErr:      err,
+			}).Warn("Failed to flush domain history counts.")
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	domainStatsRecorderSuite struct {
+		suite.Suite
+		mockStatsMgr   *mocks.DomainStatsManager
+		mockHistoryMgr *mocks.HistoryManager
+		recorder       *domainStatsRecorder
+	}
+)
+
+func TestDomainStatsRecorderSuite(t *testing.T) {
+	s := new(domainStatsRecorderSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainStatsRecorderSuite) SetupTest() {
+	s.mockStatsMgr = &mocks.DomainStatsManager{}
+	s.mockHistoryMgr = &mocks.HistoryManager{}
+	s.recorder = newDomainStatsRecorder(s.mockStatsMgr, NewConfig(dynamicconfig.NewNopCollection(), 1),
+		bark.NewLoggerFromLogrus(logrus.New()))
+}
+
+func (s *domainStatsRecorderSuite) TearDownTest() {
+	s.mockStatsMgr.AssertExpectations(s.T())
+	s.mockHistoryMgr.AssertExpectations(s.T())
+}
+
+func (s *domainStatsRecorderSuite) TestAppendHistoryEvents() {
+	historyMgr := newHistoryStatsManager(s.mockHistoryMgr, s.recorder)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	request := &persistence.AppendHistoryEventsRequest{
+		DomainID:   "domain1",
+		Execution:  workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+		Events:     persistence.NewSerializedHistoryEventBatch(make([]byte, 100), common.EncodingTypeJSON, 1),
+		EventCount: 3,
+	}
+	s.mockHistoryMgr.On("AppendHistoryEvents", request).Return(nil).Twice()
+	s.NoError(historyMgr.AppendHistoryEvents(request))
+	s.NoError(historyMgr.AppendHistoryEvents(request))
+
+	// failed appends are not counted
+	failed := &persistence.AppendHistoryEventsRequest{
+		DomainID:   "domain2",
+		Events:     persistence.NewSerializedHistoryEventBatch(make([]byte, 100), common.EncodingTypeJSON, 1),
+		EventCount: 1,
+	}
+	s.mockHistoryMgr.On("AppendHistoryEvents", failed).Return(&persistence.ConditionFailedError{}).Once()
+	s.Error(historyMgr.AppendHistoryEvents(failed))
+
+	s.mockStatsMgr.On("AddHistoryCounts", &persistence.AddHistoryCountsRequest{
+		DomainID: "domain1",
+		Day:      today,
+		Events:   6,
+		Bytes:    200,
+	}).Return(nil).Once()
+	s.recorder.flush()
+
+	// counts are reset after a flush
+	s.recorder.flush()
+}
+
+func (s *domainStatsRecorderSuite) TestAppendHistoryEvents_Overwrite() {
+	historyMgr := newHistoryStatsManager(s.mockHistoryMgr, s.recorder)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	request := &persistence.AppendHistoryEventsRequest{
+		DomainID:   "domain1",
+		Execution:  workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+		Events:     persistence.NewSerializedHistoryEventBatch(make([]byte, 100), common.EncodingTypeJSON, 1),
+		EventCount: 3,
+	}
+	s.mockHistoryMgr.On("AppendHistoryEvents", request).Return(nil).Once()
+	s.NoError(historyMgr.AppendHistoryEvents(request))
+
+	// the retry of the same append overwrites the tail written above, which is already counted
+	overwrite := *request
+	overwrite.Overwrite = true
+	s.mockHistoryMgr.On("AppendHistoryEvents", &overwrite).Return(nil).Once()
+	s.NoError(historyMgr.AppendHistoryEvents(&overwrite))
+
+	s.mockStatsMgr.On("AddHistoryCounts", &persistence.AddHistoryCountsRequest{
+		DomainID: "domain1",
+		Day:      today,
+		Events:   3,
+		Bytes:    100,
+	}).Return(nil).Once()
+	s.recorder.flush()
+}
+
+func (s *domainStatsRecorderSuite) TestFlushOnStop() {
+	s.recorder.record("domain1", 2, 50)
+	s.recorder.record("domain2", 1, 10)
+
+	s.mockStatsMgr.On("AddHistoryCounts", mock.MatchedBy(func(request *persistence.AddHistoryCountsRequest) bool {
+		return request.DomainID == "domain1" && request.Events == 2 && request.Bytes == 50
+	})).Return(nil).Once()
+	// a failure is not retried, adding to counters is not idempotent
+	s.mockStatsMgr.On("AddHistoryCounts", mock.MatchedBy(func(request *persistence.AddHistoryCountsRequest) bool {
+		return request.DomainID == "domain2" && request.Events == 1 && request.Bytes == 10
+	})).Return(&workflow.InternalServiceError{Message: "some random error"}).Once()
+
+	s.recorder.Start()
+	s.recorder.Stop()
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/uber/cadence/.gen/go/health"
@@ -34,6 +35,7 @@ import (
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
@@ -50,9 +52,11 @@ type (
 		visibilityMgr         persistence.VisibilityManager
 		historyMgr            persistence.HistoryManager
 		executionMgrFactory   persistence.ExecutionManagerFactory
+		clusterMetadataMgr    persistence.ClusterMetadataManager
 		historyServiceClient  hc.Client
 		matchingServiceClient matching.Client
 		hServiceResolver      membership.ServiceResolver
+		shardPlacement        *shardPlacement
 		controller            *shardController
 		tokenSerializer       common.TaskTokenSerializer
 		startWG               sync.WaitGroup
@@ -60,6 +64,8 @@ type (
 		config                *Config
 		historyEventNotifier  historyEventNotifier
 		publisher             messaging.Producer
+		rateLimiters          workflowIDRateLimiters
+		workflowMetricsSeries *workflowMetricsSeries
 		service.Service
 	}
 )
@@ -73,21 +79,29 @@ var (
 	errTaskListNotSet          = &gen.BadRequestError{Message: "Tasklist not set."}
 	errWorkflowIDNotSet        = &gen.BadRequestError{Message: "WorkflowId is not set on request."}
 	errRunIDNotValid           = &gen.BadRequestError{Message: "RunID is not valid UUID."}
+	errInvalidShardsPerMinute  = &gen.BadRequestError{Message: "ShardsPerMinute must not be negative."}
+	errDrainInProgress         = &gen.BadRequestError{Message: "A drain is already in progress on the host."}
+
+	errWorkflowIDRateLimitExceeded = &gen.ServiceBusyError{Message: "Workflow ID rate limit exceeded."}
 )
 
 // NewHandler creates a thrift handler for the history service
 func NewHandler(sVice service.Service, config *Config, shardManager persistence.ShardManager,
 	metadataMgr persistence.MetadataManager, visibilityMgr persistence.VisibilityManager,
-	historyMgr persistence.HistoryManager, executionMgrFactory persistence.ExecutionManagerFactory) *Handler {
+	historyMgr persistence.HistoryManager, executionMgrFactory persistence.ExecutionManagerFactory,
+	clusterMetadataMgr persistence.ClusterMetadataManager) *Handler {
 	handler := &Handler{
-		Service:             sVice,
-		config:              config,
-		shardManager:        shardManager,
-		metadataMgr:         metadataMgr,
-		historyMgr:          historyMgr,
-		visibilityMgr:       visibilityMgr,
-		executionMgrFactory: executionMgrFactory,
-		tokenSerializer:     common.NewJSONTaskTokenSerializer(),
+		Service:               sVice,
+		config:                config,
+		shardManager:          shardManager,
+		metadataMgr:           metadataMgr,
+		historyMgr:            historyMgr,
+		visibilityMgr:         visibilityMgr,
+		executionMgrFactory:   executionMgrFactory,
+		clusterMetadataMgr:    clusterMetadataMgr,
+		tokenSerializer:       common.NewJSONTaskTokenSerializer(),
+		rateLimiters:          newWorkflowIDRateLimiters(config, common.NewRealTimeSource()),
+		workflowMetricsSeries: newWorkflowMetricsSeries(config.WorkflowMetricsMaxSeries),
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -116,7 +130,10 @@ func (h *Handler) Start() error {
 	if err1 != nil {
 		h.Service.GetLogger().Fatalf("Unable to get history service resolver: ", err1)
 	}
-	h.hServiceResolver = hServiceResolver
+	// shards pinned by the shard rebalancer take precedence over the membership ring
+	h.shardPlacement = newShardPlacement(hServiceResolver, h.clusterMetadataMgr, h.config, h.GetLogger())
+	h.shardPlacement.start()
+	h.hServiceResolver = h.shardPlacement
 
 	// TODO when global domain is enabled, uncomment the line below and remove the line after
 	if h.GetClusterMetadata().IsGlobalDomainEnabled() {
@@ -127,7 +144,7 @@ func (h *Handler) Start() error {
 		}
 	}
 
-	h.controller = newShardController(h.Service, h.GetHostInfo(), hServiceResolver, h.shardManager, h.historyMgr,
+	h.controller = newShardController(h.Service, h.GetHostInfo(), h.hServiceResolver, h.shardManager, h.historyMgr,
 		h.metadataMgr, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
 	h.metricsClient = h.GetMetricsClient()
 	h.historyEventNotifier = newHistoryEventNotifier(h.GetMetricsClient(), h.config.GetShardID)
@@ -141,18 +158,21 @@ func (h *Handler) Start() error {
 // Stop stops the handler
 func (h *Handler) Stop() {
 	h.controller.Stop()
+	h.shardPlacement.stop()
 	h.shardManager.Close()
 	h.historyMgr.Close()
 	h.executionMgrFactory.Close()
 	h.metadataMgr.Close()
 	h.visibilityMgr.Close()
+	h.clusterMetadataMgr.Close()
 	h.Service.Stop()
 	h.historyEventNotifier.Stop()
 }
 
 // CreateEngine is implementation for HistoryEngineFactory used for creating the engine instance for shard
 func (h *Handler) CreateEngine(context ShardContext) Engine {
-	return NewEngineWithShardContext(context, h.visibilityMgr, h.matchingServiceClient, h.historyServiceClient, h.historyEventNotifier, h.publisher)
+	return NewEngineWithShardContext(context, h.visibilityMgr, h.matchingServiceClient, h.historyServiceClient, h.historyEventNotifier, h.publisher,
+		h.workflowMetricsSeries)
 }
 
 // Health is for health check
@@ -237,9 +257,9 @@ func (h *Handler) RecordActivityTaskStarted(ctx context.Context,
 func (h *Handler) RecordDecisionTaskStarted(ctx context.Context,
 	recordRequest *hist.RecordDecisionTaskStartedRequest) (*hist.RecordDecisionTaskStartedResponse, error) {
 	h.startWG.Wait()
-	h.Service.GetLogger().Debugf("RecordDecisionTaskStarted. DomainID: %v, WorkflowID: %v, RunID: %v, ScheduleID: %v",
-		recordRequest.GetDomainUUID(), recordRequest.WorkflowExecution.GetWorkflowId(),
-		common.StringDefault(recordRequest.WorkflowExecution.RunId), recordRequest.GetScheduleId())
+	ctx = h.withLogTags(ctx, recordRequest.GetDomainUUID(), recordRequest.WorkflowExecution)
+	logging.LoggerFromContext(ctx, h.GetLogger()).Debugf("RecordDecisionTaskStarted. ScheduleID: %v",
+		recordRequest.GetScheduleId())
 
 	h.metricsClient.IncCounter(metrics.HistoryRecordDecisionTaskStartedScope, metrics.CadenceRequests)
 	sw := h.metricsClient.StartTimer(metrics.HistoryRecordDecisionTaskStartedScope, metrics.CadenceLatency)
@@ -256,11 +276,8 @@ func (h *Handler) RecordDecisionTaskStarted(ctx context.Context,
 	workflowExecution := recordRequest.WorkflowExecution
 	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
 	if err1 != nil {
-		h.Service.GetLogger().Errorf("RecordDecisionTaskStarted failed. Error: %v. WorkflowID: %v, RunID: %v, ScheduleID: %v",
-			err1,
-			recordRequest.WorkflowExecution.GetWorkflowId(),
-			recordRequest.WorkflowExecution.GetRunId(),
-			recordRequest.GetScheduleId())
+		logging.LoggerFromContext(ctx, h.GetLogger()).Errorf("RecordDecisionTaskStarted failed. Error: %v. ScheduleID: %v",
+			err1, recordRequest.GetScheduleId())
 		h.updateErrorMetric(metrics.HistoryRecordDecisionTaskStartedScope, err1)
 		return nil, err1
 	}
@@ -418,11 +435,11 @@ func (h *Handler) RespondDecisionTaskCompleted(ctx context.Context,
 		return err0
 	}
 
-	h.Service.GetLogger().Debugf("RespondDecisionTaskCompleted. DomainID: %v, WorkflowID: %v, RunID: %v, ScheduleID: %v",
-		token.DomainID,
-		token.WorkflowID,
-		token.RunID,
-		token.ScheduleID)
+	ctx = h.withLogTags(ctx, token.DomainID, &gen.WorkflowExecution{
+		WorkflowId: common.StringPtr(token.WorkflowID),
+		RunId:      common.StringPtr(token.RunID),
+	})
+	logging.LoggerFromContext(ctx, h.GetLogger()).Debugf("RespondDecisionTaskCompleted. ScheduleID: %v", token.ScheduleID)
 
 	err0 = validateTaskToken(token)
 	if err0 != nil {
@@ -465,11 +482,11 @@ func (h *Handler) RespondDecisionTaskFailed(ctx context.Context,
 		return err0
 	}
 
-	h.Service.GetLogger().Debugf("RespondDecisionTaskFailed. DomainID: %v, WorkflowID: %v, RunID: %v, ScheduleID: %v",
-		token.DomainID,
-		token.WorkflowID,
-		token.RunID,
-		token.ScheduleID)
+	ctx = h.withLogTags(ctx, token.DomainID, &gen.WorkflowExecution{
+		WorkflowId: common.StringPtr(token.WorkflowID),
+		RunId:      common.StringPtr(token.RunID),
+	})
+	logging.LoggerFromContext(ctx, h.GetLogger()).Debugf("RespondDecisionTaskFailed. ScheduleID: %v", token.ScheduleID)
 
 	err0 = validateTaskToken(token)
 	if err0 != nil {
@@ -505,6 +522,11 @@ func (h *Handler) StartWorkflowExecution(ctx context.Context,
 	}
 
 	startRequest := wrappedRequest.StartRequest
+	if err := h.rateLimiters.allow(metrics.HistoryStartWorkflowExecutionScope, wrappedRequest.GetDomainUUID(), startRequest.GetWorkflowId()); err != nil {
+		h.updateErrorMetric(metrics.HistoryStartWorkflowExecutionScope, err)
+		return nil, err
+	}
+
 	engine, err1 := h.controller.GetEngine(*startRequest.WorkflowId)
 	if err1 != nil {
 		h.updateErrorMetric(metrics.HistoryStartWorkflowExecutionScope, err1)
@@ -576,6 +598,94 @@ func (h *Handler) DescribeWorkflowExecution(ctx context.Context, request *hist.D
 	return resp, nil
 }
 
+// GetWorkflowExecutionHistoryEvent returns a single event of the history of a workflow execution
+func (h *Handler) GetWorkflowExecutionHistoryEvent(ctx context.Context,
+	request *hist.GetWorkflowExecutionHistoryEventRequest) (*hist.GetWorkflowExecutionHistoryEventResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryGetWorkflowExecutionHistoryEventScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryGetWorkflowExecutionHistoryEventScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetDomainUUID() == "" {
+		return nil, errDomainNotSet
+	}
+
+	workflowExecution := request.Execution
+	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryGetWorkflowExecutionHistoryEventScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.GetWorkflowExecutionHistoryEvent(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryGetWorkflowExecutionHistoryEventScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+	return resp, nil
+}
+
+// GetCurrentExecution returns the current run of a workflow ID
+func (h *Handler) GetCurrentExecution(ctx context.Context,
+	request *hist.GetCurrentExecutionRequest) (*hist.GetCurrentExecutionResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryGetCurrentExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryGetCurrentExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetDomainUUID() == "" {
+		return nil, errDomainNotSet
+	}
+
+	workflowID := request.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryGetCurrentExecutionScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.GetCurrentExecution(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryGetCurrentExecutionScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+	return resp, nil
+}
+
+// ListWorkflowExecutionChain returns the runs chained by continue as new up to a run of a workflow ID
+func (h *Handler) ListWorkflowExecutionChain(ctx context.Context,
+	request *hist.ListWorkflowExecutionChainRequest) (*hist.ListWorkflowExecutionChainResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryListWorkflowExecutionChainScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryListWorkflowExecutionChainScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetDomainUUID() == "" {
+		return nil, errDomainNotSet
+	}
+
+	if request.Execution == nil {
+		return nil, errWorkflowExecutionNotSet
+	}
+
+	workflowExecution := request.Execution
+	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryListWorkflowExecutionChainScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.ListWorkflowExecutionChain(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryListWorkflowExecutionChainScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+	return resp, nil
+}
+
 // RequestCancelWorkflowExecution - requests cancellation of a workflow
 func (h *Handler) RequestCancelWorkflowExecution(ctx context.Context,
 	request *hist.RequestCancelWorkflowExecutionRequest) error {
@@ -590,11 +700,9 @@ func (h *Handler) RequestCancelWorkflowExecution(ctx context.Context,
 	}
 
 	cancelRequest := request.CancelRequest
-	h.Service.GetLogger().Debugf("RequestCancelWorkflowExecution. DomainID: %v/%v, WorkflowID: %v, RunID: %v.",
-		cancelRequest.GetDomain(),
-		request.GetDomainUUID(),
-		cancelRequest.WorkflowExecution.GetWorkflowId(),
-		cancelRequest.WorkflowExecution.GetRunId())
+	ctx = h.withLogTags(ctx, request.GetDomainUUID(), cancelRequest.WorkflowExecution)
+	logging.LoggerFromContext(ctx, h.GetLogger()).Debugf("RequestCancelWorkflowExecution. Domain: %v.",
+		cancelRequest.GetDomain())
 
 	engine, err1 := h.controller.GetEngine(cancelRequest.WorkflowExecution.GetWorkflowId())
 	if err1 != nil {
@@ -626,6 +734,11 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context,
 	}
 
 	workflowExecution := wrappedRequest.SignalRequest.WorkflowExecution
+	if err := h.rateLimiters.allow(metrics.HistorySignalWorkflowExecutionScope, wrappedRequest.GetDomainUUID(), workflowExecution.GetWorkflowId()); err != nil {
+		h.updateErrorMetric(metrics.HistorySignalWorkflowExecutionScope, err)
+		return err
+	}
+
 	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
 	if err1 != nil {
 		h.updateErrorMetric(metrics.HistorySignalWorkflowExecutionScope, err1)
@@ -659,6 +772,11 @@ func (h *Handler) SignalWithStartWorkflowExecution(ctx context.Context,
 	}
 
 	signalWithStartRequest := wrappedRequest.SignalWithStartRequest
+	if err := h.rateLimiters.allow(metrics.HistorySignalWithStartWorkflowExecutionScope, wrappedRequest.GetDomainUUID(), signalWithStartRequest.GetWorkflowId()); err != nil {
+		h.updateErrorMetric(metrics.HistorySignalWithStartWorkflowExecutionScope, err)
+		return nil, err
+	}
+
 	engine, err1 := h.controller.GetEngine(signalWithStartRequest.GetWorkflowId())
 	if err1 != nil {
 		h.updateErrorMetric(metrics.HistorySignalWithStartWorkflowExecutionScope, err1)
@@ -731,6 +849,100 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// PauseWorkflowExecution holds the tasks and timers of a workflow execution until it is resumed.
+func (h *Handler) PauseWorkflowExecution(ctx context.Context, request *hist.PauseWorkflowExecutionRequest) error {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryPauseWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryPauseWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetDomainUUID() == "" {
+		return errDomainNotSet
+	}
+
+	if request.WorkflowExecution == nil {
+		return errWorkflowExecutionNotSet
+	}
+
+	engine, err1 := h.controller.GetEngine(request.WorkflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryPauseWorkflowExecutionScope, err1)
+		return err1
+	}
+
+	err2 := engine.PauseWorkflowExecution(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryPauseWorkflowExecutionScope, h.convertError(err2))
+		return h.convertError(err2)
+	}
+
+	return nil
+}
+
+// ResumeWorkflowExecution lets a paused workflow execution make progress again.
+func (h *Handler) ResumeWorkflowExecution(ctx context.Context, request *hist.ResumeWorkflowExecutionRequest) error {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryResumeWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryResumeWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetDomainUUID() == "" {
+		return errDomainNotSet
+	}
+
+	if request.WorkflowExecution == nil {
+		return errWorkflowExecutionNotSet
+	}
+
+	engine, err1 := h.controller.GetEngine(request.WorkflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryResumeWorkflowExecutionScope, err1)
+		return err1
+	}
+
+	err2 := engine.ResumeWorkflowExecution(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryResumeWorkflowExecutionScope, h.convertError(err2))
+		return h.convertError(err2)
+	}
+
+	return nil
+}
+
+// NukeWorkflowExecution deletes a run whose state is too inconsistent to be terminated, along with its visibility
+// records and history.
+func (h *Handler) NukeWorkflowExecution(ctx context.Context,
+	request *hist.NukeWorkflowExecutionRequest) (*hist.NukeWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryNukeWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryNukeWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetDomainUUID() == "" {
+		return nil, errDomainNotSet
+	}
+
+	if request.WorkflowExecution == nil {
+		return nil, errWorkflowExecutionNotSet
+	}
+
+	engine, err1 := h.controller.GetEngine(request.WorkflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryNukeWorkflowExecutionScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.NukeWorkflowExecution(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryNukeWorkflowExecutionScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+	return resp, nil
+}
+
 // ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
 // used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
 // child execution without creating the decision task and then calls this API after updating the mutable state of
@@ -860,6 +1072,120 @@ func (h *Handler) ReplicateEvents(ctx context.Context, replicateRequest *hist.Re
 	return nil
 }
 
+// ImportWorkflowExecution recreates a run from its exported history
+func (h *Handler) ImportWorkflowExecution(ctx context.Context, importRequest *hist.ImportWorkflowExecutionRequest) error {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryImportWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryImportWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if importRequest.GetDomainUUID() == "" {
+		return errDomainNotSet
+	}
+
+	if importRequest.Execution == nil {
+		return errWorkflowExecutionNotSet
+	}
+
+	engine, err1 := h.controller.GetEngine(importRequest.Execution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryImportWorkflowExecutionScope, err1)
+		return err1
+	}
+
+	err2 := engine.ImportWorkflowExecution(importRequest)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryImportWorkflowExecutionScope, h.convertError(err2))
+		return h.convertError(err2)
+	}
+
+	return nil
+}
+
+// RefreshDomainCache expires the cached entries of a domain, so the shards of this host reload it on next use
+func (h *Handler) RefreshDomainCache(ctx context.Context, refreshRequest *hist.RefreshDomainCacheRequest) error {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryRefreshDomainCacheScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryRefreshDomainCacheScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	h.controller.domainCache.Invalidate(refreshRequest.GetDomain())
+	return nil
+}
+
+// DescribeHistoryHost returns the status of every shard owned by this host
+func (h *Handler) DescribeHistoryHost(ctx context.Context,
+	request *hist.DescribeHistoryHostRequest) (*hist.DescribeHistoryHostResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryDescribeHistoryHostScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryDescribeHistoryHostScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	shards := h.controller.describeShards()
+	return &hist.DescribeHistoryHostResponse{
+		Address:        common.StringPtr(h.GetHostInfo().GetAddress()),
+		NumberOfShards: common.Int32Ptr(int32(len(shards))),
+		Shards:         shards,
+	}, nil
+}
+
+// WarmHistoryHost acquires the shards assigned to this host and loads the executions they are about to process into
+// their history caches
+func (h *Handler) WarmHistoryHost(ctx context.Context,
+	request *hist.WarmHistoryHostRequest) (*hist.WarmHistoryHostResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryWarmHistoryHostScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryWarmHistoryHostScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	maxExecutions := int(request.GetMaximumExecutionsPerShard())
+	if maxExecutions <= 0 || maxExecutions > h.config.HistoryCacheMaxSize {
+		maxExecutions = h.config.HistoryCacheMaxSize
+	}
+	numShards, numExecutions := h.controller.warmShards(maxExecutions)
+	return &hist.WarmHistoryHostResponse{
+		Address:          common.StringPtr(h.GetHostInfo().GetAddress()),
+		NumberOfShards:   common.Int32Ptr(int32(numShards)),
+		ExecutionsLoaded: common.Int32Ptr(int32(numExecutions)),
+	}, nil
+}
+
+// DrainHistoryHost starts moving the shards owned by this host to the other history hosts at the requested rate
+func (h *Handler) DrainHistoryHost(ctx context.Context,
+	request *hist.DrainHistoryHostRequest) (*hist.DrainHistoryHostResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryDrainHistoryHostScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryDrainHistoryHostScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetShardsPerMinute() < 0 {
+		h.updateErrorMetric(metrics.HistoryDrainHistoryHostScope, errInvalidShardsPerMinute)
+		return nil, errInvalidShardsPerMinute
+	}
+	shardsPerMinute := int(request.GetShardsPerMinute())
+	if shardsPerMinute == 0 {
+		shardsPerMinute = h.config.ShardDrainShardsPerMinute()
+	}
+	if shardsPerMinute <= 0 {
+		shardsPerMinute = 1
+	}
+
+	numShards, ok := h.controller.drain(h.shardPlacement, time.Minute/time.Duration(shardsPerMinute))
+	if !ok {
+		h.updateErrorMetric(metrics.HistoryDrainHistoryHostScope, errDrainInProgress)
+		return nil, errDrainInProgress
+	}
+	return &hist.DrainHistoryHostResponse{
+		Address:        common.StringPtr(h.GetHostInfo().GetAddress()),
+		NumberOfShards: common.Int32Ptr(int32(numShards)),
+	}, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -891,11 +1217,24 @@ func (h *Handler) updateErrorMetric(scope int, err error) {
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrEntityNotExistsCounter)
 	case *gen.CancellationAlreadyRequestedError:
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrCancellationAlreadyRequestedCounter)
+	case *gen.ServiceBusyError:
+		h.metricsClient.IncCounter(scope, metrics.CadenceErrServiceBusyCounter)
 	default:
 		h.metricsClient.IncCounter(scope, metrics.CadenceFailures)
 	}
 }
 
+// withLogTags attaches the tags of the workflow execution and of the shard owning it to the context, for the log
+// lines of the request
+func (h *Handler) withLogTags(ctx context.Context, domainID string, execution *gen.WorkflowExecution) context.Context {
+	ctx = logging.ContextWithExecutionTags(ctx, domainID, execution)
+	if execution == nil {
+		return ctx
+	}
+	shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowId(), h.config.NumberOfShards)
+	return logging.ContextWithShardID(ctx, shardID)
+}
+
 func createShardOwnershipLostError(currentHost, ownerHost string) *hist.ShardOwnershipLostError {
 	shardLostErr := &hist.ShardOwnershipLostError{}
 	shardLostErr.Message = common.StringPtr(fmt.Sprintf("Shard is not owned by host: %v", currentHost))
@@ -448,6 +448,10 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.ChildPolicy = request.ChildPolicy
 	attributes.ContinuedExecutionRunId = previousRunID
 	attributes.Identity = common.StringPtr(common.StringDefault(request.Identity))
+	attributes.RetryPolicy = request.RetryPolicy
+	attributes.Attempt = common.Int32Ptr(startRequest.GetAttempt())
+	attributes.ExpirationTimestamp = startRequest.ExpirationTimestamp
+	attributes.FirstDecisionTaskBackoffSeconds = startRequest.FirstDecisionTaskBackoffSeconds
 	parentInfo := startRequest.ParentExecutionInfo
 	if parentInfo != nil {
 		attributes.ParentWorkflowDomain = parentInfo.Domain
@@ -587,11 +591,13 @@ func (b *historyBuilder) newActivityTaskFailedEvent(scheduleEventID, startedEven
 	request *workflow.RespondActivityTaskFailedRequest) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventTypeActivityTaskFailed)
 	attributes := &workflow.ActivityTaskFailedEventAttributes{}
-	attributes.Reason = common.StringPtr(common.StringDefault(request.Reason))
+	attributes.Reason = common.StringPtr(common.FailureReason(request.Reason, request.Failure))
 	attributes.Details = request.Details
 	attributes.ScheduledEventId = common.Int64Ptr(scheduleEventID)
 	attributes.StartedEventId = common.Int64Ptr(startedEventID)
 	attributes.Identity = common.StringPtr(common.StringDefault(request.Identity))
+	attributes.FailureTypes = request.FailureTypes
+	attributes.Failure = request.Failure
 	historyEvent.ActivityTaskFailedEventAttributes = attributes
 
 	return historyEvent
@@ -612,8 +618,9 @@ func (b *historyBuilder) newFailWorkflowExecutionEvent(decisionTaskCompletedEven
 	request *workflow.FailWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventTypeWorkflowExecutionFailed)
 	attributes := &workflow.WorkflowExecutionFailedEventAttributes{}
-	attributes.Reason = common.StringPtr(common.StringDefault(request.Reason))
+	attributes.Reason = common.StringPtr(common.FailureReason(request.Reason, request.Failure))
 	attributes.Details = request.Details
+	attributes.Failure = request.Failure
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
 	historyEvent.WorkflowExecutionFailedEventAttributes = attributes
 
@@ -806,6 +813,11 @@ func (b *historyBuilder) newWorkflowExecutionContinuedAsNewEvent(decisionTaskCom
 	attributes.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(*request.ExecutionStartToCloseTimeoutSeconds)
 	attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(*request.TaskStartToCloseTimeoutSeconds)
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.BackoffStartIntervalInSeconds = request.BackoffStartIntervalInSeconds
+	attributes.Initiator = request.Initiator
+	attributes.FailureReason = request.FailureReason
+	attributes.FailureDetails = request.FailureDetails
+	attributes.Failure = request.Failure
 	historyEvent.WorkflowExecutionContinuedAsNewEventAttributes = attributes
 
 	return historyEvent
@@ -889,6 +901,7 @@ func (b *historyBuilder) newChildWorkflowExecutionFailedEvent(domain *string, ex
 	attributes.StartedEventId = common.Int64Ptr(startedID)
 	attributes.Reason = common.StringPtr(common.StringDefault(failedAttributes.Reason))
 	attributes.Details = failedAttributes.Details
+	attributes.Failure = failedAttributes.Failure
 	historyEvent.ChildWorkflowExecutionFailedEventAttributes = attributes
 
 	return historyEvent
@@ -629,6 +629,60 @@ func (s *historyBuilderSuite) TestHistoryBuilderWorkflowCancellationFailed() {
 	s.Equal(int64(7), s.getNextEventID())
 }
 
+func (s *historyBuilderSuite) TestHistoryBuilderFailureChain() {
+	activityFailure := &workflow.Failure{
+		Type:    common.StringPtr("ActivityError"),
+		Message: common.StringPtr("some random activity error"),
+		Cause: &workflow.Failure{
+			Type:       common.StringPtr("IOError"),
+			StackTrace: common.StringPtr("some random stack trace"),
+		},
+	}
+	activityFailedEvent := s.msBuilder.hBuilder.newActivityTaskFailedEvent(5, 6,
+		&workflow.RespondActivityTaskFailedRequest{
+			Identity: common.StringPtr("some random identity"),
+			Failure:  activityFailure,
+		})
+	activityAttributes := activityFailedEvent.ActivityTaskFailedEventAttributes
+	s.Equal("ActivityError", activityAttributes.GetReason())
+	s.Equal(activityFailure, activityAttributes.Failure)
+
+	workflowFailure := &workflow.Failure{
+		Type:  common.StringPtr("WorkflowError"),
+		Cause: activityAttributes.Failure,
+	}
+	workflowFailedEvent := s.msBuilder.hBuilder.newFailWorkflowExecutionEvent(7,
+		&workflow.FailWorkflowExecutionDecisionAttributes{
+			Reason:  common.StringPtr("some random reason"),
+			Failure: workflowFailure,
+		})
+	workflowAttributes := workflowFailedEvent.WorkflowExecutionFailedEventAttributes
+	s.Equal("some random reason", workflowAttributes.GetReason())
+	s.Equal(workflowFailure, workflowAttributes.Failure)
+
+	continuedAsNewEvent := s.msBuilder.hBuilder.newWorkflowExecutionContinuedAsNewEvent(7, uuid.New(),
+		&workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+			Initiator:                           workflow.ContinueAsNewInitiatorRetryPolicy.Ptr(),
+			FailureReason:                       common.StringPtr("some random reason"),
+			Failure:                             workflowFailure,
+		})
+	s.Equal(workflowFailure, continuedAsNewEvent.WorkflowExecutionContinuedAsNewEventAttributes.Failure)
+
+	childFailedEvent := s.msBuilder.hBuilder.newChildWorkflowExecutionFailedEvent(
+		common.StringPtr("some random domain"),
+		&workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("some random workflow ID"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		&workflow.WorkflowType{Name: common.StringPtr("some random workflow type")},
+		5, 6, workflowAttributes)
+	childAttributes := childFailedEvent.ChildWorkflowExecutionFailedEventAttributes
+	s.Equal("some random reason", childAttributes.GetReason())
+	s.Equal("IOError", childAttributes.Failure.Cause.Cause.GetType())
+}
+
 func (s *historyBuilderSuite) getNextEventID() int64 {
 	return s.msBuilder.executionInfo.NextEventID
 }
@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 const (
@@ -47,6 +48,8 @@ const (
 	activityCancelationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancelationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
 	timerCancelationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
+	// decision tasks suggest continue as new once a run has received this percentage of its max signals
+	signalCountSuggestContinueAsNewPercent = 80
 )
 
 type (
@@ -55,6 +58,7 @@ type (
 		shard                ShardContext
 		historyMgr           persistence.HistoryManager
 		executionManager     persistence.ExecutionManager
+		visibilityMgr        persistence.VisibilityManager
 		txProcessor          transferQueueProcessor
 		timerProcessor       timerQueueProcessor
 		replicator           *historyReplicator
@@ -65,6 +69,14 @@ type (
 		historyCache         *historyCache
 		metricsClient        metrics.Client
 		logger               bark.Logger
+
+		// distinct series of workflow metrics emitted per domain, shared by the engines of the host
+		workflowMetricsSeries *workflowMetricsSeries
+
+		// candidate filters evaluated in shadow mode by the active queue
+		// processors when TaskFilterShadowMode is enabled
+		shadowTransferTaskFilter transferTaskFilter
+		shadowTimerTaskFilter    timerTaskFilter
 	}
 
 	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor on new tasks.
@@ -95,12 +107,18 @@ var (
 	ErrActivityTaskNotFound = &workflow.EntityNotExistsError{Message: "Activity task not found."}
 	// ErrWorkflowCompleted is the error to indicate workflow execution already completed
 	ErrWorkflowCompleted = &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+	// ErrWorkflowPaused is the error to indicate a task of a paused workflow execution cannot be started
+	ErrWorkflowPaused = &workflow.EntityNotExistsError{Message: "Workflow execution is paused."}
 	// ErrWorkflowParent is the error to parent execution is given and mismatch
 	ErrWorkflowParent = &workflow.EntityNotExistsError{Message: "Workflow parent does not match."}
 	// ErrDeserializingToken is the error to indicate task token is invalid
 	ErrDeserializingToken = &workflow.BadRequestError{Message: "Error deserializing task token."}
 	// ErrCancellationAlreadyRequested is the error indicating cancellation for target workflow is already requested
 	ErrCancellationAlreadyRequested = &workflow.CancellationAlreadyRequestedError{Message: "Cancellation already requested for this workflow execution."}
+	// ErrBufferedSignalsLimitExceeded is the error indicating too many signals are buffered for the workflow execution
+	ErrBufferedSignalsLimitExceeded = &workflow.ServiceBusyError{Message: "Exceeded maximum buffered signals for this workflow execution."}
+	// ErrSignalsLimitExceeded is the error indicating the workflow execution has received too many signals
+	ErrSignalsLimitExceeded = &workflow.BadRequestError{Message: "Exceeded maximum signals for this workflow execution."}
 	// FailedWorkflowCloseState is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
 	FailedWorkflowCloseState = map[int]bool{
@@ -113,7 +131,8 @@ var (
 
 // NewEngineWithShardContext creates an instance of history engine
 func NewEngineWithShardContext(shard ShardContext, visibilityMgr persistence.VisibilityManager,
-	matching matching.Client, historyClient hc.Client, historyEventNotifier historyEventNotifier, publisher messaging.Producer) Engine {
+	matching matching.Client, historyClient hc.Client, historyEventNotifier historyEventNotifier, publisher messaging.Producer,
+	workflowMetricsSeries *workflowMetricsSeries) Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 	shardWrapper := &shardContextWrapper{
 		currentClusterName:   currentClusterName,
@@ -131,15 +150,19 @@ func NewEngineWithShardContext(shard ShardContext, visibilityMgr persistence.Vis
 		shard:              shard,
 		historyMgr:         historyManager,
 		executionManager:   executionManager,
+		visibilityMgr:      visibilityMgr,
 		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
 		hSerializerFactory: historySerializerFactory,
 		historyCache:       historyCache,
 		logger: logger.WithFields(bark.Fields{
 			logging.TagWorkflowComponent: logging.TagValueHistoryEngineComponent,
 		}),
-		metricsClient:        shard.GetMetricsClient(),
-		historyEventNotifier: historyEventNotifier,
+		metricsClient:         shard.GetMetricsClient(),
+		historyEventNotifier:  historyEventNotifier,
+		workflowMetricsSeries: workflowMetricsSeries,
 	}
+	historyEngImpl.shadowTransferTaskFilter = newCandidateTransferTaskFilter(shard.GetDomainCache())
+	historyEngImpl.shadowTimerTaskFilter = newCandidateTimerTaskFilter(shard.GetDomainCache())
 	txProcessor := newTransferQueueProcessor(shard, historyEngImpl, visibilityMgr, matching, historyClient, logger)
 	historyEngImpl.timerProcessor = newTimerQueueProcessor(shard, historyEngImpl, matching, logger)
 	historyEngImpl.txProcessor = txProcessor
@@ -221,7 +244,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 
 	execution := workflow.WorkflowExecution{
 		WorkflowId: request.WorkflowId,
-		RunId:      common.StringPtr(uuid.New()),
+		RunId:      common.StringPtr(e.newRunID()),
 	}
 
 	var parentExecution *workflow.WorkflowExecution
@@ -245,6 +268,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 	} else {
 		msBuilder = newMutableStateBuilder(e.shard.GetConfig(), e.logger)
 	}
+	startRequest.ExpirationTimestamp = getWorkflowExpirationTimestamp(request, e.shard.GetTimeSource().Now())
 	startedEvent := msBuilder.AddWorkflowExecutionStartedEvent(execution, startRequest)
 	if startedEvent == nil {
 		return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
@@ -292,6 +316,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		TransactionID: 0,
 		FirstEventID:  startedEvent.GetEventId(),
 		Events:        serializedHistory,
+		EventCount:    len(msBuilder.hBuilder.history),
 	})
 	if err != nil {
 		return nil, err
@@ -345,6 +370,16 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 			ContinueAsNew:               !isBrandNew,
 			PreviousRunID:               prevRunID,
 			ReplicationState:            replicationState,
+			Attempt:                     msBuilder.executionInfo.Attempt,
+			HasRetryPolicy:              msBuilder.executionInfo.HasRetryPolicy,
+			InitialInterval:             msBuilder.executionInfo.InitialInterval,
+			BackoffCoefficient:          msBuilder.executionInfo.BackoffCoefficient,
+			MaximumInterval:             msBuilder.executionInfo.MaximumInterval,
+			ExpirationTime:              msBuilder.executionInfo.ExpirationTime,
+			MaximumAttempts:             msBuilder.executionInfo.MaximumAttempts,
+			NonRetriableErrors:          msBuilder.executionInfo.NonRetriableErrors,
+			Initiator:                   msBuilder.executionInfo.Initiator,
+			DecisionBackoffSeconds:      msBuilder.executionInfo.DecisionBackoffSeconds,
 		})
 
 		if err != nil {
@@ -586,10 +621,13 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			ChildPolicy:                         common.ChildPolicyPtr(workflow.ChildPolicyTerminate),
 		},
 		WorkflowExecutionInfo: &workflow.WorkflowExecutionInfo{
-			Execution:     request.Request.Execution,
-			Type:          &workflow.WorkflowType{Name: common.StringPtr(msBuilder.executionInfo.WorkflowTypeName)},
-			StartTime:     common.Int64Ptr(msBuilder.executionInfo.StartTimestamp.UnixNano()),
-			HistoryLength: common.Int64Ptr(msBuilder.GetNextEventID() - common.FirstEventID),
+			Execution:      request.Request.Execution,
+			Type:           &workflow.WorkflowType{Name: common.StringPtr(msBuilder.executionInfo.WorkflowTypeName)},
+			StartTime:      common.Int64Ptr(msBuilder.executionInfo.StartTimestamp.UnixNano()),
+			HistoryLength:  common.Int64Ptr(msBuilder.GetNextEventID() - common.FirstEventID),
+			Attempt:        common.Int32Ptr(msBuilder.executionInfo.Attempt),
+			LastUpdateTime: common.Int64Ptr(msBuilder.getLastUpdatedTimestamp()),
+			Initiator:      getWorkflowExecutionInitiator(msBuilder.executionInfo.Initiator),
 		},
 	}
 	if msBuilder.executionInfo.State == persistence.WorkflowStateCompleted {
@@ -626,6 +664,257 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 	return result, nil
 }
 
+func (e *historyEngineImpl) GetWorkflowExecutionHistoryEvent(
+	request *h.GetWorkflowExecutionHistoryEventRequest) (*h.GetWorkflowExecutionHistoryEventResponse, error) {
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Execution == nil {
+		return nil, &workflow.BadRequestError{Message: "Execution is not set on request."}
+	}
+	eventID := request.GetEventId()
+	if eventID < common.FirstEventID {
+		return nil, &workflow.BadRequestError{Message: "Invalid EventId."}
+	}
+
+	// the mutable state resolves the current run and tells if the event exists, the history
+	// itself is read after releasing the workflow lock
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, *request.Execution)
+	if err0 != nil {
+		return nil, err0
+	}
+	msBuilder, err1 := context.loadWorkflowExecution()
+	release(err1)
+	if err1 != nil {
+		return nil, err1
+	}
+	execution := context.workflowExecution
+	if eventID >= msBuilder.GetNextEventID() {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Event %v not found, next event ID is %v.", eventID, msBuilder.GetNextEventID()),
+		}
+	}
+
+	event, err := e.getHistoryEvent(domainID, execution, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return &h.GetWorkflowExecutionHistoryEventResponse{Event: event}, nil
+}
+
+func (e *historyEngineImpl) ListWorkflowExecutionChain(
+	request *h.ListWorkflowExecutionChainRequest) (*h.ListWorkflowExecutionChainResponse, error) {
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Execution == nil || request.Execution.GetWorkflowId() == "" {
+		return nil, &workflow.BadRequestError{Message: "WorkflowId is not set on request."}
+	}
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+	if execution.GetRunId() == "" {
+		current, err := e.historyCache.getCurrentExecutionWithRetry(&persistence.GetCurrentExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: execution.GetWorkflowId(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		execution.RunId = common.StringPtr(current.RunID)
+	} else if uuid.Parse(execution.GetRunId()) == nil {
+		return nil, &workflow.BadRequestError{Message: "RunID is not valid UUID."}
+	}
+
+	// walk the chain backwards using the previous run ID recorded in the started event of each run, the
+	// histories of the earliest runs may already be deleted after the retention period
+	maxLength := e.shard.GetConfig().MaxWorkflowChainLength()
+	var runs []*h.WorkflowExecutionChainEntry
+	truncated := false
+	for execution.GetRunId() != "" {
+		if len(runs) >= maxLength {
+			truncated = true
+			break
+		}
+		event, err := e.getHistoryEvent(domainID, execution, common.FirstEventID)
+		if err != nil {
+			if _, ok := err.(*workflow.EntityNotExistsError); ok && len(runs) > 0 {
+				truncated = true
+				break
+			}
+			return nil, err
+		}
+		attributes := event.WorkflowExecutionStartedEventAttributes
+		if attributes == nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("First event of run %v is not a workflow execution started event.", execution.GetRunId()),
+			}
+		}
+		runs = append(runs, &h.WorkflowExecutionChainEntry{
+			RunId:          common.StringPtr(execution.GetRunId()),
+			StartTimestamp: event.Timestamp,
+			Attempt:        common.Int32Ptr(attributes.GetAttempt()),
+		})
+		execution.RunId = common.StringPtr(attributes.GetContinuedExecutionRunId())
+	}
+
+	for i, j := 0, len(runs)-1; i < j; i, j = i+1, j-1 {
+		runs[i], runs[j] = runs[j], runs[i]
+	}
+	return &h.ListWorkflowExecutionChainResponse{
+		Runs:      runs,
+		Truncated: common.BoolPtr(truncated),
+	}, nil
+}
+
+// DescribeShard returns the queue levels, in-flight task counts and history cache size of the shard. The transfer and
+// timer ack levels are those of the queues of the current cluster, the shard wide levels only move when the acked
+// tasks are deleted.
+func (e *historyEngineImpl) DescribeShard() *h.HistoryShardStatus {
+	transferAckLevel := e.shard.GetTransferClusterAckLevel(e.currentClusterName)
+	transferMaxReadLevel := e.shard.GetTransferMaxReadLevel()
+	status := &h.HistoryShardStatus{
+		ShardId:               common.Int32Ptr(int32(e.shard.GetShardID())),
+		TransferAckLevel:      common.Int64Ptr(transferAckLevel),
+		TransferMaxReadLevel:  common.Int64Ptr(transferMaxReadLevel),
+		TransferTaskIDLag:     common.Int64Ptr(transferMaxReadLevel - transferAckLevel),
+		TransferTasksInFlight: common.Int32Ptr(int32(e.txProcessor.getOutstandingTaskCount())),
+		TimerAckLevel:         common.Int64Ptr(e.shard.GetTimerClusterAckLevel(e.currentClusterName).UnixNano()),
+		TimerTasksInFlight:    common.Int32Ptr(int32(e.timerProcessor.getOutstandingTaskCount())),
+		HistoryCacheSize:      common.Int32Ptr(int32(e.historyCache.Size())),
+	}
+	if e.replicatorProcessor != nil {
+		// replication tasks take their IDs from the same sequence as transfer tasks
+		replicatorAckLevel := e.shard.GetReplicatorAckLevel()
+		status.ReplicatorAckLevel = common.Int64Ptr(replicatorAckLevel)
+		status.ReplicationTaskIDLag = common.Int64Ptr(transferMaxReadLevel - replicatorAckLevel)
+		status.ReplicationTasksInFlight = common.Int32Ptr(int32(e.replicatorProcessor.getOutstandingTaskCount()))
+	}
+	return status
+}
+
+// WarmHistoryCache loads the mutable state of the executions with queued transfer or timer tasks into the history
+// cache, as these are the executions the shard is about to process. It returns the number of executions loaded.
+func (e *historyEngineImpl) WarmHistoryCache(maxExecutions int) int {
+	var executions []workflowIdentifier
+	found := make(map[string]bool)
+	add := func(domainID, workflowID, runID string) {
+		if len(executions) < maxExecutions && !found[runID] {
+			found[runID] = true
+			executions = append(executions, workflowIdentifier{domainID: domainID, workflowID: workflowID, runID: runID})
+		}
+	}
+
+	transferResponse, err := e.executionManager.GetTransferTasks(&persistence.GetTransferTasksRequest{
+		ReadLevel:    e.shard.GetTransferAckLevel(),
+		MaxReadLevel: e.shard.GetTransferMaxReadLevel(),
+		BatchSize:    maxExecutions,
+	})
+	if err != nil {
+		e.logger.Warnf("Failed to read transfer tasks to warm history cache: %v", err)
+	} else {
+		for _, task := range transferResponse.Tasks {
+			add(task.DomainID, task.WorkflowID, task.RunID)
+		}
+	}
+	timerResponse, err := e.executionManager.GetTimerIndexTasks(&persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: e.shard.GetTimerAckLevel(),
+		MaxTimestamp: timerQueueAckMgrMaxTimestamp,
+		BatchSize:    maxExecutions,
+	})
+	if err != nil {
+		e.logger.Warnf("Failed to read timer tasks to warm history cache: %v", err)
+	} else {
+		for _, task := range timerResponse.Timers {
+			add(task.DomainID, task.WorkflowID, task.RunID)
+		}
+	}
+
+	loaded := 0
+	for _, execution := range executions {
+		context, release, err := e.historyCache.getOrCreateWorkflowExecution(execution.domainID,
+			workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(execution.workflowID),
+				RunId:      common.StringPtr(execution.runID),
+			})
+		if err != nil {
+			continue
+		}
+		_, err = context.loadWorkflowExecution()
+		release(err)
+		if err == nil {
+			loaded++
+		}
+	}
+	return loaded
+}
+
+// getHistoryEvent reads a single event of a workflow execution, only the batch containing the event is read
+func (e *historyEngineImpl) getHistoryEvent(domainID string, execution workflow.WorkflowExecution,
+	eventID int64) (*workflow.HistoryEvent, error) {
+	response, err := e.historyMgr.GetWorkflowExecutionHistoryBatch(&persistence.GetWorkflowExecutionHistoryBatchRequest{
+		DomainID:  domainID,
+		Execution: execution,
+		EventID:   eventID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	batch := response.Events
+	persistence.SetSerializedHistoryDefaults(&batch)
+	serializer, err := e.hSerializerFactory.Get(batch.EncodingType)
+	if err != nil {
+		return nil, err
+	}
+	history, err := serializer.Deserialize(&batch)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range history.Events {
+		if event.GetEventId() == eventID {
+			return event, nil
+		}
+	}
+	return nil, &workflow.EntityNotExistsError{
+		Message: fmt.Sprintf("Event %v not found in history batch starting at %v.", eventID, response.FirstEventID),
+	}
+}
+
+func (e *historyEngineImpl) GetCurrentExecution(
+	request *h.GetCurrentExecutionRequest) (*h.GetCurrentExecutionResponse, error) {
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetWorkflowId() == "" {
+		return nil, &workflow.BadRequestError{Message: "WorkflowId is not set on request."}
+	}
+
+	// read the record directly instead of going through the history cache, the cached mutable state
+	// belongs to a specific run and can't tell which run is current
+	response, err := e.historyCache.getCurrentExecutionWithRetry(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: request.GetWorkflowId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &h.GetCurrentExecutionResponse{
+		RunId:          common.StringPtr(response.RunID),
+		StartRequestId: common.StringPtr(response.StartRequestID),
+		State:          getWorkflowExecutionState(response.State).Ptr(),
+	}
+	if response.State == persistence.WorkflowStateCompleted {
+		result.CloseStatus = getWorkflowExecutionCloseStatus(response.CloseStatus).Ptr()
+	}
+	return result, nil
+}
+
 func (e *historyEngineImpl) RecordDecisionTaskStarted(
 	request *h.RecordDecisionTaskStartedRequest) (retResp *h.RecordDecisionTaskStartedResponse, retError error) {
 
@@ -677,7 +966,7 @@ Update_History_Loop:
 		if di.StartedID != common.EmptyEventID {
 			// If decision is started as part of the current request scope then return a positive response
 			if di.RequestID == requestID {
-				return e.createRecordDecisionTaskStartedResponse(domainID, msBuilder, di, request.PollRequest.GetIdentity()), nil
+				return e.createRecordDecisionTaskStartedResponse(domainEntry, msBuilder, di, request.PollRequest.GetIdentity()), nil
 			}
 
 			// Looks like DecisionTask already started as a result of another call.
@@ -688,6 +977,11 @@ Update_History_Loop:
 			return nil, &h.EventAlreadyStartedError{Message: "Decision task already started."}
 		}
 
+		if msBuilder.isPaused() {
+			// Matching drops the task, it is dispatched again when the workflow execution is resumed.
+			return nil, ErrWorkflowPaused
+		}
+
 		_, di = msBuilder.AddDecisionTaskStartedEvent(scheduleID, requestID, request.PollRequest)
 		if di == nil {
 			// Unable to add DecisionTaskStarted event to history
@@ -716,7 +1010,7 @@ Update_History_Loop:
 			return nil, err3
 		}
 
-		return e.createRecordDecisionTaskStartedResponse(domainID, msBuilder, di, request.PollRequest.GetIdentity()), nil
+		return e.createRecordDecisionTaskStartedResponse(domainEntry, msBuilder, di, request.PollRequest.GetIdentity()), nil
 	}
 
 	return nil, ErrMaxAttemptsExceeded
@@ -788,6 +1082,10 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return nil, &h.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			if msBuilder.isPaused() {
+				return nil, ErrWorkflowPaused
+			}
+
 			msBuilder.AddActivityTaskStartedEvent(ai, scheduleID, requestID, request.PollRequest.GetIdentity())
 
 			response.StartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
@@ -880,17 +1178,28 @@ Update_History_Loop:
 		transferTasks := []persistence.Task{}
 		timerTasks := []persistence.Task{}
 		var continueAsNewBuilder *mutableStateBuilder
+		var workflowMetricsMarkers [][]byte
 		var continueAsNewTimerTasks []persistence.Task
 		hasDecisionScheduleActivityTask := false
 
+		// the sticky settings are looked up by the normal task list of the run, sticky task lists are per worker
+		domainFilter := dynamicconfig.DomainFilter(domainEntry.GetInfo().Name)
+		taskListFilter := dynamicconfig.TaskListFilter(msBuilder.executionInfo.TaskList)
 		if request.StickyAttributes == nil || request.StickyAttributes.WorkerTaskList == nil {
 			e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.CompleteDecisionWithStickyDisabledCounter)
 			msBuilder.executionInfo.StickyTaskList = ""
 			msBuilder.executionInfo.StickyScheduleToStartTimeout = 0
+		} else if !e.shard.GetConfig().EnableStickyExecution(domainFilter, taskListFilter) {
+			e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.CompleteDecisionWithStickyRejectedCounter)
+			msBuilder.executionInfo.StickyTaskList = ""
+			msBuilder.executionInfo.StickyScheduleToStartTimeout = 0
 		} else {
 			e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.CompleteDecisionWithStickyEnabledCounter)
 			msBuilder.executionInfo.StickyTaskList = request.StickyAttributes.WorkerTaskList.GetName()
 			msBuilder.executionInfo.StickyScheduleToStartTimeout = request.StickyAttributes.GetScheduleToStartTimeoutSeconds()
+			if timeout := e.shard.GetConfig().StickyScheduleToStartTimeout(domainFilter, taskListFilter); timeout > 0 {
+				msBuilder.executionInfo.StickyScheduleToStartTimeout = getStickyScheduleToStartTimeoutSeconds(timeout)
+			}
 		}
 		msBuilder.executionInfo.ClientLibraryVersion = clientLibVersion
 		msBuilder.executionInfo.ClientFeatureVersion = clientFeatureVersion
@@ -976,8 +1285,22 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes
 					break Process_Decision_Loop
 				}
-				if e := msBuilder.AddFailWorkflowEvent(completedID, attributes); e == nil {
-					return &workflow.InternalServiceError{Message: "Unable to add fail workflow event."}
+
+				failureReason := common.FailureReason(attributes.Reason, attributes.Failure)
+				backoffInterval := getWorkflowBackoffInterval(msBuilder.executionInfo, failureReason,
+					e.shard.GetTimeSource().Now())
+				if backoffInterval == noRetryBackoff {
+					if e := msBuilder.AddFailWorkflowEvent(completedID, attributes); e == nil {
+						return &workflow.InternalServiceError{Message: "Unable to add fail workflow event."}
+					}
+				} else {
+					newStateBuilder, newRunTimerTasks, err := e.retryWorkflowExecution(msBuilder, completedID, domainEntry,
+						backoffInterval, failureReason, attributes.Details, attributes.Failure)
+					if err != nil {
+						return err
+					}
+					continueAsNewTimerTasks = newRunTimerTasks
+					continueAsNewBuilder = newStateBuilder
 				}
 				isComplete = true
 			case workflow.DecisionTypeCancelWorkflowExecution:
@@ -1071,6 +1394,9 @@ Update_History_Loop:
 					break Process_Decision_Loop
 				}
 				msBuilder.AddRecordMarkerEvent(completedID, attributes)
+				if attributes.GetMarkerName() == common.WorkflowMetricsMarkerName {
+					workflowMetricsMarkers = append(workflowMetricsMarkers, attributes.Details)
+				}
 
 			case workflow.DecisionTypeRequestCancelExternalWorkflowExecution:
 				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
@@ -1170,31 +1496,14 @@ Update_History_Loop:
 					break Process_Decision_Loop
 				}
 
-				// Extract parentDomainName so it can be passed down to next run of workflow execution
-				var parentDomainName string
-				if msBuilder.hasParentExecution() {
-					parentDomainID := msBuilder.executionInfo.ParentDomainID
-					parentDomainEntry, err := e.shard.GetDomainCache().GetDomainByID(parentDomainID)
-					if err != nil {
-						return err
-					}
-					parentDomainName = parentDomainEntry.GetInfo().Name
-				}
-
-				runID := uuid.New()
-				_, newStateBuilder, err := msBuilder.AddContinueAsNewEvent(completedID, domainEntry, runID, parentDomainName, attributes)
+				newStateBuilder, newRunTimerTasks, err := e.addContinueAsNewEvent(msBuilder, completedID, domainEntry,
+					attributes)
 				if err != nil {
 					return err
 				}
 
-				// add timer task to new workflow
-				duration := time.Duration(*attributes.ExecutionStartToCloseTimeoutSeconds) * time.Second
-				continueAsNewTimerTasks = []persistence.Task{&persistence.WorkflowTimeoutTask{
-					VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(duration),
-				}}
-				msBuilder.continueAsNew.TimerTasks = continueAsNewTimerTasks
-
 				isComplete = true
+				continueAsNewTimerTasks = newRunTimerTasks
 				continueAsNewBuilder = newStateBuilder
 
 			case workflow.DecisionTypeStartChildWorkflowExecution:
@@ -1242,6 +1551,7 @@ Update_History_Loop:
 			isComplete = false
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			workflowMetricsMarkers = nil
 		}
 
 		if tt := tBuilder.GetUserTimerTaskIfNeeded(msBuilder); tt != nil {
@@ -1310,12 +1620,113 @@ Update_History_Loop:
 		// Inform timer about the new ones.
 		e.timerProcessor.NotifyNewTimers(e.currentClusterName, e.shard.GetCurrentTime(e.currentClusterName), timerTasks)
 
+		// Metrics markers are only emitted once the decision is persisted, so that retries do not count them twice
+		domainName := domainEntry.GetInfo().Name
+		if len(workflowMetricsMarkers) > 0 && e.shard.GetConfig().EnableWorkflowMetrics(dynamicconfig.DomainFilter(domainName)) {
+			emitWorkflowMetrics(e.metricsClient, e.workflowMetricsSeries, domainName, workflowMetricsMarkers)
+		}
+
 		return err
 	}
 
 	return ErrMaxAttemptsExceeded
 }
 
+func (e *historyEngineImpl) addContinueAsNewEvent(msBuilder *mutableStateBuilder, decisionCompletedEventID int64,
+	domainEntry *cache.DomainCacheEntry, attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) (
+	*mutableStateBuilder, []persistence.Task, error) {
+	// Extract parentDomainName so it can be passed down to next run of workflow execution
+	var parentDomainName string
+	if msBuilder.hasParentExecution() {
+		parentDomainID := msBuilder.executionInfo.ParentDomainID
+		parentDomainEntry, err := e.shard.GetDomainCache().GetDomainByID(parentDomainID)
+		if err != nil {
+			return nil, nil, err
+		}
+		parentDomainName = parentDomainEntry.GetInfo().Name
+	}
+
+	runID := e.newRunID()
+	_, newStateBuilder, err := msBuilder.AddContinueAsNewEvent(decisionCompletedEventID, domainEntry, runID,
+		parentDomainName, attributes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// add timer task to new workflow, the workflow timeout starts after the backoff of the first decision
+	now := e.shard.GetTimeSource().Now()
+	backoffDuration := time.Duration(attributes.GetBackoffStartIntervalInSeconds()) * time.Second
+	duration := time.Duration(*attributes.ExecutionStartToCloseTimeoutSeconds) * time.Second
+	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
+		VisibilityTimestamp: now.Add(backoffDuration + duration),
+	}}
+	if backoffDuration > 0 {
+		timerTasks = append(timerTasks, &persistence.WorkflowBackoffTimerTask{
+			VisibilityTimestamp: now.Add(backoffDuration),
+		})
+	}
+	msBuilder.continueAsNew.TimerTasks = timerTasks
+
+	return newStateBuilder, timerTasks, nil
+}
+
+// retryWorkflowExecution closes the run by continuing as new with the input of the run, the first decision of the
+// new run is scheduled by a backoff timer
+func (e *historyEngineImpl) retryWorkflowExecution(msBuilder *mutableStateBuilder, decisionCompletedEventID int64,
+	domainEntry *cache.DomainCacheEntry, backoffInterval time.Duration, failureReason string, failureDetails []byte,
+	failure *workflow.Failure) (*mutableStateBuilder, []persistence.Task, error) {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(msBuilder.executionInfo.WorkflowID),
+		RunId:      common.StringPtr(msBuilder.executionInfo.RunID),
+	}
+	startedEvent, err := e.getWorkflowStartedEvent(msBuilder.executionInfo.DomainID, execution)
+	if err != nil {
+		return nil, nil, err
+	}
+	startedAttributes := startedEvent.WorkflowExecutionStartedEventAttributes
+	continueAsNewAttributes := &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		Input:                               startedAttributes.Input,
+		ExecutionStartToCloseTimeoutSeconds: startedAttributes.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      startedAttributes.TaskStartToCloseTimeoutSeconds,
+		RetryPolicy:                         startedAttributes.RetryPolicy,
+		BackoffStartIntervalInSeconds:       common.Int32Ptr(int32(backoffInterval.Seconds())),
+		Initiator:                           workflow.ContinueAsNewInitiatorRetryPolicy.Ptr(),
+		FailureReason:                       common.StringPtr(failureReason),
+		FailureDetails:                      failureDetails,
+		Failure:                             failure,
+	}
+	return e.addContinueAsNewEvent(msBuilder, decisionCompletedEventID, domainEntry, continueAsNewAttributes)
+}
+
+func (e *historyEngineImpl) getWorkflowStartedEvent(domainID string, execution workflow.WorkflowExecution) (
+	*workflow.HistoryEvent, error) {
+	response, err := e.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:     domainID,
+		Execution:    execution,
+		FirstEventID: common.FirstEventID,
+		NextEventID:  common.FirstEventID + 1,
+		PageSize:     defaultHistoryPageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, batch := range response.Events {
+		persistence.SetSerializedHistoryDefaults(&batch)
+		s, _ := e.hSerializerFactory.Get(batch.EncodingType)
+		history, err := s.Deserialize(&batch)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range history.Events {
+			if event.GetEventType() == workflow.EventTypeWorkflowExecutionStarted {
+				return event, nil
+			}
+		}
+	}
+	return nil, &workflow.InternalServiceError{Message: "Unable to find workflow execution started event."}
+}
+
 func (e *historyEngineImpl) RespondDecisionTaskFailed(req *h.RespondDecisionTaskFailedRequest) error {
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
@@ -1458,7 +1869,8 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(req *h.RespondActivityTask
 			}
 
 			postActions := &updateWorkflowAction{}
-			retryTask := msBuilder.CreateRetryTimer(ai, req.FailedRequest.GetReason())
+			retryTask := msBuilder.CreateRetryTimer(ai, common.FailureReason(req.FailedRequest.Reason, req.FailedRequest.Failure),
+				req.FailedRequest.FailureTypes)
 			if retryTask != nil {
 				// need retry
 				postActions.timerTasks = append(postActions.timerTasks, retryTask)
@@ -1691,6 +2103,13 @@ func (e *historyEngineImpl) SignalWorkflowExecution(signalRequest *h.SignalWorkf
 				}
 			}
 
+			if err := e.validateSignalCount(domainEntry, msBuilder); err != nil {
+				return nil, err
+			}
+			if err := e.validateBufferedSignals(domainEntry, msBuilder); err != nil {
+				return nil, err
+			}
+
 			// deduplicate by request id for signal decision
 			if requestID := request.GetRequestId(); requestID != "" {
 				if msBuilder.isSignalRequested(requestID) {
@@ -1707,6 +2126,66 @@ func (e *historyEngineImpl) SignalWorkflowExecution(signalRequest *h.SignalWorkf
 		})
 }
 
+// validateBufferedSignals rejects a new signal if the run already has too many signals buffered behind its in-flight
+// decision; buffered signals are flushed in arrival order once the decision completes
+func (e *historyEngineImpl) validateBufferedSignals(domainEntry *cache.DomainCacheEntry,
+	msBuilder *mutableStateBuilder) error {
+	if !msBuilder.HasInFlightDecisionTask() {
+		return nil
+	}
+
+	count := msBuilder.GetBufferedSignalCount()
+	maxBufferedSignals := e.shard.GetConfig().MaximumBufferedSignals(dynamicconfig.DomainFilter(domainEntry.GetInfo().Name))
+	if count >= int64(maxBufferedSignals) {
+		e.logger.WithFields(bark.Fields{
+			logging.TagDomainID:            domainEntry.GetInfo().ID,
+			logging.TagWorkflowExecutionID: msBuilder.executionInfo.WorkflowID,
+			logging.TagWorkflowRunID:       msBuilder.executionInfo.RunID,
+		}).Warnf("Buffered signals limit exceeded. Count: %v, Limit: %v", count, maxBufferedSignals)
+		return ErrBufferedSignalsLimitExceeded
+	}
+	return nil
+}
+
+// validateSignalCount rejects a new signal once the run has received the max signals per execution, the workflow is
+// expected to continue as new well before that as decision tasks suggest it when the run gets close to the limit
+func (e *historyEngineImpl) validateSignalCount(domainEntry *cache.DomainCacheEntry,
+	msBuilder *mutableStateBuilder) error {
+	maxSignals := e.shard.GetConfig().MaximumSignalsPerExecution(dynamicconfig.DomainFilter(domainEntry.GetInfo().Name))
+	if maxSignals <= 0 {
+		return nil
+	}
+
+	count := msBuilder.executionInfo.SignalCount
+	if count >= int64(maxSignals) {
+		e.logger.WithFields(bark.Fields{
+			logging.TagDomainID:            domainEntry.GetInfo().ID,
+			logging.TagWorkflowExecutionID: msBuilder.executionInfo.WorkflowID,
+			logging.TagWorkflowRunID:       msBuilder.executionInfo.RunID,
+		}).Warnf("Signals limit exceeded. Count: %v, Limit: %v", count, maxSignals)
+		return ErrSignalsLimitExceeded
+	}
+	return nil
+}
+
+// shouldSuggestContinueAsNew tells whether the run is close to its signal limit or has grown past the history count
+// threshold, the flag is only a hint to the worker and the run is free to keep going
+func (e *historyEngineImpl) shouldSuggestContinueAsNew(domainEntry *cache.DomainCacheEntry,
+	msBuilder *mutableStateBuilder) bool {
+	domainFilter := dynamicconfig.DomainFilter(domainEntry.GetInfo().Name)
+	config := e.shard.GetConfig()
+
+	if maxSignals := config.MaximumSignalsPerExecution(domainFilter); maxSignals > 0 &&
+		msBuilder.executionInfo.SignalCount*100 >= int64(maxSignals)*signalCountSuggestContinueAsNewPercent {
+		return true
+	}
+	if historyCount := config.HistoryCountSuggestContinueAsNew(domainFilter); historyCount > 0 &&
+		msBuilder.GetNextEventID()-1 >= int64(historyCount) {
+		return true
+	}
+	return false
+}
+
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest) (
 	retResp *workflow.StartWorkflowExecutionResponse, retError error) {
 
@@ -1744,14 +2223,21 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 				break
 			}
 
+			if err := e.validateSignalCount(domainEntry, msBuilder); err != nil {
+				return nil, err
+			}
+			if err := e.validateBufferedSignals(domainEntry, msBuilder); err != nil {
+				return nil, err
+			}
+
 			if msBuilder.AddWorkflowExecutionSignaled(getSignalRequest(sRequest)) == nil {
 				return nil, &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
 
 			var transferTasks []persistence.Task
 			var timerTasks []persistence.Task
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			// Create a transfer task to schedule a decision task, unless the first decision is delayed by a backoff
+			if !msBuilder.HasPendingDecisionTask() && !msBuilder.isWorkflowBackoffPending() {
 				di := msBuilder.AddDecisionTaskScheduledEvent()
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
 					DomainID:   domainID,
@@ -1803,7 +2289,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 
 	execution = workflow.WorkflowExecution{
 		WorkflowId: request.WorkflowId,
-		RunId:      common.StringPtr(uuid.New()),
+		RunId:      common.StringPtr(e.newRunID()),
 	}
 
 	// Generate first decision task event.
@@ -1817,6 +2303,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 	} else {
 		msBuilder = newMutableStateBuilder(e.shard.GetConfig(), e.logger)
 	}
+	startRequest.ExpirationTimestamp = getWorkflowExpirationTimestamp(request, e.shard.GetTimeSource().Now())
 	startedEvent := msBuilder.AddWorkflowExecutionStartedEvent(execution, startRequest)
 	if startedEvent == nil {
 		return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
@@ -1861,6 +2348,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 		TransactionID: 0,
 		FirstEventID:  startedEvent.GetEventId(),
 		Events:        serializedHistory,
+		EventCount:    len(msBuilder.hBuilder.history),
 	})
 	if err != nil {
 		return nil, err
@@ -1889,6 +2377,16 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 			TimerTasks:                  timerTasks,
 			ContinueAsNew:               !isBrandNew,
 			PreviousRunID:               prevRunID,
+			Attempt:                     msBuilder.executionInfo.Attempt,
+			HasRetryPolicy:              msBuilder.executionInfo.HasRetryPolicy,
+			InitialInterval:             msBuilder.executionInfo.InitialInterval,
+			BackoffCoefficient:          msBuilder.executionInfo.BackoffCoefficient,
+			MaximumInterval:             msBuilder.executionInfo.MaximumInterval,
+			ExpirationTime:              msBuilder.executionInfo.ExpirationTime,
+			MaximumAttempts:             msBuilder.executionInfo.MaximumAttempts,
+			NonRetriableErrors:          msBuilder.executionInfo.NonRetriableErrors,
+			Initiator:                   msBuilder.executionInfo.Initiator,
+			DecisionBackoffSeconds:      msBuilder.executionInfo.DecisionBackoffSeconds,
 		})
 
 		if err != nil {
@@ -1972,6 +2470,546 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(terminateRequest *h.Termi
 		})
 }
 
+// PauseWorkflowExecution marks the workflow execution as paused. The task processors, RecordDecisionTaskStarted and
+// RecordActivityTaskStarted check the mark, so tasks and timers which come due while paused are dropped and
+// ResumeWorkflowExecution recreates them. The workflow execution timeout is not suspended.
+func (e *historyEngineImpl) PauseWorkflowExecution(pauseRequest *h.PauseWorkflowExecutionRequest) error {
+
+	domainEntry, err := e.getActiveDomainEntry(pauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: pauseRequest.WorkflowExecution.WorkflowId,
+		RunId:      pauseRequest.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecution(domainID, execution, false, false,
+		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
+			if !msBuilder.isWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			msBuilder.executionInfo.Paused = true
+			return nil, nil
+		})
+}
+
+// ResumeWorkflowExecution clears the paused mark of the workflow execution and recreates the tasks and timers it
+// may have dropped while paused.
+func (e *historyEngineImpl) ResumeWorkflowExecution(resumeRequest *h.ResumeWorkflowExecutionRequest) error {
+
+	domainEntry, err := e.getActiveDomainEntry(resumeRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: resumeRequest.WorkflowExecution.WorkflowId,
+		RunId:      resumeRequest.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(domainID, execution,
+		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.isWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			executionInfo := msBuilder.executionInfo
+			if !executionInfo.Paused {
+				return &updateWorkflowAction{}, nil
+			}
+			executionInfo.Paused = false
+
+			var transferTasks, timerTasks []persistence.Task
+			if di, ok := msBuilder.GetPendingDecision(executionInfo.DecisionScheduleID); ok {
+				if di.StartedID == common.EmptyEventID {
+					transferTasks = append(transferTasks, &persistence.DecisionTask{
+						DomainID:   domainID,
+						TaskList:   executionInfo.TaskList,
+						ScheduleID: di.ScheduleID,
+					})
+					if msBuilder.isStickyTaskListEnabled() {
+						timerTasks = append(timerTasks, tBuilder.AddScheduleToStartDecisionTimoutTask(di.ScheduleID,
+							di.Attempt, executionInfo.StickyScheduleToStartTimeout))
+					}
+				} else {
+					timerTasks = append(timerTasks, tBuilder.AddStartToCloseDecisionTimoutTask(di.ScheduleID,
+						di.Attempt, di.DecisionTimeout))
+				}
+			}
+
+			for _, ai := range msBuilder.pendingActivityInfoIDs {
+				if ai.StartedID == common.EmptyEventID {
+					// the retry timer dispatches the activity task, at the next attempt time for activities in backoff
+					timerTasks = append(timerTasks, &persistence.RetryTimerTask{
+						Version:             ai.Version,
+						VisibilityTimestamp: ai.ScheduledTime,
+						EventID:             ai.ScheduleID,
+						Attempt:             ai.Attempt,
+					})
+				}
+				ai.TimerTaskStatus = TimerTaskStatusNone
+				msBuilder.UpdateActivity(ai)
+			}
+			if t := tBuilder.GetActivityTimerTaskIfNeeded(msBuilder); t != nil {
+				timerTasks = append(timerTasks, t)
+			}
+
+			for _, ti := range msBuilder.pendingTimerInfoIDs {
+				ti.TaskID = TimerTaskStatusNone
+				msBuilder.UpdateUserTimer(ti.TimerID, ti)
+			}
+			tBuilder.GetUserTimers(msBuilder)
+			if t := tBuilder.GetUserTimerTaskIfNeeded(msBuilder); t != nil {
+				timerTasks = append(timerTasks, t)
+			}
+
+			return &updateWorkflowAction{transferTasks: transferTasks, timerTasks: timerTasks}, nil
+		})
+}
+
+// ImportWorkflowExecution recreates a run from its exported history. The run is created next to the current run of
+// the workflow ID and its final mutable state is written by a single update, the run only becomes the current run if
+// there is none or if it started after the current run, which has to be closed. The retention of a closed run starts
+// when it is imported, since the close time of the exported run may already be past the retention of the domain.
+// The run is created with the run ID as its create request ID and without any event, so that a retry of an import
+// which failed between the create and the update recognizes the run and writes its final state.
+func (e *historyEngineImpl) ImportWorkflowExecution(request *h.ImportWorkflowExecutionRequest) (retError error) {
+	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+	if request.Execution.GetWorkflowId() == "" || request.Execution.GetRunId() == "" {
+		return &workflow.BadRequestError{Message: "Execution is not set on request."}
+	}
+	if uuid.Parse(request.Execution.GetRunId()) == nil {
+		return &workflow.BadRequestError{Message: "Invalid RunId."}
+	}
+	if request.History == nil || len(request.History.Events) == 0 {
+		return &workflow.BadRequestError{Message: "History has no events."}
+	}
+	events := request.History.Events
+	firstEvent := events[0]
+	lastEvent := events[len(events)-1]
+	if firstEvent.GetEventType() != workflow.EventTypeWorkflowExecutionStarted ||
+		firstEvent.GetEventId() != common.FirstEventID {
+		return &workflow.BadRequestError{Message: "History does not begin with WorkflowExecutionStarted event."}
+	}
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	_, release, err := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	requestID := execution.GetRunId()
+	isHalfImported := false
+	response, err := e.executionManager.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		DomainID:  domainID,
+		Execution: execution,
+	})
+	switch err.(type) {
+	case nil:
+		info := response.State.ExecutionInfo
+		if info.CreateRequestID != requestID || info.NextEventID != common.FirstEventID {
+			return &workflow.WorkflowExecutionAlreadyStartedError{
+				Message: common.StringPtr(fmt.Sprintf("Workflow execution already exists. WorkflowId: %v, RunId: %v.",
+					execution.GetWorkflowId(), execution.GetRunId())),
+				RunId: common.StringPtr(execution.GetRunId()),
+			}
+		}
+		// an earlier attempt of the import created the run but failed to write its final state
+		isHalfImported = true
+	case *workflow.EntityNotExistsError:
+	default:
+		return err
+	}
+
+	var isCurrentRun bool
+	var prevRunID string
+	if isHalfImported {
+		isCurrentRun, err = e.isCurrentRun(domainID, execution)
+	} else {
+		isCurrentRun, prevRunID, err = e.isImportedRunNewest(domainID, execution, firstEvent)
+	}
+	if err != nil {
+		return err
+	}
+
+	var msBuilder *mutableStateBuilder
+	if e.shard.GetService().GetClusterMetadata().IsGlobalDomainEnabled() && domainEntry.IsGlobalDomain() {
+		msBuilder = newMutableStateBuilderWithReplicationState(e.shard.GetConfig(), e.logger, domainEntry.GetFailoverVersion())
+	} else {
+		msBuilder = newMutableStateBuilder(e.shard.GetConfig(), e.logger)
+	}
+	sBuilder := newStateBuilder(e.shard, msBuilder, e.logger)
+	_, di, _, err := sBuilder.applyEvents(msBuilder.GetCurrentVersion(), "", domainID, requestID, execution,
+		request.History, nil)
+	if err != nil {
+		return err
+	}
+	isRunning := msBuilder.isWorkflowExecutionRunning()
+	if isRunning && !isCurrentRun {
+		return &workflow.BadRequestError{Message: "An open run can only be imported as the current run of the workflow."}
+	}
+	msBuilder.executionInfo.NextEventID = lastEvent.GetEventId() + 1
+	msBuilder.executionInfo.LastFirstEventID = firstEvent.GetEventId()
+	// the run keeps its original start time, which the newest run is decided by and the visibility record keyed by
+	msBuilder.executionInfo.StartTimestamp = time.Unix(0, firstEvent.GetTimestamp())
+	if msBuilder.replicationState != nil {
+		msBuilder.updateReplicationStateLastEventID("", lastEvent.GetEventId())
+	}
+
+	retentionInDays := domainEntry.GetConfig().Retention
+	for _, task := range sBuilder.timerTasks {
+		if deleteTask, ok := task.(*persistence.DeleteHistoryEventTask); ok {
+			deleteTask.VisibilityTimestamp = e.shard.GetTimeSource().Now().Add(
+				time.Duration(retentionInDays) * time.Hour * 24)
+		}
+	}
+	setTaskVersion(msBuilder.GetCurrentVersion(), sBuilder.transferTasks, sBuilder.timerTasks)
+
+	serializedHistory, serializedError := newHistoryBuilderFromEvents(events, e.logger).Serialize()
+	if serializedError != nil {
+		logging.LogHistorySerializationErrorEvent(e.logger, serializedError, fmt.Sprintf(
+			"HistoryEventBatch serialization error on import workflow.  WorkflowID: %v, RunID: %v",
+			execution.GetWorkflowId(), execution.GetRunId()))
+		return serializedError
+	}
+	transactionID, err := e.shard.GetNextTransferTaskID()
+	if err != nil {
+		return err
+	}
+	// the events of a half imported run were appended before it was created
+	err = e.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+		DomainID:      domainID,
+		Execution:     execution,
+		TransactionID: transactionID,
+		FirstEventID:  firstEvent.GetEventId(),
+		Events:        serializedHistory,
+		EventCount:    len(events),
+		Overwrite:     isHalfImported,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !isHalfImported {
+		if err = e.createImportedRun(domainID, execution, msBuilder, di, isCurrentRun, prevRunID); err != nil {
+			return err
+		}
+	}
+
+	snapshot := msBuilder.ResetSnapshot()
+	finishExecution := isCurrentRun && !isRunning
+	err = e.shard.UpdateWorkflowExecution(&persistence.UpdateWorkflowExecutionRequest{
+		ExecutionInfo:             msBuilder.executionInfo,
+		ReplicationState:          msBuilder.replicationState,
+		TransferTasks:             sBuilder.transferTasks,
+		TimerTasks:                sBuilder.timerTasks,
+		Condition:                 common.FirstEventID,
+		FinishExecution:           finishExecution,
+		FinishedExecutionTTL:      retentionInDays * secondsInDay,
+		UpsertActivityInfos:       snapshot.InsertActivityInfos,
+		UpserTimerInfos:           snapshot.InsertTimerInfos,
+		UpsertChildExecutionInfos: snapshot.InsertChildExecutionInfos,
+		UpsertRequestCancelInfos:  snapshot.InsertRequestCancelInfos,
+		UpsertSignalInfos:         snapshot.InsertSignalInfos,
+		UpsertSignalRequestedIDs:  snapshot.InsertSignalRequestedIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	e.timerProcessor.NotifyNewTimers(e.currentClusterName, e.shard.GetCurrentTime(e.currentClusterName),
+		sBuilder.timerTasks)
+	return nil
+}
+
+// createImportedRun creates an imported run the way the replication path creates a run, but without any event so that
+// it is recognized as half imported until its final state is written
+func (e *historyEngineImpl) createImportedRun(domainID string, execution workflow.WorkflowExecution,
+	msBuilder *mutableStateBuilder, di *decisionInfo, isCurrentRun bool, prevRunID string) error {
+	decisionVersion := common.EmptyVersion
+	decisionScheduleID := common.EmptyEventID
+	decisionStartID := common.EmptyEventID
+	decisionTimeout := int32(0)
+	if di != nil {
+		decisionVersion = di.Version
+		decisionScheduleID = di.ScheduleID
+		decisionStartID = di.StartedID
+		decisionTimeout = di.DecisionTimeout
+	}
+	executionInfo := msBuilder.executionInfo
+	_, err := e.shard.CreateWorkflowExecution(&persistence.CreateWorkflowExecutionRequest{
+		RequestID:                   executionInfo.CreateRequestID,
+		DomainID:                    domainID,
+		Execution:                   execution,
+		ParentDomainID:              executionInfo.ParentDomainID,
+		InitiatedID:                 executionInfo.InitiatedID,
+		TaskList:                    executionInfo.TaskList,
+		WorkflowTypeName:            executionInfo.WorkflowTypeName,
+		WorkflowTimeout:             executionInfo.WorkflowTimeout,
+		DecisionTimeoutValue:        executionInfo.DecisionTimeoutValue,
+		ExecutionContext:            nil,
+		NextEventID:                 common.FirstEventID,
+		LastProcessedEvent:          common.EmptyEventID,
+		DecisionVersion:             decisionVersion,
+		DecisionScheduleID:          decisionScheduleID,
+		DecisionStartedID:           decisionStartID,
+		DecisionStartToCloseTimeout: decisionTimeout,
+		ContinueAsNew:               isCurrentRun && prevRunID != "",
+		PreviousRunID:               prevRunID,
+		SkipCurrentExecution:        !isCurrentRun,
+		ReplicationState:            msBuilder.replicationState,
+		Attempt:                     executionInfo.Attempt,
+		HasRetryPolicy:              executionInfo.HasRetryPolicy,
+		InitialInterval:             executionInfo.InitialInterval,
+		BackoffCoefficient:          executionInfo.BackoffCoefficient,
+		MaximumInterval:             executionInfo.MaximumInterval,
+		ExpirationTime:              executionInfo.ExpirationTime,
+		MaximumAttempts:             executionInfo.MaximumAttempts,
+		NonRetriableErrors:          executionInfo.NonRetriableErrors,
+		Initiator:                   executionInfo.Initiator,
+		DecisionBackoffSeconds:      executionInfo.DecisionBackoffSeconds,
+	})
+	if err != nil {
+		if _, ok := err.(*persistence.ShardOwnershipLostError); ok {
+			e.deleteEvents(domainID, execution)
+		}
+		return err
+	}
+	return nil
+}
+
+// isCurrentRun returns true if the run is the current run of its workflow ID
+func (e *historyEngineImpl) isCurrentRun(domainID string, execution workflow.WorkflowExecution) (bool, error) {
+	currentResponse, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowId(),
+	})
+	switch err.(type) {
+	case nil:
+		return currentResponse.RunID == execution.GetRunId(), nil
+	case *workflow.EntityNotExistsError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// isImportedRunNewest tells whether an imported run replaces the current run of its workflow ID, which is the case
+// when there is no current run or when the current run is closed and started before the imported run, it also returns
+// the current run ID the current row is conditionally updated on
+func (e *historyEngineImpl) isImportedRunNewest(domainID string, execution workflow.WorkflowExecution,
+	startedEvent *workflow.HistoryEvent) (bool, string, error) {
+	currentResponse, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowId(),
+	})
+	switch err.(type) {
+	case nil:
+	case *workflow.EntityNotExistsError:
+		return true, "", nil
+	default:
+		return false, "", err
+	}
+	if currentResponse.State != persistence.WorkflowStateCompleted {
+		return false, currentResponse.RunID, nil
+	}
+
+	currentMSResponse, err := e.executionManager.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		DomainID: domainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: execution.WorkflowId,
+			RunId:      common.StringPtr(currentResponse.RunID),
+		},
+	})
+	switch err.(type) {
+	case nil:
+	case *workflow.EntityNotExistsError:
+		// the mutable state of a closed current run is deleted at the end of its retention
+		return true, currentResponse.RunID, nil
+	default:
+		return false, "", err
+	}
+	startTime := time.Unix(0, startedEvent.GetTimestamp())
+	return startTime.After(currentMSResponse.State.ExecutionInfo.StartTimestamp), currentResponse.RunID, nil
+}
+
+// NukeWorkflowExecution deletes a run whose state is too inconsistent to be terminated, or a closed run deleted in
+// bulk. The pieces of the run are deleted independently of each other, the visibility record last since the start
+// time of the run is read from it, so that a failed attempt can be retried and the run is still listed. The queues are not scanned for the tasks of the run: the transfer and timer
+// tasks still queued are dropped by the queue processors, and decision and activity tasks already sent to matching are
+// dropped when they are started, since the run does not exist anymore.
+func (e *historyEngineImpl) NukeWorkflowExecution(
+	request *h.NukeWorkflowExecutionRequest) (retResp *h.NukeWorkflowExecutionResponse, retError error) {
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	if request.WorkflowExecution.GetRunId() == "" {
+		return nil, &workflow.BadRequestError{Message: "RunId is not set on request."}
+	}
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	// the lock of the run is held so that nothing updates it while it is deleted, but its mutable state is read
+	// directly since loading it is likely what fails
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+	defer context.clear()
+
+	response := &h.NukeWorkflowExecutionResponse{}
+	var startTimestamp *int64
+	msResponse, err := e.executionManager.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		DomainID:  domainID,
+		Execution: execution,
+	})
+	switch err.(type) {
+	case nil:
+		response.MutableStateFound = common.BoolPtr(true)
+		startTimestamp = common.Int64Ptr(msResponse.State.ExecutionInfo.StartTimestamp.UnixNano())
+	case *workflow.EntityNotExistsError:
+		response.MutableStateFound = common.BoolPtr(false)
+	default:
+		return nil, err
+	}
+
+	currentResponse, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowId(),
+	})
+	switch err.(type) {
+	case nil:
+		response.IsCurrentRun = common.BoolPtr(currentResponse.RunID == execution.GetRunId())
+	case *workflow.EntityNotExistsError:
+		response.IsCurrentRun = common.BoolPtr(false)
+	default:
+		return nil, err
+	}
+
+	_, err = e.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:     domainID,
+		Execution:    execution,
+		FirstEventID: common.FirstEventID,
+		NextEventID:  common.EndEventID,
+		PageSize:     1,
+	})
+	switch err.(type) {
+	case nil:
+		response.HistoryFound = common.BoolPtr(true)
+	case *workflow.EntityNotExistsError:
+		response.HistoryFound = common.BoolPtr(false)
+	default:
+		return nil, err
+	}
+
+	visibilityStartTimestamp, err := e.getVisibilityStartTimestamp(domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	response.VisibilityRecordFound = common.BoolPtr(visibilityStartTimestamp != nil)
+	if visibilityStartTimestamp != nil {
+		startTimestamp = visibilityStartTimestamp
+	}
+
+	if request.GetDryRun() {
+		return response, nil
+	}
+
+	e.logger.WithFields(bark.Fields{
+		logging.TagDomainID:            domainID,
+		logging.TagWorkflowExecutionID: execution.GetWorkflowId(),
+		logging.TagWorkflowRunID:       execution.GetRunId(),
+	}).Warnf("Nuking workflow execution: %v", response)
+
+	if err := e.historyMgr.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+		DomainID:  domainID,
+		Execution: execution,
+	}); err != nil {
+		return nil, err
+	}
+	if response.GetIsCurrentRun() {
+		if err := e.executionManager.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: execution.GetWorkflowId(),
+			RunID:      execution.GetRunId(),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err := e.executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+	}); err != nil {
+		return nil, err
+	}
+	if startTimestamp != nil {
+		if err := e.visibilityMgr.DeleteWorkflowExecution(&persistence.VisibilityDeleteWorkflowExecutionRequest{
+			DomainUUID:     domainID,
+			RunID:          execution.GetRunId(),
+			StartTimestamp: *startTimestamp,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// getVisibilityStartTimestamp looks the open or closed visibility record of a run up by workflow ID, it returns the
+// start time the record is keyed by, or nil if there is no record
+func (e *historyEngineImpl) getVisibilityStartTimestamp(domainID string,
+	execution workflow.WorkflowExecution) (*int64, error) {
+	lists := []func(*persistence.ListWorkflowExecutionsByWorkflowIDRequest) (
+		*persistence.ListWorkflowExecutionsResponse, error){
+		e.visibilityMgr.ListOpenWorkflowExecutionsByWorkflowID,
+		e.visibilityMgr.ListClosedWorkflowExecutionsByWorkflowID,
+	}
+	for _, list := range lists {
+		request := &persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+			ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+				DomainUUID:        domainID,
+				EarliestStartTime: 0,
+				LatestStartTime:   time.Now().UnixNano(),
+				PageSize:          defaultHistoryPageSize,
+			},
+			WorkflowID: execution.GetWorkflowId(),
+		}
+		for {
+			response, err := list(request)
+			if err != nil {
+				return nil, err
+			}
+			for _, info := range response.Executions {
+				if info.Execution.GetRunId() == execution.GetRunId() {
+					return info.StartTime, nil
+				}
+			}
+			if len(response.NextPageToken) == 0 {
+				break
+			}
+			request.NextPageToken = response.NextPageToken
+		}
+	}
+	return nil, nil
+}
+
 // ScheduleDecisionTask schedules a decision if no outstanding decision found
 func (e *historyEngineImpl) ScheduleDecisionTask(scheduleRequest *h.ScheduleDecisionTaskRequest) error {
 
@@ -2102,8 +3140,8 @@ Update_History_Loop:
 		}
 
 		if postActions.createDecision {
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			// Create a transfer task to schedule a decision task, unless the first decision is delayed by a backoff
+			if !msBuilder.HasPendingDecisionTask() && !msBuilder.isWorkflowBackoffPending() {
 				di := msBuilder.AddDecisionTaskScheduledEvent()
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
 					DomainID:   domainID,
@@ -2180,8 +3218,8 @@ func (e *historyEngineImpl) getDeleteWorkflowTasks(
 	return closeTask, cleanupTask, nil
 }
 
-func (e *historyEngineImpl) createRecordDecisionTaskStartedResponse(domainID string, msBuilder *mutableStateBuilder,
-	di *decisionInfo, identity string) *h.RecordDecisionTaskStartedResponse {
+func (e *historyEngineImpl) createRecordDecisionTaskStartedResponse(domainEntry *cache.DomainCacheEntry,
+	msBuilder *mutableStateBuilder, di *decisionInfo, identity string) *h.RecordDecisionTaskStartedResponse {
 	response := &h.RecordDecisionTaskStartedResponse{}
 	response.WorkflowType = msBuilder.getWorkflowType()
 	if msBuilder.previousDecisionStartedEvent() != common.EmptyEventID {
@@ -2203,10 +3241,16 @@ func (e *historyEngineImpl) createRecordDecisionTaskStartedResponse(domainID str
 		response.DecisionInfo.ScheduledEvent = scheduledEvent
 		response.DecisionInfo.StartedEvent = startedEvent
 	}
+	response.SuggestContinueAsNew = common.BoolPtr(e.shouldSuggestContinueAsNew(domainEntry, msBuilder))
 
 	return response
 }
 
+// newRunID generates the ID of a new run, the type of ID can be switched at runtime through dynamic config
+func (e *historyEngineImpl) newRunID() string {
+	return common.NewIDGenerator(e.shard.GetConfig().RunIDType(), e.shard.GetTimeSource()).NextID()
+}
+
 func (e *historyEngineImpl) deleteEvents(domainID string, execution workflow.WorkflowExecution) {
 	// We created the history events but failed to create workflow execution, so cleanup the history which could cause
 	// us to leak history events which are never cleaned up. Cleaning up the events is absolutely safe here as they
@@ -2302,19 +3346,7 @@ func validateActivityScheduleAttributes(attributes *workflow.ScheduleActivityTas
 	if attributes.HeartbeatTimeoutSeconds == nil || *attributes.HeartbeatTimeoutSeconds < 0 {
 		return &workflow.BadRequestError{Message: "A valid HeartbeatTimeoutSeconds is not set on decision."}
 	}
-	if policy := attributes.RetryPolicy; policy != nil {
-		if policy.GetInitialIntervalInSeconds() <= 0 {
-			return &workflow.BadRequestError{Message: "A valid InitialIntervalInSeconds is not set on retry policy."}
-		}
-		if policy.GetBackoffCoefficient() < 1 {
-			return &workflow.BadRequestError{Message: "BackoffCoefficient cannot be less than 1 on retry policy."}
-		}
-		if policy.GetMaximumIntervalInSeconds() < policy.GetInitialIntervalInSeconds() {
-			return &workflow.BadRequestError{Message: "MaximumIntervalInSeconds cannot be less than InitialIntervalInSeconds on retry policy."}
-		}
-	}
-
-	return nil
+	return common.ValidateRetryPolicy(attributes.RetryPolicy)
 }
 
 func validateTimerScheduleAttributes(attributes *workflow.StartTimerDecisionAttributes) error {
@@ -2371,10 +3403,10 @@ func validateFailWorkflowExecutionAttributes(attributes *workflow.FailWorkflowEx
 	if attributes == nil {
 		return &workflow.BadRequestError{Message: "FailWorkflowExecutionDecisionAttributes is not set on decision."}
 	}
-	if attributes.Reason == nil {
+	if attributes.Reason == nil && attributes.Failure == nil {
 		return &workflow.BadRequestError{Message: "Reason is not set on decision."}
 	}
-	return nil
+	return common.ValidateFailure(attributes.Failure)
 }
 
 func validateCancelWorkflowExecutionAttributes(attributes *workflow.CancelWorkflowExecutionDecisionAttributes) error {
@@ -2449,7 +3481,16 @@ func validateContinueAsNewWorkflowExecutionAttributes(executionInfo *persistence
 		attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(executionInfo.DecisionTimeoutValue)
 	}
 
-	return nil
+	// Backoff, initiator and failure are only set by the history engine when the retry policy retries a run
+	if attributes.BackoffStartIntervalInSeconds != nil || attributes.Initiator != nil ||
+		attributes.FailureReason != nil || attributes.FailureDetails != nil || attributes.Failure != nil {
+		return &workflow.BadRequestError{
+			Message: "BackoffStartIntervalInSeconds, Initiator, FailureReason, FailureDetails and Failure cannot be set " +
+				"on decision.",
+		}
+	}
+
+	return common.ValidateWorkflowRetryPolicy(attributes.RetryPolicy)
 }
 
 func validateStartChildExecutionAttributes(parentInfo *persistence.WorkflowExecutionInfo,
@@ -2498,7 +3539,7 @@ func validateStartWorkflowExecutionRequest(request *workflow.StartWorkflowExecut
 	if request.TaskList == nil || request.TaskList.Name == nil || request.TaskList.GetName() == "" {
 		return &workflow.BadRequestError{Message: "Missing Tasklist."}
 	}
-	return nil
+	return common.ValidateWorkflowRetryPolicy(request.RetryPolicy)
 }
 
 func validateDomainUUID(domainUUID *string) (string, error) {
@@ -2566,6 +3607,7 @@ func getStartRequest(domainID string,
 		Identity:                            request.Identity,
 		RequestId:                           request.RequestId,
 		WorkflowIdReusePolicy:               &policy,
+		RetryPolicy:                         request.RetryPolicy,
 	}
 
 	startRequest := &h.StartWorkflowExecutionRequest{
@@ -2575,6 +3617,22 @@ func getStartRequest(domainID string,
 	return startRequest
 }
 
+// getWorkflowExpirationTimestamp returns the expiration of the retry process of a workflow started at the given time,
+// or nil if the retry policy of the workflow does not expire
+func getWorkflowExpirationTimestamp(request *workflow.StartWorkflowExecutionRequest, now time.Time) *int64 {
+	if request.RetryPolicy == nil || request.RetryPolicy.GetExpirationIntervalInSeconds() == 0 {
+		return nil
+	}
+	expirationInterval := time.Duration(request.RetryPolicy.GetExpirationIntervalInSeconds()) * time.Second
+	return common.Int64Ptr(now.Add(expirationInterval).UnixNano())
+}
+
+// getStickyScheduleToStartTimeoutSeconds converts a configured sticky schedule to start timeout to the whole seconds
+// stored in mutable state, rounding up so that a sub second timeout does not disable the fallback timer
+func getStickyScheduleToStartTimeoutSeconds(timeout time.Duration) int32 {
+	return int32((timeout + time.Second - 1) / time.Second)
+}
+
 func setTaskVersion(version int64, transferTasks []persistence.Task, timerTasks []persistence.Task) {
 	for _, task := range transferTasks {
 		task.SetVersion(version)
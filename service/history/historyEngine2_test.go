@@ -26,6 +26,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 
@@ -141,6 +142,7 @@ func (s *engine2Suite) SetupTest() {
 		shard:              mockShard,
 		executionManager:   s.mockExecutionMgr,
 		historyMgr:         s.mockHistoryMgr,
+		visibilityMgr:      s.mockVisibilityMgr,
 		historyCache:       historyCache,
 		logger:             s.logger,
 		metricsClient:      metrics.NewClient(tally.NoopScope, metrics.History),
@@ -355,6 +357,11 @@ func (s *engine2Suite) TestRecordDecisionTaskStartedConflictOnUpdate() {
 	identity := "testIdentity"
 	tl := "testTaskList"
 
+	// log every conflict
+	sampleRate := s.config.ConflictDiffLogSampleRate
+	s.config.ConflictDiffLogSampleRate = func(opts ...dynamicconfig.FilterOption) float64 { return 1 }
+	defer func() { s.config.ConflictDiffLogSampleRate = sampleRate }()
+
 	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
 
 	ms := createMutableState(msBuilder)
@@ -362,7 +369,9 @@ func (s *engine2Suite) TestRecordDecisionTaskStartedConflictOnUpdate() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.ConditionFailedError{}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.ConditionFailedError{
+		ActualNextEventID: 4,
+	}).Once()
 
 	ms2 := createMutableState(msBuilder)
 	gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: ms2}
@@ -625,6 +634,61 @@ func (s *engine2Suite) TestRecordDecisionTaskSuccess() {
 	s.Equal(int64(3), *response.StartedEventId)
 }
 
+func (s *engine2Suite) TestRecordDecisionTaskSuggestContinueAsNew() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	maxSignals := s.config.MaximumSignalsPerExecution
+	s.config.MaximumSignalsPerExecution = func(opts ...dynamicconfig.FilterOption) int { return 5 }
+	defer func() { s.config.MaximumSignalsPerExecution = maxSignals }()
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	// 4 out of 5 signals reaches the 80% threshold
+	msBuilder.executionInfo.SignalCount = 4
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+
+	response, err := s.historyEngine.RecordDecisionTaskStarted(&h.RecordDecisionTaskStartedRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        common.Int64Ptr(2),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{
+				Name: common.StringPtr(tl),
+			},
+			Identity: common.StringPtr(identity),
+		},
+	})
+
+	s.Nil(err)
+	s.NotNil(response)
+	s.True(response.GetSuggestContinueAsNew())
+}
+
 func (s *engine2Suite) TestRecordActivityTaskStartedIfNoExecution() {
 	domainID := validDomainID
 	workflowExecution := &workflow.WorkflowExecution{
@@ -1384,3 +1448,444 @@ func (s *engine2Suite) getBuilder(domainID string, we workflow.WorkflowExecution
 
 	return context.msBuilder
 }
+
+func (s *engine2Suite) TestRecordDecisionTaskStartedIfPaused() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	msBuilder.executionInfo.Paused = true
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+
+	response, err := s.historyEngine.RecordDecisionTaskStarted(&h.RecordDecisionTaskStartedRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        common.Int64Ptr(2),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{
+				Name: common.StringPtr(tl),
+			},
+			Identity: common.StringPtr(identity),
+		},
+	})
+
+	s.Nil(response)
+	s.Equal(ErrWorkflowPaused, err)
+}
+
+func (s *engine2Suite) TestPauseAndResumeWorkflowExecution() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+
+	var updates []*persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Run(func(args mock.Arguments) {
+		request := args.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+		updates = append(updates, request)
+		s.Len(request.TransferTasks, len(updates)-1)
+	}).Return(nil).Twice()
+
+	err := s.historyEngine.PauseWorkflowExecution(&h.PauseWorkflowExecutionRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &workflowExecution,
+	})
+	s.Nil(err)
+	s.True(s.getBuilder(domainID, workflowExecution).isPaused())
+
+	err = s.historyEngine.ResumeWorkflowExecution(&h.ResumeWorkflowExecutionRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &workflowExecution,
+	})
+	s.Nil(err)
+	s.False(s.getBuilder(domainID, workflowExecution).isPaused())
+
+	s.Len(updates, 2)
+	decisionTask, ok := updates[1].TransferTasks[0].(*persistence.DecisionTask)
+	s.True(ok)
+	s.Equal(int64(2), decisionTask.ScheduleID)
+	s.Equal(tl, decisionTask.TaskList)
+}
+
+func (s *engine2Suite) TestNukeWorkflowExecution() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	startTime := time.Now()
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Twice()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		&persistence.GetCurrentExecutionResponse{RunID: validRunID}, nil).Twice()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{}, nil).Twice()
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything).Return(
+		&persistence.ListWorkflowExecutionsResponse{}, nil).Twice()
+	s.mockVisibilityMgr.On("ListClosedWorkflowExecutionsByWorkflowID", mock.Anything).Return(
+		&persistence.ListWorkflowExecutionsResponse{
+			Executions: []*workflow.WorkflowExecutionInfo{
+				{
+					Execution: &workflow.WorkflowExecution{
+						WorkflowId: common.StringPtr("wId"),
+						RunId:      common.StringPtr(uuid.New()),
+					},
+					StartTime: common.Int64Ptr(0),
+				},
+				{
+					Execution: &workflowExecution,
+					StartTime: common.Int64Ptr(startTime.UnixNano()),
+				},
+			},
+		}, nil).Twice()
+
+	resp, err := s.historyEngine.NukeWorkflowExecution(&h.NukeWorkflowExecutionRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &workflowExecution,
+		DryRun:            common.BoolPtr(true),
+	})
+	s.Nil(err)
+	s.False(resp.GetMutableStateFound())
+	s.True(resp.GetIsCurrentRun())
+	s.True(resp.GetHistoryFound())
+	s.True(resp.GetVisibilityRecordFound())
+
+	s.mockVisibilityMgr.On("DeleteWorkflowExecution", &persistence.VisibilityDeleteWorkflowExecutionRequest{
+		DomainUUID:     domainID,
+		RunID:          validRunID,
+		StartTimestamp: startTime.UnixNano(),
+	}).Return(nil).Once()
+	s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", &persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: "wId",
+		RunID:      validRunID,
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	_, err = s.historyEngine.NukeWorkflowExecution(&h.NukeWorkflowExecutionRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &workflowExecution,
+	})
+	s.Nil(err)
+}
+
+func (s *engine2Suite) TestImportWorkflowExecution_Closed() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	prevRunID := uuid.New()
+	startTime := time.Now().Add(-30 * 24 * time.Hour)
+
+	s.mockDomainForImport(domainID)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.MatchedBy(func(request *persistence.GetWorkflowExecutionRequest) bool {
+		return request.Execution.GetRunId() == validRunID
+	})).Return(nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: prevRunID,
+		State: persistence.WorkflowStateCompleted,
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.MatchedBy(func(request *persistence.GetWorkflowExecutionRequest) bool {
+		return request.Execution.GetRunId() == prevRunID
+	})).Return(&persistence.GetWorkflowExecutionResponse{State: &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{StartTimestamp: startTime.Add(-time.Hour)},
+	}}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(request *persistence.CreateWorkflowExecutionRequest) bool {
+		return request.ContinueAsNew && request.PreviousRunID == prevRunID && !request.SkipCurrentExecution &&
+			request.RequestID == validRunID && request.NextEventID == common.FirstEventID
+	})).Return(&persistence.CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		retentionStart := false
+		for _, task := range request.TimerTasks {
+			if deleteTask, ok := task.(*persistence.DeleteHistoryEventTask); ok {
+				retentionStart = deleteTask.VisibilityTimestamp.After(time.Now())
+			}
+		}
+		return retentionStart && request.FinishExecution && request.FinishedExecutionTTL == 3*secondsInDay &&
+			request.Condition == common.FirstEventID && request.ExecutionInfo.NextEventID == 6 &&
+			request.ExecutionInfo.State == persistence.WorkflowStateCompleted &&
+			request.ExecutionInfo.CloseStatus == persistence.WorkflowCloseStatusCompleted &&
+			request.ExecutionInfo.StartTimestamp.Equal(time.Unix(0, startTime.UnixNano()))
+	})).Return(nil).Once()
+
+	err := s.historyEngine.ImportWorkflowExecution(&h.ImportWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &workflowExecution,
+		History:    s.createImportHistory(startTime, true),
+	})
+	s.Nil(err)
+}
+
+func (s *engine2Suite) TestImportWorkflowExecution_ClosedOlderThanCurrentRun() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	prevRunID := uuid.New()
+	startTime := time.Now().Add(-30 * 24 * time.Hour)
+
+	s.mockDomainForImport(domainID)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.MatchedBy(func(request *persistence.GetWorkflowExecutionRequest) bool {
+		return request.Execution.GetRunId() == validRunID
+	})).Return(nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: prevRunID,
+		State: persistence.WorkflowStateCompleted,
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.MatchedBy(func(request *persistence.GetWorkflowExecutionRequest) bool {
+		return request.Execution.GetRunId() == prevRunID
+	})).Return(&persistence.GetWorkflowExecutionResponse{State: &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{StartTimestamp: startTime.Add(time.Hour)},
+	}}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(request *persistence.CreateWorkflowExecutionRequest) bool {
+		return !request.ContinueAsNew && request.SkipCurrentExecution
+	})).Return(&persistence.CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return !request.FinishExecution && request.ExecutionInfo.State == persistence.WorkflowStateCompleted
+	})).Return(nil).Once()
+
+	err := s.historyEngine.ImportWorkflowExecution(&h.ImportWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &workflowExecution,
+		History:    s.createImportHistory(startTime, true),
+	})
+	s.Nil(err)
+}
+
+func (s *engine2Suite) TestImportWorkflowExecution_Open() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	startTime := time.Now().Add(-time.Hour)
+
+	s.mockDomainForImport(domainID)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(request *persistence.CreateWorkflowExecutionRequest) bool {
+		return !request.ContinueAsNew && !request.SkipCurrentExecution
+	})).Return(&persistence.CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return !request.FinishExecution && request.Condition == common.FirstEventID &&
+			request.ExecutionInfo.State != persistence.WorkflowStateCompleted &&
+			request.ExecutionInfo.DecisionScheduleID == 2 && request.ExecutionInfo.DecisionStartedID == 3
+	})).Return(nil).Once()
+
+	err := s.historyEngine.ImportWorkflowExecution(&h.ImportWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &workflowExecution,
+		History:    s.createImportHistory(startTime, false),
+	})
+	s.Nil(err)
+}
+
+func (s *engine2Suite) TestImportWorkflowExecution_HalfImported() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	startTime := time.Now().Add(-30 * 24 * time.Hour)
+
+	s.mockDomainForImport(domainID)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				CreateRequestID: validRunID,
+				NextEventID:     common.FirstEventID,
+			},
+		},
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: validRunID,
+		State: persistence.WorkflowStateCreated,
+	}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.MatchedBy(func(request *persistence.AppendHistoryEventsRequest) bool {
+		return request.Overwrite
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return request.FinishExecution && request.Condition == common.FirstEventID &&
+			request.ExecutionInfo.NextEventID == 6 && request.ExecutionInfo.State == persistence.WorkflowStateCompleted
+	})).Return(nil).Once()
+
+	err := s.historyEngine.ImportWorkflowExecution(&h.ImportWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &workflowExecution,
+		History:    s.createImportHistory(startTime, true),
+	})
+	s.Nil(err)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "CreateWorkflowExecution", mock.Anything)
+}
+
+func (s *engine2Suite) TestImportWorkflowExecution_AlreadyImported() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	s.mockDomainForImport(domainID)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				CreateRequestID: validRunID,
+				NextEventID:     6,
+			},
+		},
+	}, nil).Once()
+
+	err := s.historyEngine.ImportWorkflowExecution(&h.ImportWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &workflowExecution,
+		History:    s.createImportHistory(time.Now(), true),
+	})
+	s.IsType(&workflow.WorkflowExecutionAlreadyStartedError{}, err)
+}
+
+func (s *engine2Suite) TestImportWorkflowExecution_OpenNotCurrentRun() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	s.mockDomainForImport(domainID)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: uuid.New(),
+		State: persistence.WorkflowStateRunning,
+	}, nil).Once()
+
+	err := s.historyEngine.ImportWorkflowExecution(&h.ImportWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &workflowExecution,
+		History:    s.createImportHistory(time.Now(), false),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engine2Suite) mockDomainForImport(domainID string) {
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 3},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+}
+
+// createImportHistory returns the history of a run with one started decision, which is completed along with the run
+// if closed is set
+func (s *engine2Suite) createImportHistory(startTime time.Time, closed bool) *workflow.History {
+	tl := &workflow.TaskList{Name: common.StringPtr("testTaskList")}
+	events := []*workflow.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(1),
+			EventType: workflow.EventTypeWorkflowExecutionStarted.Ptr(),
+			Timestamp: common.Int64Ptr(startTime.UnixNano()),
+			WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("workflowType")},
+				TaskList:                            tl,
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+			},
+		},
+		{
+			EventId:   common.Int64Ptr(2),
+			EventType: workflow.EventTypeDecisionTaskScheduled.Ptr(),
+			Timestamp: common.Int64Ptr(startTime.UnixNano()),
+			DecisionTaskScheduledEventAttributes: &workflow.DecisionTaskScheduledEventAttributes{
+				TaskList:                   tl,
+				StartToCloseTimeoutSeconds: common.Int32Ptr(10),
+			},
+		},
+		{
+			EventId:   common.Int64Ptr(3),
+			EventType: workflow.EventTypeDecisionTaskStarted.Ptr(),
+			Timestamp: common.Int64Ptr(startTime.UnixNano()),
+			DecisionTaskStartedEventAttributes: &workflow.DecisionTaskStartedEventAttributes{
+				ScheduledEventId: common.Int64Ptr(2),
+				RequestId:        common.StringPtr(uuid.New()),
+			},
+		},
+	}
+	if closed {
+		events = append(events,
+			&workflow.HistoryEvent{
+				EventId:   common.Int64Ptr(4),
+				EventType: workflow.EventTypeDecisionTaskCompleted.Ptr(),
+				Timestamp: common.Int64Ptr(startTime.UnixNano()),
+				DecisionTaskCompletedEventAttributes: &workflow.DecisionTaskCompletedEventAttributes{
+					ScheduledEventId: common.Int64Ptr(2),
+					StartedEventId:   common.Int64Ptr(3),
+				},
+			},
+			&workflow.HistoryEvent{
+				EventId:   common.Int64Ptr(5),
+				EventType: workflow.EventTypeWorkflowExecutionCompleted.Ptr(),
+				Timestamp: common.Int64Ptr(startTime.UnixNano()),
+				WorkflowExecutionCompletedEventAttributes: &workflow.WorkflowExecutionCompletedEventAttributes{
+					DecisionTaskCompletedEventId: common.Int64Ptr(4),
+				},
+			})
+	}
+	return &workflow.History{Events: events}
+}
@@ -55,6 +55,13 @@ type (
 		ResetStickyTaskList(resetRequest *h.ResetStickyTaskListRequest) (*h.ResetStickyTaskListResponse, error)
 		DescribeWorkflowExecution(
 			request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error)
+		GetWorkflowExecutionHistoryEvent(
+			request *h.GetWorkflowExecutionHistoryEventRequest) (*h.GetWorkflowExecutionHistoryEventResponse, error)
+		GetCurrentExecution(request *h.GetCurrentExecutionRequest) (*h.GetCurrentExecutionResponse, error)
+		ListWorkflowExecutionChain(
+			request *h.ListWorkflowExecutionChainRequest) (*h.ListWorkflowExecutionChainResponse, error)
+		DescribeShard() *h.HistoryShardStatus
+		WarmHistoryCache(maxExecutions int) int
 		RecordDecisionTaskStarted(request *h.RecordDecisionTaskStartedRequest) (*h.RecordDecisionTaskStartedResponse, error)
 		RecordActivityTaskStarted(request *h.RecordActivityTaskStartedRequest) (*h.RecordActivityTaskStartedResponse, error)
 		RespondDecisionTaskCompleted(ctx context.Context, request *h.RespondDecisionTaskCompletedRequest) error
@@ -69,9 +76,13 @@ type (
 			*workflow.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(request *h.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(request *h.TerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(request *h.PauseWorkflowExecutionRequest) error
+		ResumeWorkflowExecution(request *h.ResumeWorkflowExecutionRequest) error
+		NukeWorkflowExecution(request *h.NukeWorkflowExecutionRequest) (*h.NukeWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(request *h.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(request *h.RecordChildExecutionCompletedRequest) error
 		ReplicateEvents(request *h.ReplicateEventsRequest) error
+		ImportWorkflowExecution(request *h.ImportWorkflowExecutionRequest) error
 	}
 
 	// EngineFactory is used to create an instance of sharded history engine
@@ -87,6 +98,7 @@ type (
 	queueProcessor interface {
 		common.Daemon
 		notifyNewTask()
+		getOutstandingTaskCount() int
 	}
 
 	queueAckMgr interface {
@@ -94,6 +106,7 @@ type (
 		readQueueTasks() ([]queueTaskInfo, bool, error)
 		completeTask(taskID int64)
 		getAckLevel() int64
+		getOutstandingTaskCount() int
 		updateAckLevel()
 	}
 
@@ -114,6 +127,7 @@ type (
 		common.Daemon
 		FailoverDomain(domainID string, standbyClusterName string)
 		NotifyNewTask(clusterName string, currentTime time.Time, transferTasks []persistence.Task)
+		getOutstandingTaskCount() int
 	}
 
 	// TODO the timer quque processor and the one below, timer processor
@@ -123,6 +137,7 @@ type (
 		common.Daemon
 		FailoverDomain(domainID string, standbyClusterName string)
 		NotifyNewTimers(clusterName string, currentTime time.Time, timerTask []persistence.Task)
+		getOutstandingTaskCount() int
 	}
 
 	timerProcessor interface {
@@ -136,6 +151,7 @@ type (
 		readTimerTasks() ([]*persistence.TimerTaskInfo, *persistence.TimerTaskInfo, bool, error)
 		completeTimerTask(timerTask *persistence.TimerTaskInfo)
 		getAckLevel() TimerSequenceID
+		getOutstandingTaskCount() int
 		updateAckLevel()
 	}
 
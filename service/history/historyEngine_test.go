@@ -209,6 +209,261 @@ func (s *engineSuite) TestGetMutableStateSync() {
 	s.Equal(int64(4), response.GetNextEventId())
 }
 
+func (s *engineSuite) TestGetWorkflowExecutionHistoryEvent() {
+	domainID := validDomainID
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-get-workflow-execution-history-event"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil)
+
+	batch, err := persistence.NewJSONHistorySerializer().Serialize(&persistence.HistoryEventBatch{
+		Events: msBuilder.hBuilder.history,
+	})
+	s.Nil(err)
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistoryBatch", &persistence.GetWorkflowExecutionHistoryBatchRequest{
+		DomainID:  domainID,
+		Execution: execution,
+		EventID:   2,
+	}).Return(&persistence.GetWorkflowExecutionHistoryBatchResponse{
+		FirstEventID: common.FirstEventID,
+		Events:       *batch,
+	}, nil).Once()
+
+	response, err := s.mockHistoryEngine.GetWorkflowExecutionHistoryEvent(&history.GetWorkflowExecutionHistoryEventRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &execution,
+		EventId:    common.Int64Ptr(2),
+	})
+	s.Nil(err)
+	s.Equal(int64(2), response.Event.GetEventId())
+	s.Equal(workflow.EventTypeDecisionTaskScheduled, response.Event.GetEventType())
+
+	// the next event ID is 4, later events do not exist
+	_, err = s.mockHistoryEngine.GetWorkflowExecutionHistoryEvent(&history.GetWorkflowExecutionHistoryEventRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &execution,
+		EventId:    common.Int64Ptr(4),
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+
+	_, err = s.mockHistoryEngine.GetWorkflowExecutionHistoryEvent(&history.GetWorkflowExecutionHistoryEventRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &execution,
+		EventId:    common.Int64Ptr(0),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestGetCurrentExecution() {
+	domainID := validDomainID
+	workflowID := "test-get-current-execution"
+
+	s.mockExecutionMgr.On("GetCurrentExecution", &persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+	}).Return(&persistence.GetCurrentExecutionResponse{
+		RunID:          validRunID,
+		StartRequestID: "start-request-id",
+		State:          persistence.WorkflowStateCompleted,
+		CloseStatus:    persistence.WorkflowCloseStatusContinuedAsNew,
+	}, nil).Once()
+	response, err := s.mockHistoryEngine.GetCurrentExecution(&history.GetCurrentExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		WorkflowId: common.StringPtr(workflowID),
+	})
+	s.Nil(err)
+	s.Equal(validRunID, response.GetRunId())
+	s.Equal("start-request-id", response.GetStartRequestId())
+	s.Equal(workflow.WorkflowExecutionStateCompleted, response.GetState())
+	s.Equal(workflow.WorkflowExecutionCloseStatusContinuedAsNew, response.GetCloseStatus())
+
+	// a running execution has no close status
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID:       validRunID,
+		State:       persistence.WorkflowStateRunning,
+		CloseStatus: persistence.WorkflowCloseStatusNone,
+	}, nil).Once()
+	response, err = s.mockHistoryEngine.GetCurrentExecution(&history.GetCurrentExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		WorkflowId: common.StringPtr(workflowID),
+	})
+	s.Nil(err)
+	s.Equal(workflow.WorkflowExecutionStateRunning, response.GetState())
+	s.Nil(response.CloseStatus)
+
+	_, err = s.mockHistoryEngine.GetCurrentExecution(&history.GetCurrentExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestListWorkflowExecutionChain() {
+	domainID := validDomainID
+	workflowID := "test-list-workflow-execution-chain"
+	runIDs := []string{uuid.New(), uuid.New(), uuid.New()}
+
+	// the first run's history is deleted, the second run was started by continue as new and the third by a retry
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistoryBatch", &persistence.GetWorkflowExecutionHistoryBatchRequest{
+		DomainID: domainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runIDs[0]),
+		},
+		EventID: common.FirstEventID,
+	}).Return(nil, &workflow.EntityNotExistsError{})
+	for i := 1; i < len(runIDs); i++ {
+		batch, err := persistence.NewJSONHistorySerializer().Serialize(&persistence.HistoryEventBatch{
+			Events: []*workflow.HistoryEvent{{
+				EventId:   common.Int64Ptr(common.FirstEventID),
+				Timestamp: common.Int64Ptr(int64(i)),
+				EventType: workflow.EventTypeWorkflowExecutionStarted.Ptr(),
+				WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{
+					ContinuedExecutionRunId: common.StringPtr(runIDs[i-1]),
+					Attempt:                 common.Int32Ptr(int32(i - 1)),
+				},
+			}},
+		})
+		s.Nil(err)
+		s.mockHistoryMgr.On("GetWorkflowExecutionHistoryBatch", &persistence.GetWorkflowExecutionHistoryBatchRequest{
+			DomainID: domainID,
+			Execution: workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(workflowID),
+				RunId:      common.StringPtr(runIDs[i]),
+			},
+			EventID: common.FirstEventID,
+		}).Return(&persistence.GetWorkflowExecutionHistoryBatchResponse{
+			FirstEventID: common.FirstEventID,
+			Events:       *batch,
+		}, nil)
+	}
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: runIDs[2],
+	}, nil).Once()
+
+	response, err := s.mockHistoryEngine.ListWorkflowExecutionChain(&history.ListWorkflowExecutionChainRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)},
+	})
+	s.Nil(err)
+	s.True(response.GetTruncated())
+	s.Equal(2, len(response.Runs))
+	s.Equal(runIDs[1], response.Runs[0].GetRunId())
+	s.Equal(int64(1), response.Runs[0].GetStartTimestamp())
+	s.Equal(int32(0), response.Runs[0].GetAttempt())
+	s.Equal(runIDs[2], response.Runs[1].GetRunId())
+	s.Equal(int32(1), response.Runs[1].GetAttempt())
+
+	// a chain starting at the second run, limited to a single run
+	maxLength := s.config.MaxWorkflowChainLength
+	defer func() { s.config.MaxWorkflowChainLength = maxLength }()
+	s.config.MaxWorkflowChainLength = func(...dynamicconfig.FilterOption) int { return 1 }
+	response, err = s.mockHistoryEngine.ListWorkflowExecutionChain(&history.ListWorkflowExecutionChainRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runIDs[1]),
+		},
+	})
+	s.Nil(err)
+	s.True(response.GetTruncated())
+	s.Equal(1, len(response.Runs))
+	s.Equal(runIDs[1], response.Runs[0].GetRunId())
+
+	// the requested run must exist
+	_, err = s.mockHistoryEngine.ListWorkflowExecutionChain(&history.ListWorkflowExecutionChainRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runIDs[0]),
+		},
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestDescribeShard() {
+	shard := s.mockHistoryEngine.shard.(*shardContextWrapper).ShardContext.(*shardContextImpl)
+	timerAckLevel := time.Now().Add(-time.Minute)
+	shard.shardInfo.TransferAckLevel = 10
+	shard.shardInfo.ClusterTransferAckLevel = map[string]int64{cluster.TestCurrentClusterName: 40}
+	shard.shardInfo.TimerAckLevel = timerAckLevel.Add(-time.Hour)
+	shard.shardInfo.ClusterTimerAckLevel = map[string]time.Time{cluster.TestCurrentClusterName: timerAckLevel}
+	shard.transferMaxReadLevel = 100
+
+	_, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecution(validDomainID, workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wid"),
+		RunId:      common.StringPtr(validRunID),
+	})
+	s.Nil(err)
+	release(nil)
+
+	status := s.mockHistoryEngine.DescribeShard()
+	s.Equal(int32(shard.GetShardID()), status.GetShardId())
+	s.Equal(int64(40), status.GetTransferAckLevel())
+	s.Equal(int64(100), status.GetTransferMaxReadLevel())
+	s.Equal(int64(60), status.GetTransferTaskIDLag())
+	s.Equal(int32(0), status.GetTransferTasksInFlight())
+	s.Equal(timerAckLevel.UnixNano(), status.GetTimerAckLevel())
+	s.Equal(int32(0), status.GetTimerTasksInFlight())
+	s.Equal(int32(1), status.GetHistoryCacheSize())
+	// replication is not enabled for this shard
+	s.Nil(status.ReplicatorAckLevel)
+	s.Nil(status.ReplicationTaskIDLag)
+	s.Nil(status.ReplicationTasksInFlight)
+}
+
+func (s *engineSuite) TestWarmHistoryCache() {
+	shard := s.mockHistoryEngine.shard.(*shardContextWrapper).ShardContext.(*shardContextImpl)
+	shard.transferMaxReadLevel = 100
+	domainID := validDomainID
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wid"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	deletedRunID := uuid.New()
+	uncachedRunID := uuid.New()
+
+	s.mockExecutionMgr.On("GetTransferTasks", mock.Anything).Return(&persistence.GetTransferTasksResponse{
+		Tasks: []*persistence.TransferTaskInfo{
+			{DomainID: domainID, WorkflowID: "wid", RunID: validRunID, TaskID: 41},
+			{DomainID: domainID, WorkflowID: "wid", RunID: validRunID, TaskID: 42},
+			{DomainID: domainID, WorkflowID: "deleted", RunID: deletedRunID, TaskID: 43},
+		},
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(&persistence.GetTimerIndexTasksResponse{
+		Timers: []*persistence.TimerTaskInfo{
+			{DomainID: domainID, WorkflowID: "uncached", RunID: uncachedRunID, TaskID: 44},
+		},
+	}, nil).Once()
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", "testTaskList", []byte("input"), 100, 200, "identity")
+	ms := createMutableState(msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.MatchedBy(func(request *persistence.GetWorkflowExecutionRequest) bool {
+		return request.Execution.GetRunId() == validRunID
+	})).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.MatchedBy(func(request *persistence.GetWorkflowExecutionRequest) bool {
+		return request.Execution.GetRunId() == deletedRunID
+	})).Return(nil, &workflow.EntityNotExistsError{}).Once()
+
+	// the executions past the limit are not loaded
+	loaded := s.mockHistoryEngine.WarmHistoryCache(2)
+	s.Equal(1, loaded)
+	context, ok := s.mockHistoryEngine.historyCache.Get(validRunID).(*workflowExecutionContext)
+	s.True(ok)
+	s.NotNil(context.msBuilder)
+	s.Nil(s.mockHistoryEngine.historyCache.Get(uncachedRunID))
+}
+
 func (s *engineSuite) TestGetMutableState_InvalidRunID() {
 	ctx := context.Background()
 	domainID := validDomainID
@@ -1200,6 +1455,87 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowSuccess()
 	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedStickyConfig() {
+	domainID := validDomainID
+	tl := "testTaskList"
+	stickyTl := "testStickyTaskList"
+	identity := "testIdentity"
+
+	enableSticky := s.config.EnableStickyExecution
+	stickyTimeout := s.config.StickyScheduleToStartTimeout
+	defer func() {
+		s.config.EnableStickyExecution = enableSticky
+		s.config.StickyScheduleToStartTimeout = stickyTimeout
+	}()
+
+	testCases := []struct {
+		enabled          bool
+		timeout          time.Duration
+		expectedTaskList string
+		expectedTimeout  int32
+	}{
+		{true, 0, stickyTl, 5},
+		{true, 1500 * time.Millisecond, stickyTl, 2},
+		{false, 0, "", 0},
+	}
+
+	for _, tc := range testCases {
+		enabled, timeout := tc.enabled, tc.timeout
+		s.config.EnableStickyExecution = func(opts ...dynamicconfig.FilterOption) bool { return enabled }
+		s.config.StickyScheduleToStartTimeout = func(opts ...dynamicconfig.FilterOption) time.Duration { return timeout }
+
+		we := workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("wId"),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+		taskToken, _ := json.Marshal(&common.TaskToken{
+			WorkflowID: *we.WorkflowId,
+			RunID:      *we.RunId,
+			ScheduleID: 2,
+		})
+
+		msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+		addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+		di := addDecisionTaskScheduledEvent(msBuilder)
+		addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+		ms := createMutableState(msBuilder)
+		gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+		s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+		s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+			&persistence.GetDomainResponse{
+				Info:   &persistence.DomainInfo{ID: domainID},
+				Config: &persistence.DomainConfig{Retention: 1},
+				ReplicationConfig: &persistence.DomainReplicationConfig{
+					ActiveClusterName: cluster.TestCurrentClusterName,
+					Clusters: []*persistence.ClusterReplicationConfig{
+						&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+					},
+				},
+			},
+			nil,
+		)
+		err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+			DomainUUID: common.StringPtr(domainID),
+			CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+				TaskToken: taskToken,
+				Identity:  &identity,
+				StickyAttributes: &workflow.StickyExecutionAttributes{
+					WorkerTaskList:                &workflow.TaskList{Name: common.StringPtr(stickyTl)},
+					ScheduleToStartTimeoutSeconds: common.Int32Ptr(5),
+				},
+			},
+		})
+		s.Nil(err, s.printHistory(msBuilder))
+		executionBuilder := s.getBuilder(domainID, we)
+		s.Equal(tc.expectedTaskList, executionBuilder.executionInfo.StickyTaskList)
+		s.Equal(tc.expectedTimeout, executionBuilder.executionInfo.StickyScheduleToStartTimeout)
+	}
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedFailWorkflowSuccess() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
@@ -4114,6 +4450,107 @@ func (s *engineSuite) TestSignalWorkflowExecution_Failed() {
 	s.EqualError(err, "EntityNotExistsError{Message: Workflow execution already completed.}")
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_BufferedSignalsLimitExceeded() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+			SignalName:        common.StringPtr("my signal name"),
+			Input:             []byte("test input"),
+		},
+	}
+
+	maxBufferedSignals := s.config.MaximumBufferedSignals
+	s.config.MaximumBufferedSignals = func(opts ...dynamicconfig.FilterOption) int { return 1 }
+	defer func() { s.config.MaximumBufferedSignals = maxBufferedSignals }()
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	// the decision is in flight, so this signal is buffered
+	s.NotNil(msBuilder.AddWorkflowExecutionSignaled(signalRequest.SignalRequest))
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.SignalWorkflowExecution(signalRequest)
+	s.Equal(ErrBufferedSignalsLimitExceeded, err)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_SignalsLimitExceeded() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+			SignalName:        common.StringPtr("my signal name"),
+			Input:             []byte("test input"),
+		},
+	}
+
+	maxSignals := s.config.MaximumSignalsPerExecution
+	s.config.MaximumSignalsPerExecution = func(opts ...dynamicconfig.FilterOption) int { return 2 }
+	defer func() { s.config.MaximumSignalsPerExecution = maxSignals }()
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	s.NotNil(msBuilder.AddWorkflowExecutionSignaled(signalRequest.SignalRequest))
+	s.NotNil(msBuilder.AddWorkflowExecutionSignaled(signalRequest.SignalRequest))
+	s.Equal(int64(2), msBuilder.executionInfo.SignalCount)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.SignalWorkflowExecution(signalRequest)
+	s.Equal(ErrSignalsLimitExceeded, err)
+}
+
 func (s *engineSuite) TestRemoveSignalMutableState() {
 	removeRequest := &history.RemoveSignalMutableStateRequest{}
 	err := s.mockHistoryEngine.RemoveSignalMutableState(removeRequest)
@@ -4182,6 +4619,59 @@ func (s *engineSuite) TestValidateSignalExternalWorkflowExecutionAttributes() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestValidateContinueAsNewWorkflowExecutionAttributes() {
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		WorkflowTypeName:     "wType",
+		TaskList:             "testTaskList",
+		WorkflowTimeout:      100,
+		DecisionTimeoutValue: 10,
+	}
+	err := validateContinueAsNewWorkflowExecutionAttributes(executionInfo, nil)
+	s.EqualError(err, "BadRequestError{Message: ContinueAsNewWorkflowExecutionDecisionAttributes is not set on decision.}")
+
+	attributes := &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		BackoffStartIntervalInSeconds: common.Int32Ptr(10),
+	}
+	err = validateContinueAsNewWorkflowExecutionAttributes(executionInfo, attributes)
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	attributes = &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		Initiator: workflow.ContinueAsNewInitiatorRetryPolicy.Ptr(),
+	}
+	err = validateContinueAsNewWorkflowExecutionAttributes(executionInfo, attributes)
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	attributes = &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		FailureReason: common.StringPtr("some reason"),
+	}
+	err = validateContinueAsNewWorkflowExecutionAttributes(executionInfo, attributes)
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	attributes = &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		Failure: &workflow.Failure{Type: common.StringPtr("some type")},
+	}
+	err = validateContinueAsNewWorkflowExecutionAttributes(executionInfo, attributes)
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	attributes = &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		RetryPolicy: &workflow.RetryPolicy{
+			InitialIntervalInSeconds: common.Int32Ptr(1),
+			BackoffCoefficient:       common.Float64Ptr(2),
+			MaximumIntervalInSeconds: common.Int32Ptr(10),
+		},
+	}
+	err = validateContinueAsNewWorkflowExecutionAttributes(executionInfo, attributes)
+	s.EqualError(err, "BadRequestError{Message: MaximumAttempts or ExpirationIntervalInSeconds must be set on workflow retry policy.}")
+
+	attributes.RetryPolicy.MaximumAttempts = common.Int32Ptr(3)
+	err = validateContinueAsNewWorkflowExecutionAttributes(executionInfo, attributes)
+	s.Nil(err)
+	s.Equal("wType", attributes.WorkflowType.GetName())
+	s.Equal("testTaskList", attributes.TaskList.GetName())
+	s.Equal(int32(100), attributes.GetExecutionStartToCloseTimeoutSeconds())
+	s.Equal(int32(10), attributes.GetTaskStartToCloseTimeoutSeconds())
+}
+
 func (s *engineSuite) getBuilder(domainID string, we workflow.WorkflowExecution) *mutableStateBuilder {
 	context, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecution(domainID, we)
 	if err != nil {
@@ -4514,6 +5004,9 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		DecisionStartedID:            sourceInfo.DecisionStartedID,
 		DecisionRequestID:            sourceInfo.DecisionRequestID,
 		DecisionTimeout:              sourceInfo.DecisionTimeout,
+		Paused:                       sourceInfo.Paused,
+		SignalCount:                  sourceInfo.SignalCount,
+		BufferedSignalCount:          sourceInfo.BufferedSignalCount,
 	}
 }
 
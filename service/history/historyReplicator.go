@@ -231,6 +231,7 @@ func (r *historyReplicator) ApplyReplicationTask(context *workflowExecutionConte
 			TransactionID: transactionID,
 			FirstEventID:  firstEvent.GetEventId(),
 			Events:        serializedHistory,
+			EventCount:    len(request.History.Events),
 		})
 		if err != nil {
 			return err
@@ -285,6 +286,16 @@ func (r *historyReplicator) ApplyReplicationTask(context *workflowExecutionConte
 				ContinueAsNew:               !isBrandNew,
 				PreviousRunID:               prevRunID,
 				ReplicationState:            replicationState,
+				Attempt:                     msBuilder.executionInfo.Attempt,
+				HasRetryPolicy:              msBuilder.executionInfo.HasRetryPolicy,
+				InitialInterval:             msBuilder.executionInfo.InitialInterval,
+				BackoffCoefficient:          msBuilder.executionInfo.BackoffCoefficient,
+				MaximumInterval:             msBuilder.executionInfo.MaximumInterval,
+				ExpirationTime:              msBuilder.executionInfo.ExpirationTime,
+				MaximumAttempts:             msBuilder.executionInfo.MaximumAttempts,
+				NonRetriableErrors:          msBuilder.executionInfo.NonRetriableErrors,
+				Initiator:                   msBuilder.executionInfo.Initiator,
+				DecisionBackoffSeconds:      msBuilder.executionInfo.DecisionBackoffSeconds,
 			})
 
 			if err != nil {
@@ -280,6 +280,7 @@ func (e *mutableStateBuilder) FlushBufferedEvents() error {
 		e.bufferedEvents = nil
 		// clear pending buffered events
 		e.updateBufferedEvents = nil
+		e.executionInfo.BufferedSignalCount = 0
 	}
 
 	e.hBuilder.history = newCommittedEvents
@@ -296,6 +297,7 @@ func (e *mutableStateBuilder) FlushBufferedEvents() error {
 			return err
 		}
 		e.updateBufferedEvents = serializedEvents
+		e.executionInfo.BufferedSignalCount += countSignalEvents(newBufferedEvents)
 	}
 
 	return nil
@@ -480,7 +482,10 @@ func (e *mutableStateBuilder) createReplicationTask() *persistence.HistoryReplic
 func convertUpdateActivityInfos(inputs map[*persistence.ActivityInfo]struct{}) []*persistence.ActivityInfo {
 	outputs := []*persistence.ActivityInfo{}
 	for item := range inputs {
-		outputs = append(outputs, item)
+		// the update is written from a copy, so that the details are not written again by later updates
+		update := *item
+		item.DetailsUpdated = false
+		outputs = append(outputs, &update)
 	}
 	return outputs
 }
@@ -859,6 +864,7 @@ func (e *mutableStateBuilder) hasParentExecution() bool {
 func (e *mutableStateBuilder) updateActivityProgress(ai *persistence.ActivityInfo,
 	request *workflow.RecordActivityTaskHeartbeatRequest) {
 	ai.Details = request.Details
+	ai.DetailsUpdated = true
 	ai.LastHeartBeatUpdatedTime = time.Now()
 	e.updateActivityInfos[ai] = struct{}{}
 }
@@ -934,6 +940,13 @@ func (e *mutableStateBuilder) HasPendingDecisionTask() bool {
 	return e.executionInfo.DecisionScheduleID != common.EmptyEventID
 }
 
+// isWorkflowBackoffPending returns true if the first decision of this run was delayed by a backoff and is still
+// waiting for the workflow backoff timer
+func (e *mutableStateBuilder) isWorkflowBackoffPending() bool {
+	return e.executionInfo.DecisionBackoffSeconds > 0 && e.executionInfo.LastProcessedEvent == common.EmptyEventID &&
+		!e.HasPendingDecisionTask()
+}
+
 func (e *mutableStateBuilder) HasInFlightDecisionTask() bool {
 	return e.executionInfo.DecisionStartedID > 0
 }
@@ -952,6 +965,32 @@ func (e *mutableStateBuilder) HasBufferedEvents() bool {
 	return false
 }
 
+// GetBufferedSignalCount returns the number of signal events which are buffered behind the in-flight decision,
+// including those persisted by previous updates and those added in the current session
+func (e *mutableStateBuilder) GetBufferedSignalCount() int64 {
+	return e.executionInfo.BufferedSignalCount + countSignalEvents(e.getNewBufferedEvents())
+}
+
+func (e *mutableStateBuilder) getNewBufferedEvents() []*workflow.HistoryEvent {
+	var newBufferedEvents []*workflow.HistoryEvent
+	for _, event := range e.hBuilder.history {
+		if event.GetEventId() == common.BufferedEventID {
+			newBufferedEvents = append(newBufferedEvents, event)
+		}
+	}
+	return newBufferedEvents
+}
+
+func countSignalEvents(events []*workflow.HistoryEvent) int64 {
+	count := int64(0)
+	for _, event := range events {
+		if event.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled {
+			count++
+		}
+	}
+	return count
+}
+
 func (e *mutableStateBuilder) HasBufferedReplicationTasks() bool {
 	if len(e.bufferedReplicationTasks) > 0 || e.updateBufferedReplicationTasks != nil {
 		return true
@@ -1026,6 +1065,12 @@ func (e *mutableStateBuilder) isWorkflowExecutionRunning() bool {
 	return e.executionInfo.State != persistence.WorkflowStateCompleted
 }
 
+// isPaused returns true if an operator paused the workflow execution, its tasks are not dispatched and its timers
+// do not fire until it is resumed
+func (e *mutableStateBuilder) isPaused() bool {
+	return e.executionInfo.Paused
+}
+
 func (e *mutableStateBuilder) isCancelRequested() (bool, string) {
 	if e.executionInfo.CancelRequested {
 		return e.executionInfo.CancelRequested, e.executionInfo.CancelRequestID
@@ -1096,14 +1141,27 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEventForContinueAsNew(d
 		WorkflowType:                        wType,
 		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(decisionTimeout),
 		ExecutionStartToCloseTimeoutSeconds: attributes.ExecutionStartToCloseTimeoutSeconds,
-		Input:    attributes.Input,
-		Identity: nil,
+		Input:       attributes.Input,
+		Identity:    nil,
+		RetryPolicy: attributes.RetryPolicy,
 	}
 
 	req := &h.StartWorkflowExecutionRequest{
-		DomainUUID:          common.StringPtr(domainID),
-		StartRequest:        createRequest,
-		ParentExecutionInfo: parentExecutionInfo,
+		DomainUUID:                      common.StringPtr(domainID),
+		StartRequest:                    createRequest,
+		ParentExecutionInfo:             parentExecutionInfo,
+		FirstDecisionTaskBackoffSeconds: attributes.BackoffStartIntervalInSeconds,
+	}
+	if attributes.GetInitiator() == workflow.ContinueAsNewInitiatorRetryPolicy {
+		// a retry keeps the expiration of the whole retry process and counts the attempts
+		req.Attempt = common.Int32Ptr(previousExecutionState.executionInfo.Attempt + 1)
+		expirationTime := previousExecutionState.executionInfo.ExpirationTime
+		if !expirationTime.IsZero() {
+			req.ExpirationTimestamp = common.Int64Ptr(expirationTime.UnixNano())
+		}
+	} else if attributes.RetryPolicy != nil && attributes.RetryPolicy.GetExpirationIntervalInSeconds() > 0 {
+		expirationInterval := time.Duration(attributes.RetryPolicy.GetExpirationIntervalInSeconds()) * time.Second
+		req.ExpirationTimestamp = common.Int64Ptr(time.Now().Add(expirationInterval).UnixNano())
 	}
 
 	// History event only has domainName so domainID has to be passed in explicitly to update the mutable state
@@ -1169,6 +1227,36 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(domainID st
 	if event.ParentInitiatedEventId != nil {
 		e.executionInfo.InitiatedID = event.GetParentInitiatedEventId()
 	}
+
+	e.executionInfo.Attempt = event.GetAttempt()
+	if event.ExpirationTimestamp != nil {
+		e.executionInfo.ExpirationTime = time.Unix(0, event.GetExpirationTimestamp())
+	}
+	if event.RetryPolicy != nil {
+		e.executionInfo.HasRetryPolicy = true
+		e.executionInfo.BackoffCoefficient = event.RetryPolicy.GetBackoffCoefficient()
+		e.executionInfo.InitialInterval = event.RetryPolicy.GetInitialIntervalInSeconds()
+		e.executionInfo.MaximumAttempts = event.RetryPolicy.GetMaximumAttempts()
+		e.executionInfo.MaximumInterval = event.RetryPolicy.GetMaximumIntervalInSeconds()
+		e.executionInfo.NonRetriableErrors = event.RetryPolicy.NonRetriableErrorReasons
+	}
+	e.executionInfo.Initiator = getWorkflowInitiator(event)
+	e.executionInfo.DecisionBackoffSeconds = event.GetFirstDecisionTaskBackoffSeconds()
+}
+
+// getWorkflowInitiator derives how a run was started from its started event, a retry continues the previous run with
+// the next attempt while a continue as new from the decider restarts the attempts from 0
+func getWorkflowInitiator(event *workflow.WorkflowExecutionStartedEventAttributes) int {
+	switch {
+	case event.GetContinuedExecutionRunId() != "" && event.GetAttempt() > 0:
+		return persistence.WorkflowInitiatorRetryPolicy
+	case event.GetContinuedExecutionRunId() != "":
+		return persistence.WorkflowInitiatorContinueAsNew
+	case event.ParentWorkflowExecution != nil:
+		return persistence.WorkflowInitiatorParentWorkflow
+	default:
+		return persistence.WorkflowInitiatorClient
+	}
 }
 
 func (e *mutableStateBuilder) AddDecisionTaskScheduledEvent() *decisionInfo {
@@ -1476,6 +1564,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		ai.MaximumInterval = attributes.RetryPolicy.GetMaximumIntervalInSeconds()
 		ai.MaximumAttempts = attributes.RetryPolicy.GetMaximumAttempts()
 		ai.NonRetriableErrors = attributes.RetryPolicy.NonRetriableErrorReasons
+		ai.NonRetriableErrorTypes = attributes.RetryPolicy.NonRetriableErrorTypes
 	}
 	ai.ExpirationTime = ai.ScheduledTime.Add(time.Duration(scheduleToCloseTimeout) * time.Second)
 
@@ -2044,8 +2133,14 @@ func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 		return nil
 	}
 
-	// No MutableState operation needed for signal
-	return e.hBuilder.AddWorkflowExecutionSignaledEvent(request)
+	event := e.hBuilder.AddWorkflowExecutionSignaledEvent(request)
+	e.ReplicateWorkflowExecutionSignaled(event)
+	return event
+}
+
+// ReplicateWorkflowExecutionSignaled counts the signal against the run's max signals per execution
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(event *workflow.HistoryEvent) {
+	e.executionInfo.SignalCount++
 }
 
 func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int64, domainEntry *cache.DomainCacheEntry, newRunID string,
@@ -2091,9 +2186,13 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int
 	if startedEvent == nil {
 		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
-	di := newStateBuilder.AddDecisionTaskScheduledEvent()
-	if di == nil {
-		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision started event."}
+	var di *decisionInfo
+	// First decision for retry will be created by a backoff timer
+	if attributes.GetBackoffStartIntervalInSeconds() == 0 {
+		di = newStateBuilder.AddDecisionTaskScheduledEvent()
+		if di == nil {
+			return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision started event."}
+		}
 	}
 
 	e.ReplicateWorkflowExecutionContinuedAsNewEvent("", domainID, continueAsNewEvent, startedEvent, di, newStateBuilder)
@@ -2129,16 +2228,30 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(sour
 		initiatedID = newStateBuilder.executionInfo.InitiatedID
 	}
 
-	if newStateBuilder.replicationState != nil {
-		newStateBuilder.updateReplicationStateLastEventID(sourceClusterName, di.ScheduleID)
+	// the decision is nil when the first decision of the new run is delayed by a backoff timer
+	lastEventID := startedEvent.GetEventId()
+	decisionVersion := common.EmptyVersion
+	decisionScheduleID := common.EmptyEventID
+	decisionStartedID := common.EmptyEventID
+	decisionTimeout := int32(0)
+	var newTransferTasks []persistence.Task
+	if di != nil {
+		lastEventID = di.ScheduleID
+		decisionVersion = di.Version
+		decisionScheduleID = di.ScheduleID
+		decisionStartedID = di.StartedID
+		decisionTimeout = di.DecisionTimeout
+		newTransferTasks = []persistence.Task{&persistence.DecisionTask{
+			DomainID:   domainID,
+			TaskList:   newStateBuilder.executionInfo.TaskList,
+			ScheduleID: di.ScheduleID,
+		}}
+		setTaskVersion(newStateBuilder.GetCurrentVersion(), newTransferTasks, nil)
 	}
 
-	newTransferTasks := []persistence.Task{&persistence.DecisionTask{
-		DomainID:   domainID,
-		TaskList:   newStateBuilder.executionInfo.TaskList,
-		ScheduleID: di.ScheduleID,
-	}}
-	setTaskVersion(newStateBuilder.GetCurrentVersion(), newTransferTasks, nil)
+	if newStateBuilder.replicationState != nil {
+		newStateBuilder.updateReplicationStateLastEventID(sourceClusterName, lastEventID)
+	}
 
 	e.continueAsNew = &persistence.CreateWorkflowExecutionRequest{
 		RequestID:                   uuid.New(),
@@ -2155,13 +2268,23 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(sour
 		NextEventID:                 newStateBuilder.GetNextEventID(),
 		LastProcessedEvent:          common.EmptyEventID,
 		TransferTasks:               newTransferTasks,
-		DecisionVersion:             di.Version,
-		DecisionScheduleID:          di.ScheduleID,
-		DecisionStartedID:           di.StartedID,
-		DecisionStartToCloseTimeout: di.DecisionTimeout,
+		DecisionVersion:             decisionVersion,
+		DecisionScheduleID:          decisionScheduleID,
+		DecisionStartedID:           decisionStartedID,
+		DecisionStartToCloseTimeout: decisionTimeout,
 		ContinueAsNew:               true,
 		PreviousRunID:               prevRunID,
 		ReplicationState:            newStateBuilder.replicationState,
+		Attempt:                     newStateBuilder.executionInfo.Attempt,
+		HasRetryPolicy:              newStateBuilder.executionInfo.HasRetryPolicy,
+		InitialInterval:             newStateBuilder.executionInfo.InitialInterval,
+		BackoffCoefficient:          newStateBuilder.executionInfo.BackoffCoefficient,
+		MaximumInterval:             newStateBuilder.executionInfo.MaximumInterval,
+		ExpirationTime:              newStateBuilder.executionInfo.ExpirationTime,
+		MaximumAttempts:             newStateBuilder.executionInfo.MaximumAttempts,
+		NonRetriableErrors:          newStateBuilder.executionInfo.NonRetriableErrors,
+		Initiator:                   newStateBuilder.executionInfo.Initiator,
+		DecisionBackoffSeconds:      newStateBuilder.executionInfo.DecisionBackoffSeconds,
 	}
 }
 
@@ -2396,8 +2519,9 @@ func (e *mutableStateBuilder) ReplicateChildWorkflowExecutionTimedOutEvent(event
 	e.DeletePendingChildExecution(initiatedID)
 }
 
-func (e *mutableStateBuilder) CreateRetryTimer(ai *persistence.ActivityInfo, failureReason string) persistence.Task {
-	retryTask := prepareNextRetry(ai, failureReason)
+func (e *mutableStateBuilder) CreateRetryTimer(ai *persistence.ActivityInfo, failureReason string,
+	failureTypes []string) persistence.Task {
+	retryTask := prepareNextRetry(ai, failureReason, failureTypes)
 	if retryTask != nil {
 		e.updateActivityInfos[ai] = struct{}{}
 	}
@@ -28,6 +28,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
@@ -138,3 +140,110 @@ OtherEventsLoop:
 	s.Equal(len(workflow.DecisionType_Values())+1, len(decisionEvents),
 		"This assertaion will be broken a new decision is added and no corresponding logic added to shouldBufferEvent()")
 }
+
+func (s *mutableStateSuite) TestCloseUpdateSession_ActivityDetailsWrittenOnce() {
+	ai := &persistence.ActivityInfo{ScheduleID: 5}
+	s.msBuilder.pendingActivityInfoIDs[ai.ScheduleID] = ai
+	s.msBuilder.updateActivityProgress(ai, &workflow.RecordActivityTaskHeartbeatRequest{Details: []byte("details")})
+
+	updates, err := s.msBuilder.CloseUpdateSession()
+	s.Nil(err)
+	s.Equal(1, len(updates.updateActivityInfos))
+	s.True(updates.updateActivityInfos[0].DetailsUpdated)
+	s.Equal([]byte("details"), updates.updateActivityInfos[0].Details)
+
+	s.Nil(s.msBuilder.UpdateActivity(ai))
+	updates, err = s.msBuilder.CloseUpdateSession()
+	s.Nil(err)
+	s.Equal(1, len(updates.updateActivityInfos))
+	s.False(updates.updateActivityInfos[0].DetailsUpdated)
+}
+
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionStartedEvent_Initiator() {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("initiator-test-workflow-id"),
+		RunId:      common.StringPtr("0d00698f-08e1-4d36-a3e2-3bf109f5d2d6"),
+	}
+	parent := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("initiator-test-parent-id"),
+		RunId:      common.StringPtr("c4d0b2f4-3bd0-4a53-9d8f-0ad4b5ba0bd4"),
+	}
+	previousRunID := common.StringPtr("5a3e8d4f-9d2c-4a0e-8b8c-1b6f1c8a7e55")
+
+	testCases := []struct {
+		attributes *workflow.WorkflowExecutionStartedEventAttributes
+		initiator  int
+	}{
+		{&workflow.WorkflowExecutionStartedEventAttributes{}, persistence.WorkflowInitiatorClient},
+		{&workflow.WorkflowExecutionStartedEventAttributes{ParentWorkflowExecution: parent},
+			persistence.WorkflowInitiatorParentWorkflow},
+		{&workflow.WorkflowExecutionStartedEventAttributes{ContinuedExecutionRunId: previousRunID},
+			persistence.WorkflowInitiatorContinueAsNew},
+		{&workflow.WorkflowExecutionStartedEventAttributes{ContinuedExecutionRunId: previousRunID,
+			ParentWorkflowExecution: parent}, persistence.WorkflowInitiatorContinueAsNew},
+		{&workflow.WorkflowExecutionStartedEventAttributes{ContinuedExecutionRunId: previousRunID,
+			Attempt: common.Int32Ptr(2)}, persistence.WorkflowInitiatorRetryPolicy},
+	}
+
+	for _, tc := range testCases {
+		tc.attributes.TaskList = &workflow.TaskList{Name: common.StringPtr("initiator-test-tasklist")}
+		tc.attributes.WorkflowType = &workflow.WorkflowType{Name: common.StringPtr("initiator-test-type")}
+		msBuilder := newMutableStateBuilder(NewConfig(dynamicconfig.NewNopCollection(), 1), s.logger)
+		msBuilder.ReplicateWorkflowExecutionStartedEvent("domain-id", nil, execution, "request-id", tc.attributes)
+		s.Equal(tc.initiator, msBuilder.executionInfo.Initiator)
+	}
+}
+
+func (s *mutableStateSuite) TestIsWorkflowBackoffPending() {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("backoff-test-workflow-id"),
+		RunId:      common.StringPtr("7d2f8c1e-5b3a-4e6d-9c0f-2a1b3c4d5e6f"),
+	}
+	attributes := &workflow.WorkflowExecutionStartedEventAttributes{
+		TaskList:     &workflow.TaskList{Name: common.StringPtr("backoff-test-tasklist")},
+		WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("backoff-test-type")},
+		Attempt:      common.Int32Ptr(2),
+	}
+
+	// a retried run without a recorded backoff starts its first decision right away
+	msBuilder := newMutableStateBuilder(NewConfig(dynamicconfig.NewNopCollection(), 1), s.logger)
+	msBuilder.ReplicateWorkflowExecutionStartedEvent("domain-id", nil, execution, "request-id", attributes)
+	s.False(msBuilder.isWorkflowBackoffPending())
+
+	attributes.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(10)
+	msBuilder = newMutableStateBuilder(NewConfig(dynamicconfig.NewNopCollection(), 1), s.logger)
+	msBuilder.ReplicateWorkflowExecutionStartedEvent("domain-id", nil, execution, "request-id", attributes)
+	s.Equal(int32(10), msBuilder.executionInfo.DecisionBackoffSeconds)
+	s.True(msBuilder.isWorkflowBackoffPending())
+
+	s.NotNil(msBuilder.AddDecisionTaskScheduledEvent())
+	s.False(msBuilder.isWorkflowBackoffPending())
+}
+
+func (s *mutableStateSuite) TestBufferedSignalCount() {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("buffered-signal-test-workflow-id"),
+		RunId:      common.StringPtr("3e9b7a2c-6f1d-4c8e-a5b0-8d2e4f6a1c3b"),
+	}
+	signalRequest := &workflow.SignalWorkflowExecutionRequest{
+		WorkflowExecution: &execution,
+		SignalName:        common.StringPtr("buffered-signal-test-signal"),
+	}
+
+	addWorkflowExecutionStartedEvent(s.msBuilder, execution, "wType", "testTaskList", nil, 100, 200, "identity")
+	di := addDecisionTaskScheduledEvent(s.msBuilder)
+	started := addDecisionTaskStartedEvent(s.msBuilder, di.ScheduleID, "testTaskList", "identity")
+
+	// the signal is buffered behind the in-flight decision, and counted once it is flushed to the pending buffer
+	s.NotNil(s.msBuilder.AddWorkflowExecutionSignaled(signalRequest))
+	s.Equal(int64(1), s.msBuilder.GetBufferedSignalCount())
+	s.Nil(s.msBuilder.FlushBufferedEvents())
+	s.Equal(int64(1), s.msBuilder.executionInfo.BufferedSignalCount)
+	s.NotNil(s.msBuilder.AddWorkflowExecutionSignaled(signalRequest))
+	s.Equal(int64(2), s.msBuilder.GetBufferedSignalCount())
+
+	// completing the decision flushes the buffered signals into history
+	addDecisionTaskCompletedEvent(s.msBuilder, di.ScheduleID, started.GetEventId(), nil, "identity")
+	s.Equal(int64(0), s.msBuilder.executionInfo.BufferedSignalCount)
+	s.Equal(int64(0), s.msBuilder.GetBufferedSignalCount())
+}
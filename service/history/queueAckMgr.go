@@ -147,6 +147,19 @@ func (a *queueAckMgrImpl) getAckLevel() int64 {
 	return a.ackLevel
 }
 
+// getOutstandingTaskCount returns the number of tasks loaded into memory which are not yet completed
+func (a *queueAckMgrImpl) getOutstandingTaskCount() int {
+	a.RLock()
+	defer a.RUnlock()
+	count := 0
+	for _, acked := range a.outstandingTasks {
+		if !acked {
+			count++
+		}
+	}
+	return count
+}
+
 func (a *queueAckMgrImpl) getFinishedChan() <-chan struct{} {
 	return a.finishedChan
 }
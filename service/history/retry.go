@@ -29,71 +29,125 @@ import (
 	"github.com/uber/cadence/common/persistence"
 )
 
-func prepareNextRetry(a *persistence.ActivityInfo, errReason string) persistence.Task {
-	return prepareNextRetryWithNowTime(a, errReason, time.Now())
+const (
+	// failureTypeTimeout is the failure type reported with every activity timeout, in addition to the timeout specific type
+	failureTypeTimeout = "timeout"
+	// noRetryBackoff is returned by getBackoffInterval when the failure should not be retried
+	noRetryBackoff = time.Duration(-1)
+)
+
+func prepareNextRetry(a *persistence.ActivityInfo, errReason string, failureTypes []string) persistence.Task {
+	return prepareNextRetryWithNowTime(a, errReason, failureTypes, time.Now())
 }
 
-func prepareNextRetryWithNowTime(a *persistence.ActivityInfo, errReason string, now time.Time) persistence.Task {
+func prepareNextRetryWithNowTime(a *persistence.ActivityInfo, errReason string, failureTypes []string,
+	now time.Time) persistence.Task {
 	if !a.HasRetryPolicy || a.CancelRequested {
 		return nil
 	}
 
-	if a.MaximumAttempts == 0 && a.ExpirationTime.IsZero() {
-		// Invalid retry policy, Decider worker should reject this.
+	backoffInterval := getBackoffInterval(a.Attempt, a.MaximumAttempts, a.InitialInterval, a.MaximumInterval,
+		a.BackoffCoefficient, now, a.ExpirationTime, errReason, failureTypes, a.NonRetriableErrors,
+		a.NonRetriableErrorTypes)
+	if backoffInterval == noRetryBackoff {
 		return nil
 	}
+	nextScheduleTime := now.Add(backoffInterval)
+
+	// a retry is needed, update activity info for next retry
+	a.Attempt++
+	a.ScheduledTime = nextScheduleTime // update to next schedule time
+	a.StartedID = common.EmptyEventID
+	a.RequestID = ""
+	a.StartedTime = time.Time{}
+	a.LastHeartBeatUpdatedTime = time.Time{}
+	// clear timer created bits except for ScheduleToClose
+	a.TimerTaskStatus = TimerTaskStatusNone | (a.TimerTaskStatus & TimerTaskStatusCreatedScheduleToClose)
 
-	if a.MaximumAttempts > 0 && a.Attempt >= a.MaximumAttempts-1 {
+	return &persistence.RetryTimerTask{
+		Version:             a.Version,
+		VisibilityTimestamp: a.ScheduledTime,
+		EventID:             a.ScheduleID,
+		Attempt:             a.Attempt,
+	}
+}
+
+// getWorkflowBackoffInterval returns the interval to wait before retrying a failed workflow run, workflow failures
+// carry no failure types so only the reason is matched against the retry policy
+func getWorkflowBackoffInterval(info *persistence.WorkflowExecutionInfo, errReason string, now time.Time) time.Duration {
+	if !info.HasRetryPolicy || info.CancelRequested {
+		return noRetryBackoff
+	}
+
+	return getBackoffInterval(info.Attempt, info.MaximumAttempts, info.InitialInterval, info.MaximumInterval,
+		info.BackoffCoefficient, now, info.ExpirationTime, errReason, nil, info.NonRetriableErrors, nil)
+}
+
+// getBackoffInterval returns the interval to wait before the next attempt according to the retry policy
+func getBackoffInterval(currAttempt, maxAttempts, initInterval, maxInterval int32, backoffCoefficient float64,
+	now, expirationTime time.Time, errReason string, failureTypes, nonRetriableErrors,
+	nonRetriableErrorTypes []string) time.Duration {
+	if maxAttempts == 0 && expirationTime.IsZero() {
+		// Invalid retry policy, Decider worker should reject this.
+		return noRetryBackoff
+	}
+
+	if maxAttempts > 0 && currAttempt >= maxAttempts-1 {
 		// Attempt starts from 0.
 		// MaximumAttempts is the total attempts, including initial (non-retry) attempt.
-		return nil
+		return noRetryBackoff
 	}
 
-	nextInterval := int64(float64(a.InitialInterval) * math.Pow(a.BackoffCoefficient, float64(a.Attempt)))
+	nextInterval := int64(float64(initInterval) * math.Pow(backoffCoefficient, float64(currAttempt)))
 	if nextInterval <= 0 {
 		// math.Pow() could overflow
-		if a.MaximumInterval > 0 {
-			nextInterval = int64(a.MaximumInterval)
+		if maxInterval > 0 {
+			nextInterval = int64(maxInterval)
 		} else {
-			return nil
+			return noRetryBackoff
 		}
 	}
 
-	if a.MaximumInterval > 0 && nextInterval > int64(a.MaximumInterval) {
+	if maxInterval > 0 && nextInterval > int64(maxInterval) {
 		// cap next interval to MaxInterval
-		nextInterval = int64(a.MaximumInterval)
+		nextInterval = int64(maxInterval)
 	}
 
-	nextScheduleTime := now.Add(time.Duration(nextInterval) * time.Second)
-	if !a.ExpirationTime.IsZero() && nextScheduleTime.After(a.ExpirationTime) {
-		return nil
+	backoffInterval := time.Duration(nextInterval) * time.Second
+	nextScheduleTime := now.Add(backoffInterval)
+	if !expirationTime.IsZero() && nextScheduleTime.After(expirationTime) {
+		return noRetryBackoff
 	}
 
-	// check if error is non-retriable
-	for _, er := range a.NonRetriableErrors {
-		if er == errReason {
-			return nil
-		}
+	if isNonRetriableFailure(errReason, failureTypes, nonRetriableErrors, nonRetriableErrorTypes) {
+		return noRetryBackoff
 	}
 
-	// a retry is needed, update activity info for next retry
-	a.Attempt++
-	a.ScheduledTime = nextScheduleTime // update to next schedule time
-	a.StartedID = common.EmptyEventID
-	a.RequestID = ""
-	a.StartedTime = time.Time{}
-	a.LastHeartBeatUpdatedTime = time.Time{}
-	// clear timer created bits except for ScheduleToClose
-	a.TimerTaskStatus = TimerTaskStatusNone | (a.TimerTaskStatus & TimerTaskStatusCreatedScheduleToClose)
+	return backoffInterval
+}
 
-	return &persistence.RetryTimerTask{
-		Version:             a.Version,
-		VisibilityTimestamp: a.ScheduledTime,
-		EventID:             a.ScheduleID,
-		Attempt:             a.Attempt,
+// isNonRetriableFailure checks the failure reason and the failure types against the non-retriable lists
+// of the retry policy, a failure is non-retriable if any of its types is listed
+func isNonRetriableFailure(errReason string, failureTypes, nonRetriableErrors, nonRetriableErrorTypes []string) bool {
+	for _, er := range nonRetriableErrors {
+		if er == errReason {
+			return true
+		}
+	}
+	for _, et := range nonRetriableErrorTypes {
+		for _, ft := range failureTypes {
+			if et == ft {
+				return true
+			}
+		}
 	}
+	return false
 }
 
 func getTimeoutErrorReason(timeoutType shared.TimeoutType) string {
 	return "timeout:" + timeoutType.String()
 }
+
+func getTimeoutFailureTypes(timeoutType shared.TimeoutType) []string {
+	return []string{getTimeoutErrorReason(timeoutType), failureTypeTimeout}
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -43,75 +44,157 @@ func Test_NextRetry(t *testing.T) {
 		HasRetryPolicy:         false,
 		NonRetriableErrors:     []string{"bad-reason", "ugly-reason"},
 	}
-	a.Nil(prepareNextRetry(ai, reason))
+	a.Nil(prepareNextRetry(ai, reason, nil))
 
 	// no retry if cancel requested
 	ai.HasRetryPolicy = true
 	ai.CancelRequested = true
-	a.Nil(prepareNextRetry(ai, reason))
+	a.Nil(prepareNextRetry(ai, reason, nil))
 
 	// no retry if both MaximumAttempts and ExpirationTime are not set
 	ai.CancelRequested = false
-	a.Nil(prepareNextRetry(ai, reason))
+	a.Nil(prepareNextRetry(ai, reason, nil))
 
 	// no retry if MaximumAttempts is 1 (for initial attempt)
 	ai.InitialInterval = 1
 	ai.MaximumAttempts = 1
-	a.Nil(prepareNextRetry(ai, reason))
+	a.Nil(prepareNextRetry(ai, reason, nil))
 
 	// backoff retry, intervals: 1s, 2s, 4s, 8s.
 	ai.MaximumAttempts = 5
 	ai.BackoffCoefficient = 2
-	retryTask := prepareNextRetryWithNowTime(ai, reason, now)
+	retryTask := prepareNextRetryWithNowTime(ai, reason, nil, now)
 	a.NotNil(retryTask)
 	a.Equal(version, retryTask.GetVersion())
 	a.Equal(now.Add(time.Second), retryTask.(*persistence.RetryTimerTask).VisibilityTimestamp)
 
-	retryTask = prepareNextRetryWithNowTime(ai, reason, now)
+	retryTask = prepareNextRetryWithNowTime(ai, reason, nil, now)
 	a.NotNil(retryTask)
 	a.Equal(now.Add(time.Second*2), retryTask.(*persistence.RetryTimerTask).VisibilityTimestamp)
 
-	retryTask = prepareNextRetryWithNowTime(ai, reason, now)
+	retryTask = prepareNextRetryWithNowTime(ai, reason, nil, now)
 	a.NotNil(retryTask)
 	a.Equal(now.Add(time.Second*4), retryTask.(*persistence.RetryTimerTask).VisibilityTimestamp)
 
 	// test non-retriable error
 	reason = "bad-reason"
-	retryTask = prepareNextRetryWithNowTime(ai, reason, now)
+	retryTask = prepareNextRetryWithNowTime(ai, reason, nil, now)
 	a.Nil(retryTask)
 	reason = "good-reason"
 
-	retryTask = prepareNextRetryWithNowTime(ai, reason, now)
+	retryTask = prepareNextRetryWithNowTime(ai, reason, nil, now)
 	a.NotNil(retryTask)
 	a.Equal(now.Add(time.Second*8), retryTask.(*persistence.RetryTimerTask).VisibilityTimestamp)
 
 	// no retry as max attempt reached
 	a.Equal(ai.MaximumAttempts-1, ai.Attempt)
-	retryTask = prepareNextRetryWithNowTime(ai, reason, now)
+	retryTask = prepareNextRetryWithNowTime(ai, reason, nil, now)
 	a.Nil(retryTask)
 
 	// increase max attempts, with max interval cap at 10s
 	ai.MaximumAttempts = 6
 	ai.MaximumInterval = 10
-	retryTask = prepareNextRetryWithNowTime(ai, reason, now)
+	retryTask = prepareNextRetryWithNowTime(ai, reason, nil, now)
 	a.NotNil(retryTask)
 	a.Equal(now.Add(time.Second*10), retryTask.(*persistence.RetryTimerTask).VisibilityTimestamp)
 
 	// no retry because expiration time before next interval
 	ai.MaximumAttempts = 8
 	ai.ExpirationTime = now.Add(time.Second * 5)
-	retryTask = prepareNextRetryWithNowTime(ai, reason, now)
+	retryTask = prepareNextRetryWithNowTime(ai, reason, nil, now)
 	a.Nil(retryTask)
 
 	// extend expiration, next interval should be 10s
 	ai.ExpirationTime = now.Add(time.Minute)
-	retryTask = prepareNextRetryWithNowTime(ai, reason, now)
+	retryTask = prepareNextRetryWithNowTime(ai, reason, nil, now)
 	a.NotNil(retryTask)
 	a.Equal(now.Add(time.Second*10), retryTask.(*persistence.RetryTimerTask).VisibilityTimestamp)
 
 	// with big max retry, math.Pow() could overflow, verify that it uses the MaxInterval
 	ai.Attempt = 64
 	ai.MaximumAttempts = 100
-	retryTask = prepareNextRetryWithNowTime(ai, reason, now)
+	retryTask = prepareNextRetryWithNowTime(ai, reason, nil, now)
 	a.Equal(now.Add(time.Second*10), retryTask.(*persistence.RetryTimerTask).VisibilityTimestamp)
 }
+
+func Test_NextRetry_NonRetriableErrorTypes(t *testing.T) {
+	a := assert.New(t)
+	now, _ := time.Parse(time.RFC3339, "2018-04-13T16:08:08+00:00")
+	reason := "some-reason"
+
+	ai := &persistence.ActivityInfo{
+		ScheduleToStartTimeout: 5,
+		ScheduleToCloseTimeout: 30,
+		StartToCloseTimeout:    25,
+		HasRetryPolicy:         true,
+		InitialInterval:        1,
+		BackoffCoefficient:     1,
+		MaximumAttempts:        10,
+		NonRetriableErrorTypes: []string{"InvalidArgumentError", failureTypeTimeout},
+	}
+
+	// retry if no failure type matches
+	a.NotNil(prepareNextRetryWithNowTime(ai, reason, nil, now))
+	a.NotNil(prepareNextRetryWithNowTime(ai, reason, []string{"NetworkError", "IOError"}, now))
+
+	// no retry if any of the failure types matches
+	a.Nil(prepareNextRetryWithNowTime(ai, reason, []string{"MissingFieldError", "InvalidArgumentError"}, now))
+
+	// failure types are matched exactly, not by prefix
+	a.NotNil(prepareNextRetryWithNowTime(ai, reason, []string{"InvalidArgument"}, now))
+
+	// timeouts are classified with the generic timeout type
+	a.Nil(prepareNextRetryWithNowTime(ai, reason, getTimeoutFailureTypes(shared.TimeoutTypeStartToClose), now))
+}
+
+func Test_WorkflowBackoffInterval(t *testing.T) {
+	a := assert.New(t)
+	now, _ := time.Parse(time.RFC3339, "2018-04-13T16:08:08+00:00")
+	reason := "some-reason"
+
+	info := &persistence.WorkflowExecutionInfo{
+		HasRetryPolicy:     true,
+		InitialInterval:    2,
+		BackoffCoefficient: 2,
+		MaximumInterval:    10,
+		MaximumAttempts:    5,
+		NonRetriableErrors: []string{"bad-reason"},
+	}
+
+	// first retry uses the initial interval
+	a.Equal(time.Second*2, getWorkflowBackoffInterval(info, reason, now))
+
+	// interval grows with the attempt and is capped to the maximum
+	info.Attempt = 2
+	a.Equal(time.Second*8, getWorkflowBackoffInterval(info, reason, now))
+	info.Attempt = 3
+	a.Equal(time.Second*10, getWorkflowBackoffInterval(info, reason, now))
+
+	// no retry once maximum attempts are used up
+	info.Attempt = 4
+	a.Equal(noRetryBackoff, getWorkflowBackoffInterval(info, reason, now))
+
+	// no retry for non retriable reasons
+	info.Attempt = 0
+	a.Equal(noRetryBackoff, getWorkflowBackoffInterval(info, "bad-reason", now))
+
+	// timeouts are retried like failures, unless the timeout reason is non retriable
+	timeoutReason := getTimeoutErrorReason(shared.TimeoutTypeStartToClose)
+	a.Equal(time.Second*2, getWorkflowBackoffInterval(info, timeoutReason, now))
+	info.NonRetriableErrors = []string{timeoutReason}
+	a.Equal(noRetryBackoff, getWorkflowBackoffInterval(info, timeoutReason, now))
+
+	// no retry if the next attempt would start after expiration
+	info.ExpirationTime = now.Add(time.Second)
+	a.Equal(noRetryBackoff, getWorkflowBackoffInterval(info, reason, now))
+	info.ExpirationTime = time.Time{}
+
+	// no retry once cancellation has been requested
+	info.CancelRequested = true
+	a.Equal(noRetryBackoff, getWorkflowBackoffInterval(info, reason, now))
+
+	// no retry without a policy
+	info.CancelRequested = false
+	info.HasRetryPolicy = false
+	a.Equal(noRetryBackoff, getWorkflowBackoffInterval(info, reason, now))
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
@@ -91,6 +92,60 @@ type Config struct {
 	// Time to hold a poll request before returning an empty response
 	// right now only used by GetMutableState
 	LongPollExpirationInterval dynamicconfig.DurationPropertyFn
+
+	// MaximumBufferedSignals is the max number of signals buffered for a single run while a decision is in flight,
+	// signals beyond this limit are rejected until the decision completes and the buffer is flushed
+	MaximumBufferedSignals dynamicconfig.IntPropertyFn
+	// MaximumSignalsPerExecution is the max number of signals a single run can receive, decision tasks suggest
+	// continue as new once a run gets close to it
+	MaximumSignalsPerExecution dynamicconfig.IntPropertyFn
+	// HistoryCountSuggestContinueAsNew is the history event count above which decision tasks suggest continue as new
+	HistoryCountSuggestContinueAsNew dynamicconfig.IntPropertyFn
+
+	// RunIDType is the type of ID given to new runs, see common.NewIDGenerator
+	RunIDType dynamicconfig.StringPropertyFn
+
+	// ConflictDiffLogSampleRate is the rate of update conflicts logged with the expected and actual run state
+	ConflictDiffLogSampleRate dynamicconfig.FloatPropertyFn
+
+	// Per workflow ID rate limits, protecting a shard from a single hot workflow
+	WorkflowIDSignalRPS            dynamicconfig.IntPropertyFn
+	WorkflowIDStartRPS             dynamicconfig.IntPropertyFn
+	WorkflowIDRateLimiterCacheSize int
+	WorkflowIDRateLimiterCacheTTL  time.Duration
+
+	// Domain stats settings, the history counts are buffered in memory and flushed periodically
+	EnableDomainStats        dynamicconfig.BoolPropertyFn
+	DomainStatsFlushInterval time.Duration
+
+	// TaskFilterShadowMode evaluates candidate queue task filters without acting on their result
+	TaskFilterShadowMode dynamicconfig.BoolPropertyFn
+
+	// User timer settings, protecting the timer queue from workflows creating large numbers of short timers
+	MinUserTimerDuration dynamicconfig.DurationPropertyFn
+	UserTimerResolution  dynamicconfig.DurationPropertyFn
+
+	// MaxWorkflowChainLength bounds the number of histories read when listing the runs of a workflow ID
+	MaxWorkflowChainLength dynamicconfig.IntPropertyFn
+
+	// EnableWorkflowMetrics is to emit, per domain, the metrics recorded by workflows in metrics markers
+	EnableWorkflowMetrics dynamicconfig.BoolPropertyFn
+	// WorkflowMetricsMaxSeries is the max number of distinct series the workflows of a domain can emit on the host
+	WorkflowMetricsMaxSeries dynamicconfig.IntPropertyFn
+
+	// Sticky execution settings, per domain and task list, overriding the sticky attributes sent by workers
+	EnableStickyExecution        dynamicconfig.BoolPropertyFn
+	StickyScheduleToStartTimeout dynamicconfig.DurationPropertyFn
+
+	// HedgedReads configures sending a second history read when the first one is slower than usual
+	HedgedReads *persistence.HedgingConfig
+
+	// BlobStatsSampleRate is the rate of history and execution writes whose blob encodings and sizes are sampled
+	BlobStatsSampleRate dynamicconfig.FloatPropertyFn
+
+	// Shard rebalancer settings, shards pinned to a host are reloaded periodically and drains are throttled
+	ShardPlacementRefreshInterval dynamicconfig.DurationPropertyFn
+	ShardDrainShardsPerMinute     dynamicconfig.IntPropertyFn
 }
 
 // NewConfig returns new service config with default values
@@ -139,6 +194,77 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int) *Config {
 		LongPollExpirationInterval: dc.GetDurationProperty(
 			dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20,
 		),
+		MaximumBufferedSignals: dc.GetIntProperty(
+			dynamicconfig.HistoryMaximumBufferedSignals, 1000,
+		),
+		MaximumSignalsPerExecution: dc.GetIntProperty(
+			dynamicconfig.HistoryMaximumSignalsPerExecution, 0,
+		),
+		HistoryCountSuggestContinueAsNew: dc.GetIntProperty(
+			dynamicconfig.HistoryCountSuggestContinueAsNew, 0,
+		),
+		RunIDType: dc.GetStringProperty(
+			dynamicconfig.HistoryRunIDType, common.IDTypeUUIDv4,
+		),
+		ConflictDiffLogSampleRate: dc.GetFloat64Property(
+			dynamicconfig.HistoryConflictDiffLogSampleRate, 0,
+		),
+		WorkflowIDSignalRPS: dc.GetIntProperty(
+			dynamicconfig.HistoryWorkflowIDSignalRPS, 0,
+		),
+		WorkflowIDStartRPS: dc.GetIntProperty(
+			dynamicconfig.HistoryWorkflowIDStartRPS, 0,
+		),
+		WorkflowIDRateLimiterCacheSize: 10000,
+		WorkflowIDRateLimiterCacheTTL:  time.Minute,
+		EnableDomainStats: dc.GetBoolProperty(
+			dynamicconfig.HistoryEnableDomainStats, true,
+		),
+		DomainStatsFlushInterval: time.Minute,
+		TaskFilterShadowMode: dc.GetBoolProperty(
+			dynamicconfig.HistoryTaskFilterShadowMode, false,
+		),
+		MinUserTimerDuration: dc.GetDurationProperty(
+			dynamicconfig.HistoryMinUserTimerDuration, time.Second,
+		),
+		UserTimerResolution: dc.GetDurationProperty(
+			dynamicconfig.HistoryUserTimerResolution, 0,
+		),
+		MaxWorkflowChainLength: dc.GetIntProperty(
+			dynamicconfig.HistoryMaxWorkflowChainLength, 1000,
+		),
+		EnableWorkflowMetrics: dc.GetBoolProperty(
+			dynamicconfig.HistoryEnableWorkflowMetrics, false,
+		),
+		WorkflowMetricsMaxSeries: dc.GetIntProperty(
+			dynamicconfig.HistoryWorkflowMetricsMaxSeries, 100,
+		),
+		EnableStickyExecution: dc.GetBoolProperty(
+			dynamicconfig.HistoryEnableStickyExecution, true,
+		),
+		StickyScheduleToStartTimeout: dc.GetDurationProperty(
+			dynamicconfig.HistoryStickyScheduleToStartTimeout, 0,
+		),
+		HedgedReads: &persistence.HedgingConfig{
+			Enabled: dc.GetBoolProperty(
+				dynamicconfig.HistoryEnableHedgedReads, false,
+			),
+			MinDelay: dc.GetDurationProperty(
+				dynamicconfig.HistoryHedgedReadMinDelay, 20*time.Millisecond,
+			),
+			MaxHedgedPercentage: dc.GetFloat64Property(
+				dynamicconfig.HistoryHedgedReadMaxPercentage, 5,
+			),
+		},
+		BlobStatsSampleRate: dc.GetFloat64Property(
+			dynamicconfig.HistoryBlobStatsSampleRate, 0,
+		),
+		ShardPlacementRefreshInterval: dc.GetDurationProperty(
+			dynamicconfig.HistoryShardPlacementRefreshInterval, 10*time.Second,
+		),
+		ShardDrainShardsPerMinute: dc.GetIntProperty(
+			dynamicconfig.HistoryShardDrainShardsPerMinute, 10,
+		),
 	}
 }
 
@@ -161,7 +287,8 @@ func NewService(params *service.BootstrapParams) common.Daemon {
 		params: params,
 		stopC:  make(chan struct{}),
 		config: NewConfig(
-			dynamicconfig.NewCollection(params.DynamicConfig, params.Logger),
+			dynamicconfig.NewCollection(params.DynamicConfig, params.Logger,
+				metrics.NewClient(params.MetricScope, metrics.History)),
 			params.CassandraConfig.NumHistoryShards,
 		),
 	}
@@ -179,13 +306,15 @@ func (s *Service) Start() {
 
 	s.metricsClient = base.GetMetricsClient()
 
-	shardMgr, err := persistence.NewCassandraShardPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
+	shardCfg := p.CassandraConfig.ForStore(config.StoreShard)
+	shardMgr, err := persistence.NewCassandraShardPersistence(shardCfg.Hosts,
+		shardCfg.Port,
+		shardCfg.User,
+		shardCfg.Password,
+		shardCfg.Datacenter,
+		shardCfg.Keyspace,
 		p.ClusterMetadata.GetCurrentClusterName(),
+		shardCfg.Timeouts,
 		p.Logger)
 
 	if err != nil {
@@ -210,12 +339,13 @@ func (s *Service) Start() {
 		}
 	}
 
-	metadata, err := persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
+	metadataCfg := p.CassandraConfig.ForStore(config.StoreMetadata)
+	metadata, err := persistence.NewCassandraMetadataPersistence(metadataCfg.Hosts,
+		metadataCfg.Port,
+		metadataCfg.User,
+		metadataCfg.Password,
+		metadataCfg.Datacenter,
+		metadataCfg.Keyspace,
 		p.ClusterMetadata.GetCurrentClusterName(),
 		p.Logger)
 
@@ -224,12 +354,14 @@ func (s *Service) Start() {
 	}
 	metadata = persistence.NewMetadataPersistenceClient(metadata, base.GetMetricsClient())
 
-	visibility, err := persistence.NewCassandraVisibilityPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.VisibilityKeyspace,
+	visibilityCfg := p.CassandraConfig.ForStore(config.StoreVisibility)
+	visibility, err := persistence.NewCassandraVisibilityPersistence(visibilityCfg.Hosts,
+		visibilityCfg.Port,
+		visibilityCfg.User,
+		visibilityCfg.Password,
+		visibilityCfg.Datacenter,
+		visibilityCfg.VisibilityKeyspace,
+		visibilityCfg.Timeouts,
 		p.Logger)
 
 	if err != nil {
@@ -237,27 +369,73 @@ func (s *Service) Start() {
 	}
 	visibility = persistence.NewVisibilityPersistenceClient(visibility, base.GetMetricsClient())
 
-	history, err := persistence.NewCassandraHistoryPersistence(p.CassandraConfig.Hosts,
+	encryptor, err := persistence.NewPayloadEncryptorFromKey(p.CassandraConfig.PayloadEncryptionKey)
+	if err != nil {
+		log.Fatalf("failed to create payload encryptor: %v", err)
+	}
+
+	historyCfg := p.CassandraConfig.ForStore(config.StoreHistory)
+	history, err := persistence.NewCassandraHistoryPersistence(historyCfg.Hosts,
+		historyCfg.Port,
+		historyCfg.User,
+		historyCfg.Password,
+		historyCfg.Datacenter,
+		historyCfg.Keyspace,
+		s.config.HistoryMgrNumConns,
+		historyCfg.Timeouts,
+		historyCfg.HistoryCompression,
+		encryptor,
+		p.Logger)
+
+	if err != nil {
+		log.Fatalf("Creating Cassandra history manager persistence failed: %v", err)
+	}
+	history = persistence.NewHistoryPersistenceHedgingClient(history, s.config.HedgedReads, base.GetMetricsClient())
+	history = persistence.NewHistoryPersistenceBlobStatsClient(history, s.config.BlobStatsSampleRate,
+		base.GetMetricsClient())
+	history = persistence.NewHistoryPersistenceClient(history, base.GetMetricsClient())
+
+	domainStats, err := persistence.NewCassandraDomainStatsPersistence(p.CassandraConfig.Hosts,
 		p.CassandraConfig.Port,
 		p.CassandraConfig.User,
 		p.CassandraConfig.Password,
 		p.CassandraConfig.Datacenter,
 		p.CassandraConfig.Keyspace,
-		s.config.HistoryMgrNumConns,
 		p.Logger)
 
 	if err != nil {
-		log.Fatalf("Creating Cassandra history manager persistence failed: %v", err)
+		log.Fatalf("failed to create domain stats manager: %v", err)
 	}
-	history = persistence.NewHistoryPersistenceClient(history, base.GetMetricsClient())
+	domainStats = persistence.NewDomainStatsPersistenceClient(domainStats, base.GetMetricsClient())
 
-	execMgrFactory, err := persistence.NewCassandraPersistenceClientFactory(p.CassandraConfig.Hosts,
+	statsRecorder := newDomainStatsRecorder(domainStats, s.config, p.Logger)
+	statsRecorder.Start()
+	history = newHistoryStatsManager(history, statsRecorder)
+
+	clusterMetadata, err := persistence.NewCassandraClusterMetadataPersistence(p.CassandraConfig.Hosts,
 		p.CassandraConfig.Port,
 		p.CassandraConfig.User,
 		p.CassandraConfig.Password,
 		p.CassandraConfig.Datacenter,
 		p.CassandraConfig.Keyspace,
+		p.ClusterMetadata.GetCurrentClusterName(),
+		p.Logger)
+
+	if err != nil {
+		log.Fatalf("failed to create cluster metadata manager: %v", err)
+	}
+	clusterMetadata = persistence.NewClusterMetadataPersistenceClient(clusterMetadata, base.GetMetricsClient())
+
+	executionCfg := p.CassandraConfig.ForStore(config.StoreExecution)
+	execMgrFactory, err := persistence.NewCassandraPersistenceClientFactory(executionCfg.Hosts,
+		executionCfg.Port,
+		executionCfg.User,
+		executionCfg.Password,
+		executionCfg.Datacenter,
+		executionCfg.Keyspace,
 		s.config.ExecutionMgrNumConns,
+		executionCfg.Timeouts,
+		encryptor,
 		p.Logger,
 		s.metricsClient,
 	)
@@ -271,13 +449,15 @@ func (s *Service) Start() {
 		metadata,
 		visibility,
 		history,
-		execMgrFactory)
+		execMgrFactory,
+		clusterMetadata)
 
 	handler.Start()
 
 	log.Infof("%v started", common.HistoryServiceName)
 
 	<-s.stopC
+	statsRecorder.Stop()
 	base.Stop()
 }
 
@@ -22,6 +22,7 @@ package history
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/uber-common/bark"
 
+	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/membership"
@@ -57,6 +59,7 @@ type (
 		shardClosedCh       chan int
 		isStarted           int32
 		isStopped           int32
+		isDraining          int32
 		shutdownWG          sync.WaitGroup
 		shutdownCh          chan struct{}
 		logger              bark.Logger
@@ -121,6 +124,8 @@ func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence
 	if err != nil {
 		return nil, err
 	}
+	executionMgr = persistence.NewWorkflowExecutionPersistenceBlobStatsClient(executionMgr, config.BlobStatsSampleRate,
+		metricsClient)
 
 	return &historyShardsItem{
 		service:       svc,
@@ -359,6 +364,114 @@ func (c *shardController) numShards() int {
 	return nShards
 }
 
+// describeShards returns the status of the shards owned by this host, ordered by shard ID. Shards whose engine
+// is still being created are not included.
+func (c *shardController) describeShards() []*h.HistoryShardStatus {
+	c.RLock()
+	items := make([]*historyShardsItem, 0, len(c.historyShards))
+	for _, item := range c.historyShards {
+		items = append(items, item)
+	}
+	c.RUnlock()
+
+	var statuses []*h.HistoryShardStatus
+	for _, item := range items {
+		if engine := item.getEngine(); engine != nil {
+			statuses = append(statuses, engine.DescribeShard())
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].GetShardId() < statuses[j].GetShardId()
+	})
+	return statuses
+}
+
+// warmShards acquires every shard the membership ring assigns to this host and warms the history cache of each,
+// shards which cannot be acquired are skipped. It returns the number of shards and executions warmed.
+func (c *shardController) warmShards(maxExecutionsPerShard int) (int, int) {
+	numShards, numExecutions := 0, 0
+	for shardID := 0; shardID < c.config.NumberOfShards; shardID++ {
+		info, err := c.hServiceResolver.Lookup(string(shardID))
+		if err != nil || info.Identity() != c.host.Identity() {
+			continue
+		}
+		engine, err := c.getEngineForShard(shardID)
+		if err != nil {
+			logging.LogOperationFailedEvent(c.logger, fmt.Sprintf("Unable to warm history shard: %v", shardID), err)
+			continue
+		}
+		numShards++
+		numExecutions += engine.WarmHistoryCache(maxExecutionsPerShard)
+	}
+	return numShards, numExecutions
+}
+
+// drain moves the shards owned by this host to the other history hosts, one every interval, by pinning each to the
+// history host owning the fewest shards. It returns the number of shards owned by this host, or false if a drain is
+// already in progress. The drain stops once this host owns no shard.
+func (c *shardController) drain(placement *shardPlacement, interval time.Duration) (int, bool) {
+	if !atomic.CompareAndSwapInt32(&c.isDraining, 0, 1) {
+		return 0, false
+	}
+
+	numShards := c.numShards()
+	logging.LogShardDrainStartedEvent(c.logger, c.host.Identity(), numShards)
+	c.shutdownWG.Add(1)
+	go c.drainLoop(placement, interval)
+	return numShards, true
+}
+
+func (c *shardController) drainLoop(placement *shardPlacement, interval time.Duration) {
+	defer c.shutdownWG.Done()
+	defer atomic.StoreInt32(&c.isDraining, 0)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-ticker.C:
+			shardID, ok := c.nextShardToDrain(placement)
+			if !ok {
+				logging.LogShardDrainCompletedEvent(c.logger, c.host.Identity())
+				return
+			}
+			target, err := placement.leastLoadedHost(c.config.NumberOfShards, c.host)
+			if err == nil {
+				err = placement.pin(shardID, target.GetAddress())
+			}
+			if err != nil {
+				logging.LogOperationFailedEvent(c.logger, fmt.Sprintf("Unable to move history shard: %v", shardID), err)
+				continue
+			}
+			c.metricsClient.IncCounter(metrics.HistoryShardControllerScope, metrics.ShardPinnedCounter)
+			logging.LogShardPinnedEvent(c.logger, c.host.Identity(), shardID, target.Identity())
+		}
+	}
+}
+
+// nextShardToDrain returns the lowest shard owned by this host which is still placed on it, the shards already moved
+// are released asynchronously by the shard management pump
+func (c *shardController) nextShardToDrain(placement *shardPlacement) (int, bool) {
+	c.RLock()
+	shardIDs := make([]int, 0, len(c.historyShards))
+	for shardID := range c.historyShards {
+		shardIDs = append(shardIDs, shardID)
+	}
+	c.RUnlock()
+
+	sort.Ints(shardIDs)
+	for _, shardID := range shardIDs {
+		info, err := placement.Lookup(string(shardID))
+		if err == nil && info.Identity() == c.host.Identity() {
+			return shardID, true
+		}
+	}
+	return 0, false
+}
+
 func (i *historyShardsItem) getEngine() Engine {
 	i.RLock()
 	defer i.RUnlock()
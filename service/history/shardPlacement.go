@@ -0,0 +1,218 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// shardPlacement resolves the history host owning a shard. The shards pinned to a history host by the shard
+	// rebalancer are owned by that host, the membership ring places the other shards as well as the shards pinned to
+	// a host which left the ring. The pinned shards are stored in the cluster metadata so that all history hosts
+	// agree on them, each host reloads them periodically and notifies its listeners when they change, so that the
+	// shard controller releases and acquires the moved shards. The history clients of the other services only use
+	// the ring, the host of the ring redirects them to the host a shard is pinned to.
+	shardPlacement struct {
+		membership.ServiceResolver
+		clusterMetadataMgr persistence.ClusterMetadataManager
+		refreshInterval    dynamicconfig.DurationPropertyFn
+		logger             bark.Logger
+		shutdownCh         chan struct{}
+		shutdownWG         sync.WaitGroup
+
+		sync.RWMutex
+		// overrides is keyed by the lookup key of the shard
+		overrides map[string]string
+		listeners map[string]chan<- *membership.ChangedEvent
+	}
+)
+
+var _ membership.ServiceResolver = (*shardPlacement)(nil)
+
+var errNoHostToMoveShardTo = errors.New("no other history host to move the shard to")
+
+func newShardPlacement(resolver membership.ServiceResolver, clusterMetadataMgr persistence.ClusterMetadataManager,
+	config *Config, logger bark.Logger) *shardPlacement {
+	return &shardPlacement{
+		ServiceResolver:    resolver,
+		clusterMetadataMgr: clusterMetadataMgr,
+		refreshInterval:    config.ShardPlacementRefreshInterval,
+		logger:             logger,
+		shutdownCh:         make(chan struct{}),
+		overrides:          make(map[string]string),
+		listeners:          make(map[string]chan<- *membership.ChangedEvent),
+	}
+}
+
+func (p *shardPlacement) start() {
+	p.refresh()
+	p.shutdownWG.Add(1)
+	go p.refreshLoop()
+}
+
+func (p *shardPlacement) stop() {
+	close(p.shutdownCh)
+	p.shutdownWG.Wait()
+}
+
+// Lookup returns the host owning the shard with the given lookup key
+func (p *shardPlacement) Lookup(key string) (*membership.HostInfo, error) {
+	p.RLock()
+	address, ok := p.overrides[key]
+	p.RUnlock()
+
+	if ok {
+		for _, host := range p.Members() {
+			if host.GetAddress() == address {
+				return host, nil
+			}
+		}
+	}
+	return p.ServiceResolver.Lookup(key)
+}
+
+// AddListener adds a listener notified of the changes of the membership ring and of the pinned shards
+func (p *shardPlacement) AddListener(name string, notifyChannel chan<- *membership.ChangedEvent) error {
+	if err := p.ServiceResolver.AddListener(name, notifyChannel); err != nil {
+		return err
+	}
+	p.Lock()
+	p.listeners[name] = notifyChannel
+	p.Unlock()
+	return nil
+}
+
+// RemoveListener removes a listener added by AddListener
+func (p *shardPlacement) RemoveListener(name string) error {
+	p.Lock()
+	delete(p.listeners, name)
+	p.Unlock()
+	return p.ServiceResolver.RemoveListener(name)
+}
+
+func (p *shardPlacement) refreshLoop() {
+	defer p.shutdownWG.Done()
+
+	for {
+		select {
+		case <-time.After(p.refreshInterval()):
+			p.refresh()
+		case <-p.shutdownCh:
+			return
+		}
+	}
+}
+
+func (p *shardPlacement) refresh() {
+	resp, err := p.clusterMetadataMgr.GetShardPlacement()
+	if err != nil {
+		p.logger.WithField(logging.TagErr, err).Warn("Failed to reload shard placement, keeping the last known one.")
+		return
+	}
+
+	overrides := make(map[string]string, len(resp.Overrides))
+	for shardID, address := range resp.Overrides {
+		overrides[string(shardID)] = address
+	}
+	p.Lock()
+	changed := !reflect.DeepEqual(p.overrides, overrides)
+	p.overrides = overrides
+	p.Unlock()
+
+	if changed {
+		p.logger.Infof("Shard placement changed, %v shards are pinned.", len(overrides))
+		p.notifyListeners()
+	}
+}
+
+// pin persists the history host owning a shard, and applies it to this host right away
+func (p *shardPlacement) pin(shardID int, address string) error {
+	err := p.clusterMetadataMgr.UpdateShardPlacement(&persistence.UpdateShardPlacementRequest{
+		Overrides: map[int]string{shardID: address},
+	})
+	if err != nil {
+		return err
+	}
+
+	p.Lock()
+	p.overrides[string(shardID)] = address
+	p.Unlock()
+	p.notifyListeners()
+	return nil
+}
+
+// leastLoadedHost returns the history host other than the given one which owns the fewest shards
+func (p *shardPlacement) leastLoadedHost(numberOfShards int, exclude *membership.HostInfo) (*membership.HostInfo,
+	error) {
+	counts := make(map[string]int)
+	var hosts []*membership.HostInfo
+	for _, host := range p.Members() {
+		if host.Identity() != exclude.Identity() {
+			counts[host.GetAddress()] = 0
+			hosts = append(hosts, host)
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, errNoHostToMoveShardTo
+	}
+
+	for shardID := 0; shardID < numberOfShards; shardID++ {
+		host, err := p.Lookup(string(shardID))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := counts[host.GetAddress()]; ok {
+			counts[host.GetAddress()]++
+		}
+	}
+	sort.Slice(hosts, func(i, j int) bool {
+		ci, cj := counts[hosts[i].GetAddress()], counts[hosts[j].GetAddress()]
+		if ci != cj {
+			return ci < cj
+		}
+		return hosts[i].GetAddress() < hosts[j].GetAddress()
+	})
+	return hosts[0], nil
+}
+
+func (p *shardPlacement) notifyListeners() {
+	p.RLock()
+	defer p.RUnlock()
+
+	for name, ch := range p.listeners {
+		select {
+		case ch <- &membership.ChangedEvent{}:
+		default:
+			p.logger.WithField(`listenerName`, name).Error("Failed to send listener notification, channel full")
+		}
+	}
+}
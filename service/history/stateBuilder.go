@@ -80,11 +80,13 @@ func (b *stateBuilder) applyEvents(version int64, sourceClusterName string, doma
 
 		case shared.EventTypeDecisionTaskScheduled:
 			attributes := event.DecisionTaskScheduledEventAttributes
+			// the first decision of a retried run records the workflow start, since it may not be the second event
+			recordVisibility := b.msBuilder.isWorkflowBackoffPending()
 			di := b.msBuilder.ReplicateDecisionTaskScheduledEvent(event.GetVersion(), event.GetEventId(),
 				attributes.TaskList.GetName(), attributes.GetStartToCloseTimeoutSeconds())
 
 			b.transferTasks = append(b.transferTasks, b.scheduleDecisionTransferTask(domainID, b.getTaskList(b.msBuilder),
-				di.ScheduleID))
+				di.ScheduleID, recordVisibility))
 			// since we do not use stickyness on the standby side, there shall be no decision schedule to start timeout
 
 			lastDecision = di
@@ -247,7 +249,7 @@ func (b *stateBuilder) applyEvents(version int64, sourceClusterName string, doma
 			// No mutable state action is needed
 
 		case shared.EventTypeWorkflowExecutionSignaled:
-			// No mutable state action is needed
+			b.msBuilder.ReplicateWorkflowExecutionSignaled(event)
 
 		case shared.EventTypeWorkflowExecutionCancelRequested:
 			b.msBuilder.ReplicateWorkflowExecutionCancelRequestedEvent(event)
@@ -299,9 +301,21 @@ func (b *stateBuilder) applyEvents(version int64, sourceClusterName string, doma
 
 		case shared.EventTypeWorkflowExecutionContinuedAsNew:
 			// ContinuedAsNew event also has history for first 2 events for next run as they are created transactionally
+			// the decision scheduled event is missing when the first decision of the next run is delayed by a backoff
+			if newRunHistory == nil {
+				// an imported run is applied without its next run, which is imported on its own
+				b.msBuilder.executionInfo.State = persistence.WorkflowStateCompleted
+				b.msBuilder.executionInfo.CloseStatus = persistence.WorkflowCloseStatusContinuedAsNew
+				b.transferTasks = append(b.transferTasks, b.scheduleDeleteHistoryTransferTask())
+				timerTask, err := b.scheduleDeleteHistoryTimerTask(event, domainID)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				b.timerTasks = append(b.timerTasks, timerTask)
+				break
+			}
 			startedEvent := newRunHistory.Events[0]
 			startedAttributes := startedEvent.WorkflowExecutionStartedEventAttributes
-			dtScheduledEvent := newRunHistory.Events[1]
 
 			// History event only have the parentDomainName.  Lookup the domain ID from cache
 			var parentDomainID *string
@@ -324,21 +338,37 @@ func (b *stateBuilder) applyEvents(version int64, sourceClusterName string, doma
 			newRunStateBuilder = newMutableStateBuilderWithReplicationState(b.shard.GetConfig(), b.logger, version)
 			newRunStateBuilder.ReplicateWorkflowExecutionStartedEvent(domainID, parentDomainID, newExecution, uuid.New(),
 				startedAttributes)
-			di := newRunStateBuilder.ReplicateDecisionTaskScheduledEvent(
-				dtScheduledEvent.GetVersion(),
-				dtScheduledEvent.GetEventId(),
-				dtScheduledEvent.DecisionTaskScheduledEventAttributes.TaskList.GetName(),
-				dtScheduledEvent.DecisionTaskScheduledEventAttributes.GetStartToCloseTimeoutSeconds(),
-			)
-			nextEventID := di.ScheduleID + 1
+			var di *decisionInfo
+			nextEventID := startedEvent.GetEventId() + 1
+			if len(newRunHistory.Events) > 1 {
+				dtScheduledEvent := newRunHistory.Events[1]
+				di = newRunStateBuilder.ReplicateDecisionTaskScheduledEvent(
+					dtScheduledEvent.GetVersion(),
+					dtScheduledEvent.GetEventId(),
+					dtScheduledEvent.DecisionTaskScheduledEventAttributes.TaskList.GetName(),
+					dtScheduledEvent.DecisionTaskScheduledEventAttributes.GetStartToCloseTimeoutSeconds(),
+				)
+				nextEventID = di.ScheduleID + 1
+			}
 			newRunStateBuilder.executionInfo.NextEventID = nextEventID
 			newRunStateBuilder.executionInfo.LastFirstEventID = startedEvent.GetEventId()
 			// Set the history from replication task on the newStateBuilder
 			newRunStateBuilder.hBuilder = newHistoryBuilderFromEvents(newRunHistory.Events, b.logger)
 
-			b.newRunTransferTasks = append(b.newRunTransferTasks, b.scheduleDecisionTransferTask(domainID,
-				b.getTaskList(newRunStateBuilder), di.ScheduleID))
-			b.newRunTimerTasks = append(b.newRunTimerTasks, b.scheduleWorkflowTimerTask(event, newRunStateBuilder))
+			backoffDuration := time.Duration(startedAttributes.GetFirstDecisionTaskBackoffSeconds()) * time.Second
+			if di != nil {
+				b.newRunTransferTasks = append(b.newRunTransferTasks, b.scheduleDecisionTransferTask(domainID,
+					b.getTaskList(newRunStateBuilder), di.ScheduleID, false))
+			} else {
+				b.newRunTimerTasks = append(b.newRunTimerTasks, &persistence.WorkflowBackoffTimerTask{
+					VisibilityTimestamp: time.Unix(0, startedEvent.GetTimestamp()).Add(backoffDuration),
+				})
+			}
+			// the workflow timeout of the next run starts after its backoff
+			workflowTimeout := time.Duration(newRunStateBuilder.executionInfo.WorkflowTimeout) * time.Second
+			b.newRunTimerTasks = append(b.newRunTimerTasks, &persistence.WorkflowTimeoutTask{
+				VisibilityTimestamp: time.Unix(0, event.GetTimestamp()).Add(backoffDuration + workflowTimeout),
+			})
 
 			b.msBuilder.ReplicateWorkflowExecutionContinuedAsNewEvent(sourceClusterName, domainID, event,
 				startedEvent, di, newRunStateBuilder)
@@ -356,11 +386,12 @@ func (b *stateBuilder) applyEvents(version int64, sourceClusterName string, doma
 }
 
 func (b *stateBuilder) scheduleDecisionTransferTask(domainID string, tasklist string,
-	scheduleID int64) persistence.Task {
+	scheduleID int64, recordVisibility bool) persistence.Task {
 	return &persistence.DecisionTask{
-		DomainID:   domainID,
-		TaskList:   tasklist,
-		ScheduleID: scheduleID,
+		DomainID:         domainID,
+		TaskList:         tasklist,
+		ScheduleID:       scheduleID,
+		RecordVisibility: recordVisibility,
 	}
 }
 
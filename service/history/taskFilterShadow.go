@@ -0,0 +1,136 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// taskFilterShadow runs a candidate task filter next to the filter in use. The
+	// result of the candidate is only compared and reported, it never decides
+	// whether a task gets processed, so new filter logic can be rolled out safely
+	taskFilterShadow struct {
+		shadowMode    dynamicconfig.BoolPropertyFn
+		metricsClient metrics.Client
+		scope         int
+		logger        bark.Logger
+	}
+)
+
+func newTaskFilterShadow(shadowMode dynamicconfig.BoolPropertyFn, metricsClient metrics.Client, scope int,
+	logger bark.Logger) *taskFilterShadow {
+	return &taskFilterShadow{
+		shadowMode:    shadowMode,
+		metricsClient: metricsClient,
+		scope:         scope,
+		logger:        logger,
+	}
+}
+
+// transferTaskFilter returns primary unchanged if there is no candidate
+func (s *taskFilterShadow) transferTaskFilter(primary transferTaskFilter, candidate transferTaskFilter) transferTaskFilter {
+	if candidate == nil {
+		return primary
+	}
+	return func(task *persistence.TransferTaskInfo) (bool, error) {
+		ok, err := primary(task)
+		if err == nil && s.shadowMode() {
+			s.compare(task.DomainID, task.TaskID, task.TaskType, ok, func() (bool, error) {
+				return candidate(task)
+			})
+		}
+		return ok, err
+	}
+}
+
+// timerTaskFilter returns primary unchanged if there is no candidate
+func (s *taskFilterShadow) timerTaskFilter(primary timerTaskFilter, candidate timerTaskFilter) timerTaskFilter {
+	if candidate == nil {
+		return primary
+	}
+	return func(timer *persistence.TimerTaskInfo) (bool, error) {
+		ok, err := primary(timer)
+		if err == nil && s.shadowMode() {
+			s.compare(timer.DomainID, timer.TaskID, timer.TaskType, ok, func() (bool, error) {
+				return candidate(timer)
+			})
+		}
+		return ok, err
+	}
+}
+
+func (s *taskFilterShadow) compare(domainID string, taskID int64, taskType int, expected bool,
+	candidate func() (bool, error)) {
+	actual, err := candidate()
+	if err != nil {
+		s.metricsClient.IncCounter(s.scope, metrics.TaskFilterShadowErrorCounter)
+		s.logger.WithFields(bark.Fields{
+			logging.TagDomainID: domainID,
+			logging.TagTaskID:   taskID,
+			logging.TagTaskType: taskType,
+			logging.TagErr:      err,
+		}).Warn("Shadow task filter failed.")
+		return
+	}
+	if actual != expected {
+		s.metricsClient.IncCounter(s.scope, metrics.TaskFilterShadowDivergenceCounter)
+		s.logger.WithFields(bark.Fields{
+			logging.TagDomainID: domainID,
+			logging.TagTaskID:   taskID,
+			logging.TagTaskType: taskType,
+		}).Warnf("Shadow task filter diverged, filter: %v, shadow filter: %v.", expected, actual)
+	}
+}
+
+// newCandidateTransferTaskFilter creates the transfer task filter evaluated in shadow mode next to the one of the
+// active processor. It leaves deciding whether the domain of a task is active to the domain cache entry instead of
+// comparing cluster names in the processor. Replace it to roll out another filter change.
+func newCandidateTransferTaskFilter(domainCache cache.DomainCache) transferTaskFilter {
+	return func(task *persistence.TransferTaskInfo) (bool, error) {
+		return isTaskDomainActive(domainCache, task.DomainID)
+	}
+}
+
+// newCandidateTimerTaskFilter is the timer counterpart of newCandidateTransferTaskFilter
+func newCandidateTimerTaskFilter(domainCache cache.DomainCache) timerTaskFilter {
+	return func(timer *persistence.TimerTaskInfo) (bool, error) {
+		return isTaskDomainActive(domainCache, timer.DomainID)
+	}
+}
+
+func isTaskDomainActive(domainCache cache.DomainCache, domainID string) (bool, error) {
+	domainEntry, err := domainCache.GetDomainByID(domainID)
+	if err != nil {
+		// a deleted domain is treated as active, as the filters in use do
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return domainEntry.IsDomainActive(), nil
+}
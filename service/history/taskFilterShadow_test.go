@@ -0,0 +1,162 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	taskFilterShadowSuite struct {
+		suite.Suite
+		scope      tally.TestScope
+		logHook    *test.Hook
+		shadowMode bool
+		shadow     *taskFilterShadow
+	}
+)
+
+func TestTaskFilterShadowSuite(t *testing.T) {
+	s := new(taskFilterShadowSuite)
+	suite.Run(t, s)
+}
+
+func (s *taskFilterShadowSuite) SetupTest() {
+	s.scope = tally.NewTestScope("test", nil)
+	s.shadowMode = true
+	shadowMode := func(...dynamicconfig.FilterOption) bool { return s.shadowMode }
+	var logger *logrus.Logger
+	logger, s.logHook = test.NewNullLogger()
+	s.shadow = newTaskFilterShadow(shadowMode, metrics.NewClient(s.scope, metrics.History),
+		metrics.TransferQueueProcessorScope, bark.NewLoggerFromLogrus(logger))
+}
+
+func (s *taskFilterShadowSuite) TestNoCandidate() {
+	primary := func(task *persistence.TransferTaskInfo) (bool, error) { return true, nil }
+	filter := s.shadow.transferTaskFilter(primary, nil)
+	ok, err := filter(&persistence.TransferTaskInfo{})
+	s.Nil(err)
+	s.True(ok)
+	s.Equal(int64(0), s.counter("task-filter-shadow-divergence"))
+}
+
+func (s *taskFilterShadowSuite) TestDivergence() {
+	candidateCalls := 0
+	primary := func(task *persistence.TransferTaskInfo) (bool, error) { return task.TaskID%2 == 0, nil }
+	candidate := func(task *persistence.TransferTaskInfo) (bool, error) {
+		candidateCalls++
+		return false, nil
+	}
+	filter := s.shadow.transferTaskFilter(primary, candidate)
+
+	// the candidate never changes the outcome
+	ok, err := filter(&persistence.TransferTaskInfo{TaskID: 2})
+	s.Nil(err)
+	s.True(ok)
+	ok, err = filter(&persistence.TransferTaskInfo{TaskID: 3})
+	s.Nil(err)
+	s.False(ok)
+	s.Equal(2, candidateCalls)
+	s.Equal(int64(1), s.counter("task-filter-shadow-divergence"))
+	s.Equal(1, len(s.logHook.Entries))
+	s.Equal(logrus.WarnLevel, s.logHook.LastEntry().Level)
+	s.Equal(int64(3), s.logHook.LastEntry().Data[logging.TagTaskID])
+
+	s.shadowMode = false
+	ok, err = filter(&persistence.TransferTaskInfo{TaskID: 4})
+	s.Nil(err)
+	s.True(ok)
+	s.Equal(2, candidateCalls)
+}
+
+func (s *taskFilterShadowSuite) TestCandidateError() {
+	primary := func(timer *persistence.TimerTaskInfo) (bool, error) { return true, nil }
+	candidate := func(timer *persistence.TimerTaskInfo) (bool, error) { return false, errors.New("candidate failed") }
+	filter := s.shadow.timerTaskFilter(primary, candidate)
+
+	ok, err := filter(&persistence.TimerTaskInfo{TaskID: 1})
+	s.Nil(err)
+	s.True(ok)
+	s.Equal(int64(1), s.counter("task-filter-shadow-errors"))
+	s.Equal(int64(0), s.counter("task-filter-shadow-divergence"))
+}
+
+func (s *taskFilterShadowSuite) TestCandidateFilters() {
+	metadataMgr := &mocks.MetadataManager{}
+	clusterMetadata := &mocks.ClusterMetadata{}
+	clusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	clusterMetadata.On("IsGlobalDomainEnabled").Return(true)
+	for id, activeClusterName := range map[string]string{
+		"active-domain":  cluster.TestCurrentClusterName,
+		"standby-domain": cluster.TestAlternativeClusterName,
+	} {
+		metadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: id}).Return(&persistence.GetDomainResponse{
+			Info:              &persistence.DomainInfo{ID: id, Name: id},
+			Config:            &persistence.DomainConfig{Retention: 1},
+			IsGlobalDomain:    true,
+			ReplicationConfig: &persistence.DomainReplicationConfig{ActiveClusterName: activeClusterName},
+		}, nil)
+	}
+	metadataMgr.On("GetDomain", mock.Anything).Return(nil, &workflow.EntityNotExistsError{})
+	domainCache := cache.NewDomainCache(metadataMgr, clusterMetadata, bark.NewLoggerFromLogrus(logrus.New()))
+
+	transferFilter := newCandidateTransferTaskFilter(domainCache)
+	timerFilter := newCandidateTimerTaskFilter(domainCache)
+	for domainID, expected := range map[string]bool{
+		"active-domain":  true,
+		"standby-domain": false,
+		"deleted-domain": true,
+	} {
+		ok, err := transferFilter(&persistence.TransferTaskInfo{DomainID: domainID})
+		s.Nil(err)
+		s.Equal(expected, ok, domainID)
+		ok, err = timerFilter(&persistence.TimerTaskInfo{DomainID: domainID})
+		s.Nil(err)
+		s.Equal(expected, ok, domainID)
+	}
+}
+
+func (s *taskFilterShadowSuite) counter(name string) int64 {
+	// counters are registered once per scope, sum them up
+	total := int64(0)
+	for _, c := range s.scope.Snapshot().Counters() {
+		if c.Name() == "test."+name {
+			total += c.Value()
+		}
+	}
+	return total
+}
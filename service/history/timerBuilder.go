@@ -179,8 +179,10 @@ func (tb *timerBuilder) AddActivityTimeoutTask(scheduleID int64,
 	return timeOutTask
 }
 
-// AddUserTimer - Adds an user timeout request.
+// AddUserTimer - Adds an user timeout request. The expiry time of the timer is adjusted to the configured minimum
+// duration and resolution of user timers.
 func (tb *timerBuilder) AddUserTimer(ti *persistence.TimerInfo, msBuilder *mutableStateBuilder) {
+	ti.ExpiryTime = tb.adjustUserTimerExpiry(ti.ExpiryTime)
 	if !tb.isLoadedUserTimers {
 		tb.loadUserTimers(msBuilder)
 	}
@@ -372,6 +374,20 @@ func (tb *timerBuilder) createActivityTimeoutTask(fireTimeOut int32, timeoutType
 	}
 }
 
+func (tb *timerBuilder) adjustUserTimerExpiry(expiry time.Time) time.Time {
+	minExpiry := tb.timeSource.Now().Add(tb.config.MinUserTimerDuration())
+	if expiry.Before(minExpiry) {
+		expiry = minExpiry
+	}
+	// round up so that timers expiring close to each other fire together
+	if resolution := tb.config.UserTimerResolution(); resolution > 0 {
+		if rounded := expiry.Truncate(resolution); rounded.Before(expiry) {
+			expiry = rounded.Add(resolution)
+		}
+	}
+	return expiry
+}
+
 func (tb *timerBuilder) loadUserTimer(expires time.Time, timerID string, taskCreated bool) (*timerDetails, bool) {
 	seqNum := tb.localSeqNumGen.NextSeq()
 	timer := &timerDetails{
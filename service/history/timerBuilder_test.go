@@ -160,6 +160,39 @@ func (s *timerBuilderProcessorSuite) TestTimerBuilderMulitpleUserTimer() {
 	s.Equal(int64(203), ti.StartedID)
 }
 
+func (s *timerBuilderProcessorSuite) TestTimerBuilderUserTimerMinDurationAndResolution() {
+	config := NewConfig(dynamicconfig.NewNopCollection(), 1)
+	config.MinUserTimerDuration = func(...dynamicconfig.FilterOption) time.Duration { return 5 * time.Second }
+	now := time.Now()
+	tb := newTimerBuilder(config, s.logger, &mockTimeSource{currTime: now})
+
+	msb := newMutableStateBuilder(config, s.logger)
+	msb.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(201)},
+		TimerInfos:    make(map[string]*persistence.TimerInfo),
+	})
+	_, ti1 := msb.AddTimerStartedEvent(int64(3), &workflow.StartTimerDecisionAttributes{
+		TimerId:                   common.StringPtr("tid1"),
+		StartToFireTimeoutSeconds: common.Int64Ptr(1),
+	})
+	tb.AddUserTimer(ti1, msb)
+	s.Equal(now.Add(5*time.Second), ti1.ExpiryTime)
+	t1 := tb.GetUserTimerTaskIfNeeded(msb)
+	s.NotNil(t1)
+	s.Equal(ti1.ExpiryTime, t1.(*persistence.UserTimerTask).VisibilityTimestamp)
+
+	config.UserTimerResolution = func(...dynamicconfig.FilterOption) time.Duration { return 10 * time.Second }
+	_, ti2 := msb.AddTimerStartedEvent(int64(3), &workflow.StartTimerDecisionAttributes{
+		TimerId:                   common.StringPtr("tid2"),
+		StartToFireTimeoutSeconds: common.Int64Ptr(15),
+	})
+	expiry := ti2.ExpiryTime
+	tb.AddUserTimer(ti2, msb)
+	s.Equal(ti2.ExpiryTime.Truncate(10*time.Second), ti2.ExpiryTime)
+	s.False(ti2.ExpiryTime.Before(expiry))
+	s.True(ti2.ExpiryTime.Before(expiry.Add(10 * time.Second)))
+}
+
 func (s *timerBuilderProcessorSuite) TestTimerBuilderDuplicateTimerID() {
 	tp := &persistence.TimerInfo{TimerID: "tid-exist", StartedID: 201, TaskID: 101, ExpiryTime: time.Now().Add(10 * time.Second)}
 	timerInfos := map[string]*persistence.TimerInfo{"tid-exist": tp}
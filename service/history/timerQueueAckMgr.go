@@ -229,6 +229,18 @@ func (t *timerQueueAckMgrImpl) getAckLevel() TimerSequenceID {
 	return t.ackLevel
 }
 
+func (t *timerQueueAckMgrImpl) getOutstandingTaskCount() int {
+	t.Lock()
+	defer t.Unlock()
+	count := 0
+	for _, acked := range t.outstandingTasks {
+		if !acked {
+			count++
+		}
+	}
+	return count
+}
+
 func (t *timerQueueAckMgrImpl) updateAckLevel() {
 	t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.AckLevelUpdateCounter)
 
@@ -74,6 +74,9 @@ func newTimerQueueActiveProcessor(shard ShardContext, historyService *historyEng
 		}
 		return true, nil
 	}
+	filterShadow := newTaskFilterShadow(shard.GetConfig().TaskFilterShadowMode, historyService.metricsClient,
+		metrics.TimerQueueProcessorScope, logger)
+	timerTaskFilter = filterShadow.timerTaskFilter(timerTaskFilter, historyService.shadowTimerTaskFilter)
 
 	timerGate := NewLocalTimerGate()
 	// this will trigger a timer gate fire event immediately
@@ -142,6 +145,10 @@ func (t *timerQueueActiveProcessorImpl) getTimerFiredCount() uint64 {
 	return t.timerQueueProcessorBase.getTimerFiredCount()
 }
 
+func (t *timerQueueActiveProcessorImpl) getOutstandingTaskCount() int {
+	return t.timerQueueProcessorBase.getOutstandingTaskCount()
+}
+
 func (t *timerQueueActiveProcessorImpl) getTimerGate() TimerGate {
 	return t.timerGate
 }
@@ -188,6 +195,10 @@ func (t *timerQueueActiveProcessorImpl) process(timerTask *persistence.TimerTask
 		scope = metrics.TimerTaskRetryTimerScope
 		err = t.processRetryTimer(timerTask)
 
+	case persistence.TaskTypeWorkflowBackoffTimer:
+		scope = metrics.TimerTaskWorkflowBackoffTimerScope
+		err = t.processWorkflowBackoffTimer(timerTask)
+
 	case persistence.TaskTypeDeleteHistoryEvent:
 		scope = metrics.TimerTaskDeleteHistoryEvent
 		err = t.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
@@ -233,6 +244,12 @@ Update_History_Loop:
 			// Workflow is completed.
 			return nil
 		}
+		if msBuilder.isPaused() {
+			// Timers of a paused workflow execution are held, resuming it recreates the timer tasks dropped here and
+			// the ones which expired in the meantime fire right away. The same goes for activity and decision
+			// timeouts and activity retries below.
+			return nil
+		}
 
 		var timerTasks []persistence.Task
 		scheduleNewDecision := false
@@ -313,8 +330,8 @@ Update_History_Loop:
 			continue Update_History_Loop
 		}
 
-		if !msBuilder.isWorkflowExecutionRunning() {
-			// Workflow is completed.
+		if !msBuilder.isWorkflowExecutionRunning() || msBuilder.isPaused() {
+			// Workflow is completed or paused.
 			return nil
 		}
 
@@ -360,7 +377,7 @@ Update_History_Loop:
 				if timeoutType != workflow.TimeoutTypeScheduleToStart {
 					// ScheduleToStart (queue timeout) is not retriable. Instead of retry, customer should set larger
 					// ScheduleToStart timeout.
-					retryTask := msBuilder.CreateRetryTimer(ai, getTimeoutErrorReason(timeoutType))
+					retryTask := msBuilder.CreateRetryTimer(ai, getTimeoutErrorReason(timeoutType), getTimeoutFailureTypes(timeoutType))
 					if retryTask != nil {
 						timerTasks = append(timerTasks, retryTask)
 						createNewTimer = true
@@ -471,7 +488,7 @@ Update_History_Loop:
 		if err1 != nil {
 			return err1
 		}
-		if !msBuilder.isWorkflowExecutionRunning() {
+		if !msBuilder.isWorkflowExecutionRunning() || msBuilder.isPaused() {
 			return nil
 		}
 
@@ -498,6 +515,7 @@ Update_History_Loop:
 		}
 
 		scheduleNewDecision := false
+		stickyFallback := false
 		switch task.TimeoutType {
 		case int(workflow.TimeoutTypeStartToClose):
 			t.metricsClient.IncCounter(metrics.TimerTaskDecisionTimeoutScope, metrics.StartToCloseTimeoutCounter)
@@ -519,6 +537,7 @@ Update_History_Loop:
 
 				// reschedule decision, which will be on its original task list
 				scheduleNewDecision = true
+				stickyFallback = true
 			}
 		}
 
@@ -530,6 +549,8 @@ Update_History_Loop:
 				if err == ErrConflict {
 					continue Update_History_Loop
 				}
+			} else if stickyFallback {
+				t.metricsClient.IncCounter(metrics.TimerTaskDecisionTimeoutScope, metrics.StickyDecisionFallbackCounter)
 			}
 			return err
 		}
@@ -560,7 +581,7 @@ func (t *timerQueueActiveProcessorImpl) processRetryTimer(task *persistence.Time
 			return err1
 		}
 
-		if !msBuilder.isWorkflowExecutionRunning() {
+		if !msBuilder.isWorkflowExecutionRunning() || msBuilder.isPaused() {
 			return nil
 		}
 
@@ -621,6 +642,71 @@ func (t *timerQueueActiveProcessorImpl) processRetryTimer(task *persistence.Time
 	return ErrMaxAttemptsExceeded
 }
 
+func (t *timerQueueActiveProcessorImpl) processWorkflowBackoffTimer(task *persistence.TimerTaskInfo) (retError error) {
+	t.metricsClient.IncCounter(metrics.TimerTaskWorkflowBackoffTimerScope, metrics.TaskRequests)
+	sw := t.metricsClient.StartTimer(metrics.TimerTaskWorkflowBackoffTimerScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	context, release, err0 := t.cache.getOrCreateWorkflowExecution(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(task))
+	if err0 != nil {
+		return err0
+	}
+	defer func() { release(retError) }()
+
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return err1
+		}
+
+		if !msBuilder.isWorkflowExecutionRunning() || !msBuilder.isWorkflowBackoffPending() {
+			// the first decision is already scheduled
+			return nil
+		}
+
+		ok, err := verifyTimerTaskVersion(t.shard, task.DomainID, msBuilder.GetStartVersion(), task)
+		if err != nil {
+			return err
+		} else if !ok {
+			return nil
+		}
+
+		// schedule the first decision of the run, which also records the workflow start on visibility
+		di := msBuilder.AddDecisionTaskScheduledEvent()
+		if di == nil {
+			return &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
+		}
+		transferTasks := []persistence.Task{&persistence.DecisionTask{
+			DomainID:         msBuilder.executionInfo.DomainID,
+			TaskList:         di.TaskList,
+			ScheduleID:       di.ScheduleID,
+			RecordVisibility: true,
+		}}
+
+		// Generate a transaction ID for appending events to history
+		transactionID, err1 := t.historyService.shard.GetNextTransferTaskID()
+		if err1 != nil {
+			return err1
+		}
+
+		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
+		// the history and try the operation again.
+		err = context.updateWorkflowExecution(transferTasks, nil, transactionID)
+		if err != nil {
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+			if isShardOwnershiptLostError(err) {
+				// Shard is stolen.  Stop timer processing to reduce duplicates
+				t.timerQueueProcessorBase.Stop()
+			}
+		}
+		return err
+	}
+	return ErrMaxAttemptsExceeded
+}
+
 func (t *timerQueueActiveProcessorImpl) processWorkflowTimeout(task *persistence.TimerTaskInfo) (retError error) {
 	t.metricsClient.IncCounter(metrics.TimerTaskWorkflowTimeoutScope, metrics.TaskRequests)
 	sw := t.metricsClient.StartTimer(metrics.TimerTaskWorkflowTimeoutScope, metrics.TaskLatency)
@@ -650,15 +736,23 @@ Update_History_Loop:
 			return nil
 		}
 
-		if e := msBuilder.AddTimeoutWorkflowEvent(); e == nil {
-			// If we failed to add the event that means the workflow is already completed.
-			// we drop this timeout event.
-			return nil
-		}
+		// a timed out run is retried by the retry policy the same way as a failed run
+		timeoutReason := getTimeoutErrorReason(workflow.TimeoutTypeStartToClose)
+		backoffInterval := getWorkflowBackoffInterval(msBuilder.executionInfo, timeoutReason,
+			t.shard.GetTimeSource().Now())
+		if backoffInterval == noRetryBackoff {
+			if e := msBuilder.AddTimeoutWorkflowEvent(); e == nil {
+				// If we failed to add the event that means the workflow is already completed.
+				// we drop this timeout event.
+				return nil
+			}
 
-		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
-		// the history and try the operation again.
-		err = t.updateWorkflowExecution(context, msBuilder, false, true, nil, nil)
+			// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
+			// the history and try the operation again.
+			err = t.updateWorkflowExecution(context, msBuilder, false, true, nil, nil)
+		} else {
+			err = t.retryWorkflowExecution(context, msBuilder, backoffInterval, timeoutReason)
+		}
 		if err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
@@ -669,6 +763,47 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
+// retryWorkflowExecution closes the run by continuing as new after the backoff of the retry policy
+func (t *timerQueueActiveProcessorImpl) retryWorkflowExecution(context *workflowExecutionContext,
+	msBuilder *mutableStateBuilder, backoffInterval time.Duration, failureReason string) error {
+	domainID := msBuilder.executionInfo.DomainID
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return err
+	}
+
+	newStateBuilder, newRunTimerTasks, err := t.historyService.retryWorkflowExecution(msBuilder, common.EmptyEventID,
+		domainEntry, backoffInterval, failureReason, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	tBuilder := t.historyService.getTimerBuilder(&context.workflowExecution)
+	tranT, timerT, err := t.historyService.getDeleteWorkflowTasks(domainID, tBuilder)
+	if err != nil {
+		return err
+	}
+	transferTasks := []persistence.Task{tranT}
+	timerTasks := []persistence.Task{timerT}
+
+	// Generate a transaction ID for appending events to history
+	transactionID, err := t.historyService.shard.GetNextTransferTaskID()
+	if err != nil {
+		return err
+	}
+
+	err = context.continueAsNewWorkflowExecution(nil, newStateBuilder, transferTasks, timerTasks, transactionID)
+	if err != nil {
+		if isShardOwnershiptLostError(err) {
+			// Shard is stolen.  Stop timer processing to reduce duplicates
+			t.timerQueueProcessorBase.Stop()
+		}
+		return err
+	}
+	t.notifyNewTimers(append(timerTasks, newRunTimerTasks...))
+	return nil
+}
+
 func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
 	context *workflowExecutionContext,
 	msBuilder *mutableStateBuilder,
@@ -143,6 +143,14 @@ func (t *timerQueueProcessorImpl) getTimerFiredCount(clusterName string) uint64
 	return standbyTimerProcessor.getTimerFiredCount()
 }
 
+func (t *timerQueueProcessorImpl) getOutstandingTaskCount() int {
+	count := t.activeTimerProcessor.getOutstandingTaskCount()
+	for _, standbyTimerProcessor := range t.standbyTimerProcessors {
+		count += standbyTimerProcessor.getOutstandingTaskCount()
+	}
+	return count
+}
+
 func (t *timerQueueProcessorImpl) completeTimersLoop() {
 	timer := time.NewTimer(t.config.TimerProcessorCompleteTimerInterval)
 	defer timer.Stop()
@@ -300,3 +300,92 @@ func (s *timerQueueProcessor2Suite) TestWorkflowTimeout() {
 	<-waitCh
 	s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.Stop()
 }
+
+func (s *timerQueueProcessor2Suite) TestWorkflowTimeout_Retry() {
+	domainID := testDomainActiveID
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("workflow-timesout-retry-test"),
+		RunId: common.StringPtr(validRunID)}
+	taskList := "task-workflow-times-out-retry"
+
+	builder := newMutableStateBuilder(s.config, s.logger)
+	startRequest := &workflow.StartWorkflowExecutionRequest{
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+		TaskList:                            common.TaskListPtr(workflow.TaskList{Name: common.StringPtr(taskList)}),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RetryPolicy: &workflow.RetryPolicy{
+			InitialIntervalInSeconds: common.Int32Ptr(1),
+			BackoffCoefficient:       common.Float64Ptr(1),
+			MaximumAttempts:          common.Int32Ptr(3),
+		},
+	}
+	builder.AddWorkflowExecutionStartedEvent(we, &history.StartWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		StartRequest: startRequest,
+	})
+
+	di := addDecisionTaskScheduledEvent(builder)
+	addDecisionTaskStartedEvent(builder, di.ScheduleID, taskList, uuid.New())
+
+	waitCh := make(chan struct{})
+
+	mockTS := &mockTimeSource{currTime: time.Now()}
+
+	taskID := int64(100)
+	timerTask := &persistence.TimerTaskInfo{
+		DomainID:            domainID,
+		WorkflowID:          "wid",
+		RunID:               validRunID,
+		TaskID:              taskID,
+		TaskType:            persistence.TaskTypeWorkflowTimeout,
+		VisibilityTimestamp: mockTS.Now(),
+		EventID:             di.ScheduleID}
+	timerIndexResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{timerTask}}
+
+	ms := createMutableState(builder)
+	wfResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(wfResponse, nil).Once()
+
+	// the retry reads the input of the run from its started event
+	serializedHistory, err := builder.hBuilder.Serialize()
+	s.Nil(err)
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+
+	// one append for the history of the new run and one for the continued as new event
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Run(func(arguments mock.Arguments) {
+		updateRequest = arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+		// Done.
+		waitCh <- struct{}{}
+	}).Once()
+
+	// Start timer Processor.
+	emptyResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{}}
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(emptyResponse, nil).Run(func(arguments mock.Arguments) {
+		// Done.
+		waitCh <- struct{}{}
+	}).Once()
+	s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.Start()
+	<-waitCh
+
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(timerIndexResponse, nil)
+	s.mockHistoryEngine.timerProcessor.NotifyNewTimers(
+		cluster.TestCurrentClusterName,
+		s.mockShard.GetCurrentTime(cluster.TestCurrentClusterName),
+		[]persistence.Task{&persistence.WorkflowTimeoutTask{
+			VisibilityTimestamp: timerTask.VisibilityTimestamp,
+		}})
+
+	<-waitCh
+	s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.Stop()
+
+	// the timed out run is continued as new with the next attempt, like a failed run
+	s.Equal(persistence.WorkflowCloseStatusContinuedAsNew, updateRequest.ExecutionInfo.CloseStatus)
+	s.NotNil(updateRequest.ContinueAsNew)
+	s.Equal(int32(1), updateRequest.ContinueAsNew.Attempt)
+	s.Equal(common.EmptyEventID, updateRequest.ContinueAsNew.DecisionScheduleID)
+}
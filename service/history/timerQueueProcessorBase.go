@@ -234,6 +234,8 @@ func (t *timerQueueProcessorBase) notifyNewTimers(timerTasks []persistence.Task,
 			t.metricsClient.IncCounter(metrics.TimerTaskDeleteHistoryEvent, counterType)
 		case persistence.TaskTypeRetryTimer:
 			t.metricsClient.IncCounter(metrics.TimerTaskRetryTimerScope, counterType)
+		case persistence.TaskTypeWorkflowBackoffTimer:
+			t.metricsClient.IncCounter(metrics.TimerTaskWorkflowBackoffTimerScope, counterType)
 			// TODO add default
 		}
 	}
@@ -362,6 +364,10 @@ func (t *timerQueueProcessorBase) getTimerFiredCount() uint64 {
 	return atomic.LoadUint64(&t.timerFiredCount)
 }
 
+func (t *timerQueueProcessorBase) getOutstandingTaskCount() int {
+	return t.timerQueueAckMgr.getOutstandingTaskCount()
+}
+
 func (t *timerQueueProcessorBase) getDomainIDAndWorkflowExecution(task *persistence.TimerTaskInfo) (string, workflow.WorkflowExecution) {
 	return task.DomainID, workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
@@ -430,6 +436,8 @@ func (t *timerQueueProcessorBase) getTimerTaskType(taskType int) string {
 		return "DeleteHistoryEvent"
 	case persistence.TaskTypeRetryTimer:
 		return "RetryTimerTask"
+	case persistence.TaskTypeWorkflowBackoffTimer:
+		return "WorkflowBackoffTimer"
 	}
 	return "UnKnown"
 }
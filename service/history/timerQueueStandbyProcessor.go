@@ -102,6 +102,10 @@ func (t *timerQueueStandbyProcessorImpl) getTimerFiredCount() uint64 {
 	return t.timerQueueProcessorBase.getTimerFiredCount()
 }
 
+func (t *timerQueueStandbyProcessorImpl) getOutstandingTaskCount() int {
+	return t.timerQueueProcessorBase.getOutstandingTaskCount()
+}
+
 func (t *timerQueueStandbyProcessorImpl) getTimerGate() TimerGate {
 	return t.timerGate
 }
@@ -156,6 +160,10 @@ func (t *timerQueueStandbyProcessorImpl) process(timerTask *persistence.TimerTas
 		scope = metrics.TimerTaskRetryTimerScope
 		err = nil // retry backoff timer should not get created on passive cluster
 
+	case persistence.TaskTypeWorkflowBackoffTimer:
+		scope = metrics.TimerTaskWorkflowBackoffTimerScope
+		err = t.processWorkflowBackoffTimer(timerTask)
+
 	case persistence.TaskTypeDeleteHistoryEvent:
 		scope = metrics.TimerTaskDeleteHistoryEvent
 		err = t.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
@@ -307,6 +315,30 @@ func (t *timerQueueStandbyProcessorImpl) processWorkflowTimeout(timerTask *persi
 	})
 }
 
+func (t *timerQueueStandbyProcessorImpl) processWorkflowBackoffTimer(timerTask *persistence.TimerTaskInfo) error {
+	t.metricsClient.IncCounter(metrics.TimerTaskWorkflowBackoffTimerScope, metrics.TaskRequests)
+	sw := t.metricsClient.StartTimer(metrics.TimerTaskWorkflowBackoffTimerScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	return t.processTimer(timerTask, func(msBuilder *mutableStateBuilder) error {
+		if !msBuilder.isWorkflowBackoffPending() {
+			return nil
+		}
+
+		ok, err := verifyTimerTaskVersion(t.shard, timerTask.DomainID, msBuilder.GetStartVersion(), timerTask)
+		if err != nil {
+			return err
+		} else if !ok {
+			return nil
+		}
+
+		// active cluster will schedule the first decision of the run
+		// standby cluster should just call ack manager to retry this task
+		// since we are stilling waiting for the decision scheduled event to be replicated
+		return ErrTaskRetry
+	})
+}
+
 func (t *timerQueueStandbyProcessorImpl) processTimer(timerTask *persistence.TimerTaskInfo, fn func(*mutableStateBuilder) error) (retError error) {
 	context, release, err := t.cache.getOrCreateWorkflowExecution(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(timerTask))
 	if err != nil {
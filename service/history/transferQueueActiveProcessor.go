@@ -102,6 +102,9 @@ func newTransferQueueActiveProcessor(shard ShardContext, historyService *history
 		}
 		return true, nil
 	}
+	filterShadow := newTaskFilterShadow(config.TaskFilterShadowMode, historyService.metricsClient,
+		metrics.TransferQueueProcessorScope, logger)
+	transferTaskFilter = filterShadow.transferTaskFilter(transferTaskFilter, historyService.shadowTransferTaskFilter)
 	maxReadAckLevel := func() int64 {
 		return shard.GetTransferMaxReadLevel()
 	}
@@ -319,6 +322,10 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence
 	} else if !ok {
 		return nil
 	}
+	if msBuilder.isPaused() {
+		// the activity task is regenerated when the workflow execution is resumed
+		return nil
+	}
 
 	timeout := ai.ScheduleToStartTimeout
 	// release the context lock since we no longer need mutable state builder and
@@ -383,6 +390,9 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	decisionTimeout := workflowTimeout
 	wfTypeName := msBuilder.executionInfo.WorkflowTypeName
 	startTimestamp := msBuilder.executionInfo.StartTimestamp
+	initiator := getWorkflowExecutionInitiator(msBuilder.executionInfo.Initiator)
+	// the decision task of a paused workflow execution is regenerated when it is resumed, visibility is still recorded
+	isPaused := msBuilder.isPaused()
 	if msBuilder.isStickyTaskListEnabled() {
 		taskList.Name = common.StringPtr(msBuilder.executionInfo.StickyTaskList)
 		taskList.Kind = common.TaskListKindPtr(workflow.TaskListKindSticky)
@@ -392,20 +402,22 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	err = t.matchingClient.AddDecisionTask(nil, &m.AddDecisionTaskRequest{
-		DomainUUID:                    common.StringPtr(domainID),
-		Execution:                     &execution,
-		TaskList:                      taskList,
-		ScheduleId:                    &task.ScheduleID,
-		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionTimeout),
-	})
+	if !isPaused {
+		err = t.matchingClient.AddDecisionTask(nil, &m.AddDecisionTaskRequest{
+			DomainUUID:                    common.StringPtr(domainID),
+			Execution:                     &execution,
+			TaskList:                      taskList,
+			ScheduleId:                    &task.ScheduleID,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionTimeout),
+		})
 
-	if err != nil {
-		return err
+		if err != nil {
+			return err
+		}
 	}
 
-	if task.ScheduleID == common.FirstEventID+1 {
-		err = t.recordWorkflowExecutionStarted(execution, task, wfTypeName, startTimestamp, workflowTimeout)
+	if task.ScheduleID == common.FirstEventID+1 || task.RecordVisibility {
+		err = t.recordWorkflowExecutionStarted(execution, task, wfTypeName, startTimestamp, workflowTimeout, initiator)
 	}
 
 	if err != nil {
@@ -466,6 +478,7 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 	workflowCloseTimestamp := msBuilder.getLastUpdatedTimestamp()
 	workflowCloseStatus := getWorkflowExecutionCloseStatus(msBuilder.executionInfo.CloseStatus)
 	workflowHistoryLength := msBuilder.GetNextEventID()
+	workflowInitiator := getWorkflowExecutionInitiator(msBuilder.executionInfo.Initiator)
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
@@ -518,6 +531,7 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 		Status:           workflowCloseStatus,
 		HistoryLength:    workflowHistoryLength,
 		RetentionSeconds: retentionSeconds,
+		Initiator:        workflowInitiator,
 	})
 }
 
@@ -918,7 +932,7 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 
 func (t *transferQueueActiveProcessorImpl) recordWorkflowExecutionStarted(
 	execution workflow.WorkflowExecution, task *persistence.TransferTaskInfo, wfTypeName string,
-	startTimestamp time.Time, timeout int32,
+	startTimestamp time.Time, timeout int32, initiator *workflow.WorkflowExecutionInitiator,
 ) error {
 	err := t.visibilityManager.RecordWorkflowExecutionStarted(&persistence.RecordWorkflowExecutionStartedRequest{
 		DomainUUID:       task.DomainID,
@@ -926,6 +940,7 @@ func (t *transferQueueActiveProcessorImpl) recordWorkflowExecutionStarted(
 		WorkflowTypeName: wfTypeName,
 		StartTimestamp:   startTimestamp.UnixNano(),
 		WorkflowTimeout:  int64(timeout),
+		Initiator:        initiator,
 	})
 
 	return err
@@ -1178,6 +1193,19 @@ func (t *transferQueueActiveProcessorImpl) SignalExecutionWithRetry(signalReques
 	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
 
+func getWorkflowExecutionState(state int) workflow.WorkflowExecutionState {
+	switch state {
+	case persistence.WorkflowStateCreated:
+		return workflow.WorkflowExecutionStateCreated
+	case persistence.WorkflowStateRunning:
+		return workflow.WorkflowExecutionStateRunning
+	case persistence.WorkflowStateCompleted:
+		return workflow.WorkflowExecutionStateCompleted
+	default:
+		panic("Invalid value for enum WorkflowExecutionState")
+	}
+}
+
 func getWorkflowExecutionCloseStatus(status int) workflow.WorkflowExecutionCloseStatus {
 	switch status {
 	case persistence.WorkflowCloseStatusCompleted:
@@ -1196,3 +1224,19 @@ func getWorkflowExecutionCloseStatus(status int) workflow.WorkflowExecutionClose
 		panic("Invalid value for enum WorkflowExecutionCloseStatus")
 	}
 }
+
+func getWorkflowExecutionInitiator(initiator int) *workflow.WorkflowExecutionInitiator {
+	switch initiator {
+	case persistence.WorkflowInitiatorClient:
+		return workflow.WorkflowExecutionInitiatorClient.Ptr()
+	case persistence.WorkflowInitiatorParentWorkflow:
+		return workflow.WorkflowExecutionInitiatorParentWorkflow.Ptr()
+	case persistence.WorkflowInitiatorContinueAsNew:
+		return workflow.WorkflowExecutionInitiatorContinueAsNew.Ptr()
+	case persistence.WorkflowInitiatorRetryPolicy:
+		return workflow.WorkflowExecutionInitiatorRetryPolicy.Ptr()
+	default:
+		// runs started before the initiator was recorded
+		return nil
+	}
+}
@@ -1351,6 +1351,7 @@ func (s *transferQueueActiveProcessorSuite) createRecordWorkflowExecutionStarted
 		WorkflowTypeName: msBuilder.executionInfo.WorkflowTypeName,
 		StartTimestamp:   msBuilder.executionInfo.StartTimestamp.UnixNano(),
 		WorkflowTimeout:  int64(msBuilder.executionInfo.WorkflowTimeout),
+		Initiator:        workflow.WorkflowExecutionInitiatorClient.Ptr(),
 	}
 }
 
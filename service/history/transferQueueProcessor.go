@@ -143,6 +143,14 @@ func (t *transferQueueProcessorImpl) FailoverDomain(domainID string, standbyClus
 	failoverTaskProcessor.notifyNewTask()
 }
 
+func (t *transferQueueProcessorImpl) getOutstandingTaskCount() int {
+	count := t.activeTaskProcessor.getOutstandingTaskCount()
+	for _, standbyTaskProcessor := range t.standbyTaskProcessors {
+		count += standbyTaskProcessor.getOutstandingTaskCount()
+	}
+	return count
+}
+
 func (t *transferQueueProcessorImpl) completeTransferLoop() {
 	timer := time.NewTimer(t.config.TransferProcessorCompleteTransferInterval)
 	defer timer.Stop()
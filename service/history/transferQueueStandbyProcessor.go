@@ -220,7 +220,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 		decisionInfo, isPending := msBuilder.GetPendingDecision(transferTask.ScheduleID)
 
 		if !isPending {
-			if transferTask.ScheduleID == common.FirstEventID+1 {
+			if transferTask.ScheduleID == common.FirstEventID+1 || transferTask.RecordVisibility {
 				return t.recordWorkflowStarted(msBuilder)
 			}
 			return nil
@@ -236,7 +236,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 			return ErrTaskRetry
 		}
 
-		if transferTask.ScheduleID == common.FirstEventID+1 {
+		if transferTask.ScheduleID == common.FirstEventID+1 || transferTask.RecordVisibility {
 			return t.recordWorkflowStarted(msBuilder)
 		}
 
@@ -408,6 +408,7 @@ func (t *transferQueueStandbyProcessorImpl) recordWorkflowStarted(msBuilder *mut
 		WorkflowTypeName: msBuilder.executionInfo.WorkflowTypeName,
 		StartTimestamp:   msBuilder.executionInfo.StartTimestamp.UnixNano(),
 		WorkflowTimeout:  int64(msBuilder.executionInfo.WorkflowTimeout),
+		Initiator:        getWorkflowExecutionInitiator(msBuilder.executionInfo.Initiator),
 	})
 }
 
@@ -424,5 +425,6 @@ func (t *transferQueueStandbyProcessorImpl) recordWorkflowClosed(msBuilder *muta
 		Status:           getWorkflowExecutionCloseStatus(msBuilder.executionInfo.CloseStatus),
 		HistoryLength:    msBuilder.GetNextEventID(),
 		RetentionSeconds: retentionSeconds,
+		Initiator:        getWorkflowExecutionInitiator(msBuilder.executionInfo.Initiator),
 	})
 }
@@ -356,6 +356,7 @@ func (s *transferQueueStandbyProcessorSuite) TestProcessDecisionTask_Success_Fir
 		WorkflowTypeName: msBuilder.executionInfo.WorkflowTypeName,
 		StartTimestamp:   msBuilder.executionInfo.StartTimestamp.UnixNano(),
 		WorkflowTimeout:  int64(msBuilder.executionInfo.WorkflowTimeout),
+		Initiator:        workflow.WorkflowExecutionInitiatorClient.Ptr(),
 	}).Return(nil).Once()
 	s.mockQueueAckMgr.On("completeTask", taskID).Return(nil).Once()
 
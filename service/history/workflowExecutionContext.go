@@ -22,6 +22,7 @@ package history
 
 import (
 	"fmt"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -214,6 +215,7 @@ func (c *workflowExecutionContext) updateHelper(builder *historyBuilder, transfe
 			TransactionID: transactionID,
 			FirstEventID:  *firstEvent.EventId,
 			Events:        serializedHistory,
+			EventCount:    len(builder.history),
 		}); err0 != nil {
 			switch err0.(type) {
 			case *persistence.ConditionFailedError:
@@ -251,7 +253,7 @@ func (c *workflowExecutionContext) updateHelper(builder *historyBuilder, transfe
 
 	setTaskVersion(c.msBuilder.GetCurrentVersion(), transferTasks, timerTasks)
 
-	if err1 := c.updateWorkflowExecutionWithRetry(&persistence.UpdateWorkflowExecutionRequest{
+	request := &persistence.UpdateWorkflowExecutionRequest{
 		ExecutionInfo:                 c.msBuilder.executionInfo,
 		ReplicationState:              c.msBuilder.replicationState,
 		TransferTasks:                 transferTasks,
@@ -278,9 +280,11 @@ func (c *workflowExecutionContext) updateHelper(builder *historyBuilder, transfe
 		ContinueAsNew:                 continueAsNew,
 		FinishExecution:               finishExecution,
 		FinishedExecutionTTL:          finishExecutionTTL,
-	}); err1 != nil {
-		switch err1.(type) {
+	}
+	if err1 := c.updateWorkflowExecutionWithRetry(request); err1 != nil {
+		switch err := err1.(type) {
 		case *persistence.ConditionFailedError:
+			c.logConflict(request, err)
 			return ErrConflict
 		}
 
@@ -352,6 +356,7 @@ func (c *workflowExecutionContext) continueAsNewWorkflowExecutionHelper(context
 		TransactionID: transactionID,
 		FirstEventID:  *firstEvent.EventId,
 		Events:        serializedHistory,
+		EventCount:    len(newStateBuilder.hBuilder.history),
 	})
 }
 
@@ -373,6 +378,32 @@ func (c *workflowExecutionContext) getWorkflowExecutionWithRetry(
 	return response, nil
 }
 
+// logConflict logs a sample of update conflicts along with what the update expected and what the store had, to tell
+// apart a stale cached mutable state from concurrent writers to the same run
+func (c *workflowExecutionContext) logConflict(request *persistence.UpdateWorkflowExecutionRequest,
+	err *persistence.ConditionFailedError) {
+	sampleRate := c.shard.GetConfig().ConflictDiffLogSampleRate()
+	if sampleRate <= 0 || rand.Float64() >= sampleRate {
+		return
+	}
+
+	c.logger.WithFields(bark.Fields{
+		logging.TagHistoryShardID:      c.shard.GetShardID(),
+		logging.TagRangeID:             request.RangeID,
+		logging.TagExpectedNextEventID: request.Condition,
+		logging.TagActualNextEventID:   err.ActualNextEventID,
+		logging.TagWorkflowExecutionID: c.workflowExecution.GetWorkflowId(),
+		logging.TagWorkflowRunID:       c.workflowExecution.GetRunId(),
+		logging.TagWorkflowErr:         err,
+	}).Warnf("Workflow execution update conflict. Update: {NextEventID: %v, TransferTasks: %v, TimerTasks: %v, "+
+		"ActivityInfos: %v, TimerInfos: %v, ChildExecutionInfos: %v, SignalInfos: %v, BufferedEvents: %v, "+
+		"ContinueAsNew: %v, FinishExecution: %v}",
+		request.ExecutionInfo.NextEventID, len(request.TransferTasks), len(request.TimerTasks),
+		len(request.UpsertActivityInfos), len(request.UpserTimerInfos), len(request.UpsertChildExecutionInfos),
+		len(request.UpsertSignalInfos), request.NewBufferedEvents != nil, request.ContinueAsNew != nil,
+		request.FinishExecution)
+}
+
 func (c *workflowExecutionContext) updateWorkflowExecutionWithRetry(
 	request *persistence.UpdateWorkflowExecutionRequest) error {
 	op := func() error {
@@ -0,0 +1,56 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+)
+
+type (
+	// workflowIDRateLimiters maps the metrics scope of a history API to the limiter throttling it. Only writes are
+	// limited: reads such as GetMutableState, which also backs the long poll of GetWorkflowExecutionHistory with
+	// waitForNewEvent, hold no lock on the workflow and are never throttled
+	workflowIDRateLimiters map[int]*quotas.WorkflowIDRateLimiter
+)
+
+func newWorkflowIDRateLimiters(config *Config, timeSource common.TimeSource) workflowIDRateLimiters {
+	signalRateLimiter := quotas.NewWorkflowIDRateLimiter(config.WorkflowIDSignalRPS,
+		config.WorkflowIDRateLimiterCacheSize, config.WorkflowIDRateLimiterCacheTTL, timeSource)
+	startRateLimiter := quotas.NewWorkflowIDRateLimiter(config.WorkflowIDStartRPS,
+		config.WorkflowIDRateLimiterCacheSize, config.WorkflowIDRateLimiterCacheTTL, timeSource)
+	return workflowIDRateLimiters{
+		metrics.HistorySignalWorkflowExecutionScope:          signalRateLimiter,
+		metrics.HistorySignalWithStartWorkflowExecutionScope: signalRateLimiter,
+		metrics.HistoryStartWorkflowExecutionScope:           startRateLimiter,
+	}
+}
+
+// allow returns errWorkflowIDRateLimitExceeded if the workflow ID is over the rate of the API with the given
+// metrics scope, APIs without a limiter are always allowed
+func (l workflowIDRateLimiters) allow(scope int, domainID, workflowID string) error {
+	limiter, ok := l[scope]
+	if !ok || limiter.Allow(domainID, workflowID) {
+		return nil
+	}
+	return errWorkflowIDRateLimitExceeded
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	workflowIDRateLimitersSuite struct {
+		suite.Suite
+	}
+)
+
+func TestWorkflowIDRateLimitersSuite(t *testing.T) {
+	s := new(workflowIDRateLimitersSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowIDRateLimitersSuite) TestReadsNotThrottled() {
+	timeSource := common.NewFakeTimeSource()
+	timeSource.Update(time.Now())
+	config := NewConfig(dynamicconfig.NewNopCollection(), 1)
+	config.WorkflowIDSignalRPS = func(opts ...dynamicconfig.FilterOption) int { return 1 }
+	config.WorkflowIDStartRPS = func(opts ...dynamicconfig.FilterOption) int { return 1 }
+	limiters := newWorkflowIDRateLimiters(config, timeSource)
+
+	// GetMutableState is the long poll behind GetWorkflowExecutionHistory with waitForNewEvent
+	readScopes := []int{
+		metrics.HistoryGetMutableStateScope,
+		metrics.HistoryDescribeWorkflowExecutionScope,
+		metrics.HistoryGetWorkflowExecutionHistoryEventScope,
+		metrics.HistoryGetCurrentExecutionScope,
+		metrics.HistoryListWorkflowExecutionChainScope,
+	}
+	for _, scope := range readScopes {
+		for i := 0; i < 100; i++ {
+			s.Nil(limiters.allow(scope, "domain", "wId"))
+		}
+	}
+
+	s.Nil(limiters.allow(metrics.HistorySignalWorkflowExecutionScope, "domain", "wId"))
+	s.Equal(errWorkflowIDRateLimitExceeded, limiters.allow(metrics.HistorySignalWorkflowExecutionScope, "domain", "wId"))
+	// signal with start shares the signal rate of the workflow ID
+	s.Equal(errWorkflowIDRateLimitExceeded,
+		limiters.allow(metrics.HistorySignalWithStartWorkflowExecutionScope, "domain", "wId"))
+	s.Nil(limiters.allow(metrics.HistoryStartWorkflowExecutionScope, "domain", "wId"))
+	s.Equal(errWorkflowIDRateLimitExceeded, limiters.allow(metrics.HistoryStartWorkflowExecutionScope, "domain", "wId"))
+}
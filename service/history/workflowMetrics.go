@@ -0,0 +1,198 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	workflowMetricsMaxMetrics   = 10
+	workflowMetricsMaxTags      = 4
+	workflowMetricsMaxNameLen   = 64
+	workflowMetricsMaxTagValLen = 64
+
+	workflowMetricTypeCounter = "counter"
+	workflowMetricTypeGauge   = "gauge"
+
+	workflowMetricsPrefix = "workflow."
+)
+
+var workflowMetricNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.-]*$`)
+
+type (
+	// workflowMetric is a metric recorded by a workflow in the details of a metrics marker:
+	// {"metrics":[{"name":"orders","type":"counter","value":1,"tags":{"region":"us"}}]}
+	workflowMetric struct {
+		Name  string            `json:"name"`
+		Type  string            `json:"type"`
+		Value float64           `json:"value"`
+		Tags  map[string]string `json:"tags"`
+	}
+
+	workflowMetrics struct {
+		Metrics []*workflowMetric `json:"metrics"`
+	}
+
+	// workflowMetricsSeries tracks the distinct series, a metric name and its tags, emitted for the workflows of each
+	// domain on the host. The metrics reporter keeps every series it has seen for the lifetime of the process, so the
+	// series are never forgotten either, and a domain cannot emit more than maxSeries of them.
+	workflowMetricsSeries struct {
+		maxSeries dynamicconfig.IntPropertyFn
+
+		sync.Mutex
+		series map[string]map[string]struct{}
+	}
+)
+
+// parseWorkflowMetrics decodes and validates the details of a metrics marker, the metrics are bounded in number
+// and in tags per marker, the number of distinct series per domain is bounded by workflowMetricsSeries
+func parseWorkflowMetrics(details []byte) ([]*workflowMetric, error) {
+	var m workflowMetrics
+	if err := json.Unmarshal(details, &m); err != nil {
+		return nil, err
+	}
+	if len(m.Metrics) > workflowMetricsMaxMetrics {
+		return nil, fmt.Errorf("%v metrics recorded, at most %v are allowed", len(m.Metrics), workflowMetricsMaxMetrics)
+	}
+	for _, metric := range m.Metrics {
+		if err := validateWorkflowMetric(metric); err != nil {
+			return nil, err
+		}
+	}
+	return m.Metrics, nil
+}
+
+func validateWorkflowMetric(metric *workflowMetric) error {
+	if metric == nil {
+		return fmt.Errorf("metric is not set")
+	}
+	if len(metric.Name) > workflowMetricsMaxNameLen || !workflowMetricNameRegex.MatchString(metric.Name) {
+		return fmt.Errorf("invalid metric name: %q", metric.Name)
+	}
+	switch metric.Type {
+	case workflowMetricTypeCounter:
+		if metric.Value < 0 || metric.Value != math.Trunc(metric.Value) || metric.Value > math.MaxInt32 {
+			return fmt.Errorf("invalid value of counter %v: %v", metric.Name, metric.Value)
+		}
+	case workflowMetricTypeGauge:
+		if math.IsNaN(metric.Value) || math.IsInf(metric.Value, 0) {
+			return fmt.Errorf("invalid value of gauge %v: %v", metric.Name, metric.Value)
+		}
+	default:
+		return fmt.Errorf("invalid type of metric %v: %q", metric.Name, metric.Type)
+	}
+	if len(metric.Tags) > workflowMetricsMaxTags {
+		return fmt.Errorf("metric %v has %v tags, at most %v are allowed", metric.Name, len(metric.Tags),
+			workflowMetricsMaxTags)
+	}
+	for k, v := range metric.Tags {
+		if len(k) > workflowMetricsMaxNameLen || !workflowMetricNameRegex.MatchString(k) ||
+			k == metrics.OperationTagName || k == metrics.DomainTagName {
+			return fmt.Errorf("invalid tag of metric %v: %q", metric.Name, k)
+		}
+		if len(v) > workflowMetricsMaxTagValLen {
+			return fmt.Errorf("value of tag %v of metric %v is too long", k, metric.Name)
+		}
+	}
+	return nil
+}
+
+// emitWorkflowMetrics emits the metrics recorded by the workflows of a domain in metrics markers, the details of
+// markers which are not valid and the metrics of new series over the limit of the domain are counted and dropped,
+// the marker itself is recorded in history regardless
+func emitWorkflowMetrics(metricsClient metrics.Client, series *workflowMetricsSeries, domainName string,
+	markers [][]byte) {
+	for _, details := range markers {
+		recorded, err := parseWorkflowMetrics(details)
+		if err != nil {
+			metricsClient.IncCounter(metrics.HistoryWorkflowMetricsScope, metrics.WorkflowMetricsInvalidCounter)
+			continue
+		}
+		for _, metric := range recorded {
+			if !series.admit(domainName, metric) {
+				metricsClient.IncCounter(metrics.HistoryWorkflowMetricsScope, metrics.WorkflowMetricsDroppedCounter)
+				continue
+			}
+			tags := map[string]string{metrics.DomainTagName: domainName}
+			for k, v := range metric.Tags {
+				tags[k] = v
+			}
+			scope := metricsClient.Scope(metrics.HistoryWorkflowMetricsScope).Tagged(tags)
+			switch metric.Type {
+			case workflowMetricTypeCounter:
+				scope.Counter(workflowMetricsPrefix + metric.Name).Inc(int64(metric.Value))
+			case workflowMetricTypeGauge:
+				scope.Gauge(workflowMetricsPrefix + metric.Name).Update(metric.Value)
+			}
+			metricsClient.IncCounter(metrics.HistoryWorkflowMetricsScope, metrics.WorkflowMetricsEmittedCounter)
+		}
+	}
+}
+
+func newWorkflowMetricsSeries(maxSeries dynamicconfig.IntPropertyFn) *workflowMetricsSeries {
+	return &workflowMetricsSeries{
+		maxSeries: maxSeries,
+		series:    make(map[string]map[string]struct{}),
+	}
+}
+
+// admit returns true if the series of the metric was emitted for the domain before, or if the domain is still
+// below its limit of series, in which case the series is added to the domain
+func (s *workflowMetricsSeries) admit(domainName string, metric *workflowMetric) bool {
+	key := workflowMetricSeriesKey(metric)
+	maxSeries := s.maxSeries(dynamicconfig.DomainFilter(domainName))
+
+	s.Lock()
+	defer s.Unlock()
+
+	domainSeries, ok := s.series[domainName]
+	if !ok {
+		domainSeries = make(map[string]struct{})
+		s.series[domainName] = domainSeries
+	}
+	if _, ok := domainSeries[key]; ok {
+		return true
+	}
+	if len(domainSeries) >= maxSeries {
+		return false
+	}
+	domainSeries[key] = struct{}{}
+	return true
+}
+
+func workflowMetricSeriesKey(metric *workflowMetric) string {
+	tags := make([]string, 0, len(metric.Tags))
+	for k, v := range metric.Tags {
+		tags = append(tags, k+"="+v)
+	}
+	sort.Strings(tags)
+	return metric.Name + "," + strings.Join(tags, ",")
+}
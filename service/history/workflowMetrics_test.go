@@ -0,0 +1,153 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	workflowMetricsSuite struct {
+		suite.Suite
+	}
+)
+
+func TestWorkflowMetricsSuite(t *testing.T) {
+	s := new(workflowMetricsSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowMetricsSuite) TestParseWorkflowMetrics() {
+	recorded, err := parseWorkflowMetrics([]byte(
+		`{"metrics":[{"name":"orders","type":"counter","value":2,"tags":{"region":"us"}},` +
+			`{"name":"cart.size","type":"gauge","value":3.5}]}`))
+	s.NoError(err)
+	s.Equal(2, len(recorded))
+	s.Equal("orders", recorded[0].Name)
+	s.Equal(map[string]string{"region": "us"}, recorded[0].Tags)
+	s.Equal(3.5, recorded[1].Value)
+
+	invalid := []string{
+		`not json`,
+		`{"metrics":[{"name":"1orders","type":"counter","value":1}]}`,
+		`{"metrics":[{"name":"orders","type":"histogram","value":1}]}`,
+		`{"metrics":[{"name":"orders","type":"counter","value":-1}]}`,
+		`{"metrics":[{"name":"orders","type":"counter","value":1.5}]}`,
+		`{"metrics":[{"name":"orders","type":"counter","value":1,"tags":{"domain":"other"}}]}`,
+		`{"metrics":[{"name":"orders","type":"counter","value":1,"tags":{"a":"1","b":"2","c":"3","d":"4","e":"5"}}]}`,
+		`{"metrics":[` + repeatWorkflowMetric(workflowMetricsMaxMetrics+1) + `]}`,
+	}
+	for _, details := range invalid {
+		_, err := parseWorkflowMetrics([]byte(details))
+		s.Error(err, details)
+	}
+}
+
+func (s *workflowMetricsSuite) TestEmitWorkflowMetrics() {
+	scope := tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(scope, metrics.History)
+
+	emitWorkflowMetrics(metricsClient, newWorkflowMetricsSeries(maxWorkflowMetricsSeries(10)), "domain1", [][]byte{
+		[]byte(`{"metrics":[{"name":"orders","type":"counter","value":2,"tags":{"region":"us"}}]}`),
+		[]byte(`{"metrics":[{"name":"cart.size","type":"gauge","value":3.5}]}`),
+		[]byte(`{"metrics":[{"name":"orders","type":"counter","value":-1}]}`),
+	})
+
+	snapshot := scope.Snapshot()
+	var orders, emitted, invalid int64
+	for _, c := range snapshot.Counters() {
+		switch c.Name() {
+		case "test.workflow.orders":
+			s.Equal("domain1", c.Tags()[metrics.DomainTagName])
+			s.Equal("us", c.Tags()["region"])
+			orders += c.Value()
+		case "test.workflow-metrics-emitted":
+			emitted += c.Value()
+		case "test.workflow-metrics-invalid":
+			invalid += c.Value()
+		}
+	}
+	s.Equal(int64(2), orders)
+	s.Equal(int64(2), emitted)
+	s.Equal(int64(1), invalid)
+
+	found := false
+	for _, g := range snapshot.Gauges() {
+		if g.Name() == "test.workflow.cart.size" {
+			s.Equal(3.5, g.Value())
+			found = true
+		}
+	}
+	s.True(found)
+}
+
+func (s *workflowMetricsSuite) TestWorkflowMetricsSeriesLimit() {
+	scope := tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(scope, metrics.History)
+	series := newWorkflowMetricsSeries(maxWorkflowMetricsSeries(2))
+
+	emitWorkflowMetrics(metricsClient, series, "domain1", [][]byte{
+		[]byte(`{"metrics":[{"name":"orders","type":"counter","value":1,"tags":{"region":"us","zone":"a"}}]}`),
+		[]byte(`{"metrics":[{"name":"orders","type":"counter","value":1,"tags":{"zone":"a","region":"us"}}]}`),
+		[]byte(`{"metrics":[{"name":"orders","type":"counter","value":1,"tags":{"region":"eu"}}]}`),
+		[]byte(`{"metrics":[{"name":"orders","type":"counter","value":1,"tags":{"region":"asia"}}]}`),
+		[]byte(`{"metrics":[{"name":"payments","type":"counter","value":1}]}`),
+	})
+	// the limit applies per domain
+	emitWorkflowMetrics(metricsClient, series, "domain2", [][]byte{
+		[]byte(`{"metrics":[{"name":"payments","type":"counter","value":1}]}`),
+	})
+
+	var orders, payments, dropped int64
+	for _, c := range scope.Snapshot().Counters() {
+		switch c.Name() {
+		case "test.workflow.orders":
+			orders += c.Value()
+		case "test.workflow.payments":
+			payments += c.Value()
+		case "test.workflow-metrics-dropped":
+			dropped += c.Value()
+		}
+	}
+	s.Equal(int64(3), orders)
+	s.Equal(int64(1), payments)
+	s.Equal(int64(2), dropped)
+}
+
+func maxWorkflowMetricsSeries(maxSeries int) dynamicconfig.IntPropertyFn {
+	return func(...dynamicconfig.FilterOption) int { return maxSeries }
+}
+
+func repeatWorkflowMetric(n int) string {
+	metrics := ""
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			metrics += ","
+		}
+		metrics += `{"name":"orders","type":"counter","value":1}`
+	}
+	return metrics
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/.gen/go/matching/matchingserviceserver"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
@@ -91,10 +92,10 @@ func (h *Handler) Health(ctx context.Context) (*health.HealthStatus, error) {
 }
 
 // startRequestProfile initiates recording of request metrics
-func (h *Handler) startRequestProfile(api string, scope int) tally.Stopwatch {
+func (h *Handler) startRequestProfile(ctx context.Context, api string, scope int) tally.Stopwatch {
 	h.startWG.Wait()
 	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
-	h.Service.GetLogger().WithField("api", api).Debug("Received new request")
+	logging.LoggerFromContext(ctx, h.GetLogger()).WithField("api", api).Debug("Received new request")
 	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
 	return sw
 }
@@ -102,7 +103,8 @@ func (h *Handler) startRequestProfile(api string, scope int) tally.Stopwatch {
 // AddActivityTask - adds an activity task.
 func (h *Handler) AddActivityTask(ctx context.Context, addRequest *m.AddActivityTaskRequest) error {
 	scope := metrics.MatchingAddActivityTaskScope
-	sw := h.startRequestProfile("AddActivityTask", scope)
+	ctx = logging.ContextWithExecutionTags(ctx, addRequest.GetDomainUUID(), addRequest.Execution)
+	sw := h.startRequestProfile(ctx, "AddActivityTask", scope)
 	defer sw.Stop()
 	return h.handleErr(h.engine.AddActivityTask(addRequest), scope)
 }
@@ -110,7 +112,8 @@ func (h *Handler) AddActivityTask(ctx context.Context, addRequest *m.AddActivity
 // AddDecisionTask - adds a decision task.
 func (h *Handler) AddDecisionTask(ctx context.Context, addRequest *m.AddDecisionTaskRequest) error {
 	scope := metrics.MatchingAddDecisionTaskScope
-	sw := h.startRequestProfile("AddDecisionTask", scope)
+	ctx = logging.ContextWithExecutionTags(ctx, addRequest.GetDomainUUID(), addRequest.Execution)
+	sw := h.startRequestProfile(ctx, "AddDecisionTask", scope)
 	defer sw.Stop()
 	return h.handleErr(h.engine.AddDecisionTask(addRequest), scope)
 }
@@ -120,7 +123,8 @@ func (h *Handler) PollForActivityTask(ctx context.Context,
 	pollRequest *m.PollForActivityTaskRequest) (*gen.PollForActivityTaskResponse, error) {
 
 	scope := metrics.MatchingPollForActivityTaskScope
-	sw := h.startRequestProfile("PollForActivityTask", scope)
+	ctx = logging.ContextWithWorkflowTags(ctx, pollRequest.GetDomainUUID(), "", "")
+	sw := h.startRequestProfile(ctx, "PollForActivityTask", scope)
 	defer sw.Stop()
 
 	response, err := h.engine.PollForActivityTask(ctx, pollRequest)
@@ -132,7 +136,8 @@ func (h *Handler) PollForDecisionTask(ctx context.Context,
 	pollRequest *m.PollForDecisionTaskRequest) (*m.PollForDecisionTaskResponse, error) {
 
 	scope := metrics.MatchingPollForDecisionTaskScope
-	sw := h.startRequestProfile("PollForDecisionTask", scope)
+	ctx = logging.ContextWithWorkflowTags(ctx, pollRequest.GetDomainUUID(), "", "")
+	sw := h.startRequestProfile(ctx, "PollForDecisionTask", scope)
 	defer sw.Stop()
 
 	response, err := h.engine.PollForDecisionTask(ctx, pollRequest)
@@ -143,7 +148,12 @@ func (h *Handler) PollForDecisionTask(ctx context.Context,
 func (h *Handler) QueryWorkflow(ctx context.Context,
 	queryRequest *m.QueryWorkflowRequest) (*gen.QueryWorkflowResponse, error) {
 	scope := metrics.MatchingQueryWorkflowScope
-	sw := h.startRequestProfile("QueryWorkflow", scope)
+	var execution *gen.WorkflowExecution
+	if queryRequest.QueryRequest != nil {
+		execution = queryRequest.QueryRequest.Execution
+	}
+	ctx = logging.ContextWithExecutionTags(ctx, queryRequest.GetDomainUUID(), execution)
+	sw := h.startRequestProfile(ctx, "QueryWorkflow", scope)
 	defer sw.Stop()
 
 	response, err := h.engine.QueryWorkflow(ctx, queryRequest)
@@ -153,7 +163,8 @@ func (h *Handler) QueryWorkflow(ctx context.Context,
 // RespondQueryTaskCompleted responds a query task completed
 func (h *Handler) RespondQueryTaskCompleted(ctx context.Context, request *m.RespondQueryTaskCompletedRequest) error {
 	scope := metrics.MatchingRespondQueryTaskCompletedScope
-	sw := h.startRequestProfile("RespondQueryTaskCompleted", scope)
+	ctx = logging.ContextWithWorkflowTags(ctx, request.GetDomainUUID(), "", "")
+	sw := h.startRequestProfile(ctx, "RespondQueryTaskCompleted", scope)
 	defer sw.Stop()
 
 	err := h.engine.RespondQueryTaskCompleted(ctx, request)
@@ -164,7 +175,8 @@ func (h *Handler) RespondQueryTaskCompleted(ctx context.Context, request *m.Resp
 func (h *Handler) CancelOutstandingPoll(ctx context.Context,
 	request *m.CancelOutstandingPollRequest) error {
 	scope := metrics.MatchingCancelOutstandingPollScope
-	sw := h.startRequestProfile("CancelOutstandingPoll", scope)
+	ctx = logging.ContextWithWorkflowTags(ctx, request.GetDomainUUID(), "", "")
+	sw := h.startRequestProfile(ctx, "CancelOutstandingPoll", scope)
 	defer sw.Stop()
 
 	err := h.engine.CancelOutstandingPoll(ctx, request)
@@ -175,13 +187,36 @@ func (h *Handler) CancelOutstandingPoll(ctx context.Context,
 // pollers which polled this tasklist in last few minutes.
 func (h *Handler) DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest) (*gen.DescribeTaskListResponse, error) {
 	scope := metrics.MatchingDescribeTaskListScope
-	sw := h.startRequestProfile("DescribeTaskList", scope)
+	ctx = logging.ContextWithWorkflowTags(ctx, request.GetDomainUUID(), "", "")
+	sw := h.startRequestProfile(ctx, "DescribeTaskList", scope)
 	defer sw.Stop()
 
 	response, err := h.engine.DescribeTaskList(ctx, request)
 	return response, h.handleErr(err, scope)
 }
 
+// PauseTaskList stops dispatching tasks of the target tasklist to pollers
+func (h *Handler) PauseTaskList(ctx context.Context, request *m.PauseTaskListRequest) error {
+	scope := metrics.MatchingPauseTaskListScope
+	ctx = logging.ContextWithWorkflowTags(ctx, request.GetDomainUUID(), "", "")
+	sw := h.startRequestProfile(ctx, "PauseTaskList", scope)
+	defer sw.Stop()
+
+	err := h.engine.PauseTaskList(ctx, request)
+	return h.handleErr(err, scope)
+}
+
+// ResumeTaskList resumes dispatching tasks of a paused tasklist
+func (h *Handler) ResumeTaskList(ctx context.Context, request *m.ResumeTaskListRequest) error {
+	scope := metrics.MatchingResumeTaskListScope
+	ctx = logging.ContextWithWorkflowTags(ctx, request.GetDomainUUID(), "", "")
+	sw := h.startRequestProfile(ctx, "ResumeTaskList", scope)
+	defer sw.Stop()
+
+	err := h.engine.ResumeTaskList(ctx, request)
+	return h.handleErr(err, scope)
+}
+
 func (h *Handler) handleErr(err error, scope int) error {
 
 	if err == nil {
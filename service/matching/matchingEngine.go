@@ -277,6 +277,13 @@ pollLoop:
 		}
 
 		if tCtx.queryTaskInfo != nil {
+			if tCtx.queryTaskInfo.isExpired() {
+				// the caller of the query stopped waiting for it, the poll is better spent on the next task
+				e.metricsClient.IncCounter(metrics.MatchingQueryWorkflowScope, metrics.QueryExpiredAfterMatchCounter)
+				tCtx.completeTask(nil)
+				continue pollLoop
+			}
+
 			// for query task, we don't need to update history to record decision task started. but we need to know
 			// the NextEventID so front end knows what are the history events to load for this decision task.
 			mutableStateResp, err := e.historyService.GetMutableState(ctx, &h.GetMutableStateRequest{
@@ -406,7 +413,8 @@ pollLoop:
 }
 
 // QueryWorkflow creates a DecisionTask with query data, send it through sync match channel, wait for that DecisionTask
-// to be processed by worker, and then return the query result.
+// to be processed by worker, and then return the query result. Once the caller stops waiting, the query is no longer
+// handed to pollers.
 func (e *matchingEngineImpl) QueryWorkflow(ctx context.Context, queryRequest *m.QueryWorkflowRequest) (*workflow.QueryWorkflowResponse, error) {
 	domainID := queryRequest.GetDomainUUID()
 	taskListName := queryRequest.TaskList.GetName()
@@ -419,15 +427,11 @@ func (e *matchingEngineImpl) QueryWorkflow(ctx context.Context, queryRequest *m.
 	queryTask := &queryTaskInfo{
 		queryRequest: queryRequest,
 		taskID:       uuid.New(),
+		resultCh:     make(chan *workflow.RespondQueryTaskCompletedRequest, 1),
+		doneCh:       ctx.Done(),
 	}
-	err = tlMgr.SyncMatchQueryTask(ctx, queryTask)
-	if err != nil {
-		return nil, err
-	}
-
-	queryResultCh := make(chan *workflow.RespondQueryTaskCompletedRequest, 1)
 	e.queryMapLock.Lock()
-	e.queryTaskMap[queryTask.taskID] = queryResultCh
+	e.queryTaskMap[queryTask.taskID] = queryTask.resultCh
 	e.queryMapLock.Unlock()
 	defer func() {
 		e.queryMapLock.Lock()
@@ -435,8 +439,13 @@ func (e *matchingEngineImpl) QueryWorkflow(ctx context.Context, queryRequest *m.
 		e.queryMapLock.Unlock()
 	}()
 
+	err = tlMgr.SyncMatchQueryTask(ctx, queryTask)
+	if err != nil {
+		return nil, err
+	}
+
 	select {
-	case result := <-queryResultCh:
+	case result := <-queryTask.resultCh:
 		if *result.CompletedType == workflow.QueryTaskCompletedTypeFailed {
 			return nil, &workflow.QueryFailedError{Message: result.GetErrorMessage()}
 		}
@@ -455,7 +464,11 @@ func (e *matchingEngineImpl) RespondQueryTaskCompleted(ctx context.Context, requ
 		return &workflow.EntityNotExistsError{Message: "query task not found, or already expired"}
 	}
 
-	queryResultCh <- request.CompletedRequest
+	select {
+	case queryResultCh <- request.CompletedRequest:
+	default:
+		// the query was already answered, only the first answer is returned to the caller
+	}
 
 	return nil
 }
@@ -502,6 +515,33 @@ func (e *matchingEngineImpl) DescribeTaskList(ctx context.Context, request *m.De
 	return &workflow.DescribeTaskListResponse{Pollers: pollers}, nil
 }
 
+func (e *matchingEngineImpl) PauseTaskList(ctx context.Context, request *m.PauseTaskListRequest) error {
+	return e.setTaskListPaused(request.GetDomainUUID(), request.TaskList, request.GetTaskListType(), true)
+}
+
+func (e *matchingEngineImpl) ResumeTaskList(ctx context.Context, request *m.ResumeTaskListRequest) error {
+	return e.setTaskListPaused(request.GetDomainUUID(), request.TaskList, request.GetTaskListType(), false)
+}
+
+func (e *matchingEngineImpl) setTaskListPaused(
+	domainID string, taskList *workflow.TaskList, taskListType workflow.TaskListType, paused bool,
+) error {
+	if taskList == nil || taskList.GetName() == "" {
+		return &workflow.BadRequestError{Message: "TaskList is not set on request."}
+	}
+	tlType := persistence.TaskListTypeDecision
+	if taskListType == workflow.TaskListTypeActivity {
+		tlType = persistence.TaskListTypeActivity
+	}
+
+	id := newTaskListID(domainID, taskList.GetName(), tlType)
+	tlMgr, err := e.getTaskListManager(id, common.TaskListKindPtr(taskList.GetKind()))
+	if err != nil {
+		return err
+	}
+	return tlMgr.SetPaused(paused)
+}
+
 // Loads a task from persistence and wraps it in a task context
 func (e *matchingEngineImpl) getTask(
 	ctx context.Context, taskList *taskListID, maxDispatchPerSecond *float64, taskListKind *workflow.TaskListKind,
@@ -563,6 +603,7 @@ func (e *matchingEngineImpl) createPollForDecisionTaskResponse(context *taskCont
 	response.BacklogCountHint = common.Int64Ptr(context.backlogCountHint)
 	response.NextEventId = historyResponse.NextEventId
 	response.DecisionInfo = historyResponse.DecisionInfo
+	response.SuggestContinueAsNew = historyResponse.SuggestContinueAsNew
 
 	return response
 }
@@ -39,5 +39,7 @@ type (
 		RespondQueryTaskCompleted(ctx context.Context, request *m.RespondQueryTaskCompletedRequest) error
 		CancelOutstandingPoll(ctx context.Context, request *m.CancelOutstandingPollRequest) error
 		DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest) (*workflow.DescribeTaskListResponse, error)
+		PauseTaskList(ctx context.Context, request *m.PauseTaskListRequest) error
+		ResumeTaskList(ctx context.Context, request *m.ResumeTaskListRequest) error
 	}
 )
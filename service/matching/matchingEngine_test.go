@@ -465,6 +465,86 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 	s.True(expectedRange <= s.taskManager.getTaskListManager(tlID).rangeID)
 }
 
+func (s *matchingEngineSuite) TestPauseAndResumeTaskList() {
+	s.matchingEngine.config.LongPollExpirationInterval = func(...dynamicconfig.FilterOption) time.Duration { return 10 * time.Millisecond }
+
+	domainID := "domainId"
+	tl := "pausedTaskList"
+	tlID := &taskListID{domainID: domainID, taskListName: tl, taskType: persistence.TaskListTypeActivity}
+	taskList := &workflow.TaskList{Name: &tl}
+	workflowExecution := workflow.WorkflowExecution{RunId: common.StringPtr("run1"), WorkflowId: common.StringPtr("workflow1")}
+
+	err := s.matchingEngine.PauseTaskList(s.callContext, &matching.PauseTaskListRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		TaskList:     taskList,
+		TaskListType: workflow.TaskListTypeActivity.Ptr(),
+	})
+	s.NoError(err)
+	s.True(s.taskManager.getTaskListManager(tlID).paused)
+
+	err = s.matchingEngine.AddActivityTask(&matching.AddActivityTaskRequest{
+		SourceDomainUUID:              common.StringPtr(domainID),
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &workflowExecution,
+		ScheduleId:                    common.Int64Ptr(3),
+		TaskList:                      taskList,
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+	})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+
+	pollRequest := &matching.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: taskList,
+			Identity: common.StringPtr("nobody"),
+		},
+	}
+	result, err := s.matchingEngine.PollForActivityTask(s.callContext, pollRequest)
+	s.NoError(err)
+	s.Empty(result.TaskToken)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+
+	s.historyClient.On("RecordActivityTaskStarted", nil,
+		mock.AnythingOfType("*history.RecordActivityTaskStartedRequest")).Return(
+		&gohistory.RecordActivityTaskStartedResponse{
+			ScheduledEvent: newActivityTaskScheduledEvent(3, 0, &workflow.ScheduleActivityTaskDecisionAttributes{
+				ActivityId:   common.StringPtr("activityId1"),
+				TaskList:     taskList,
+				ActivityType: &workflow.ActivityType{Name: common.StringPtr("activity1")},
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(50),
+				StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+				HeartbeatTimeoutSeconds:       common.Int32Ptr(10),
+			}),
+			StartedTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+		}, nil)
+
+	err = s.matchingEngine.ResumeTaskList(s.callContext, &matching.ResumeTaskListRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		TaskList:     taskList,
+		TaskListType: workflow.TaskListTypeActivity.Ptr(),
+	})
+	s.NoError(err)
+	s.False(s.taskManager.getTaskListManager(tlID).paused)
+
+	// the task is loaded from persistence asynchronously, allow for a few empty polls
+	for i := 0; i < 100 && len(result.TaskToken) == 0; i++ {
+		result, err = s.matchingEngine.PollForActivityTask(s.callContext, pollRequest)
+		s.NoError(err)
+	}
+	s.NotEmpty(result.TaskToken)
+	s.Equal("activityId1", result.GetActivityId())
+}
+
+func (s *matchingEngineSuite) TestPauseTaskListNameNotSet() {
+	err := s.matchingEngine.PauseTaskList(s.callContext, &matching.PauseTaskListRequest{
+		DomainUUID: common.StringPtr("domainId"),
+		TaskList:   &workflow.TaskList{},
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
 func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	// Set a short long poll expiration so we don't have to wait too long for 0 throttling cases
 	s.matchingEngine.config.LongPollExpirationInterval = func(...dynamicconfig.FilterOption) time.Duration { return 50 * time.Millisecond }
@@ -908,6 +988,82 @@ func (s *matchingEngineSuite) TestPollWithExpiredContext() {
 
 }
 
+func (s *matchingEngineSuite) TestQueryWorkflow() {
+	domainID := "domainId"
+	tl := "makeToast"
+	taskList := &workflow.TaskList{Name: common.StringPtr(tl)}
+	execution := &workflow.WorkflowExecution{WorkflowId: common.StringPtr("workflow"), RunId: common.StringPtr("run")}
+
+	s.historyClient.On("GetMutableState", mock.Anything, mock.Anything).Return(
+		&gohistory.GetMutableStateResponse{NextEventId: common.Int64Ptr(5)}, nil)
+
+	go func() {
+		resp, err := s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+			DomainUUID:  common.StringPtr(domainID),
+			PollRequest: &workflow.PollForDecisionTaskRequest{TaskList: taskList},
+		})
+		s.NoError(err)
+		token, err := s.matchingEngine.tokenSerializer.DeserializeQueryTaskToken(resp.TaskToken)
+		s.NoError(err)
+		err = s.matchingEngine.RespondQueryTaskCompleted(s.callContext, &matching.RespondQueryTaskCompletedRequest{
+			TaskID: common.StringPtr(token.TaskID),
+			CompletedRequest: &workflow.RespondQueryTaskCompletedRequest{
+				CompletedType: workflow.QueryTaskCompletedTypeCompleted.Ptr(),
+				QueryResult:   []byte("result"),
+			},
+		})
+		s.NoError(err)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := s.matchingEngine.QueryWorkflow(ctx, &matching.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TaskList:   taskList,
+		QueryRequest: &workflow.QueryWorkflowRequest{
+			Execution: execution,
+			Query:     &workflow.WorkflowQuery{QueryType: common.StringPtr("state")},
+		},
+	})
+	s.NoError(err)
+	s.Equal([]byte("result"), resp.QueryResult)
+}
+
+func (s *matchingEngineSuite) TestQueryWorkflowExpired() {
+	domainID := "domainId"
+	tl := "makeToast"
+	taskList := &workflow.TaskList{Name: common.StringPtr(tl)}
+	execution := &workflow.WorkflowExecution{WorkflowId: common.StringPtr("workflow"), RunId: common.StringPtr("run")}
+
+	// whether the poller is matched with the expired query or not, the query is never handed to it
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		resp, err := s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+			DomainUUID:  common.StringPtr(domainID),
+			PollRequest: &workflow.PollForDecisionTaskRequest{TaskList: taskList},
+		})
+		s.NoError(err)
+		s.Equal(emptyPollForDecisionTaskResponse, resp)
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := s.matchingEngine.QueryWorkflow(ctx, &matching.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TaskList:   taskList,
+		QueryRequest: &workflow.QueryWorkflowRequest{
+			Execution: execution,
+			Query:     &workflow.WorkflowQuery{QueryType: common.StringPtr("state")},
+		},
+	})
+	s.IsType(&workflow.QueryFailedError{}, err)
+	wg.Wait()
+	s.historyClient.AssertNotCalled(s.T(), "GetMutableState", mock.Anything, mock.Anything)
+}
+
 func (s *matchingEngineSuite) TestMultipleEnginesActivitiesRangeStealing() {
 	runID := "run1"
 	workflowID := "workflow1"
@@ -1429,6 +1585,7 @@ type testTaskListManager struct {
 	sync.Mutex
 	rangeID         int64
 	ackLevel        int64
+	paused          bool
 	createTaskCount int
 	tasks           *treemap.Map
 }
@@ -1466,6 +1623,7 @@ func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListReques
 			TaskType: request.TaskType,
 			RangeID:  tlm.rangeID,
 			Kind:     request.TaskListKind,
+			Paused:   tlm.paused,
 		},
 	}, nil
 }
@@ -1485,6 +1643,7 @@ func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequ
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.paused = tli.Paused
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
@@ -24,14 +24,23 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 // Config represents configuration for cadence-matching service
 type Config struct {
 	EnableSyncMatch dynamicconfig.BoolPropertyFn
+	// New tasks wait in memory for a poller up to SyncMatchWaitDuration before being written to the database,
+	// this saves the write and the read back for task lists which are polled just behind their producers
+	SyncMatchWaitDuration    dynamicconfig.DurationPropertyFn
+	MaxSyncMatchWaitingTasks dynamicconfig.IntPropertyFn
+	// EnableStrictFIFO dispatches tasks in the order they were added, sync match is disabled as it lets a new task
+	// jump ahead of the persisted backlog
+	EnableStrictFIFO dynamicconfig.BoolPropertyFn
 
 	// taskListManager configuration
 	RangeSize                 int64
@@ -53,6 +62,15 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		EnableSyncMatch: dc.GetBoolProperty(
 			dynamicconfig.MatchingEnableSyncMatch, true,
 		),
+		SyncMatchWaitDuration: dc.GetDurationProperty(
+			dynamicconfig.MatchingSyncMatchWaitDuration, 0,
+		),
+		MaxSyncMatchWaitingTasks: dc.GetIntProperty(
+			dynamicconfig.MatchingMaxSyncMatchWaitingTasks, 1000,
+		),
+		EnableStrictFIFO: dc.GetBoolProperty(
+			dynamicconfig.MatchingEnableStrictFIFO, false,
+		),
 		RangeSize: 100000,
 		GetTasksBatchSize: dc.GetIntProperty(
 			dynamicconfig.MatchingMaxTaskBatchSize, 1000,
@@ -83,9 +101,11 @@ type Service struct {
 
 // NewService builds a new cadence-matching service
 func NewService(params *service.BootstrapParams) common.Daemon {
+	dc := dynamicconfig.NewCollection(params.DynamicConfig, params.Logger,
+		metrics.NewClient(params.MetricScope, metrics.Matching))
 	return &Service{
 		params: params,
-		config: NewConfig(dynamicconfig.NewCollection(params.DynamicConfig, params.Logger)),
+		config: NewConfig(dc),
 		stopC:  make(chan struct{}),
 	}
 }
@@ -100,12 +120,14 @@ func (s *Service) Start() {
 
 	base := service.New(p)
 
-	taskPersistence, err := persistence.NewCassandraTaskPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
+	taskCfg := p.CassandraConfig.ForStore(config.StoreTask)
+	taskPersistence, err := persistence.NewCassandraTaskPersistence(taskCfg.Hosts,
+		taskCfg.Port,
+		taskCfg.User,
+		taskCfg.Password,
+		taskCfg.Datacenter,
+		taskCfg.Keyspace,
+		taskCfg.Timeouts,
 		base.GetLogger())
 
 	if err != nil {
@@ -64,11 +64,15 @@ type taskListManager interface {
 	SyncMatchQueryTask(ctx context.Context, queryTask *queryTaskInfo) error
 	CancelPoller(pollerID string)
 	GetAllPollerInfo() []*pollerInfo
+	SetPaused(paused bool) error
 	String() string
 }
 
 type taskListConfig struct {
-	EnableSyncMatch func() bool
+	EnableSyncMatch          func() bool
+	SyncMatchWaitDuration    func() time.Duration
+	MaxSyncMatchWaitingTasks func() int
+	EnableStrictFIFO         func() bool
 	// Time to hold a poll request before returning an empty response if there are no tasks
 	LongPollExpirationInterval func() time.Duration
 	RangeSize                  int64
@@ -101,6 +105,15 @@ func newTaskListConfig(id *taskListID, config *Config) *taskListConfig {
 		EnableSyncMatch: func() bool {
 			return config.EnableSyncMatch(tlOpt)
 		},
+		SyncMatchWaitDuration: func() time.Duration {
+			return config.SyncMatchWaitDuration(tlOpt)
+		},
+		MaxSyncMatchWaitingTasks: func() int {
+			return config.MaxSyncMatchWaitingTasks(tlOpt)
+		},
+		EnableStrictFIFO: func() bool {
+			return config.EnableStrictFIFO(tlOpt)
+		},
 		LongPollExpirationInterval: func() time.Duration {
 			return config.LongPollExpirationInterval(tlOpt)
 		},
@@ -238,6 +251,21 @@ type taskContext struct {
 type queryTaskInfo struct {
 	taskID       string
 	queryRequest *m.QueryWorkflowRequest
+	// resultCh receives the answer of the worker, it is listened to before the query is dispatched so that an
+	// answer cannot arrive before anyone waits for it
+	resultCh chan *s.RespondQueryTaskCompletedRequest
+	// doneCh is closed when the caller of the query stops waiting for it, a poller matched with the query after
+	// that drops it instead of handing it to a worker
+	doneCh <-chan struct{}
+}
+
+func (q *queryTaskInfo) isExpired() bool {
+	select {
+	case <-q.doneCh:
+		return true
+	default:
+		return false
+	}
 }
 
 // Single task list in memory state
@@ -282,6 +310,8 @@ type taskListManagerImpl struct {
 	rangeID                 int64      // Current range of the task list. Starts from 1.
 	taskSequenceNumber      int64      // Sequence number of the next task. Starts from 1.
 	nextRangeSequenceNumber int64      // Current range boundary
+	// resumeCh is non-nil while task dispatch is paused by an operator and gets closed on resume
+	resumeCh chan struct{}
 
 	// outstandingPollsMap is needed to keep track of all outstanding pollers for a
 	// particular tasklist.  PollerID generated by frontend is used as the key and
@@ -292,6 +322,8 @@ type taskListManagerImpl struct {
 	outstandingPollsMap  map[string]context.CancelFunc
 	// Rate limiter for task dispatch
 	rateLimiter rateLimiter
+	// number of added tasks held in memory waiting for a poller, see trySyncMatch
+	syncMatchWaitingTasks int32
 
 	taskListKind *s.TaskListKind // sticky taskList has different process in persistence
 }
@@ -362,6 +394,10 @@ func (c *taskListManagerImpl) AddTask(execution *s.WorkflowExecution, taskInfo *
 func (c *taskListManagerImpl) SyncMatchQueryTask(ctx context.Context, queryTask *queryTaskInfo) error {
 	c.startWG.Wait()
 
+	if c.isPaused() {
+		return &s.QueryFailedError{Message: "tasklist is paused, query cannot be dispatched to a worker"}
+	}
+
 	domainID := queryTask.queryRequest.GetDomainUUID()
 	we := queryTask.queryRequest.QueryRequest.Execution
 	taskInfo := &persistence.TaskInfo{
@@ -376,6 +412,7 @@ func (c *taskListManagerImpl) SyncMatchQueryTask(ctx context.Context, queryTask
 		<-request.C
 		return nil
 	case <-ctx.Done():
+		c.metricsClient.IncCounter(metrics.MatchingQueryWorkflowScope, metrics.QueryExpiredBeforePollCounter)
 		return &s.QueryFailedError{Message: "timeout: no workflow worker polling for given tasklist"}
 	}
 }
@@ -420,6 +457,9 @@ func (c *taskListManagerImpl) isEqualRangeID(rangeID int64) bool {
 }
 
 func (c *taskListManagerImpl) persistAckLevel() error {
+	// the persistence lock is taken before reading the state, see SetPaused
+	c.persistenceLock.Lock()
+	defer c.persistenceLock.Unlock()
 	c.Lock()
 	updateTaskListRequest := &persistence.UpdateTaskListRequest{
 		TaskListInfo: &persistence.TaskListInfo{
@@ -429,15 +469,83 @@ func (c *taskListManagerImpl) persistAckLevel() error {
 			AckLevel: c.taskAckManager.getAckLevel(),
 			RangeID:  c.rangeID,
 			Kind:     c.getTaskListKind(),
+			Paused:   c.resumeCh != nil,
 		},
 	}
 	c.Unlock()
-	c.persistenceLock.Lock()
-	defer c.persistenceLock.Unlock()
 	_, err := c.engine.taskManager.UpdateTaskList(updateTaskListRequest)
 	return err
 }
 
+// SetPaused pauses or resumes dispatching of tasks to pollers. The paused state is persisted with the
+// task list, so it is restored when the task list is loaded again, possibly by another host.
+func (c *taskListManagerImpl) SetPaused(paused bool) error {
+	c.startWG.Wait()
+
+	// Every write of the task list row carries the paused flag, holding the persistence lock until the
+	// in memory state is updated prevents concurrent ack level and task writes from reverting it.
+	c.persistenceLock.Lock()
+	defer c.persistenceLock.Unlock()
+	_, err := c.executeWithRetry(func(rangeID int64) (interface{}, error) {
+		return c.engine.taskManager.UpdateTaskList(&persistence.UpdateTaskListRequest{
+			TaskListInfo: &persistence.TaskListInfo{
+				DomainID: c.taskListID.domainID,
+				Name:     c.taskListID.taskListName,
+				TaskType: c.taskListID.taskType,
+				AckLevel: c.getAckLevel(),
+				RangeID:  rangeID,
+				Kind:     c.getTaskListKind(),
+				Paused:   paused,
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	c.setPausedLocked(paused)
+	c.Unlock()
+	if paused {
+		c.drainPollers()
+	}
+	c.logger.Infof("Task dispatch paused=%v", paused)
+	return nil
+}
+
+func (c *taskListManagerImpl) setPausedLocked(paused bool) {
+	if paused == (c.resumeCh != nil) {
+		return
+	}
+	if paused {
+		c.resumeCh = make(chan struct{})
+	} else {
+		close(c.resumeCh)
+		c.resumeCh = nil
+	}
+}
+
+func (c *taskListManagerImpl) isPaused() bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.resumeCh != nil
+}
+
+func (c *taskListManagerImpl) getResumeCh() chan struct{} {
+	c.Lock()
+	defer c.Unlock()
+	return c.resumeCh
+}
+
+// drainPollers unblocks all outstanding polls so that they do not pick up tasks once the task list is paused
+func (c *taskListManagerImpl) drainPollers() {
+	c.outstandingPollsLock.Lock()
+	defer c.outstandingPollsLock.Unlock()
+	for _, cancel := range c.outstandingPollsMap {
+		cancel()
+	}
+}
+
 // newTaskIDs taskID to use to persist the task
 func (c *taskListManagerImpl) newTaskIDs(count int) (taskIDs []int64, err error) {
 	c.Lock()
@@ -514,6 +622,19 @@ func (c *taskListManagerImpl) getTask(ctx context.Context) (*getTaskResult, erro
 		})
 	}
 
+	// hold the poll while task dispatch is paused, the outstanding polls get drained when a pause starts
+	if resumeCh := c.getResumeCh(); resumeCh != nil {
+		select {
+		case <-resumeCh:
+		case <-timer.C:
+			c.metricsClient.IncCounter(scope, metrics.PollTimeoutCounter)
+			return nil, ErrNoTasks
+		case <-childCtx.Done():
+			c.metricsClient.IncCounter(scope, metrics.PollTimeoutCounter)
+			return nil, ErrNoTasks
+		}
+	}
+
 	select {
 	case result := <-c.tasksForPoll:
 		if result.syncMatch {
@@ -623,6 +744,7 @@ func (c *taskListManagerImpl) updateRangeIfNeededLocked(e *matchingEngineImpl) e
 
 	tli := resp.TaskListInfo
 	c.rangeID = tli.RangeID // Starts from 1
+	c.setPausedLocked(tli.Paused)
 	c.taskAckManager.setAckLevel(tli.AckLevel)
 	c.taskSequenceNumber = (tli.RangeID-1)*c.config.RangeSize + 1
 	c.nextRangeSequenceNumber = (tli.RangeID)*c.config.RangeSize + 1
@@ -647,6 +769,7 @@ func (c *taskListManagerImpl) String() string {
 	r += fmt.Sprintf("NextRangeSequenceNumber=%v\n", c.nextRangeSequenceNumber)
 	r += fmt.Sprintf("AckLevel=%v\n", c.taskAckManager.ackLevel)
 	r += fmt.Sprintf("MaxReadLevel=%v\n", c.taskAckManager.getReadLevel())
+	r += fmt.Sprintf("Paused=%v\n", c.resumeCh != nil)
 
 	return r
 }
@@ -661,12 +784,15 @@ func (c *taskListManagerImpl) GetAllPollerInfo() []*pollerInfo {
 	return c.pollerHistory.getAllPollerInfo()
 }
 
-// Tries to match task to a poller that is already waiting on getTask.
-// When this method returns non nil response without error it is guaranteed that the task is started
-// and sent to a poller. So it not necessary to persist it.
+// Tries to match task to a poller that is already waiting on getTask, or that arrives within the sync match
+// wait duration. When this method returns non nil response without error it is guaranteed that the task is
+// started and sent to a poller. So it not necessary to persist it.
 // Returns (nil, nil) if there is no waiting poller which indicates that task has to be persisted.
+// A task waiting for a poller is only held in memory, it is safe as the caller is not acked until the task is
+// either matched or persisted, a caller whose request fails on a restart retries it.
+// Task lists in strict FIFO mode never sync match, their tasks are all read back from the database in task ID order.
 func (c *taskListManagerImpl) trySyncMatch(task *persistence.TaskInfo) (*persistence.CreateTasksResponse, error) {
-	if !c.config.EnableSyncMatch() {
+	if !c.config.EnableSyncMatch() || c.config.EnableStrictFIFO() || c.isPaused() {
 		return nil, nil
 	}
 	// Request from the point of view of Add(Activity|Decision)Task operation.
@@ -685,9 +811,40 @@ func (c *taskListManagerImpl) trySyncMatch(task *persistence.TaskInfo) (*persist
 		r := <-request.C
 		return r.response, r.err
 	default: // no poller waiting for tasks
+	}
+
+	if !c.startSyncMatchWait() {
 		rsv.Cancel()
 		return nil, nil
 	}
+	defer atomic.AddInt32(&c.syncMatchWaitingTasks, -1)
+
+	timer := time.NewTimer(c.config.SyncMatchWaitDuration())
+	defer timer.Stop()
+	select {
+	case c.tasksForPoll <- request:
+		c.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.SyncMatchAfterWaitCounter)
+		r := <-request.C
+		return r.response, r.err
+	case <-timer.C:
+		c.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.SyncMatchWaitSpillCounter)
+	case <-c.shutdownCh:
+	}
+	rsv.Cancel()
+	return nil, nil
+}
+
+// startSyncMatchWait reserves a slot for a task to wait in memory for a poller, it fails if waiting is disabled,
+// if too many tasks are already waiting, or if there is a persisted backlog the task would jump ahead of
+func (c *taskListManagerImpl) startSyncMatchWait() bool {
+	if c.config.SyncMatchWaitDuration() <= 0 || c.taskAckManager.getBacklogCountHint() > 0 {
+		return false
+	}
+	if atomic.AddInt32(&c.syncMatchWaitingTasks, 1) > int32(c.config.MaxSyncMatchWaitingTasks()) {
+		atomic.AddInt32(&c.syncMatchWaitingTasks, -1)
+		return false
+	}
+	return true
 }
 
 func (c *taskListManagerImpl) deliverBufferTasksForPoll() {
@@ -22,6 +22,7 @@ package matching
 
 import (
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 const _minBurst = 10000
@@ -66,10 +68,66 @@ func TestNewRateLimiter(t *testing.T) {
 	assert.Equal(t, _minBurst, limiter.Burst())
 }
 
+func TestSyncMatchWait(t *testing.T) {
+	cfg := defaultTestConfig()
+	cfg.SyncMatchWaitDuration = func(...dynamicconfig.FilterOption) time.Duration { return time.Minute }
+	tlm := createTestTaskListManagerWithConfig(cfg)
+
+	// a poller arriving after the task is added gets it without a write
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		result := <-tlm.tasksForPoll
+		assert.True(t, result.syncMatch)
+		result.C <- &syncMatchResponse{response: &persistence.CreateTasksResponse{}}
+	}()
+	resp, err := tlm.trySyncMatch(&persistence.TaskInfo{TaskID: 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&tlm.syncMatchWaitingTasks))
+
+	// tasks beyond the max number of waiting tasks are persisted right away
+	cfg.MaxSyncMatchWaitingTasks = func(...dynamicconfig.FilterOption) int { return 0 }
+	resp, err = tlm.trySyncMatch(&persistence.TaskInfo{TaskID: 2})
+	assert.NoError(t, err)
+	assert.Nil(t, resp)
+
+	// without a poller the task is persisted after waiting
+	cfg.MaxSyncMatchWaitingTasks = func(...dynamicconfig.FilterOption) int { return 1 }
+	cfg.SyncMatchWaitDuration = func(...dynamicconfig.FilterOption) time.Duration { return 10 * time.Millisecond }
+	resp, err = tlm.trySyncMatch(&persistence.TaskInfo{TaskID: 3})
+	assert.NoError(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&tlm.syncMatchWaitingTasks))
+}
+
+func TestSyncMatchStrictFIFO(t *testing.T) {
+	cfg := defaultTestConfig()
+	cfg.EnableStrictFIFO = func(...dynamicconfig.FilterOption) bool { return true }
+	tlm := createTestTaskListManagerWithConfig(cfg)
+
+	// a waiting poller does not get the task, it is persisted to be dispatched after the backlog
+	polled := make(chan *getTaskResult, 1)
+	go func() {
+		select {
+		case result := <-tlm.tasksForPoll:
+			polled <- result
+		case <-time.After(50 * time.Millisecond):
+		}
+	}()
+	resp, err := tlm.trySyncMatch(&persistence.TaskInfo{TaskID: 1})
+	assert.NoError(t, err)
+	assert.Nil(t, resp)
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, polled)
+}
+
 func createTestTaskListManager() *taskListManagerImpl {
+	return createTestTaskListManagerWithConfig(defaultTestConfig())
+}
+
+func createTestTaskListManagerWithConfig(cfg *Config) *taskListManagerImpl {
 	logger := bark.NewLoggerFromLogrus(log.New())
 	tm := newTestTaskManager(logger)
-	cfg := defaultTestConfig()
 	me := newMatchingEngine(
 		cfg, tm, &mocks.HistoryClient{}, logger,
 	)
@@ -168,6 +168,8 @@ writerLoop:
 				}
 
 				w.tlMgr.persistenceLock.Lock()
+				// read under the persistence lock to not revert a concurrent pause or resume
+				tlInfo.Paused = w.tlMgr.isPaused()
 				r, err := w.taskManager.CreateTasks(&persistence.CreateTasksRequest{
 					TaskListInfo: tlInfo,
 					Tasks:        tasks,
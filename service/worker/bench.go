@@ -0,0 +1,284 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	benchWorkflowName     = "cadence-bench-workflow"
+	benchSignalName       = "cadence-bench-signal"
+	benchDefaultTaskList  = "cadence-bench"
+	benchWorkflowIDPrefix = "cadence-bench-"
+	benchWorkflowTimeout  = time.Hour
+	benchDecisionTimeout  = 10 * time.Second
+	benchRPCTimeout       = 10 * time.Second
+	// each iteration of the bench workflow appends a timer started, a timer fired and the three events of a decision
+	benchEventsPerIteration = 5
+)
+
+var registerBenchWorkflow sync.Once
+
+type (
+	// BenchLoad generates synthetic load against the cluster the worker service belongs to. It starts bench
+	// workflows at the configured rate, each running a number of timer iterations drawn from the configured history
+	// size range, and signals the running ones at the configured rate. End to end latencies of the workflows are
+	// reported as metrics, which makes the load usable for the acceptance testing of persistence backends.
+	BenchLoad struct {
+		config        config.Bench
+		rpcFactory    common.RPCFactory
+		monitor       membership.Monitor
+		logger        bark.Logger
+		metricsClient metrics.Client
+
+		ctx        context.Context
+		cancel     context.CancelFunc
+		shutdownWG sync.WaitGroup
+
+		sync.Mutex
+		running map[string]struct{}
+	}
+)
+
+// NewBenchLoad creates a new bench load from the given config
+func NewBenchLoad(config config.Bench, rpcFactory common.RPCFactory, monitor membership.Monitor, logger bark.Logger,
+	metricsClient metrics.Client) *BenchLoad {
+	if config.TaskList == "" {
+		config.TaskList = benchDefaultTaskList
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &BenchLoad{
+		config:     config,
+		rpcFactory: rpcFactory,
+		monitor:    monitor,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueBenchComponent,
+		}),
+		metricsClient: metricsClient,
+		ctx:           ctx,
+		cancel:        cancel,
+		running:       make(map[string]struct{}),
+	}
+}
+
+// Start is called to start generating load
+func (b *BenchLoad) Start() {
+	registerBench()
+	b.shutdownWG.Add(1)
+	go b.run()
+}
+
+// Stop is called to stop generating load, bench workflows still running are left to complete on their own
+func (b *BenchLoad) Stop() {
+	b.cancel()
+	b.shutdownWG.Wait()
+}
+
+func (b *BenchLoad) run() {
+	defer b.shutdownWG.Done()
+
+	service, dispatcher, host, ok := dialFrontend(b.ctx, b.rpcFactory, b.monitor, b.logger)
+	if !ok {
+		return
+	}
+	defer dispatcher.Stop()
+
+	w := worker.New(service, b.config.Domain, b.config.TaskList, worker.Options{
+		Logger:   zap.NewNop(),
+		Identity: common.WorkerServiceName + "-bench@" + host.GetAddress(),
+	})
+	if err := w.Start(); err != nil {
+		b.logger.WithField(logging.TagErr, err).Error("Failed to start bench worker.")
+		return
+	}
+	defer w.Stop()
+
+	c := client.NewClient(service, b.config.Domain, &client.Options{})
+	b.logger.Infof("Bench load started, %v starts and %v signals per second.", b.config.StartRPS, b.config.SignalRPS)
+
+	startC := b.newTicker(b.config.StartRPS)
+	signalC := b.newTicker(b.config.SignalRPS)
+	for {
+		select {
+		case <-startC:
+			b.startWorkflow(c)
+		case <-signalC:
+			b.signalWorkflow(c)
+		case <-b.ctx.Done():
+			return
+		}
+	}
+}
+
+// newTicker returns a channel ticking rps times per second, or a channel never ticking if rps is not positive.
+// The ticker is stopped when the load is stopped.
+func (b *BenchLoad) newTicker(rps float64) <-chan time.Time {
+	if rps <= 0 {
+		return nil
+	}
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / rps))
+	go func() {
+		<-b.ctx.Done()
+		ticker.Stop()
+	}()
+	return ticker.C
+}
+
+func (b *BenchLoad) startWorkflow(c client.Client) {
+	workflowID := benchWorkflowIDPrefix + uuid.New()
+	options := client.StartWorkflowOptions{
+		ID:                              workflowID,
+		TaskList:                        b.config.TaskList,
+		ExecutionStartToCloseTimeout:    benchWorkflowTimeout,
+		DecisionTaskStartToCloseTimeout: benchDecisionTimeout,
+	}
+	iterations := benchIterations(b.config.MinHistorySize, b.config.MaxHistorySize, rand.Intn)
+
+	ctx, cancel := context.WithTimeout(b.ctx, benchRPCTimeout)
+	defer cancel()
+	startTime := time.Now()
+	run, err := c.ExecuteWorkflow(ctx, options, benchWorkflowName, iterations)
+	if err != nil {
+		b.metricsClient.IncCounter(metrics.BenchScope, metrics.BenchWorkflowFailures)
+		b.logger.WithField(logging.TagErr, err).Warn("Failed to start bench workflow.")
+		return
+	}
+	b.metricsClient.IncCounter(metrics.BenchScope, metrics.BenchWorkflowsStarted)
+
+	b.Lock()
+	b.running[workflowID] = struct{}{}
+	b.Unlock()
+
+	b.shutdownWG.Add(1)
+	go func() {
+		defer b.shutdownWG.Done()
+		defer func() {
+			b.Lock()
+			delete(b.running, workflowID)
+			b.Unlock()
+		}()
+
+		if err := run.Get(b.ctx, nil); err != nil {
+			if b.ctx.Err() == nil {
+				b.metricsClient.IncCounter(metrics.BenchScope, metrics.BenchWorkflowFailures)
+				b.logger.WithFields(bark.Fields{
+					logging.TagWorkflowExecutionID: workflowID,
+					logging.TagErr:                 err,
+				}).Warn("Bench workflow failed.")
+			}
+			return
+		}
+		b.metricsClient.IncCounter(metrics.BenchScope, metrics.BenchWorkflowsCompleted)
+		b.metricsClient.RecordTimer(metrics.BenchScope, metrics.BenchLatency, time.Since(startTime))
+	}()
+}
+
+func (b *BenchLoad) signalWorkflow(c client.Client) {
+	workflowID, ok := b.pickRunning()
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, benchRPCTimeout)
+	defer cancel()
+	err := c.SignalWorkflow(ctx, workflowID, "", benchSignalName, time.Now().UnixNano())
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			// the workflow completed after it was picked
+			return
+		}
+		b.metricsClient.IncCounter(metrics.BenchScope, metrics.BenchSignalFailures)
+		b.logger.WithFields(bark.Fields{
+			logging.TagWorkflowExecutionID: workflowID,
+			logging.TagErr:                 err,
+		}).Warn("Failed to signal bench workflow.")
+		return
+	}
+	b.metricsClient.IncCounter(metrics.BenchScope, metrics.BenchSignalsSent)
+}
+
+// pickRunning returns one of the running bench workflows, map iteration order is random enough for the bench
+func (b *BenchLoad) pickRunning() (string, bool) {
+	b.Lock()
+	defer b.Unlock()
+	for workflowID := range b.running {
+		return workflowID, true
+	}
+	return "", false
+}
+
+// registerBench registers the bench workflow with the client library, once per process
+func registerBench() {
+	registerBenchWorkflow.Do(func() {
+		workflow.RegisterWithOptions(benchWorkflow, workflow.RegisterOptions{Name: benchWorkflowName})
+	})
+}
+
+// benchIterations returns the number of iterations of a bench workflow whose history size is drawn uniformly from
+// the [minEvents, maxEvents] range
+func benchIterations(minEvents, maxEvents int, intn func(int) int) int {
+	events := minEvents
+	if maxEvents > minEvents {
+		events += intn(maxEvents - minEvents + 1)
+	}
+	if iterations := events / benchEventsPerIteration; iterations > 1 {
+		return iterations
+	}
+	return 1
+}
+
+// benchWorkflow sleeps for a second per iteration, draining the signals received in the meantime
+func benchWorkflow(ctx workflow.Context, iterations int) (int, error) {
+	if iterations <= 0 {
+		return 0, fmt.Errorf("invalid number of iterations: %v", iterations)
+	}
+
+	signalC := workflow.GetSignalChannel(ctx, benchSignalName)
+	signals := 0
+	for i := 0; i < iterations; i++ {
+		if err := workflow.Sleep(ctx, time.Second); err != nil {
+			return signals, err
+		}
+		var sentTime int64
+		for signalC.ReceiveAsync(&sentTime) {
+			signals++
+		}
+	}
+	return signals, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/testsuite"
+)
+
+type (
+	benchSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+	}
+)
+
+func TestBenchSuite(t *testing.T) {
+	s := new(benchSuite)
+	suite.Run(t, s)
+}
+
+func (s *benchSuite) SetupSuite() {
+	registerBench()
+}
+
+func (s *benchSuite) TestBenchIterations() {
+	fixed := func(n int) int { return n - 1 }
+	s.Equal(1, benchIterations(0, 0, fixed))
+	s.Equal(1, benchIterations(3, 3, fixed))
+	s.Equal(20, benchIterations(100, 100, fixed))
+	s.Equal(20, benchIterations(50, 100, fixed))
+	s.Equal(10, benchIterations(50, 100, func(int) int { return 0 }))
+	s.Equal(20, benchIterations(100, 10, fixed))
+}
+
+func (s *benchSuite) TestBenchWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(benchSignalName, int64(1))
+		env.SignalWorkflow(benchSignalName, int64(2))
+	}, 1500*time.Millisecond)
+	env.ExecuteWorkflow(benchWorkflowName, 3)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var signals int
+	s.NoError(env.GetWorkflowResult(&signals))
+	s.Equal(2, signals)
+}
+
+func (s *benchSuite) TestBenchWorkflow_InvalidIterations() {
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(benchWorkflowName, 0)
+
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/bulkdelete"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	bulkDeletePageActivityName   = "cadence-bulk-delete-page-activity"
+	bulkDeletePageSize           = 100
+	bulkDeletePagesPerRun        = 500
+	bulkDeleteMinActivityTimeout = time.Minute
+	bulkDeleteScheduleTimeout    = time.Minute
+	bulkDeleteRetryDelay         = 30 * time.Second
+	bulkDeleteThrottleTimeout    = time.Second
+	bulkDeleteExecutionTimeout   = 30 * time.Second
+)
+
+var registerBulkDeleteWorkflow sync.Once
+
+type (
+	// BulkDeleter runs the workflows deleting the closed workflow executions of a domain, which the admin API of the
+	// frontend starts in the configured domain. Each run of a workflow scans the closed executions of the domain from
+	// visibility one page at a time, in an activity having the history service delete the executions which closed
+	// before the requested time at the requested rate, and continues as new after a number of pages so that its
+	// history stays small. The progress is carried over from run to run and is returned by a query.
+	BulkDeleter struct {
+		config        config.BulkDelete
+		rpcFactory    common.RPCFactory
+		monitor       membership.Monitor
+		visibilityMgr persistence.VisibilityManager
+		history       history.Client
+		logger        bark.Logger
+		metricsClient metrics.Client
+
+		ctx        context.Context
+		cancel     context.CancelFunc
+		shutdownWG sync.WaitGroup
+	}
+)
+
+// NewBulkDeleter creates a new runner of the bulk delete workflows from the given config
+func NewBulkDeleter(config config.BulkDelete, rpcFactory common.RPCFactory, monitor membership.Monitor,
+	visibilityMgr persistence.VisibilityManager, history history.Client, logger bark.Logger,
+	metricsClient metrics.Client) *BulkDeleter {
+	if config.TaskList == "" {
+		config.TaskList = bulkdelete.DefaultTaskList
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &BulkDeleter{
+		config:        config,
+		rpcFactory:    rpcFactory,
+		monitor:       monitor,
+		visibilityMgr: visibilityMgr,
+		history:       history,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueBulkDeleteComponent,
+		}),
+		metricsClient: metricsClient,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// Start is called to start polling for the bulk delete workflows
+func (d *BulkDeleter) Start() {
+	registerBulkDelete(d)
+	d.shutdownWG.Add(1)
+	go d.run()
+}
+
+// Stop is called to stop polling for the bulk delete workflows, the workflows resume on another worker host
+func (d *BulkDeleter) Stop() {
+	d.cancel()
+	d.shutdownWG.Wait()
+}
+
+func (d *BulkDeleter) run() {
+	defer d.shutdownWG.Done()
+
+	service, dispatcher, host, ok := dialFrontend(d.ctx, d.rpcFactory, d.monitor, d.logger)
+	if !ok {
+		return
+	}
+	defer dispatcher.Stop()
+
+	w := worker.New(service, d.config.Domain, d.config.TaskList, worker.Options{
+		Logger:   zap.NewNop(),
+		Identity: common.WorkerServiceName + "-bulk-delete@" + host.GetAddress(),
+	})
+	if err := w.Start(); err != nil {
+		d.logger.WithField(logging.TagErr, err).Error("Failed to start bulk delete worker.")
+		return
+	}
+	defer w.Stop()
+
+	d.logger.Info("Bulk delete worker started.")
+	<-d.ctx.Done()
+}
+
+// registerBulkDelete registers the bulk delete workflow and activity with the client library, once per process
+func registerBulkDelete(d *BulkDeleter) {
+	registerBulkDeleteWorkflow.Do(func() {
+		workflow.RegisterWithOptions(bulkDeleteWorkflow, workflow.RegisterOptions{Name: bulkdelete.WorkflowName})
+		activity.RegisterWithOptions(d.deletePage, activity.RegisterOptions{Name: bulkDeletePageActivityName})
+	})
+}
+
+func bulkDeleteWorkflow(ctx workflow.Context, progress bulkdelete.Progress) (bulkdelete.Progress, error) {
+	if err := workflow.SetQueryHandler(ctx, bulkdelete.ProgressQueryType, func() (bulkdelete.Progress, error) {
+		return progress, nil
+	}); err != nil {
+		return progress, err
+	}
+	if progress.DeletesPerSecond <= 0 {
+		progress.DeletesPerSecond = bulkdelete.DefaultDeletesPerSecond
+	}
+
+	// a page takes at least as long as deleting all of its executions at the requested rate
+	timeout := time.Duration(bulkDeletePageSize/progress.DeletesPerSecond)*2*time.Second + bulkDeleteMinActivityTimeout
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: bulkDeleteScheduleTimeout,
+		StartToCloseTimeout:    timeout,
+	})
+	logger := workflow.GetLogger(ctx)
+	for pages := 0; pages < bulkDeletePagesPerRun; pages++ {
+		var next bulkdelete.Progress
+		if err := workflow.ExecuteActivity(ctx, bulkDeletePageActivityName, progress).Get(ctx, &next); err != nil {
+			// the page is scanned again from the same token, the executions of the page which are already
+			// deleted are not listed anymore
+			logger.Warn("Failed to delete a page of closed workflow executions.", zap.Error(err))
+			if err := workflow.Sleep(ctx, bulkDeleteRetryDelay); err != nil {
+				return progress, err
+			}
+			continue
+		}
+		progress = next
+		if progress.Completed {
+			return progress, nil
+		}
+	}
+	return progress, workflow.NewContinueAsNewError(ctx, bulkdelete.WorkflowName, progress)
+}
+
+// deletePage deletes the executions of the next page of closed executions of the domain which closed before the
+// requested time, and returns the progress advanced past the page. An execution which fails to be deleted is counted
+// and logged, it is not retried.
+func (d *BulkDeleter) deletePage(ctx context.Context, progress bulkdelete.Progress) (bulkdelete.Progress, error) {
+	resp, err := d.visibilityMgr.ListClosedWorkflowExecutions(&persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:      progress.DomainID,
+		LatestStartTime: progress.ClosedBeforeTime,
+		PageSize:        bulkDeletePageSize,
+		NextPageToken:   progress.NextPageToken,
+	})
+	if err != nil {
+		return progress, err
+	}
+
+	rateLimiter := common.NewTokenBucket(progress.DeletesPerSecond, common.NewRealTimeSource())
+	for _, execution := range resp.Executions {
+		progress.Scanned++
+		if execution.GetCloseTime() >= progress.ClosedBeforeTime {
+			progress.Skipped++
+			d.metricsClient.IncCounter(metrics.BulkDeleteScope, metrics.BulkDeleteExecutionsSkipped)
+			continue
+		}
+
+		for !rateLimiter.Consume(1, bulkDeleteThrottleTimeout) {
+			if err := ctx.Err(); err != nil {
+				return progress, err
+			}
+		}
+		if err := d.deleteExecution(ctx, progress.DomainID, execution); err != nil {
+			progress.Failed++
+			d.metricsClient.IncCounter(metrics.BulkDeleteScope, metrics.BulkDeleteExecutionFailures)
+			d.logger.WithFields(bark.Fields{
+				logging.TagDomainID:            progress.DomainID,
+				logging.TagWorkflowExecutionID: execution.Execution.GetWorkflowId(),
+				logging.TagWorkflowRunID:       execution.Execution.GetRunId(),
+				logging.TagErr:                 err,
+			}).Warn("Failed to delete workflow execution.")
+			continue
+		}
+		progress.Deleted++
+		d.metricsClient.IncCounter(metrics.BulkDeleteScope, metrics.BulkDeleteExecutionsDeleted)
+	}
+
+	progress.NextPageToken = resp.NextPageToken
+	progress.Completed = len(resp.NextPageToken) == 0
+	return progress, nil
+}
+
+// deleteExecution has the history service nuke a closed run, which deletes it under the lock of the run and clears
+// the run from the cache of the history host. The visibility record of the run is deleted last, so that the run is
+// listed again if the delete fails. The timer and transfer tasks of the run which are still queued are dropped when
+// they find the run missing.
+func (d *BulkDeleter) deleteExecution(ctx context.Context, domainID string, info *shared.WorkflowExecutionInfo) error {
+	ctx, cancel := context.WithTimeout(ctx, bulkDeleteExecutionTimeout)
+	defer cancel()
+	_, err := d.history.NukeWorkflowExecution(ctx, &h.NukeWorkflowExecutionRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: info.Execution,
+	})
+	return err
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/bulkdelete"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/cadence/testsuite"
+)
+
+type (
+	bulkDeleteSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+		mockVisibilityMgr *mocks.VisibilityManager
+		mockHistory       *mocks.HistoryClient
+		deleter           *BulkDeleter
+	}
+)
+
+func TestBulkDeleteSuite(t *testing.T) {
+	s := new(bulkDeleteSuite)
+	suite.Run(t, s)
+}
+
+func (s *bulkDeleteSuite) SetupTest() {
+	s.mockVisibilityMgr = &mocks.VisibilityManager{}
+	s.mockHistory = &mocks.HistoryClient{}
+	s.deleter = NewBulkDeleter(config.BulkDelete{Enabled: true, Domain: "cadence-system"}, nil, nil,
+		s.mockVisibilityMgr, s.mockHistory, bark.NewLoggerFromLogrus(logrus.New()),
+		metrics.NewClient(tally.NewTestScope("", nil), metrics.Worker))
+	registerBulkDelete(s.deleter)
+}
+
+func (s *bulkDeleteSuite) TearDownTest() {
+	s.mockVisibilityMgr.AssertExpectations(s.T())
+	s.mockHistory.AssertExpectations(s.T())
+}
+
+func (s *bulkDeleteSuite) TestDeletePage() {
+	domainID := "some random domain ID"
+	closedBefore := time.Date(2018, 6, 3, 10, 0, 0, 0, time.UTC).UnixNano()
+
+	s.mockVisibilityMgr.On("ListClosedWorkflowExecutions", &persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:      domainID,
+		LatestStartTime: closedBefore,
+		PageSize:        bulkDeletePageSize,
+		NextPageToken:   []byte("this page"),
+	}).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{
+			s.executionInfo("old", closedBefore-1),
+			s.executionInfo("recent", closedBefore),
+			s.executionInfo("broken", closedBefore-1),
+		},
+		NextPageToken: []byte("next page"),
+	}, nil).Once()
+	s.expectNuke("old", nil)
+	s.expectNuke("broken", &shared.InternalServiceError{Message: "some random error"})
+
+	progress, err := s.deleter.deletePage(context.Background(), bulkdelete.Progress{
+		DomainID:         domainID,
+		ClosedBeforeTime: closedBefore,
+		DeletesPerSecond: 100,
+		NextPageToken:    []byte("this page"),
+		Scanned:          10,
+		Deleted:          10,
+	})
+	s.NoError(err)
+	s.Equal(bulkdelete.Progress{
+		DomainID:         domainID,
+		ClosedBeforeTime: closedBefore,
+		DeletesPerSecond: 100,
+		NextPageToken:    []byte("next page"),
+		Scanned:          13,
+		Deleted:          11,
+		Skipped:          1,
+		Failed:           1,
+	}, progress)
+}
+
+func (s *bulkDeleteSuite) TestDeletePage_LastPage() {
+	s.mockVisibilityMgr.On("ListClosedWorkflowExecutions", mock.Anything).Return(
+		&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+
+	progress, err := s.deleter.deletePage(context.Background(), bulkdelete.Progress{
+		DomainID:         "some random domain ID",
+		ClosedBeforeTime: time.Now().UnixNano(),
+		DeletesPerSecond: 100,
+		NextPageToken:    []byte("last page"),
+	})
+	s.NoError(err)
+	s.True(progress.Completed)
+	s.Empty(progress.NextPageToken)
+}
+
+func (s *bulkDeleteSuite) TestWorkflow() {
+	input := bulkdelete.Progress{DomainID: "some random domain ID", ClosedBeforeTime: 1, DeletesPerSecond: 10}
+	firstPage := input
+	firstPage.NextPageToken = []byte("next page")
+	firstPage.Scanned = 2
+	firstPage.Deleted = 2
+	lastPage := firstPage
+	lastPage.NextPageToken = nil
+	lastPage.Scanned = 3
+	lastPage.Deleted = 3
+	lastPage.Completed = true
+
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(bulkDeletePageActivityName, mock.Anything, input).Return(firstPage, nil).Once()
+	env.OnActivity(bulkDeletePageActivityName, mock.Anything, firstPage).
+		Return(bulkdelete.Progress{}, errors.New("some random error")).Once()
+	env.OnActivity(bulkDeletePageActivityName, mock.Anything, firstPage).Return(lastPage, nil).Once()
+	env.ExecuteWorkflow(bulkdelete.WorkflowName, input)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result bulkdelete.Progress
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(lastPage, result)
+}
+
+func (s *bulkDeleteSuite) executionInfo(workflowID string, closeTime int64) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr("some random run ID"),
+		},
+		StartTime: common.Int64Ptr(closeTime - 1),
+		CloseTime: common.Int64Ptr(closeTime),
+	}
+}
+
+func (s *bulkDeleteSuite) expectNuke(workflowID string, err error) {
+	var resp *h.NukeWorkflowExecutionResponse
+	if err == nil {
+		resp = &h.NukeWorkflowExecutionResponse{}
+	}
+	s.mockHistory.On("NukeWorkflowExecution", mock.Anything, mock.MatchedBy(
+		func(request *h.NukeWorkflowExecutionRequest) bool {
+			return request.WorkflowExecution.GetWorkflowId() == workflowID
+		})).Return(resp, err).Once()
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	canaryTimerWorkflowName  = "cadence-canary-timer-workflow"
+	canarySignalWorkflowName = "cadence-canary-signal-workflow"
+	canaryChildWorkflowName  = "cadence-canary-child-workflow"
+	canaryQueryWorkflowName  = "cadence-canary-query-workflow"
+	canarySignalName         = "cadence-canary-signal"
+	canaryQueryType          = "cadence-canary-state"
+	canaryDefaultTaskList    = "cadence-canary"
+	canaryDefaultInterval    = time.Minute
+	canaryWorkflowIDPrefix   = "cadence-canary-"
+	canaryWorkflowTimeout    = 5 * time.Minute
+	canaryDecisionTimeout    = 10 * time.Second
+	canaryTestTimeout        = time.Minute
+	canaryTimerDuration      = time.Second
+)
+
+var registerCanaryWorkflows sync.Once
+
+type (
+	// Canary continuously runs a suite of canary workflows against the cluster the worker service belongs to, in each
+	// of the configured domains. Each canary exercises one feature end to end, through the client library, and its
+	// runs, failures and latencies are emitted tagged with the domain, so that regressions the unit and integration
+	// tests miss can be alerted on.
+	Canary struct {
+		config        config.Canary
+		rpcFactory    common.RPCFactory
+		monitor       membership.Monitor
+		logger        bark.Logger
+		metricsClient metrics.Client
+		domainTagger  *metrics.DomainTagger
+
+		ctx        context.Context
+		cancel     context.CancelFunc
+		shutdownWG sync.WaitGroup
+	}
+
+	canaryTest struct {
+		name  string
+		scope int
+		run   func(ctx context.Context, c client.Client, taskList string) error
+	}
+)
+
+var canaryTests = []canaryTest{
+	{name: "timer", scope: metrics.CanaryTimerScope, run: runTimerCanary},
+	{name: "signal", scope: metrics.CanarySignalScope, run: runSignalCanary},
+	{name: "child", scope: metrics.CanaryChildScope, run: runChildCanary},
+	{name: "query", scope: metrics.CanaryQueryScope, run: runQueryCanary},
+}
+
+// NewCanary creates a new canary from the given config
+func NewCanary(config config.Canary, rpcFactory common.RPCFactory, monitor membership.Monitor, logger bark.Logger,
+	metricsClient metrics.Client, domainMetricTags map[string]map[string]string) *Canary {
+	if config.TaskList == "" {
+		config.TaskList = canaryDefaultTaskList
+	}
+	if config.Interval <= 0 {
+		config.Interval = canaryDefaultInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Canary{
+		config:     config,
+		rpcFactory: rpcFactory,
+		monitor:    monitor,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueCanaryComponent,
+		}),
+		metricsClient: metricsClient,
+		domainTagger:  metrics.NewDomainTagger(metricsClient, domainMetricTags),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// Start is called to start running the canary workflows
+func (c *Canary) Start() {
+	registerCanary()
+	c.shutdownWG.Add(1)
+	go c.run()
+}
+
+// Stop is called to stop running the canary workflows
+func (c *Canary) Stop() {
+	c.cancel()
+	c.shutdownWG.Wait()
+}
+
+func (c *Canary) run() {
+	defer c.shutdownWG.Done()
+
+	service, dispatcher, host, ok := dialFrontend(c.ctx, c.rpcFactory, c.monitor, c.logger)
+	if !ok {
+		return
+	}
+	defer dispatcher.Stop()
+
+	var domainWG sync.WaitGroup
+	for _, domain := range c.config.Domains {
+		domainWG.Add(1)
+		go func(domain string) {
+			defer domainWG.Done()
+			c.runDomain(service, domain, host.GetAddress())
+		}(domain)
+	}
+	domainWG.Wait()
+}
+
+// runDomain runs the suite in the domain at the configured interval, until the canary is stopped
+func (c *Canary) runDomain(service workflowserviceclient.Interface, domain string, hostAddress string) {
+	logger := c.logger.WithField(logging.TagDomainName, domain)
+	w := worker.New(service, domain, c.config.TaskList, worker.Options{
+		Logger:   zap.NewNop(),
+		Identity: common.WorkerServiceName + "-canary@" + hostAddress,
+	})
+	if err := w.Start(); err != nil {
+		logger.WithField(logging.TagErr, err).Error("Failed to start canary worker.")
+		return
+	}
+	defer w.Stop()
+
+	cadenceClient := client.NewClient(service, domain, &client.Options{})
+	metricsClient := c.domainTagger.Client(domain)
+	logger.Info("Canary started.")
+
+	ticker := time.NewTicker(c.config.Interval)
+	defer ticker.Stop()
+	for {
+		for _, test := range canaryTests {
+			c.runTest(cadenceClient, metricsClient, logger, test)
+		}
+		select {
+		case <-ticker.C:
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *Canary) runTest(cadenceClient client.Client, metricsClient metrics.Client, logger bark.Logger,
+	test canaryTest) {
+	ctx, cancel := context.WithTimeout(c.ctx, canaryTestTimeout)
+	defer cancel()
+
+	metricsClient.IncCounter(test.scope, metrics.CadenceRequests)
+	sw := metricsClient.StartTimer(test.scope, metrics.CadenceLatency)
+	err := test.run(ctx, cadenceClient, c.config.TaskList)
+	sw.Stop()
+	if err != nil && c.ctx.Err() == nil {
+		metricsClient.IncCounter(test.scope, metrics.CadenceFailures)
+		logger.WithField(logging.TagErr, err).Warnf("Canary %v failed.", test.name)
+	}
+}
+
+func canaryStartOptions(taskList string) client.StartWorkflowOptions {
+	return client.StartWorkflowOptions{
+		ID:                              canaryWorkflowIDPrefix + uuid.New(),
+		TaskList:                        taskList,
+		ExecutionStartToCloseTimeout:    canaryWorkflowTimeout,
+		DecisionTaskStartToCloseTimeout: canaryDecisionTimeout,
+	}
+}
+
+// runTimerCanary checks a workflow timer fires, and not before it is due
+func runTimerCanary(ctx context.Context, c client.Client, taskList string) error {
+	run, err := c.ExecuteWorkflow(ctx, canaryStartOptions(taskList), canaryTimerWorkflowName)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx, nil)
+}
+
+// runSignalCanary checks a signal is delivered to the workflow with its input
+func runSignalCanary(ctx context.Context, c client.Client, taskList string) error {
+	options := canaryStartOptions(taskList)
+	run, err := c.ExecuteWorkflow(ctx, options, canarySignalWorkflowName)
+	if err != nil {
+		return err
+	}
+	sent := uuid.New()
+	if err := c.SignalWorkflow(ctx, options.ID, run.GetRunID(), canarySignalName, sent); err != nil {
+		return err
+	}
+	var received string
+	if err := run.Get(ctx, &received); err != nil {
+		return err
+	}
+	if received != sent {
+		return fmt.Errorf("signal input mismatch, sent %v, received %v", sent, received)
+	}
+	return nil
+}
+
+// runChildCanary checks a workflow completes once the child workflow it starts completes
+func runChildCanary(ctx context.Context, c client.Client, taskList string) error {
+	run, err := c.ExecuteWorkflow(ctx, canaryStartOptions(taskList), canaryChildWorkflowName)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx, nil)
+}
+
+// runQueryCanary checks a query of a running workflow returns its state, then signals the workflow to complete
+func runQueryCanary(ctx context.Context, c client.Client, taskList string) error {
+	state := uuid.New()
+	options := canaryStartOptions(taskList)
+	run, err := c.ExecuteWorkflow(ctx, options, canaryQueryWorkflowName, state)
+	if err != nil {
+		return err
+	}
+	value, err := c.QueryWorkflow(ctx, options.ID, run.GetRunID(), canaryQueryType)
+	if err != nil {
+		return err
+	}
+	var queried string
+	if err := value.Get(&queried); err != nil {
+		return err
+	}
+	if queried != state {
+		return fmt.Errorf("query result mismatch, expected %v, queried %v", state, queried)
+	}
+	if err := c.SignalWorkflow(ctx, options.ID, run.GetRunID(), canarySignalName, ""); err != nil {
+		return err
+	}
+	return run.Get(ctx, nil)
+}
+
+// registerCanary registers the canary workflows with the client library, once per process
+func registerCanary() {
+	registerCanaryWorkflows.Do(func() {
+		workflow.RegisterWithOptions(canaryTimerWorkflow, workflow.RegisterOptions{Name: canaryTimerWorkflowName})
+		workflow.RegisterWithOptions(canarySignalWorkflow, workflow.RegisterOptions{Name: canarySignalWorkflowName})
+		workflow.RegisterWithOptions(canaryChildWorkflow, workflow.RegisterOptions{Name: canaryChildWorkflowName})
+		workflow.RegisterWithOptions(canaryQueryWorkflow, workflow.RegisterOptions{Name: canaryQueryWorkflowName})
+	})
+}
+
+func canaryTimerWorkflow(ctx workflow.Context) error {
+	startTime := workflow.Now(ctx)
+	if err := workflow.Sleep(ctx, canaryTimerDuration); err != nil {
+		return err
+	}
+	if elapsed := workflow.Now(ctx).Sub(startTime); elapsed < canaryTimerDuration {
+		return fmt.Errorf("timer of %v fired after %v", canaryTimerDuration, elapsed)
+	}
+	return nil
+}
+
+func canarySignalWorkflow(ctx workflow.Context) (string, error) {
+	var input string
+	workflow.GetSignalChannel(ctx, canarySignalName).Receive(ctx, &input)
+	return input, nil
+}
+
+func canaryChildWorkflow(ctx workflow.Context) error {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		ExecutionStartToCloseTimeout: canaryWorkflowTimeout,
+		TaskStartToCloseTimeout:      canaryDecisionTimeout,
+	})
+	return workflow.ExecuteChildWorkflow(ctx, canaryTimerWorkflowName).Get(ctx, nil)
+}
+
+func canaryQueryWorkflow(ctx workflow.Context, state string) error {
+	if err := workflow.SetQueryHandler(ctx, canaryQueryType, func() (string, error) {
+		return state, nil
+	}); err != nil {
+		return err
+	}
+	var input string
+	workflow.GetSignalChannel(ctx, canarySignalName).Receive(ctx, &input)
+	return nil
+}
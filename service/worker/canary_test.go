@@ -0,0 +1,91 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/testsuite"
+)
+
+type (
+	canarySuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+	}
+)
+
+func TestCanarySuite(t *testing.T) {
+	s := new(canarySuite)
+	suite.Run(t, s)
+}
+
+func (s *canarySuite) SetupSuite() {
+	registerCanary()
+}
+
+func (s *canarySuite) TestTimerWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(canaryTimerWorkflowName)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *canarySuite) TestSignalWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(canarySignalName, "input")
+	}, time.Second)
+	env.ExecuteWorkflow(canarySignalWorkflowName)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var input string
+	s.NoError(env.GetWorkflowResult(&input))
+	s.Equal("input", input)
+}
+
+func (s *canarySuite) TestChildWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(canaryChildWorkflowName)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
+func (s *canarySuite) TestQueryWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterDelayedCallback(func() {
+		value, err := env.QueryWorkflow(canaryQueryType)
+		s.NoError(err)
+		var state string
+		s.NoError(value.Get(&state))
+		s.Equal("state", state)
+		env.SignalWorkflow(canarySignalName, "")
+	}, time.Second)
+	env.ExecuteWorkflow(canaryQueryWorkflowName, "state")
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
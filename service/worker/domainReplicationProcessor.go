@@ -0,0 +1,205 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber-go/kafka-client/kafka"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	domainReplicationThrottleTimeout = time.Second
+)
+
+type (
+	// domainReplicationTaskProcessor applies the domain replication tasks of a remote cluster. Domain tasks have their
+	// own topic so that a backlog of history replication tasks does not delay domain updates such as failovers, they
+	// are applied one at a time and in order, at a bounded rate.
+	domainReplicationTaskProcessor struct {
+		currentCluster   string
+		sourceCluster    string
+		consumerName     string
+		client           messaging.Client
+		consumer         kafka.Consumer
+		rateLimiter      common.TokenBucket
+		isStarted        int32
+		isStopped        int32
+		shutdownWG       sync.WaitGroup
+		shutdownCh       chan struct{}
+		logger           bark.Logger
+		metricsClient    metrics.Client
+		domainReplicator DomainReplicator
+	}
+)
+
+func newDomainReplicationTaskProcessor(currentCluster, sourceCluster, consumer string, client messaging.Client,
+	config *Config, logger bark.Logger, metricsClient metrics.Client,
+	domainReplicator DomainReplicator) *domainReplicationTaskProcessor {
+	return &domainReplicationTaskProcessor{
+		currentCluster: currentCluster,
+		sourceCluster:  sourceCluster,
+		consumerName:   consumer,
+		client:         client,
+		rateLimiter:    common.NewTokenBucket(config.DomainReplicatorRPS, common.NewRealTimeSource()),
+		shutdownCh:     make(chan struct{}),
+		logger: logger.WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueDomainReplicationTaskProcessorComponent,
+			logging.TagSourceCluster:     sourceCluster,
+			logging.TagConsumerName:      consumer,
+		}),
+		metricsClient:    metricsClient,
+		domainReplicator: domainReplicator,
+	}
+}
+
+// Start returns messaging.ErrDomainTopicsNotConfigured if the source cluster does not publish domain replication
+// tasks to a topic of their own, in which case they are applied by the history replication task processor
+func (p *domainReplicationTaskProcessor) Start() error {
+	if !atomic.CompareAndSwapInt32(&p.isStarted, 0, 1) {
+		return nil
+	}
+
+	logging.LogReplicationTaskProcessorStartingEvent(p.logger)
+	// domain tasks are applied in order, a single consumer goroutine is used
+	consumer, err := p.client.NewDomainConsumer(p.currentCluster, p.sourceCluster, p.consumerName, 1)
+	if err != nil {
+		logging.LogReplicationTaskProcessorStartFailedEvent(p.logger, err)
+		return err
+	}
+
+	if err := consumer.Start(); err != nil {
+		logging.LogReplicationTaskProcessorStartFailedEvent(p.logger, err)
+		return err
+	}
+
+	p.consumer = consumer
+	p.shutdownWG.Add(1)
+	go p.processorPump()
+
+	logging.LogReplicationTaskProcessorStartedEvent(p.logger)
+	return nil
+}
+
+func (p *domainReplicationTaskProcessor) Stop() {
+	if !atomic.CompareAndSwapInt32(&p.isStopped, 0, 1) {
+		return
+	}
+
+	logging.LogReplicationTaskProcessorShuttingDownEvent(p.logger)
+	defer logging.LogReplicationTaskProcessorShutdownEvent(p.logger)
+
+	if atomic.LoadInt32(&p.isStarted) == 1 {
+		close(p.shutdownCh)
+	}
+
+	if success := common.AwaitWaitGroup(&p.shutdownWG, time.Minute); !success {
+		logging.LogReplicationTaskProcessorShutdownTimedoutEvent(p.logger)
+	}
+}
+
+func (p *domainReplicationTaskProcessor) processorPump() {
+	defer p.shutdownWG.Done()
+	defer p.consumer.Stop()
+
+	for {
+		select {
+		case <-p.shutdownCh:
+			return
+		case msg, ok := <-p.consumer.Messages():
+			if !ok {
+				p.logger.Info("Domain replication task processor shutting down.")
+				return // channel closed
+			}
+			if !p.throttle() {
+				// shutting down, the message is redelivered to the next consumer
+				return
+			}
+			p.processMessage(msg)
+		case <-p.consumer.Closed():
+			p.logger.Info("Consumer closed. Domain replication task processor shutting down.")
+			return
+		}
+	}
+}
+
+// throttle blocks until the rate limiter allows the next task, it returns false if the processor is shutting down
+func (p *domainReplicationTaskProcessor) throttle() bool {
+	for !p.rateLimiter.Consume(1, domainReplicationThrottleTimeout) {
+		select {
+		case <-p.shutdownCh:
+			return false
+		default:
+		}
+	}
+	return true
+}
+
+func (p *domainReplicationTaskProcessor) processMessage(msg kafka.Message) {
+	scope := metrics.DomainReplicationTaskScope
+	p.metricsClient.IncCounter(scope, metrics.ReplicatorMessages)
+	sw := p.metricsClient.StartTimer(scope, metrics.ReplicatorLatency)
+	defer sw.Stop()
+
+	// the lag is only known if the producer sets the message timestamp
+	if publishTime := msg.Timestamp(); !publishTime.IsZero() {
+		p.metricsClient.RecordTimer(scope, metrics.DomainReplicationLag, time.Since(publishTime))
+	}
+
+	err := p.applyTask(msg.Value())
+	if err != nil {
+		p.logger.WithFields(bark.Fields{
+			logging.TagErr:       err,
+			logging.TagPartition: msg.Partition(),
+			logging.TagOffset:    msg.Offset(),
+		}).Error("Error processing domain replication task.")
+		p.metricsClient.IncCounter(scope, metrics.ReplicatorFailures)
+		msg.Nack()
+		return
+	}
+	msg.Ack()
+}
+
+func (p *domainReplicationTaskProcessor) applyTask(payload []byte) error {
+	task, err := deserialize(payload)
+	if err != nil {
+		return fmt.Errorf("Deserialize Error. Value: %v, Error: %v", string(payload), err)
+	}
+
+	if task.TaskType == nil {
+		return ErrEmptyReplicationTask
+	}
+	if task.GetTaskType() != replicator.ReplicationTaskTypeDomain {
+		return ErrUnknownReplicationTask
+	}
+
+	p.logger.Debugf("Received domain replication task %v.", task.DomainTaskAttributes)
+	return p.domainReplicator.HandleReceivingTask(task.DomainTaskAttributes)
+}
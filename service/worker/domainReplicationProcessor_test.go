@@ -0,0 +1,149 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/kafka-client/kafka"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"go.uber.org/zap/zapcore"
+)
+
+type (
+	domainReplicationProcessorSuite struct {
+		suite.Suite
+		domainReplicator *fakeDomainReplicator
+		processor        *domainReplicationTaskProcessor
+	}
+
+	fakeDomainReplicator struct {
+		tasks []*replicator.DomainTaskAttributes
+		err   error
+	}
+
+	fakeMessage struct {
+		value     []byte
+		timestamp time.Time
+		acked     bool
+		nacked    bool
+	}
+)
+
+var _ kafka.Message = (*fakeMessage)(nil)
+
+func TestDomainReplicationProcessorSuite(t *testing.T) {
+	suite.Run(t, new(domainReplicationProcessorSuite))
+}
+
+func (s *domainReplicationProcessorSuite) SetupTest() {
+	s.domainReplicator = &fakeDomainReplicator{}
+	s.processor = newDomainReplicationTaskProcessor("active", "standby", "consumer",
+		mocks.NewMockMessagingClient(nil, nil), NewConfig(), bark.NewLoggerFromLogrus(logrus.New()),
+		metrics.NewClient(tally.NoopScope, metrics.Worker), s.domainReplicator)
+}
+
+func (s *domainReplicationProcessorSuite) TestProcessMessage_DomainTask() {
+	operation := replicator.DomainOperationUpdate
+	taskType := replicator.ReplicationTaskTypeDomain
+	msg := s.newMessage(&replicator.ReplicationTask{
+		TaskType: &taskType,
+		DomainTaskAttributes: &replicator.DomainTaskAttributes{
+			DomainOperation: &operation,
+			ID:              common.StringPtr("some random domain ID"),
+		},
+	})
+
+	s.processor.processMessage(msg)
+	s.True(msg.acked)
+	s.False(msg.nacked)
+	s.Equal(1, len(s.domainReplicator.tasks))
+	s.Equal("some random domain ID", s.domainReplicator.tasks[0].GetID())
+}
+
+func (s *domainReplicationProcessorSuite) TestProcessMessage_HistoryTask() {
+	taskType := replicator.ReplicationTaskTypeHistory
+	msg := s.newMessage(&replicator.ReplicationTask{
+		TaskType:              &taskType,
+		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{},
+	})
+
+	s.Equal(ErrUnknownReplicationTask, s.processor.applyTask(msg.value))
+	s.processor.processMessage(msg)
+	s.True(msg.nacked)
+	s.Empty(s.domainReplicator.tasks)
+}
+
+func (s *domainReplicationProcessorSuite) TestProcessMessage_Failure() {
+	s.domainReplicator.err = errors.New("some random error")
+	taskType := replicator.ReplicationTaskTypeDomain
+	msg := s.newMessage(&replicator.ReplicationTask{
+		TaskType:             &taskType,
+		DomainTaskAttributes: &replicator.DomainTaskAttributes{},
+	})
+
+	s.processor.processMessage(msg)
+	s.False(msg.acked)
+	s.True(msg.nacked)
+}
+
+func (s *domainReplicationProcessorSuite) TestProcessMessage_Corrupted() {
+	msg := &fakeMessage{value: []byte("not a replication task")}
+
+	s.processor.processMessage(msg)
+	s.True(msg.nacked)
+	s.Empty(s.domainReplicator.tasks)
+}
+
+func (s *domainReplicationProcessorSuite) newMessage(task *replicator.ReplicationTask) *fakeMessage {
+	payload, err := json.Marshal(task)
+	s.Nil(err)
+	return &fakeMessage{value: payload, timestamp: time.Now()}
+}
+
+func (r *fakeDomainReplicator) HandleReceivingTask(task *replicator.DomainTaskAttributes) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.tasks = append(r.tasks, task)
+	return nil
+}
+
+func (m *fakeMessage) MarshalLogObject(enc zapcore.ObjectEncoder) error { return nil }
+func (m *fakeMessage) Key() []byte                                      { return nil }
+func (m *fakeMessage) Value() []byte                                    { return m.value }
+func (m *fakeMessage) Topic() string                                    { return "standby-domain" }
+func (m *fakeMessage) Partition() int32                                 { return 0 }
+func (m *fakeMessage) Offset() int64                                    { return 0 }
+func (m *fakeMessage) Timestamp() time.Time                             { return m.timestamp }
+func (m *fakeMessage) RetryCount() int64                                { return 0 }
+func (m *fakeMessage) Ack() error                                       { m.acked = true; return nil }
+func (m *fakeMessage) Nack() error                                      { m.nacked = true; return nil }
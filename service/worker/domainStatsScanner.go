@@ -0,0 +1,249 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	domainStatsListPageSize = 100
+	openExecutionsPageSize  = 1000
+)
+
+type (
+	// DomainStatsScanner periodically computes the daily statistics of domains. The statistics of a day are derived
+	// from the history counts the history service adds to as history is appended, and the running total of bytes
+	// appended is carried over from the statistics of the previous day, so each scan only reads the days since the last
+	// one. Scans are idempotent, running them concurrently on several worker hosts is wasteful but safe.
+	DomainStatsScanner struct {
+		statsMgr      persistence.DomainStatsManager
+		visibilityMgr persistence.VisibilityManager
+		domainCache   cache.DomainCache
+		config        *Config
+		logger        bark.Logger
+		metricsClient metrics.Client
+		domainTagger  *metrics.DomainTagger
+		shutdownCh    chan struct{}
+		shutdownWG    sync.WaitGroup
+	}
+)
+
+// NewDomainStatsScanner creates a new scanner computing domain statistics
+func NewDomainStatsScanner(statsMgr persistence.DomainStatsManager, visibilityMgr persistence.VisibilityManager,
+	domainCache cache.DomainCache, config *Config, logger bark.Logger, metricsClient metrics.Client,
+	domainMetricTags map[string]map[string]string) *DomainStatsScanner {
+	return &DomainStatsScanner{
+		statsMgr:      statsMgr,
+		visibilityMgr: visibilityMgr,
+		domainCache:   domainCache,
+		config:        config,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueDomainStatsScannerComponent,
+		}),
+		metricsClient: metricsClient,
+		domainTagger:  metrics.NewDomainTagger(metricsClient, domainMetricTags),
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+// Start is called to start the scanner
+func (s *DomainStatsScanner) Start() {
+	s.shutdownWG.Add(1)
+	go s.scanLoop()
+}
+
+// Stop is called to stop the scanner
+func (s *DomainStatsScanner) Stop() {
+	close(s.shutdownCh)
+	s.shutdownWG.Wait()
+}
+
+func (s *DomainStatsScanner) scanLoop() {
+	defer s.shutdownWG.Done()
+
+	ticker := time.NewTicker(s.config.DomainStatsScanInterval)
+	defer ticker.Stop()
+	for {
+		s.scan(time.Now())
+		select {
+		case <-ticker.C:
+		case <-s.shutdownCh:
+			return
+		}
+	}
+}
+
+func (s *DomainStatsScanner) scan(now time.Time) {
+	sw := s.metricsClient.StartTimer(metrics.DomainStatsScannerScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	var nextPageToken []byte
+	for {
+		resp, err := s.statsMgr.ListHistoryCountDomains(&persistence.ListHistoryCountDomainsRequest{
+			PageSize:      domainStatsListPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			s.metricsClient.IncCounter(metrics.DomainStatsScannerScope, metrics.CadenceFailures)
+			s.logger.WithField(logging.TagErr, err).Warn("Failed to list domains with history counts.")
+			return
+		}
+
+		for _, domainID := range resp.DomainIDs {
+			select {
+			case <-s.shutdownCh:
+				return
+			default:
+			}
+
+			s.metricsClient.IncCounter(metrics.DomainStatsScannerScope, metrics.CadenceRequests)
+			if err := s.scanDomain(domainID, now); err != nil {
+				s.metricsClient.IncCounter(metrics.DomainStatsScannerScope, metrics.CadenceFailures)
+				s.logger.WithFields(bark.Fields{
+					logging.TagDomainID: domainID,
+					logging.TagErr:      err,
+				}).Warn("Failed to compute domain stats.")
+			}
+		}
+
+		if len(resp.NextPageToken) == 0 {
+			return
+		}
+		nextPageToken = resp.NextPageToken
+	}
+}
+
+// scanDomain computes the statistics of the domain for the days since the last scan
+func (s *DomainStatsScanner) scanDomain(domainID string, now time.Time) error {
+	today := now.UTC().Truncate(24 * time.Hour)
+	fromDay := time.Unix(0, 0).UTC()
+	totalBytesAppended := int64(0)
+
+	latest, err := s.statsMgr.GetDomainStats(&persistence.GetDomainStatsRequest{
+		DomainID: domainID,
+		Days:     1,
+	})
+	if err != nil {
+		return err
+	}
+	if len(latest.Stats) > 0 {
+		// the latest day was likely computed before it was over, compute it again
+		last := latest.Stats[0]
+		fromDay = last.Day
+		totalBytesAppended = last.TotalBytesAppended - last.BytesAppended
+	}
+
+	counts, err := s.statsMgr.GetHistoryCounts(&persistence.GetHistoryCountsRequest{
+		DomainID: domainID,
+		FromDay:  fromDay,
+		ToDay:    today,
+	})
+	if err != nil {
+		return err
+	}
+
+	openExecutions, err := s.countOpenExecutions(domainID, now)
+	if err != nil {
+		return err
+	}
+
+	var todayStats *persistence.DomainStats
+	for _, c := range counts.Counts {
+		totalBytesAppended += c.Bytes
+		stats := &persistence.DomainStats{
+			DomainID:           domainID,
+			Day:                c.Day,
+			OpenExecutions:     openExecutions,
+			TotalBytesAppended: totalBytesAppended,
+			EventsAppended:     c.Events,
+			BytesAppended:      c.Bytes,
+			LastUpdated:        now,
+		}
+		if err := s.statsMgr.UpsertDomainStats(&persistence.UpsertDomainStatsRequest{Stats: stats}); err != nil {
+			return err
+		}
+		if c.Day.Equal(today) {
+			todayStats = stats
+		}
+	}
+
+	if todayStats == nil {
+		// keep the open executions of today current even if no history was appended yet
+		todayStats = &persistence.DomainStats{
+			DomainID:           domainID,
+			Day:                today,
+			OpenExecutions:     openExecutions,
+			TotalBytesAppended: totalBytesAppended,
+			LastUpdated:        now,
+		}
+		if err := s.statsMgr.UpsertDomainStats(&persistence.UpsertDomainStatsRequest{Stats: todayStats}); err != nil {
+			return err
+		}
+	}
+
+	return s.emitDomainStats(todayStats)
+}
+
+// emitDomainStats reports the statistics of today as gauges tagged with the domain and the tags mapped to it
+func (s *DomainStatsScanner) emitDomainStats(stats *persistence.DomainStats) error {
+	domain, err := s.domainCache.GetDomainByID(stats.DomainID)
+	if err != nil {
+		return err
+	}
+
+	metricsClient := s.domainTagger.Client(domain.GetInfo().Name)
+	metricsClient.UpdateGauge(metrics.DomainStatsScannerScope, metrics.DomainOpenExecutions, float64(stats.OpenExecutions))
+	metricsClient.UpdateGauge(metrics.DomainStatsScannerScope, metrics.DomainTotalBytesAppended, float64(stats.TotalBytesAppended))
+	metricsClient.UpdateGauge(metrics.DomainStatsScannerScope, metrics.DomainEventsAppended, float64(stats.EventsAppended))
+	metricsClient.UpdateGauge(metrics.DomainStatsScannerScope, metrics.DomainBytesAppended, float64(stats.BytesAppended))
+	return nil
+}
+
+func (s *DomainStatsScanner) countOpenExecutions(domainID string, now time.Time) (int64, error) {
+	count := int64(0)
+	var nextPageToken []byte
+	for {
+		resp, err := s.visibilityMgr.ListOpenWorkflowExecutions(&persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:        domainID,
+			EarliestStartTime: 0,
+			LatestStartTime:   now.UnixNano(),
+			PageSize:          openExecutionsPageSize,
+			NextPageToken:     nextPageToken,
+		})
+		if err != nil {
+			return 0, err
+		}
+		count += int64(len(resp.Executions))
+
+		if len(resp.NextPageToken) == 0 {
+			return count, nil
+		}
+		nextPageToken = resp.NextPageToken
+	}
+}
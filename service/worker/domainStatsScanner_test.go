@@ -0,0 +1,249 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	domainStatsScannerSuite struct {
+		suite.Suite
+		mockStatsMgr      *mocks.DomainStatsManager
+		mockVisibilityMgr *mocks.VisibilityManager
+		mockMetadataMgr   *mocks.MetadataManager
+		metricsScope      tally.TestScope
+		scanner           *DomainStatsScanner
+	}
+)
+
+func TestDomainStatsScannerSuite(t *testing.T) {
+	s := new(domainStatsScannerSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainStatsScannerSuite) SetupTest() {
+	s.mockStatsMgr = &mocks.DomainStatsManager{}
+	s.mockVisibilityMgr = &mocks.VisibilityManager{}
+	s.mockMetadataMgr = &mocks.MetadataManager{}
+	s.metricsScope = tally.NewTestScope("", nil)
+	logger := bark.NewLoggerFromLogrus(logrus.New())
+	domainCache := cache.NewDomainCache(s.mockMetadataMgr, cluster.GetTestClusterMetadata(false, false), logger)
+	s.scanner = NewDomainStatsScanner(s.mockStatsMgr, s.mockVisibilityMgr, domainCache, NewConfig(), logger,
+		metrics.NewClient(s.metricsScope, metrics.Worker), map[string]map[string]string{
+			"some random domain": {"team": "some random team"},
+		})
+}
+
+func (s *domainStatsScannerSuite) TearDownTest() {
+	s.mockStatsMgr.AssertExpectations(s.T())
+	s.mockVisibilityMgr.AssertExpectations(s.T())
+	s.mockMetadataMgr.AssertExpectations(s.T())
+}
+
+func (s *domainStatsScannerSuite) TestScanDomain_FirstScan() {
+	domainID := "some random domain ID"
+	now := time.Date(2018, 6, 3, 10, 0, 0, 0, time.UTC)
+	today := now.Truncate(24 * time.Hour)
+	yesterday := today.Add(-24 * time.Hour)
+
+	s.mockStatsMgr.On("GetDomainStats", &persistence.GetDomainStatsRequest{DomainID: domainID, Days: 1}).
+		Return(&persistence.GetDomainStatsResponse{}, nil).Once()
+	s.mockStatsMgr.On("GetHistoryCounts", &persistence.GetHistoryCountsRequest{
+		DomainID: domainID,
+		FromDay:  time.Unix(0, 0).UTC(),
+		ToDay:    today,
+	}).Return(&persistence.GetHistoryCountsResponse{
+		Counts: []*persistence.HistoryCount{
+			{Day: yesterday, Events: 10, Bytes: 1000},
+			{Day: today, Events: 5, Bytes: 500},
+		},
+	}, nil).Once()
+	s.expectOpenExecutions(domainID, 3)
+	s.mockStatsMgr.On("UpsertDomainStats", &persistence.UpsertDomainStatsRequest{
+		Stats: &persistence.DomainStats{
+			DomainID:           domainID,
+			Day:                yesterday,
+			OpenExecutions:     3,
+			TotalBytesAppended: 1000,
+			EventsAppended:     10,
+			BytesAppended:      1000,
+			LastUpdated:        now,
+		},
+	}).Return(nil).Once()
+	s.mockStatsMgr.On("UpsertDomainStats", &persistence.UpsertDomainStatsRequest{
+		Stats: &persistence.DomainStats{
+			DomainID:           domainID,
+			Day:                today,
+			OpenExecutions:     3,
+			TotalBytesAppended: 1500,
+			EventsAppended:     5,
+			BytesAppended:      500,
+			LastUpdated:        now,
+		},
+	}).Return(nil).Once()
+
+	s.expectDomain(domainID)
+
+	s.NoError(s.scanner.scanDomain(domainID, now))
+}
+
+func (s *domainStatsScannerSuite) TestScanDomain_ContinuesFromLatestDay() {
+	domainID := "some random domain ID"
+	now := time.Date(2018, 6, 3, 10, 0, 0, 0, time.UTC)
+	today := now.Truncate(24 * time.Hour)
+	yesterday := today.Add(-24 * time.Hour)
+
+	// yesterday was computed before it was over, its bytes appended are recomputed from the counts
+	s.mockStatsMgr.On("GetDomainStats", &persistence.GetDomainStatsRequest{DomainID: domainID, Days: 1}).
+		Return(&persistence.GetDomainStatsResponse{
+			Stats: []*persistence.DomainStats{
+				{DomainID: domainID, Day: yesterday, TotalBytesAppended: 5000, EventsAppended: 4, BytesAppended: 400},
+			},
+		}, nil).Once()
+	s.mockStatsMgr.On("GetHistoryCounts", &persistence.GetHistoryCountsRequest{
+		DomainID: domainID,
+		FromDay:  yesterday,
+		ToDay:    today,
+	}).Return(&persistence.GetHistoryCountsResponse{
+		Counts: []*persistence.HistoryCount{
+			{Day: yesterday, Events: 6, Bytes: 600},
+		},
+	}, nil).Once()
+	s.expectOpenExecutions(domainID, 0)
+	s.mockStatsMgr.On("UpsertDomainStats", &persistence.UpsertDomainStatsRequest{
+		Stats: &persistence.DomainStats{
+			DomainID:           domainID,
+			Day:                yesterday,
+			TotalBytesAppended: 5200,
+			EventsAppended:     6,
+			BytesAppended:      600,
+			LastUpdated:        now,
+		},
+	}).Return(nil).Once()
+	// no history appended today, the day is still recorded with the running total
+	s.mockStatsMgr.On("UpsertDomainStats", &persistence.UpsertDomainStatsRequest{
+		Stats: &persistence.DomainStats{
+			DomainID:           domainID,
+			Day:                today,
+			TotalBytesAppended: 5200,
+			LastUpdated:        now,
+		},
+	}).Return(nil).Once()
+
+	s.expectDomain(domainID)
+
+	s.NoError(s.scanner.scanDomain(domainID, now))
+}
+
+func (s *domainStatsScannerSuite) TestScan_ListsAllDomains() {
+	now := time.Date(2018, 6, 3, 10, 0, 0, 0, time.UTC)
+	token := []byte("next page")
+
+	s.mockStatsMgr.On("ListHistoryCountDomains", &persistence.ListHistoryCountDomainsRequest{
+		PageSize: domainStatsListPageSize,
+	}).Return(&persistence.ListHistoryCountDomainsResponse{
+		DomainIDs:     []string{"domain1"},
+		NextPageToken: token,
+	}, nil).Once()
+	s.mockStatsMgr.On("ListHistoryCountDomains", &persistence.ListHistoryCountDomainsRequest{
+		PageSize:      domainStatsListPageSize,
+		NextPageToken: token,
+	}).Return(&persistence.ListHistoryCountDomainsResponse{
+		DomainIDs: []string{"domain2"},
+	}, nil).Once()
+	// failing to compute one domain does not stop the scan
+	s.mockStatsMgr.On("GetDomainStats", &persistence.GetDomainStatsRequest{DomainID: "domain1", Days: 1}).
+		Return(nil, &shared.InternalServiceError{Message: "some random error"}).Once()
+	s.mockStatsMgr.On("GetDomainStats", &persistence.GetDomainStatsRequest{DomainID: "domain2", Days: 1}).
+		Return(&persistence.GetDomainStatsResponse{}, nil).Once()
+	s.mockStatsMgr.On("GetHistoryCounts", mock.Anything).Return(&persistence.GetHistoryCountsResponse{}, nil).Once()
+	s.expectOpenExecutions("domain2", 0)
+	s.mockStatsMgr.On("UpsertDomainStats", mock.Anything).Return(nil).Once()
+	s.expectDomain("domain2")
+
+	s.scanner.scan(now)
+}
+
+func (s *domainStatsScannerSuite) TestScanDomain_EmitsTaggedGauges() {
+	domainID := "some random domain ID"
+	now := time.Date(2018, 6, 3, 10, 0, 0, 0, time.UTC)
+	today := now.Truncate(24 * time.Hour)
+
+	s.mockStatsMgr.On("GetDomainStats", mock.Anything).Return(&persistence.GetDomainStatsResponse{}, nil).Once()
+	s.mockStatsMgr.On("GetHistoryCounts", mock.Anything).Return(&persistence.GetHistoryCountsResponse{
+		Counts: []*persistence.HistoryCount{{Day: today, Events: 5, Bytes: 500}},
+	}, nil).Once()
+	s.expectOpenExecutions(domainID, 2)
+	s.mockStatsMgr.On("UpsertDomainStats", mock.Anything).Return(nil).Once()
+	s.expectDomain(domainID)
+
+	s.NoError(s.scanner.scanDomain(domainID, now))
+
+	values := make(map[string]float64)
+	for _, gauge := range s.metricsScope.Snapshot().Gauges() {
+		tags := gauge.Tags()
+		if tags[metrics.OperationTagName] != "DomainStatsScanner" || tags[metrics.DomainTagName] == "" {
+			continue
+		}
+		s.Equal("some random domain", tags[metrics.DomainTagName])
+		s.Equal("some random team", tags["team"])
+		values[gauge.Name()] = gauge.Value()
+	}
+	s.Equal(map[string]float64{
+		"domain.open-executions":      2,
+		"domain.total-bytes-appended": 500,
+		"domain.events-appended":      5,
+		"domain.bytes-appended":       500,
+	}, values)
+}
+
+func (s *domainStatsScannerSuite) expectDomain(domainID string) {
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(&persistence.GetDomainResponse{
+		Info:              &persistence.DomainInfo{ID: domainID, Name: "some random domain"},
+		Config:            &persistence.DomainConfig{},
+		ReplicationConfig: &persistence.DomainReplicationConfig{},
+	}, nil).Once()
+}
+
+func (s *domainStatsScannerSuite) expectOpenExecutions(domainID string, count int) {
+	executions := make([]*shared.WorkflowExecutionInfo, count)
+	for i := range executions {
+		executions[i] = &shared.WorkflowExecutionInfo{}
+	}
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutions", mock.MatchedBy(
+		func(request *persistence.ListWorkflowExecutionsRequest) bool {
+			return request.DomainUUID == domainID
+		})).Return(&persistence.ListWorkflowExecutionsResponse{Executions: executions}, nil).Once()
+}
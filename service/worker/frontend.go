@@ -0,0 +1,60 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/membership"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/yarpc"
+)
+
+const frontendRetryDelay = 5 * time.Second
+
+// dialFrontend resolves a frontend host of the cluster and creates a workflow service client for it, for the loads
+// the worker service generates through the client library. Resolution is retried until a host is found or the
+// context is done. The returned dispatcher must be stopped once the client is no longer used.
+func dialFrontend(ctx context.Context, rpcFactory common.RPCFactory, monitor membership.Monitor,
+	logger bark.Logger) (workflowserviceclient.Interface, *yarpc.Dispatcher, *membership.HostInfo, bool) {
+	for {
+		resolver, err := monitor.GetResolver(common.FrontendServiceName)
+		if err == nil {
+			host, err := resolver.Lookup(common.WorkerServiceName)
+			if err == nil {
+				dispatcher := rpcFactory.CreateDispatcherForOutbound(common.WorkerServiceName,
+					common.FrontendServiceName, host.GetAddress())
+				service := workflowserviceclient.New(dispatcher.ClientConfig(common.FrontendServiceName))
+				return service, dispatcher, host, true
+			}
+		}
+		logger.WithField(logging.TagErr, err).Warn("Failed to resolve a frontend host.")
+		select {
+		case <-time.After(frontendRetryDelay):
+		case <-ctx.Done():
+			return nil, nil, nil, false
+		}
+	}
+}
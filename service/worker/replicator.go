@@ -41,6 +41,7 @@ type (
 		config           *Config
 		client           messaging.Client
 		processors       []*replicationTaskProcessor
+		domainProcessors []*domainReplicationTaskProcessor
 		logger           bark.Logger
 		metricsClient    metrics.Client
 	}
@@ -81,6 +82,22 @@ func (r *Replicator) Start() error {
 		}
 	}
 
+	for cluster := range r.clusterMetadata.GetAllClusterFailoverVersions() {
+		if cluster != currentClusterName {
+			consumerName := getDomainConsumerName(currentClusterName, cluster)
+			processor := newDomainReplicationTaskProcessor(currentClusterName, cluster, consumerName, r.client,
+				r.config, r.logger, r.metricsClient, r.domainReplicator)
+			r.domainProcessors = append(r.domainProcessors, processor)
+			if err := processor.Start(); err != nil {
+				if err == messaging.ErrDomainTopicsNotConfigured {
+					// domain replication tasks of this cluster are on the history replication topic
+					continue
+				}
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -89,8 +106,15 @@ func (r *Replicator) Stop() {
 	for _, processor := range r.processors {
 		processor.Stop()
 	}
+	for _, processor := range r.domainProcessors {
+		processor.Stop()
+	}
 }
 
 func getConsumerName(currentCluster, remoteCluster string) string {
 	return fmt.Sprintf("%v_consumer_for_%v", currentCluster, remoteCluster)
 }
+
+func getDomainConsumerName(currentCluster, remoteCluster string) string {
+	return fmt.Sprintf("%v_domain_consumer_for_%v", currentCluster, remoteCluster)
+}
@@ -21,10 +21,14 @@
 package worker
 
 import (
+	"time"
+
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 )
 
 type (
@@ -42,6 +46,16 @@ type (
 	Config struct {
 		// Replicator settings
 		ReplicatorConcurrency int
+		// DomainReplicatorRPS is the maximum rate at which the domain replication tasks of a remote cluster are applied
+		DomainReplicatorRPS int
+		// DomainStatsScanInterval is the interval between computations of the domain statistics
+		DomainStatsScanInterval time.Duration
+		// StuckWorkflowScanInterval is the interval between scans for stuck workflows
+		StuckWorkflowScanInterval time.Duration
+		// StuckWorkflowThreshold is how long an open workflow has to go without updates to be reported as stuck
+		StuckWorkflowThreshold time.Duration
+		// StuckWorkflowMaxChecksPerDomain bounds the number of old open workflows of a domain checked in a scan
+		StuckWorkflowMaxChecksPerDomain int
 	}
 )
 
@@ -57,7 +71,12 @@ func NewService(params *service.BootstrapParams) common.Daemon {
 // NewConfig builds the new Config for cadence-worker service
 func NewConfig() *Config {
 	return &Config{
-		ReplicatorConcurrency: 10,
+		ReplicatorConcurrency:           10,
+		DomainReplicatorRPS:             10,
+		DomainStatsScanInterval:         time.Hour,
+		StuckWorkflowScanInterval:       time.Hour,
+		StuckWorkflowThreshold:          24 * time.Hour,
+		StuckWorkflowMaxChecksPerDomain: 1000,
 	}
 }
 
@@ -72,12 +91,13 @@ func (s *Service) Start() {
 
 	s.metricsClient = base.GetMetricsClient()
 
-	metadataManager, err := persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
+	metadataCfg := p.CassandraConfig.ForStore(config.StoreMetadata)
+	metadataManager, err := persistence.NewCassandraMetadataPersistence(metadataCfg.Hosts,
+		metadataCfg.Port,
+		metadataCfg.User,
+		metadataCfg.Password,
+		metadataCfg.Datacenter,
+		metadataCfg.Keyspace,
 		p.ClusterMetadata.GetCurrentClusterName(),
 		p.Logger)
 
@@ -98,8 +118,76 @@ func (s *Service) Start() {
 		log.Fatalf("Fail to start replicator: %v", err)
 	}
 
+	visibilityCfg := p.CassandraConfig.ForStore(config.StoreVisibility)
+	visibilityManager, err := persistence.NewCassandraVisibilityPersistence(visibilityCfg.Hosts,
+		visibilityCfg.Port,
+		visibilityCfg.User,
+		visibilityCfg.Password,
+		visibilityCfg.Datacenter,
+		visibilityCfg.VisibilityKeyspace,
+		visibilityCfg.Timeouts,
+		p.Logger)
+
+	if err != nil {
+		log.Fatalf("failed to create visiblity manager: %v", err)
+	}
+	visibilityManager = persistence.NewVisibilityPersistenceClient(visibilityManager, s.metricsClient)
+
+	domainStatsManager, err := persistence.NewCassandraDomainStatsPersistence(p.CassandraConfig.Hosts,
+		p.CassandraConfig.Port,
+		p.CassandraConfig.User,
+		p.CassandraConfig.Password,
+		p.CassandraConfig.Datacenter,
+		p.CassandraConfig.Keyspace,
+		p.Logger)
+
+	if err != nil {
+		log.Fatalf("failed to create domain stats manager: %v", err)
+	}
+	domainStatsManager = persistence.NewDomainStatsPersistenceClient(domainStatsManager, s.metricsClient)
+
+	domainCache := cache.NewDomainCache(metadataManager, p.ClusterMetadata, log)
+	domainStatsScanner := NewDomainStatsScanner(domainStatsManager, visibilityManager, domainCache, s.config, log,
+		s.metricsClient, p.DomainMetricTags)
+	domainStatsScanner.Start()
+
+	stuckWorkflowScanner := NewStuckWorkflowScanner(metadataManager, visibilityManager, history, s.config, log,
+		s.metricsClient, p.DomainMetricTags)
+	stuckWorkflowScanner.Start()
+
+	var benchLoad *BenchLoad
+	if p.BenchConfig.Enabled {
+		benchLoad = NewBenchLoad(p.BenchConfig, p.RPCFactory, base.GetMembershipMonitor(), log, s.metricsClient)
+		benchLoad.Start()
+	}
+
+	var canary *Canary
+	if p.CanaryConfig.Enabled {
+		canary = NewCanary(p.CanaryConfig, p.RPCFactory, base.GetMembershipMonitor(), log, s.metricsClient,
+			p.DomainMetricTags)
+		canary.Start()
+	}
+
+	var bulkDeleter *BulkDeleter
+	if p.BulkDeleteConfig.Enabled {
+		bulkDeleter = NewBulkDeleter(p.BulkDeleteConfig, p.RPCFactory, base.GetMembershipMonitor(), visibilityManager,
+			history, log, s.metricsClient)
+		bulkDeleter.Start()
+	}
+
 	log.Infof("%v started", common.WorkerServiceName)
 	<-s.stopC
+	if bulkDeleter != nil {
+		bulkDeleter.Stop()
+	}
+	if canary != nil {
+		canary.Stop()
+	}
+	if benchLoad != nil {
+		benchLoad.Stop()
+	}
+	stuckWorkflowScanner.Stop()
+	domainStatsScanner.Stop()
 	base.Stop()
 }
 
@@ -0,0 +1,212 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	stuckWorkflowDomainsPageSize = 100
+	stuckWorkflowListPageSize    = 100
+	stuckWorkflowDescribeTimeout = 5 * time.Second
+)
+
+type (
+	// StuckWorkflowScanner periodically looks for open workflows which were started long ago and whose mutable state
+	// has not been updated since, which usually means no decision or activity is making progress on them. The old
+	// open workflows of a domain are listed from visibility, and the last update of each is read from history.
+	StuckWorkflowScanner struct {
+		metadataMgr   persistence.MetadataManager
+		visibilityMgr persistence.VisibilityManager
+		history       history.Client
+		config        *Config
+		logger        bark.Logger
+		metricsClient metrics.Client
+		domainTagger  *metrics.DomainTagger
+		shutdownCh    chan struct{}
+		shutdownWG    sync.WaitGroup
+	}
+)
+
+// NewStuckWorkflowScanner creates a new scanner reporting stuck workflows
+func NewStuckWorkflowScanner(metadataMgr persistence.MetadataManager, visibilityMgr persistence.VisibilityManager,
+	history history.Client, config *Config, logger bark.Logger, metricsClient metrics.Client,
+	domainMetricTags map[string]map[string]string) *StuckWorkflowScanner {
+	return &StuckWorkflowScanner{
+		metadataMgr:   metadataMgr,
+		visibilityMgr: visibilityMgr,
+		history:       history,
+		config:        config,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueStuckWorkflowScannerComponent,
+		}),
+		metricsClient: metricsClient,
+		domainTagger:  metrics.NewDomainTagger(metricsClient, domainMetricTags),
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+// Start is called to start the scanner
+func (s *StuckWorkflowScanner) Start() {
+	s.shutdownWG.Add(1)
+	go s.scanLoop()
+}
+
+// Stop is called to stop the scanner
+func (s *StuckWorkflowScanner) Stop() {
+	close(s.shutdownCh)
+	s.shutdownWG.Wait()
+}
+
+func (s *StuckWorkflowScanner) scanLoop() {
+	defer s.shutdownWG.Done()
+
+	ticker := time.NewTicker(s.config.StuckWorkflowScanInterval)
+	defer ticker.Stop()
+	for {
+		s.scan(time.Now())
+		select {
+		case <-ticker.C:
+		case <-s.shutdownCh:
+			return
+		}
+	}
+}
+
+func (s *StuckWorkflowScanner) scan(now time.Time) {
+	sw := s.metricsClient.StartTimer(metrics.StuckWorkflowScannerScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	var nextPageToken []byte
+	for {
+		resp, err := s.metadataMgr.ListDomains(&persistence.ListDomainsRequest{
+			PageSize:      stuckWorkflowDomainsPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			s.metricsClient.IncCounter(metrics.StuckWorkflowScannerScope, metrics.CadenceFailures)
+			s.logger.WithField(logging.TagErr, err).Warn("Failed to list domains.")
+			return
+		}
+
+		for _, domain := range resp.Domains {
+			select {
+			case <-s.shutdownCh:
+				return
+			default:
+			}
+
+			s.metricsClient.IncCounter(metrics.StuckWorkflowScannerScope, metrics.CadenceRequests)
+			stuck, err := s.scanDomain(domain.Info.ID, now)
+			if err != nil {
+				s.metricsClient.IncCounter(metrics.StuckWorkflowScannerScope, metrics.CadenceFailures)
+				s.logger.WithFields(bark.Fields{
+					logging.TagDomainID: domain.Info.ID,
+					logging.TagErr:      err,
+				}).Warn("Failed to scan domain for stuck workflows.")
+				continue
+			}
+			s.domainTagger.Client(domain.Info.Name).
+				UpdateGauge(metrics.StuckWorkflowScannerScope, metrics.DomainStuckWorkflows, float64(stuck))
+		}
+
+		if len(resp.NextPageToken) == 0 {
+			return
+		}
+		nextPageToken = resp.NextPageToken
+	}
+}
+
+// scanDomain returns the number of stuck workflows found among the open workflows of the domain started before the
+// threshold, each stuck workflow is logged so it can be looked at
+func (s *StuckWorkflowScanner) scanDomain(domainID string, now time.Time) (int, error) {
+	threshold := now.Add(-s.config.StuckWorkflowThreshold)
+	checked := 0
+	stuck := 0
+	var nextPageToken []byte
+	for {
+		resp, err := persistence.ListOpenWorkflowExecutionsOlderThan(s.visibilityMgr, domainID, threshold,
+			stuckWorkflowListPageSize, nextPageToken)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, execution := range resp.Executions {
+			if checked >= s.config.StuckWorkflowMaxChecksPerDomain {
+				return stuck, nil
+			}
+			checked++
+
+			lastUpdateTime, err := s.getLastUpdateTime(domainID, execution.Execution)
+			if err != nil {
+				if _, ok := err.(*shared.EntityNotExistsError); ok {
+					// closed since it was listed
+					continue
+				}
+				return 0, err
+			}
+			if lastUpdateTime.After(threshold) {
+				continue
+			}
+
+			stuck++
+			s.logger.WithFields(bark.Fields{
+				logging.TagDomainID:            domainID,
+				logging.TagWorkflowExecutionID: execution.Execution.GetWorkflowId(),
+				logging.TagWorkflowRunID:       execution.Execution.GetRunId(),
+			}).Warnf("Workflow has not been updated since %v.", lastUpdateTime)
+		}
+
+		if len(resp.NextPageToken) == 0 {
+			return stuck, nil
+		}
+		nextPageToken = resp.NextPageToken
+	}
+}
+
+func (s *StuckWorkflowScanner) getLastUpdateTime(domainID string, execution *shared.WorkflowExecution) (time.Time,
+	error) {
+	ctx, cancel := context.WithTimeout(context.Background(), stuckWorkflowDescribeTimeout)
+	defer cancel()
+
+	resp, err := s.history.DescribeWorkflowExecution(ctx, &h.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &shared.DescribeWorkflowExecutionRequest{
+			Execution: execution,
+		},
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, resp.WorkflowExecutionInfo.GetLastUpdateTime()), nil
+}
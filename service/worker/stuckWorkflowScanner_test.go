@@ -0,0 +1,162 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	stuckWorkflowScannerSuite struct {
+		suite.Suite
+		mockMetadataMgr   *mocks.MetadataManager
+		mockVisibilityMgr *mocks.VisibilityManager
+		mockHistory       *mocks.HistoryClient
+		metricsScope      tally.TestScope
+		scanner           *StuckWorkflowScanner
+	}
+)
+
+func TestStuckWorkflowScannerSuite(t *testing.T) {
+	s := new(stuckWorkflowScannerSuite)
+	suite.Run(t, s)
+}
+
+func (s *stuckWorkflowScannerSuite) SetupTest() {
+	s.mockMetadataMgr = &mocks.MetadataManager{}
+	s.mockVisibilityMgr = &mocks.VisibilityManager{}
+	s.mockHistory = &mocks.HistoryClient{}
+	s.metricsScope = tally.NewTestScope("", nil)
+	config := NewConfig()
+	config.StuckWorkflowMaxChecksPerDomain = 3
+	s.scanner = NewStuckWorkflowScanner(s.mockMetadataMgr, s.mockVisibilityMgr, s.mockHistory, config,
+		bark.NewLoggerFromLogrus(logrus.New()), metrics.NewClient(s.metricsScope, metrics.Worker),
+		map[string]map[string]string{})
+}
+
+func (s *stuckWorkflowScannerSuite) TearDownTest() {
+	s.mockMetadataMgr.AssertExpectations(s.T())
+	s.mockVisibilityMgr.AssertExpectations(s.T())
+	s.mockHistory.AssertExpectations(s.T())
+}
+
+func (s *stuckWorkflowScannerSuite) TestScanDomain() {
+	domainID := "some random domain ID"
+	now := time.Date(2018, 6, 3, 10, 0, 0, 0, time.UTC)
+	threshold := now.Add(-s.scanner.config.StuckWorkflowThreshold)
+
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutions", &persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:      domainID,
+		LatestStartTime: threshold.UnixNano(),
+		PageSize:        stuckWorkflowListPageSize,
+	}).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{
+			s.executionInfo("stuck"),
+			s.executionInfo("active"),
+			s.executionInfo("closed"),
+		},
+		NextPageToken: []byte("next page"),
+	}, nil).Once()
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutions", &persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:      domainID,
+		LatestStartTime: threshold.UnixNano(),
+		PageSize:        stuckWorkflowListPageSize,
+		NextPageToken:   []byte("next page"),
+	}).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{
+			s.executionInfo("stuck too"),
+			s.executionInfo("not checked"),
+		},
+	}, nil).Once()
+	s.expectDescribe("stuck", threshold.Add(-time.Minute), nil)
+	s.expectDescribe("active", now.Add(-time.Minute), nil)
+	s.expectDescribe("closed", time.Time{}, &shared.EntityNotExistsError{})
+	s.expectDescribe("stuck too", threshold, nil)
+
+	stuck, err := s.scanner.scanDomain(domainID, now)
+	s.NoError(err)
+	s.Equal(2, stuck)
+}
+
+func (s *stuckWorkflowScannerSuite) TestScan_EmitsGauge() {
+	now := time.Date(2018, 6, 3, 10, 0, 0, 0, time.UTC)
+
+	s.mockMetadataMgr.On("ListDomains", &persistence.ListDomainsRequest{PageSize: stuckWorkflowDomainsPageSize}).
+		Return(&persistence.ListDomainsResponse{
+			Domains: []*persistence.GetDomainResponse{
+				{Info: &persistence.DomainInfo{ID: "domain1", Name: "some random domain"}},
+			},
+		}, nil).Once()
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutions", mock.Anything).Return(
+		&persistence.ListWorkflowExecutionsResponse{
+			Executions: []*shared.WorkflowExecutionInfo{s.executionInfo("stuck")},
+		}, nil).Once()
+	s.expectDescribe("stuck", now.Add(-48*time.Hour), nil)
+
+	s.scanner.scan(now)
+
+	values := make(map[string]float64)
+	for _, gauge := range s.metricsScope.Snapshot().Gauges() {
+		tags := gauge.Tags()
+		if tags[metrics.OperationTagName] != "StuckWorkflowScanner" {
+			continue
+		}
+		values[tags[metrics.DomainTagName]] = gauge.Value()
+	}
+	s.Equal(map[string]float64{"some random domain": 1}, values)
+}
+
+func (s *stuckWorkflowScannerSuite) executionInfo(workflowID string) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr("some random run ID"),
+		},
+	}
+}
+
+func (s *stuckWorkflowScannerSuite) expectDescribe(workflowID string, lastUpdateTime time.Time, err error) {
+	var resp *shared.DescribeWorkflowExecutionResponse
+	if err == nil {
+		resp = &shared.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &shared.WorkflowExecutionInfo{
+				LastUpdateTime: common.Int64Ptr(lastUpdateTime.UnixNano()),
+			},
+		}
+	}
+	s.mockHistory.On("DescribeWorkflowExecution", mock.Anything, mock.MatchedBy(
+		func(request *h.DescribeWorkflowExecutionRequest) bool {
+			return request.Request.Execution.GetWorkflowId() == workflowID
+		})).Return(resp, err).Once()
+}
@@ -23,6 +23,7 @@ package cassandra
 import (
 	"fmt"
 	"regexp"
+	"time"
 )
 
 type (
@@ -44,6 +45,7 @@ type (
 		TargetVersion string
 		SchemaDir     string
 		IsDryRun      bool
+		LockTimeout   time.Duration // how long to wait for the schema update lock held by another run
 	}
 
 	// SetupSchemaConfig holds the config
@@ -52,8 +54,10 @@ type (
 		BaseConfig
 		SchemaFilePath    string
 		InitialVersion    string
-		Overwrite         bool // overwrite previous data
-		DisableVersioning bool // do not use schema versioning
+		Overwrite         bool   // overwrite previous data
+		DisableVersioning bool   // do not use schema versioning
+		Reason            string // reason recorded in the audit table for overwrite
+		Actor             string // actor recorded in the audit table for overwrite
 	}
 
 	// CreateKeyspaceConfig holds the config
@@ -86,6 +90,9 @@ const (
 	cliOptSchemaDir         = "schema-dir"
 	cliOptReplicationFactor = "replication-factor"
 	cliOptQuiet             = "quiet"
+	cliOptReason            = "reason"
+	cliOptActor             = "actor"
+	cliOptLockTimeout       = "lock-timeout"
 
 	cliFlagEndpoint          = cliOptEndpoint + ", ep"
 	cliFlagPort              = cliOptPort + ", p"
@@ -101,6 +108,9 @@ const (
 	cliFlagSchemaDir         = cliOptSchemaDir + ", d"
 	cliFlagReplicationFactor = cliOptReplicationFactor + ", rf"
 	cliFlagQuiet             = cliOptQuiet + ", q"
+	cliFlagReason            = cliOptReason + ", r"
+	cliFlagActor             = cliOptActor
+	cliFlagLockTimeout       = cliOptLockTimeout
 )
 
 var rmspaceRegex = regexp.MustCompile("\\s+")
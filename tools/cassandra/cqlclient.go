@@ -60,6 +60,15 @@ type (
 		UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error
 		// WriteSchemaUpdateLog adds an entry to the schema update history table
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
+		// WriteAuditRecord adds an entry to the admin audit table
+		WriteAuditRecord(operation string, actor string, reason string, keys map[string]string) error
+		// CreateSchemaLockTable sets up the schema update lock table, if it doesn't exist
+		CreateSchemaLockTable() error
+		// AcquireSchemaLock takes the schema update lock of the keyspace for the owner until it is released or
+		// the ttl expires, it returns false and the current owner if the lock is held by another owner
+		AcquireSchemaLock(owner string, ttl time.Duration) (bool, string, error)
+		// ReleaseSchemaLock releases the schema update lock of the keyspace, if it is held by the owner
+		ReleaseSchemaLock(owner string) error
 		// Close gracefully closes the client object
 		Close()
 	}
@@ -78,6 +87,9 @@ const (
 	cqlProtoVersion      = 4        // default CQL protocol version
 	defaultConsistency   = "QUORUM" // schema updates must always be QUORUM
 	defaultCassandraPort = 9042
+	auditTableName       = "admin_audit"
+	schemaLockTableName  = "schema_update_lock"
+	auditOpDropTable     = "DropTable"
 )
 
 const (
@@ -86,6 +98,9 @@ const (
 	listTypesCQL                = `SELECT type_name from system_schema.types where keyspace_name=?`
 	writeSchemaVersionCQL       = `INSERT into schema_version(keyspace_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistoryCQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
+	writeAuditRecordCQL         = `INSERT into admin_audit(partition, id, operation, actor, reason, keys) VALUES(0,?,?,?,?,?)`
+	acquireSchemaLockCQL        = `INSERT into schema_update_lock(keyspace_name, owner, acquired_time) VALUES(?,?,?) IF NOT EXISTS USING TTL ?`
+	releaseSchemaLockCQL        = `DELETE from schema_update_lock where keyspace_name=? IF owner=?`
 
 	createSchemaVersionTableCQL = `CREATE TABLE schema_version(keyspace_name text PRIMARY KEY, ` +
 		`creation_time timestamp, ` +
@@ -102,6 +117,11 @@ const (
 		`old_version text, ` +
 		`PRIMARY KEY ((year, month), update_time));`
 
+	createSchemaLockTableCQL = `CREATE TABLE IF NOT EXISTS schema_update_lock(` +
+		`keyspace_name text PRIMARY KEY, ` +
+		`owner text, ` +
+		`acquired_time timestamp);`
+
 	createKeyspaceCQL = `CREATE KEYSPACE IF NOT EXISTS %v ` +
 		`WITH replication = { 'class' : 'SimpleStrategy', 'replication_factor' : %v};`
 )
@@ -191,7 +211,15 @@ func (client *cqlClient) CreateSchemaVersionTables() error {
 	if err := client.Exec(createSchemaVersionTableCQL); err != nil {
 		return err
 	}
-	return client.Exec(createSchemaUpdateHistoryTableCQL)
+	if err := client.Exec(createSchemaUpdateHistoryTableCQL); err != nil {
+		return err
+	}
+	return client.CreateSchemaLockTable()
+}
+
+// CreateSchemaLockTable sets up the schema update lock table, if it doesn't exist
+func (client *cqlClient) CreateSchemaLockTable() error {
+	return client.Exec(createSchemaLockTableCQL)
 }
 
 // ReadSchemaVersion returns the current schema version for the keyspace
@@ -223,6 +251,36 @@ func (client *cqlClient) WriteSchemaUpdateLog(oldVersion string, newVersion stri
 	return query.Exec()
 }
 
+// WriteAuditRecord adds an entry to the admin audit table
+func (client *cqlClient) WriteAuditRecord(operation string, actor string, reason string, keys map[string]string) error {
+	query := client.session.Query(writeAuditRecordCQL)
+	query.Bind(gocql.TimeUUID(), operation, actor, reason, keys)
+	return query.Exec()
+}
+
+// AcquireSchemaLock takes the schema update lock of the keyspace for the owner, unless another owner holds it
+func (client *cqlClient) AcquireSchemaLock(owner string, ttl time.Duration) (bool, string, error) {
+	query := client.session.Query(acquireSchemaLockCQL, client.clusterConfig.Keyspace, owner, time.Now(),
+		int(ttl.Seconds()))
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return false, "", err
+	}
+	if !applied {
+		currentOwner, _ := previous["owner"].(string)
+		return false, currentOwner, nil
+	}
+	return true, owner, nil
+}
+
+// ReleaseSchemaLock releases the schema update lock of the keyspace, if it is held by the owner
+func (client *cqlClient) ReleaseSchemaLock(owner string) error {
+	query := client.session.Query(releaseSchemaLockCQL, client.clusterConfig.Keyspace, owner)
+	_, err := query.MapScanCAS(make(map[string]interface{}))
+	return err
+}
+
 // Exec executes a cql statement
 func (client *cqlClient) Exec(stmt string) error {
 	return client.session.Query(stmt).Exec()
@@ -291,14 +349,21 @@ func ParseCQLFile(filePath string) ([]string, error) {
 }
 
 // dropAllTablesTypes deletes all tables/types in the
-// keyspace without deleting the keyspace
-func dropAllTablesTypes(client CQLClient) {
+// keyspace without deleting the keyspace, the admin
+// audit table is kept. Returns the dropped tables
+func dropAllTablesTypes(client CQLClient) []string {
 	tables, err := client.ListTables()
 	if err != nil {
-		return
+		return nil
 	}
-	log.Printf("Dropping following tables: %v\n", tables)
+	var dropped []string
 	for _, table := range tables {
+		if table != auditTableName {
+			dropped = append(dropped, table)
+		}
+	}
+	log.Printf("Dropping following tables: %v\n", dropped)
+	for _, table := range dropped {
 		err1 := client.DropTable(table)
 		if err1 != nil {
 			log.Printf("Error dropping table %v, err=%v\n", table, err1)
@@ -306,7 +371,7 @@ func dropAllTablesTypes(client CQLClient) {
 	}
 	types, err := client.ListTypes()
 	if err != nil {
-		return
+		return dropped
 	}
 	log.Printf("Dropping following types: %v\n", types)
 	for _, t := range types {
@@ -315,4 +380,5 @@ func dropAllTablesTypes(client CQLClient) {
 			log.Printf("Error dropping type %v, err=%v\n", t, err1)
 		}
 	}
+	return dropped
 }
@@ -153,6 +153,7 @@ func (s *CQLClientTestSuite) testCreate(client CQLClient) {
 	expectedTables := make(map[string]struct{})
 	expectedTables["schema_version"] = struct{}{}
 	expectedTables["schema_update_history"] = struct{}{}
+	expectedTables["schema_update_lock"] = struct{}{}
 
 	tables, err = client.ListTables()
 	s.Nil(err)
@@ -195,9 +196,35 @@ func (s *CQLClientTestSuite) TestCQLClient() {
 	s.Nil(err)
 	s.testCreate(client)
 	s.testUpdate(client)
+	s.testLock(client)
 	s.testDrop(client)
 }
 
+func (s *CQLClientTestSuite) testLock(client CQLClient) {
+	acquired, owner, err := client.AcquireSchemaLock("deploy-1", time.Minute)
+	s.Nil(err)
+	s.True(acquired)
+	s.Equal("deploy-1", owner)
+
+	acquired, owner, err = client.AcquireSchemaLock("deploy-2", time.Minute)
+	s.Nil(err)
+	s.False(acquired)
+	s.Equal("deploy-1", owner)
+
+	// only the owner releases the lock
+	s.Nil(client.ReleaseSchemaLock("deploy-2"))
+	acquired, _, err = client.AcquireSchemaLock("deploy-2", time.Minute)
+	s.Nil(err)
+	s.False(acquired)
+
+	s.Nil(client.ReleaseSchemaLock("deploy-1"))
+	acquired, owner, err = client.AcquireSchemaLock("deploy-2", time.Minute)
+	s.Nil(err)
+	s.True(acquired)
+	s.Equal("deploy-2", owner)
+	s.Nil(client.ReleaseSchemaLock("deploy-2"))
+}
+
 func createTestCQLFileContent() string {
 	return `
 -- test cql file content
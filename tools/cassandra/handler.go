@@ -22,8 +22,10 @@ package cassandra
 
 import (
 	"fmt"
-	"github.com/urfave/cli"
 	"log"
+	"os/user"
+
+	"github.com/urfave/cli"
 )
 
 // setupSchema executes the setupSchemaTask
@@ -117,6 +119,9 @@ func validateSetupSchemaConfig(config *SetupSchemaConfig) error {
 		}
 		config.InitialVersion = ver
 	}
+	if config.Overwrite && len(config.Reason) == 0 {
+		return newConfigError("missing " + flag(cliOptReason) + " argument, required with " + flag(cliOptOverwrite))
+	}
 	return nil
 }
 
@@ -132,6 +137,13 @@ func newSetupSchemaConfig(cli *cli.Context) (*SetupSchemaConfig, error) {
 	config.InitialVersion = cli.String(cliOptVersion)
 	config.DisableVersioning = cli.Bool(cliOptDisableVersioning)
 	config.Overwrite = cli.Bool(cliOptOverwrite)
+	config.Reason = cli.String(cliOptReason)
+	config.Actor = cli.String(cliOptActor)
+	if len(config.Actor) == 0 {
+		if u, err := user.Current(); err == nil {
+			config.Actor = u.Username
+		}
+	}
 
 	if err := validateSetupSchemaConfig(config); err != nil {
 		return nil, err
@@ -175,6 +187,7 @@ func newUpdateSchemaConfig(cli *cli.Context) (*UpdateSchemaConfig, error) {
 	config.SchemaDir = cli.String(cliOptSchemaDir)
 	config.IsDryRun = cli.Bool(cliOptDryrun)
 	config.TargetVersion = cli.String(cliOptTargetVersion)
+	config.LockTimeout = cli.Duration(cliOptLockTimeout)
 
 	if err := validateUpdateSchemaConfig(config); err != nil {
 		return nil, err
@@ -81,6 +81,12 @@ func (s *HandlerTestSuite) TestValidateSetupSchemaConfig() {
 	config.DisableVersioning = true
 	config.SchemaFilePath = "/tmp/foo.cql"
 	s.assertValidateSetupSucceeds(config)
+
+	config.Overwrite = true
+	s.assertValidateSetupFails(config)
+
+	config.Reason = "rebuild test cluster"
+	s.assertValidateSetupSucceeds(config)
 }
 
 func (s *HandlerTestSuite) TestValidateUpdateSchemaConfig() {
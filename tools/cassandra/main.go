@@ -114,6 +114,14 @@ func buildCLIOptions() *cli.App {
 					Name:  cliFlagOverwrite,
 					Usage: "drop all existing tables before setting up new schema",
 				},
+				cli.StringFlag{
+					Name:  cliFlagReason,
+					Usage: "reason for overwriting the schema, recorded in the admin audit table",
+				},
+				cli.StringFlag{
+					Name:  cliFlagActor,
+					Usage: "actor recorded in the admin audit table, defaults to the current user",
+				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, setupSchema)
@@ -136,6 +144,11 @@ func buildCLIOptions() *cli.App {
 					Name:  cliFlagDryrun,
 					Usage: "do a dryrun",
 				},
+				cli.DurationFlag{
+					Name:  cliFlagLockTimeout,
+					Value: defaultSchemaLockTimeout,
+					Usage: "how long to wait for another update of the keyspace to finish",
+				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, updateSchema)
@@ -0,0 +1,95 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+const (
+	defaultSchemaLockTimeout = 10 * time.Minute
+	// schemaLockTTL bounds how long the lock of a run which crashed blocks other runs
+	schemaLockTTL           = time.Hour
+	schemaLockRetryInterval = 5 * time.Second
+)
+
+// acquireSchemaLock takes the schema update lock of the keyspace, so that concurrent updates of the same keyspace,
+// such as parallel deploy jobs, run one after the other instead of racing on the schema version. It waits for
+// the lock for up to timeout and returns the owner name the lock was taken with.
+func acquireSchemaLock(client CQLClient, keyspace string, timeout time.Duration) (string, error) {
+	tables, err := client.ListTables()
+	if err != nil {
+		return "", fmt.Errorf("error listing tables:%v", err)
+	}
+	if !contains(tables, schemaLockTableName) {
+		// keyspace set up before the lock table was added to the schema version tables
+		if err := client.CreateSchemaLockTable(); err != nil {
+			return "", fmt.Errorf("error creating schema lock table:%v", err)
+		}
+	}
+
+	owner := newSchemaLockOwner()
+	deadline := time.Now().Add(timeout)
+	for {
+		acquired, currentOwner, err := client.AcquireSchemaLock(owner, schemaLockTTL)
+		if err != nil {
+			return "", fmt.Errorf("error acquiring schema lock:%v", err)
+		}
+		if acquired {
+			log.Printf("Acquired schema update lock of keyspace %v as %v\n", keyspace, owner)
+			return owner, nil
+		}
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("schema update lock of keyspace %v is held by %v", keyspace, currentOwner)
+		}
+		log.Printf("Waiting for schema update lock of keyspace %v held by %v\n", keyspace, currentOwner)
+		time.Sleep(schemaLockRetryInterval)
+	}
+}
+
+// releaseSchemaLock releases the lock taken by acquireSchemaLock, a lock which cannot be released expires
+func releaseSchemaLock(client CQLClient, owner string) {
+	if err := client.ReleaseSchemaLock(owner); err != nil {
+		log.Printf("Failed to release schema update lock, it expires in %v: %v\n", schemaLockTTL, err)
+	}
+}
+
+func newSchemaLockOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%v/%v/%v", hostname, os.Getpid(), gocql.TimeUUID())
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
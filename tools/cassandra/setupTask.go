@@ -55,8 +55,9 @@ func (task *SetupSchemaTask) run() error {
 
 	log.Printf("Starting schema setup, config=%+v\n", config)
 
+	var dropped []string
 	if config.Overwrite {
-		dropAllTablesTypes(task.client)
+		dropped = dropAllTablesTypes(task.client)
 	}
 
 	if !config.DisableVersioning {
@@ -95,7 +96,40 @@ func (task *SetupSchemaTask) run() error {
 		}
 	}
 
+	if err := task.recordDroppedTables(dropped); err != nil {
+		return err
+	}
+
 	log.Println("Schema setup complete")
 
 	return nil
 }
+
+// recordDroppedTables writes an audit entry for every table dropped
+// by overwrite, provided the keyspace has the admin audit table
+func (task *SetupSchemaTask) recordDroppedTables(dropped []string) error {
+	if len(dropped) == 0 {
+		return nil
+	}
+	tables, err := task.client.ListTables()
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, table := range tables {
+		if table == auditTableName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil
+	}
+	for _, table := range dropped {
+		keys := map[string]string{"keyspace": task.config.CassKeyspace, "table": table}
+		if err := task.client.WriteAuditRecord(auditOpDropTable, task.config.Actor, task.config.Reason, keys); err != nil {
+			return err
+		}
+	}
+	return nil
+}
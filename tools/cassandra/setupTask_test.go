@@ -119,9 +119,9 @@ func (s *SetupSchemaTestSuite) TestSetupSchema() {
 
 		// test overwrite with versioning works
 		if versioningEnabled {
-			RunTool([]string{"./tool", "-k", s.keyspace, "-q", "setup-schema", "-f", cqlFile.Name(), "-version", ver, "-o"})
+			RunTool([]string{"./tool", "-k", s.keyspace, "-q", "setup-schema", "-f", cqlFile.Name(), "-version", ver, "-o", "-reason", "test"})
 		} else {
-			RunTool([]string{"./tool", "-k", s.keyspace, "-q", "setup-schema", "-f", cqlFile.Name(), "-d", "-o"})
+			RunTool([]string{"./tool", "-k", s.keyspace, "-q", "setup-schema", "-f", cqlFile.Name(), "-d", "-o", "-reason", "test"})
 		}
 
 		expectedTables := getExpectedTables(versioningEnabled)
@@ -153,6 +153,7 @@ func getExpectedTables(versioningEnabled bool) map[string]struct{} {
 	if versioningEnabled {
 		expectedTables["schema_version"] = struct{}{}
 		expectedTables["schema_update_history"] = struct{}{}
+		expectedTables["schema_update_lock"] = struct{}{}
 	}
 	return expectedTables
 }
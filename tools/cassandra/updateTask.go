@@ -111,6 +111,14 @@ func (task *UpdateSchemaTask) run() error {
 
 	log.Printf("UpdateSchemeTask started, config=%+v\n", config)
 
+	if !config.IsDryRun {
+		owner, err := acquireSchemaLock(task.client, config.CassKeyspace, config.LockTimeout)
+		if err != nil {
+			return err
+		}
+		defer releaseSchemaLock(task.client, owner)
+	}
+
 	currVer, err := task.client.ReadSchemaVersion()
 	if err != nil {
 		return fmt.Errorf("error reading current schema version:%v", err.Error())
@@ -0,0 +1,544 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+func newAdminCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "export",
+			Aliases: []string{"ex"},
+			Usage:   "Export history of a workflow execution into an archival format file",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "File the exported history is written to",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminExportWorkflow(c)
+			},
+		},
+		{
+			Name:    "import",
+			Aliases: []string{"im"},
+			Usage:   "Import a workflow execution from an archival format file produced by export",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "File the history is read from",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminImportWorkflow(c)
+			},
+		},
+		{
+			Name:    "terminate",
+			Aliases: []string{"term"},
+			Usage:   "Terminate a workflow execution, the operation is recorded in the admin audit table",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for terminating the workflow execution",
+				},
+				cli.StringFlag{
+					Name:  FlagActor,
+					Usage: "Actor recorded in the audit table, defaults to the current user",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminTerminateWorkflow(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a workflow execution so that none of its tasks or timers run until it is resumed, the operation is recorded in the admin audit table",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for pausing the workflow execution",
+				},
+				cli.StringFlag{
+					Name:  FlagActor,
+					Usage: "Actor recorded in the audit table, defaults to the current user",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "resume",
+			Usage: "Resume a paused workflow execution, the operation is recorded in the admin audit table",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for resuming the workflow execution",
+				},
+				cli.StringFlag{
+					Name:  FlagActor,
+					Usage: "Actor recorded in the audit table, defaults to the current user",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminResumeWorkflow(c)
+			},
+		},
+		{
+			Name:  "nuke",
+			Usage: "Remove every persisted trace of a corrupted workflow execution, the operation is recorded in the admin audit table",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for nuking the workflow execution",
+				},
+				cli.StringFlag{
+					Name:  FlagActor,
+					Usage: "Actor recorded in the audit table, defaults to the current user",
+				},
+				cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only report what would be removed",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminNukeWorkflow(c)
+			},
+		},
+		{
+			Name:    "event",
+			Aliases: []string{"ev"},
+			Usage:   "Show a single history event of a workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, the current run is used if not set",
+				},
+				cli.Int64Flag{
+					Name:  FlagEventIDWithAlias,
+					Usage: "ID of the event",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetWorkflowHistoryEvent(c)
+			},
+		},
+		{
+			Name:    "current",
+			Aliases: []string{"cur"},
+			Usage:   "Show the current run of a workflow ID, along with its state and close status",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetCurrentExecution(c)
+			},
+		},
+		{
+			Name:  "chain",
+			Usage: "List the runs chained by continue as new, retries and cron schedules, first run first",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID of the last run of the chain, the current run is used if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListWorkflowChain(c)
+			},
+		},
+		{
+			Name:  "audit",
+			Usage: "Show the audit records of destructive admin operations, most recent first",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "List more pages, default is to list one page of size 10",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 10,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListAuditRecords(c)
+			},
+		},
+		{
+			Name:    "describe_host",
+			Aliases: []string{"dh"},
+			Usage:   "Describe the queues and caches of the shards owned by a history host",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagHistoryAddressWithAlias,
+					Usage: "Address of the history host",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ID of a shard owned by the history host",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID of a workflow owned by the history host",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeHistoryHost(c)
+			},
+		},
+		{
+			Name:    "warm_host",
+			Aliases: []string{"wh"},
+			Usage:   "Make a history host acquire its shards and load the executions they are about to process into its cache",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagHistoryAddressWithAlias,
+					Usage: "Address of the history host",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxExecutionsWithAlias,
+					Usage: "Maximum number of executions loaded per shard, defaults to the history cache size",
+				},
+				cli.IntFlag{
+					Name:  FlagContextTimeoutWithAlias,
+					Usage: "Optional timeout for the command context in seconds, default value is 120",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminWarmHistoryHost(c)
+			},
+		},
+		{
+			Name:        "tasklist",
+			Aliases:     []string{"tl"},
+			Usage:       "Run admin operation on tasklist",
+			Subcommands: newAdminTaskListCommands(),
+		},
+		{
+			Name:        "cluster",
+			Aliases:     []string{"cl"},
+			Usage:       "Run admin operation on cluster",
+			Subcommands: newAdminClusterCommands(),
+		},
+		{
+			Name:    "domain",
+			Aliases: []string{"d"},
+			Usage:   "Run admin operation on domain",
+			Subcommands: []cli.Command{
+				{
+					Name:  "stats",
+					Usage: "Show the daily statistics of domain, most recent day first",
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  FlagDaysWithAlias,
+							Value: 7,
+							Usage: "Number of most recent days to show",
+						},
+					},
+					Action: func(c *cli.Context) {
+						AdminGetDomainStats(c)
+					},
+				},
+				{
+					Name:    "refresh_cache",
+					Aliases: []string{"rc"},
+					Usage:   "Reload domain into the domain cache of the frontend serving the request and of all history hosts, without waiting for the cache to expire",
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  FlagAllDomains,
+							Usage: "Reload all domains instead of the domain given by the global domain option",
+						},
+					},
+					Action: func(c *cli.Context) {
+						AdminRefreshDomainCache(c)
+					},
+				},
+				{
+					Name:    "update_retention",
+					Aliases: []string{"ur"},
+					Usage:   "Set the workflow execution retention period of domain, beyond the bounds enforced by domain update",
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  FlagRetentionDaysWithAlias,
+							Usage: "Workflow execution retention in days",
+						},
+						cli.StringFlag{
+							Name:  FlagReasonWithAlias,
+							Usage: "Reason for bypassing the retention bounds",
+						},
+						cli.StringFlag{
+							Name:  FlagActor,
+							Usage: "Actor recorded in the audit table, defaults to the current user",
+						},
+					},
+					Action: func(c *cli.Context) {
+						AdminUpdateDomainRetention(c)
+					},
+				},
+				{
+					Name:  "resync",
+					Usage: "Publish the global domains replicated to a remote cluster again, to repair missed domain replication",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  FlagCluster,
+							Usage: "Name of the remote cluster",
+						},
+						cli.BoolFlag{
+							Name:  FlagAllDomains,
+							Usage: "Publish all domains replicated to the cluster instead of the domain given by the global domain option",
+						},
+					},
+					Action: func(c *cli.Context) {
+						AdminResyncDomains(c)
+					},
+				},
+				{
+					Name:  "clone",
+					Usage: "Copy the retention and metric settings of domain to another domain, the target domain is registered if it does not exist",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  FlagTargetDomainWithAlias,
+							Usage: "Name of the domain the settings are copied to",
+						},
+						cli.StringFlag{
+							Name:  FlagTargetAddressWithAlias,
+							Usage: "host:port of the frontend of the target cluster, defaults to the global address option",
+						},
+					},
+					Action: func(c *cli.Context) {
+						AdminCloneDomain(c)
+					},
+				},
+				{
+					Name:  "bulk_delete",
+					Usage: "Delete the histories and executions of domain which closed before a time, through a rate limited workflow run by the worker service",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  FlagClosedBeforeWithAlias,
+							Usage: "Delete the executions closed before this time, in format '2006-01-02T15:04:05Z' or raw UnixNano",
+						},
+						cli.IntFlag{
+							Name:  FlagDeletesPerSecondWithAlias,
+							Usage: "Max number of executions deleted per second, defaults to 10",
+						},
+						cli.StringFlag{
+							Name:  FlagReasonWithAlias,
+							Usage: "Reason for deleting the executions",
+						},
+						cli.StringFlag{
+							Name:  FlagActor,
+							Usage: "Actor recorded in the audit table, defaults to the current user",
+						},
+					},
+					Action: func(c *cli.Context) {
+						AdminBulkDeleteWorkflowExecutions(c)
+					},
+				},
+				{
+					Name:  "bulk_delete_status",
+					Usage: "Show the progress of the last bulk delete of domain",
+					Action: func(c *cli.Context) {
+						AdminDescribeBulkDeleteWorkflowExecutions(c)
+					},
+				},
+			},
+		},
+	}
+}
+
+func newAdminTaskListCommands() []cli.Command {
+	flags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "TaskList name",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListTypeWithAlias,
+			Value: "decision",
+			Usage: "Optional TaskList type [decision|activity]",
+		},
+	}
+	return []cli.Command{
+		{
+			Name:  "pause",
+			Usage: "Stop dispatching tasks of tasklist to pollers, new tasks are still accepted",
+			Flags: flags,
+			Action: func(c *cli.Context) {
+				AdminPauseTaskList(c)
+			},
+		},
+		{
+			Name:  "resume",
+			Usage: "Resume dispatching tasks of a paused tasklist",
+			Flags: flags,
+			Action: func(c *cli.Context) {
+				AdminResumeTaskList(c)
+			},
+		},
+	}
+}
+
+func newAdminClusterCommands() []cli.Command {
+	flags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagReasonWithAlias,
+			Usage: "Reason for the maintenance, announced to the callers of the rejected APIs",
+		},
+		cli.StringFlag{
+			Name:  FlagActor,
+			Usage: "Actor recorded in the audit table, defaults to the current user",
+		},
+	}
+	return []cli.Command{
+		{
+			Name:  "start_maintenance",
+			Usage: "Reject the APIs starting new work with a retryable error while outstanding work drains, for persistence maintenance",
+			Flags: flags,
+			Action: func(c *cli.Context) {
+				AdminUpdateMaintenanceMode(c, true)
+			},
+		},
+		{
+			Name:  "end_maintenance",
+			Usage: "Serve all APIs again after maintenance",
+			Flags: flags,
+			Action: func(c *cli.Context) {
+				AdminUpdateMaintenanceMode(c, false)
+			},
+		},
+		{
+			Name:  "maintenance",
+			Usage: "Show whether the cluster is in maintenance, and why",
+			Action: func(c *cli.Context) {
+				AdminDescribeMaintenanceMode(c)
+			},
+		},
+		{
+			Name:  "shards",
+			Usage: "Show the history host owning each history shard, and whether the shard was pinned to the host by drain_host",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagHistoryAddressWithAlias,
+					Usage: "Only show the shards owned by the history host",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeShardDistribution(c)
+			},
+		},
+		{
+			Name:  "drain_host",
+			Usage: "Move the shards of a history host to the other history hosts at a throttled rate, before the host is taken down",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagHistoryAddressWithAlias,
+					Usage: "Address of the history host",
+				},
+				cli.IntFlag{
+					Name:  FlagShardsPerMinuteWithAlias,
+					Usage: "Number of shards moved per minute, defaults to the rate configured for the cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for draining the history host",
+				},
+				cli.StringFlag{
+					Name:  FlagActor,
+					Usage: "Actor recorded in the audit table, defaults to the current user",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDrainHistoryHost(c)
+			},
+		},
+		{
+			Name:  "reset_shards",
+			Usage: "Unpin the shards moved by drain_host, so that the membership ring places all shards again",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for resetting the shard placement",
+				},
+				cli.StringFlag{
+					Name:  FlagActor,
+					Usage: "Actor recorded in the audit table, defaults to the current user",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminResetShardPlacement(c)
+			},
+		},
+	}
+}
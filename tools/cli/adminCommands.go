@@ -0,0 +1,859 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/urfave/cli"
+	s "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/client"
+)
+
+// AdminExportWorkflow writes the full history of a workflow execution to a file
+func AdminExportWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := getRequiredOption(c, FlagRunID)
+	outputFileName := getRequiredOption(c, FlagOutputFilename)
+
+	wfClient := getWorkflowClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	history, err := GetHistory(ctx, wfClient, wid, rid)
+	if err != nil {
+		ErrorAndExit("Failed to get history of workflow execution", err)
+	}
+
+	// the client and server share the same thrift definitions, convert between the two generated packages
+	data, err := json.Marshal(history)
+	if err != nil {
+		ErrorAndExit("Failed to serialize history", err)
+	}
+	serverHistory := &shared.History{}
+	if err := json.Unmarshal(data, serverHistory); err != nil {
+		ErrorAndExit("Failed to deserialize history", err)
+	}
+
+	execution := &shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(wid),
+		RunId:      common.StringPtr(rid),
+	}
+	blob, err := archiver.NewHistoryBlob(domain, execution, serverHistory)
+	if err != nil {
+		ErrorAndExit("Failed to create history blob", err)
+	}
+	data, err = archiver.EncodeHistoryBlob(blob)
+	if err != nil {
+		ErrorAndExit("Failed to encode history blob", err)
+	}
+	if err := ioutil.WriteFile(outputFileName, data, 0666); err != nil {
+		ErrorAndExit("Failed to write history file", err)
+	}
+	fmt.Printf("Exported %v events to %v\n", blob.Header.EventCount, outputFileName)
+}
+
+// AdminImportWorkflow recreates a workflow execution from a file written by AdminExportWorkflow
+func AdminImportWorkflow(c *cli.Context) {
+	inputFile := getRequiredOption(c, FlagInputFile)
+	data, err := ioutil.ReadFile(inputFile)
+	if err != nil {
+		ErrorAndExit("Error reading input file", err)
+	}
+	blob, err := archiver.DecodeHistoryBlob(data)
+	if err != nil {
+		ErrorAndExit("Failed to decode history blob", err)
+	}
+
+	domain := blob.Header.DomainName
+	if d := c.GlobalString(FlagDomain); d != "" {
+		domain = d
+	}
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	err = adminClient.ImportWorkflowExecution(ctx, &admin.ImportWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(blob.Header.WorkflowID),
+			RunId:      common.StringPtr(blob.Header.RunID),
+		},
+		History: blob.Body,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to import workflow execution", err)
+	}
+	fmt.Printf("Imported workflow execution %v/%v into domain %v\n", blob.Header.WorkflowID, blob.Header.RunID, domain)
+}
+
+// AdminPauseTaskList stops dispatching tasks of a tasklist
+func AdminPauseTaskList(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.PauseTaskList(ctx, &admin.PauseTaskListRequest{
+		Domain:       common.StringPtr(domain),
+		TaskList:     &shared.TaskList{Name: common.StringPtr(taskList)},
+		TaskListType: adminTaskListType(c),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to pause tasklist", err)
+	}
+	fmt.Printf("Tasklist %v is paused\n", taskList)
+}
+
+// AdminResumeTaskList resumes dispatching tasks of a paused tasklist
+func AdminResumeTaskList(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.ResumeTaskList(ctx, &admin.ResumeTaskListRequest{
+		Domain:       common.StringPtr(domain),
+		TaskList:     &shared.TaskList{Name: common.StringPtr(taskList)},
+		TaskListType: adminTaskListType(c),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to resume tasklist", err)
+	}
+	fmt.Printf("Tasklist %v is resumed\n", taskList)
+}
+
+// AdminGetDomainStats shows the daily statistics of a domain
+func AdminGetDomainStats(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.GetDomainStats(ctx, &admin.GetDomainStatsRequest{
+		Domain: common.StringPtr(domain),
+		Days:   common.Int32Ptr(int32(c.Int(FlagDays))),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get domain stats", err)
+	}
+	if len(resp.Stats) == 0 {
+		fmt.Println(colorMagenta("No stats for domain: " + domain))
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Day", "Open Executions", "Total Bytes Appended", "Events Appended", "Bytes Appended", "Last Updated"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, s := range resp.Stats {
+		table.Append([]string{
+			time.Unix(0, s.GetDayTimestamp()).UTC().Format("2006-01-02"),
+			strconv.FormatInt(s.GetOpenExecutions(), 10),
+			strconv.FormatInt(s.GetTotalBytesAppended(), 10),
+			strconv.FormatInt(s.GetEventsAppended(), 10),
+			strconv.FormatInt(s.GetBytesAppended(), 10),
+			convertTime(s.GetLastUpdatedTimestamp(), false),
+		})
+	}
+	table.Render()
+}
+
+// AdminTerminateWorkflow terminates a workflow execution and records it in the audit table
+func AdminTerminateWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := getRequiredOption(c, FlagReason)
+	actor := getActor(c)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.TerminateWorkflowExecution(ctx, &admin.TerminateWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		Reason: common.StringPtr(reason),
+		Actor:  common.StringPtr(actor),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to terminate workflow execution", err)
+	}
+	fmt.Println("Terminate workflow succeeded.")
+}
+
+// AdminPauseWorkflow pauses a workflow execution and records it in the audit table
+func AdminPauseWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := getRequiredOption(c, FlagReason)
+	actor := getActor(c)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.PauseWorkflowExecution(ctx, &admin.PauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		Reason: common.StringPtr(reason),
+		Actor:  common.StringPtr(actor),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to pause workflow execution", err)
+	}
+	fmt.Println("Pause workflow succeeded.")
+}
+
+// AdminResumeWorkflow resumes a paused workflow execution and records it in the audit table
+func AdminResumeWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := getRequiredOption(c, FlagReason)
+	actor := getActor(c)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.ResumeWorkflowExecution(ctx, &admin.ResumeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		Reason: common.StringPtr(reason),
+		Actor:  common.StringPtr(actor),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to resume workflow execution", err)
+	}
+	fmt.Println("Resume workflow succeeded.")
+}
+
+// AdminNukeWorkflow removes all persisted records of a workflow execution
+func AdminNukeWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := getRequiredOption(c, FlagRunID)
+	dryRun := c.Bool(FlagDryRun)
+	reason := getRequiredOption(c, FlagReason)
+	actor := getActor(c)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.NukeWorkflowExecution(ctx, &admin.NukeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		Reason: common.StringPtr(reason),
+		Actor:  common.StringPtr(actor),
+		DryRun: common.BoolPtr(dryRun),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to nuke workflow execution", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// getActor returns the actor recorded to the audit log, the current user unless given by option
+func getActor(c *cli.Context) string {
+	actor := c.String(FlagActor)
+	if actor == "" {
+		u, err := user.Current()
+		if err != nil {
+			ErrorAndExit("Unable to determine the current user, use option "+FlagActor, err)
+		}
+		actor = u.Username
+	}
+	return actor
+}
+
+// AdminGetWorkflowHistoryEvent shows a single history event of a workflow execution
+func AdminGetWorkflowHistoryEvent(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	if !c.IsSet(FlagEventID) {
+		ErrorAndExit("Option "+FlagEventID+" is required", nil)
+	}
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.GetWorkflowExecutionHistoryEvent(ctx, &admin.GetWorkflowExecutionHistoryEventRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		EventId: common.Int64Ptr(c.Int64(FlagEventID)),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get history event", err)
+	}
+	prettyPrintJSONObject(resp.Event)
+}
+
+// AdminGetCurrentExecution shows the current run of a workflow ID
+func AdminGetCurrentExecution(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.GetCurrentExecution(ctx, &admin.GetCurrentExecutionRequest{
+		Domain:     common.StringPtr(domain),
+		WorkflowId: common.StringPtr(wid),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get current execution", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// AdminListWorkflowChain lists the runs of a workflow ID chained by continue as new
+func AdminListWorkflowChain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.ListWorkflowExecutionChain(ctx, &admin.ListWorkflowExecutionChainRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Failed to list workflow chain", err)
+	}
+
+	if resp.GetTruncated() {
+		fmt.Println("Earlier runs are not listed, the chain is too long or their histories are deleted.")
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Run ID", "Start Time", "Attempt"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, run := range resp.Runs {
+		table.Append([]string{
+			run.GetRunId(),
+			convertTime(run.GetStartTimestamp(), false),
+			strconv.Itoa(int(run.GetAttempt())),
+		})
+	}
+	table.Render()
+}
+
+// AdminDescribeHistoryHost shows the status of the shards owned by a history host
+func AdminDescribeHistoryHost(c *cli.Context) {
+	request := &admin.DescribeHistoryHostRequest{}
+	switch {
+	case c.IsSet(FlagHistoryAddress):
+		request.HostAddress = common.StringPtr(c.String(FlagHistoryAddress))
+	case c.IsSet(FlagShardID):
+		request.ShardIdForHost = common.Int32Ptr(int32(c.Int(FlagShardID)))
+	case c.IsSet(FlagWorkflowID):
+		request.ExecutionForHost = &shared.WorkflowExecution{WorkflowId: common.StringPtr(c.String(FlagWorkflowID))}
+	default:
+		ErrorAndExit(fmt.Sprintf("Option %s, %s or %s is required", FlagHistoryAddress, FlagShardID, FlagWorkflowID), nil)
+	}
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.DescribeHistoryHost(ctx, request)
+	if err != nil {
+		ErrorAndExit("Failed to describe history host", err)
+	}
+
+	fmt.Printf("Host %v owns %v shards\n", resp.GetAddress(), resp.GetNumberOfShards())
+	header := []string{"Shard", "Transfer Ack Level", "Transfer ID Lag", "Transfer In Flight", "Timer Ack Level",
+		"Timer In Flight", "Replication Ack Level", "Replication ID Lag", "Replication In Flight", "Cache Size"}
+	headerColors := make([]tablewriter.Colors, len(header))
+	for i := range headerColors {
+		headerColors[i] = tableHeaderBlue
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader(header)
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(headerColors...)
+	for _, shard := range resp.Shards {
+		table.Append([]string{
+			strconv.Itoa(int(shard.GetShardId())),
+			strconv.FormatInt(shard.GetTransferAckLevel(), 10),
+			strconv.FormatInt(shard.GetTransferTaskIDLag(), 10),
+			strconv.Itoa(int(shard.GetTransferTasksInFlight())),
+			convertTime(shard.GetTimerAckLevel(), false),
+			strconv.Itoa(int(shard.GetTimerTasksInFlight())),
+			strconv.FormatInt(shard.GetReplicatorAckLevel(), 10),
+			strconv.FormatInt(shard.GetReplicationTaskIDLag(), 10),
+			strconv.Itoa(int(shard.GetReplicationTasksInFlight())),
+			strconv.Itoa(int(shard.GetHistoryCacheSize())),
+		})
+	}
+	table.Render()
+}
+
+// AdminWarmHistoryHost loads the caches of a history host before it takes traffic
+func AdminWarmHistoryHost(c *cli.Context) {
+	host := getRequiredOption(c, FlagHistoryAddress)
+	contextTimeout := defaultContextTimeoutForLongPoll
+	if c.IsSet(FlagContextTimeout) {
+		contextTimeout = time.Duration(c.Int(FlagContextTimeout)) * time.Second
+	}
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContextForLongPoll(contextTimeout)
+	defer cancel()
+	resp, err := adminClient.WarmHistoryHost(ctx, &admin.WarmHistoryHostRequest{
+		HostAddress:               common.StringPtr(host),
+		MaximumExecutionsPerShard: common.Int32Ptr(int32(c.Int(FlagMaxExecutions))),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to warm history host", err)
+	}
+	fmt.Printf("Host %v loaded %v executions into the caches of %v shards\n", resp.GetAddress(),
+		resp.GetExecutionsLoaded(), resp.GetNumberOfShards())
+}
+
+// AdminRefreshDomainCache reloads a domain, or all domains, into the domain cache of a frontend host and of all
+// history hosts
+func AdminRefreshDomainCache(c *cli.Context) {
+	var domain string
+	if !c.Bool(FlagAllDomains) {
+		domain = getRequiredGlobalOption(c, FlagDomain)
+	}
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.RefreshDomainCache(ctx, &admin.RefreshDomainCacheRequest{
+		Domain: common.StringPtr(domain),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to refresh domain cache", err)
+	}
+	if domain == "" {
+		fmt.Println("Domain cache is refreshed for all domains")
+	} else {
+		fmt.Printf("Domain cache is refreshed for domain %v\n", domain)
+	}
+}
+
+// AdminBulkDeleteWorkflowExecutions starts deleting the executions of a domain which closed before a time
+func AdminBulkDeleteWorkflowExecutions(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	closedBeforeStr := getRequiredOption(c, FlagClosedBefore)
+	var closedBefore int64
+	if t, err := time.Parse(time.RFC3339, closedBeforeStr); err == nil {
+		closedBefore = t.UnixNano()
+	} else {
+		closedBefore = parseTime(closedBeforeStr, 0)
+	}
+	reason := getRequiredOption(c, FlagReason)
+	actor := getActor(c)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.BulkDeleteWorkflowExecutions(ctx, &admin.BulkDeleteWorkflowExecutionsRequest{
+		Domain:           common.StringPtr(domain),
+		ClosedBeforeTime: common.Int64Ptr(closedBefore),
+		DeletesPerSecond: common.Int32Ptr(int32(c.Int(FlagDeletesPerSecond))),
+		Reason:           common.StringPtr(reason),
+		Actor:            common.StringPtr(actor),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to start bulk delete", err)
+	}
+	fmt.Printf("Started deleting executions of domain %v closed before %v, workflow ID: %v, run ID: %v\n", domain,
+		convertTime(closedBefore, false), resp.GetWorkflowId(), resp.GetRunId())
+	fmt.Println("Follow the progress with the bulk_delete_status command")
+}
+
+// AdminDescribeBulkDeleteWorkflowExecutions shows the progress of the last bulk delete of a domain
+func AdminDescribeBulkDeleteWorkflowExecutions(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.DescribeBulkDeleteWorkflowExecutions(ctx,
+		&admin.DescribeBulkDeleteWorkflowExecutionsRequest{
+			Domain: common.StringPtr(domain),
+		})
+	if err != nil {
+		ErrorAndExit("Failed to describe bulk delete", err)
+	}
+	status := "closed"
+	if resp.GetRunning() {
+		status = "running"
+	}
+	fmt.Printf("Status: %v\nClosedBefore: %v\nDeletesPerSecond: %v\nScanned: %v\nDeleted: %v\nSkipped: %v\n"+
+		"Failed: %v\n", status, convertTime(resp.GetClosedBeforeTime(), false), resp.GetDeletesPerSecond(),
+		resp.GetScannedCount(), resp.GetDeletedCount(), resp.GetSkippedCount(), resp.GetFailedCount())
+}
+
+// AdminResyncDomains publishes the global domains replicated to a remote cluster as domain replication tasks
+func AdminResyncDomains(c *cli.Context) {
+	cluster := getRequiredOption(c, FlagCluster)
+	var domain string
+	if !c.Bool(FlagAllDomains) {
+		domain = getRequiredGlobalOption(c, FlagDomain)
+	}
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.ResyncDomains(ctx, &admin.ResyncDomainsRequest{
+		ClusterName: common.StringPtr(cluster),
+		Domain:      common.StringPtr(domain),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to resync domains", err)
+	}
+	for _, name := range resp.Domains {
+		fmt.Println(name)
+	}
+	fmt.Printf("Published %v domain(s) for cluster %v\n", len(resp.Domains), cluster)
+}
+
+// AdminUpdateDomainRetention sets the retention period of a domain regardless of the retention bounds of the cluster
+func AdminUpdateDomainRetention(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	if !c.IsSet(FlagRetentionDays) {
+		ErrorAndExit("Option "+FlagRetentionDays+" is required", nil)
+	}
+	reason := getRequiredOption(c, FlagReason)
+	actor := getActor(c)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.UpdateDomainRetention(ctx, &admin.UpdateDomainRetentionRequest{
+		Domain:        common.StringPtr(domain),
+		RetentionDays: common.Int32Ptr(int32(c.Int(FlagRetentionDays))),
+		Reason:        common.StringPtr(reason),
+		Actor:         common.StringPtr(actor),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to update domain retention", err)
+	}
+	fmt.Printf("Retention of domain %v is updated\n", domain)
+}
+
+// AdminUpdateMaintenanceMode enables or disables the maintenance mode of the cluster
+func AdminUpdateMaintenanceMode(c *cli.Context, enabled bool) {
+	reason := getRequiredOption(c, FlagReason)
+	actor := getActor(c)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.UpdateMaintenanceMode(ctx, &admin.UpdateMaintenanceModeRequest{
+		Enabled: common.BoolPtr(enabled),
+		Reason:  common.StringPtr(reason),
+		Actor:   common.StringPtr(actor),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to update maintenance mode", err)
+	}
+	if enabled {
+		fmt.Println("Cluster is in maintenance, other frontend hosts pick the change up within their refresh interval")
+	} else {
+		fmt.Println("Cluster is out of maintenance, other frontend hosts pick the change up within their refresh interval")
+	}
+}
+
+// AdminDescribeMaintenanceMode shows the maintenance mode of the cluster
+func AdminDescribeMaintenanceMode(c *cli.Context) {
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.DescribeMaintenanceMode(ctx)
+	if err != nil {
+		ErrorAndExit("Failed to describe maintenance mode", err)
+	}
+	if resp.GetEnabled() {
+		fmt.Printf("Cluster is in maintenance: %v\n", resp.GetReason())
+	} else {
+		fmt.Println("Cluster is not in maintenance")
+	}
+}
+
+// AdminDescribeShardDistribution shows the history host owning each history shard
+func AdminDescribeShardDistribution(c *cli.Context) {
+	request := &admin.DescribeShardDistributionRequest{}
+	if c.IsSet(FlagHistoryAddress) {
+		request.HostAddress = common.StringPtr(c.String(FlagHistoryAddress))
+	}
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.DescribeShardDistribution(ctx, request)
+	if err != nil {
+		ErrorAndExit("Failed to describe shard distribution", err)
+	}
+
+	shardsPerHost := make(map[string]int)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Shard", "Host", "Pinned"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, shard := range resp.Shards {
+		shardsPerHost[shard.GetHostAddress()]++
+		table.Append([]string{
+			strconv.Itoa(int(shard.GetShardId())),
+			shard.GetHostAddress(),
+			strconv.FormatBool(shard.GetPinned()),
+		})
+	}
+	table.Render()
+
+	hosts := make([]string, 0, len(shardsPerHost))
+	for host := range shardsPerHost {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	for _, host := range hosts {
+		fmt.Printf("Host %v owns %v of %v shards\n", host, shardsPerHost[host], resp.GetNumberOfShards())
+	}
+}
+
+// AdminDrainHistoryHost starts moving the shards of a history host to the other history hosts
+func AdminDrainHistoryHost(c *cli.Context) {
+	host := getRequiredOption(c, FlagHistoryAddress)
+	reason := getRequiredOption(c, FlagReason)
+	actor := getActor(c)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.DrainHistoryHost(ctx, &admin.DrainHistoryHostRequest{
+		HostAddress:     common.StringPtr(host),
+		ShardsPerMinute: common.Int32Ptr(int32(c.Int(FlagShardsPerMinute))),
+		Reason:          common.StringPtr(reason),
+		Actor:           common.StringPtr(actor),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to drain history host", err)
+	}
+	fmt.Printf("Host %v started moving its %v shards to the other history hosts\n", resp.GetAddress(),
+		resp.GetNumberOfShards())
+	fmt.Println("Follow the progress with the shards command, the shards stay pinned until reset_shards")
+}
+
+// AdminResetShardPlacement unpins the history shards moved by drained history hosts
+func AdminResetShardPlacement(c *cli.Context) {
+	reason := getRequiredOption(c, FlagReason)
+	actor := getActor(c)
+
+	adminClient := getAdminClient(c)
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.ResetShardPlacement(ctx, &admin.ResetShardPlacementRequest{
+		Reason: common.StringPtr(reason),
+		Actor:  common.StringPtr(actor),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to reset shard placement", err)
+	}
+	fmt.Println("Shards are unpinned, history hosts pick the change up within their refresh interval")
+}
+
+// AdminCloneDomain copies the retention and metric settings of a domain to another domain, registering the target
+// domain with the description and owner of the source domain if it does not exist yet
+func AdminCloneDomain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	targetDomain := getRequiredOption(c, FlagTargetDomain)
+
+	sourceClient := getDomainClient(c)
+	targetClient := sourceClient
+	if c.IsSet(FlagTargetAddress) {
+		service, err := buildServiceClientForAddress(c.String(FlagTargetAddress))
+		if err != nil {
+			ErrorAndExit("Failed to connect to target cluster", err)
+		}
+		targetClient = client.NewDomainClient(service, &client.Options{})
+	} else if targetDomain == domain {
+		ErrorAndExit("Target domain must differ from domain within the same cluster", nil)
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+	source, err := sourceClient.Describe(ctx, domain)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to describe domain %v", domain), err)
+	}
+	retentionDays := source.Configuration.GetWorkflowExecutionRetentionPeriodInDays()
+	emitMetric := source.Configuration.GetEmitMetric()
+
+	target, err := targetClient.Describe(ctx, targetDomain)
+	if err != nil {
+		if _, ok := err.(*s.EntityNotExistsError); !ok {
+			ErrorAndExit(fmt.Sprintf("Failed to describe domain %v", targetDomain), err)
+		}
+		err = targetClient.Register(ctx, &s.RegisterDomainRequest{
+			Name:                                   common.StringPtr(targetDomain),
+			Description:                            common.StringPtr(source.DomainInfo.GetDescription()),
+			OwnerEmail:                             common.StringPtr(source.DomainInfo.GetOwnerEmail()),
+			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(retentionDays),
+			EmitMetric:                             common.BoolPtr(emitMetric),
+		})
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to register domain %v", targetDomain), err)
+		}
+		fmt.Printf("Domain %v is registered with the settings of domain %v\n", targetDomain, domain)
+		return
+	}
+
+	err = targetClient.Update(ctx, &s.UpdateDomainRequest{
+		Name: common.StringPtr(targetDomain),
+		UpdatedInfo: &s.UpdateDomainInfo{
+			Description: common.StringPtr(target.DomainInfo.GetDescription()),
+			OwnerEmail:  common.StringPtr(target.DomainInfo.GetOwnerEmail()),
+		},
+		Configuration: &s.DomainConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(retentionDays),
+			EmitMetric:                             common.BoolPtr(emitMetric),
+		},
+	})
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to update domain %v", targetDomain), err)
+	}
+	fmt.Printf("Settings of domain %v are copied to domain %v\n", domain, targetDomain)
+}
+
+// AdminListAuditRecords lists the audit records of destructive admin operations
+func AdminListAuditRecords(c *cli.Context) {
+	more := c.Bool(FlagMore)
+	pageSize := c.Int(FlagPageSize)
+
+	adminClient := getAdminClient(c)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Time", "Operation", "Actor", "Reason", "Keys"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext()
+		resp, err := adminClient.ListAuditRecords(ctx, &admin.ListAuditRecordsRequest{
+			PageSize:      common.Int32Ptr(int32(pageSize)),
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list audit records", err)
+		}
+		for _, r := range resp.Records {
+			table.Append([]string{
+				convertTime(r.GetTimestamp(), false),
+				r.GetOperation(),
+				r.GetActor(),
+				r.GetReason(),
+				formatAuditKeys(r.Keys),
+			})
+		}
+		table.Render()
+		table.ClearRows()
+
+		nextPageToken = resp.NextPageToken
+		if !more || len(nextPageToken) == 0 {
+			break
+		}
+		fmt.Printf("Press %s to show next page, press %s to quit: ",
+			color.GreenString("Enter"), color.RedString("any other key then Enter"))
+		var input string
+		fmt.Scanln(&input)
+		if strings.Trim(input, " ") != "" {
+			break
+		}
+	}
+}
+
+func formatAuditKeys(keys map[string]string) string {
+	pairs := make([]string, 0, len(keys))
+	for k, v := range keys {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
+
+func adminTaskListType(c *cli.Context) *shared.TaskListType {
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		return shared.TaskListTypeActivity.Ptr()
+	}
+	return shared.TaskListTypeDecision.Ptr()
+}
+
+func getAdminClient(c *cli.Context) adminserviceclient.Interface {
+	client, err := cBuilder.BuildAdminClient(c)
+	if err != nil {
+		ExitIfError(err)
+	}
+
+	return client
+}
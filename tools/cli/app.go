@@ -46,6 +46,11 @@ func NewCliApp() *cli.App {
 			Usage:  "cadence workflow domain",
 			EnvVar: "CADENCE_CLI_DOMAIN",
 		},
+		cli.StringFlag{
+			Name:   FlagContext,
+			Usage:  "cli context to take address and domain from, overrides currentContext in ~/.cadence/config.yaml",
+			EnvVar: "CADENCE_CLI_CONTEXT",
+		},
 	}
 	app.Commands = []cli.Command{
 		{
@@ -66,6 +71,18 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate cadence tasklist",
 			Subcommands: newTaskListCommands(),
 		},
+		{
+			Name:        "admin",
+			Aliases:     []string{"adm"},
+			Usage:       "Run admin operation",
+			Subcommands: newAdminCommands(),
+		},
+		{
+			Name:        "context",
+			Aliases:     []string{"ctx"},
+			Usage:       "Manage cli contexts",
+			Subcommands: newContextCommands(),
+		},
 	}
 
 	// set builder if not customized
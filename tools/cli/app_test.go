@@ -21,12 +21,19 @@
 package cli
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	serverShared "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
 
@@ -40,16 +47,22 @@ type cliAppSuite struct {
 	app      *cli.App
 	mockCtrl *gomock.Controller
 	service  *workflowservicetest.MockClient
+	admin    *adminservicetest.MockClient
 }
 
 type workflowClientBuilderMock struct {
 	service workflowserviceclient.Interface
+	admin   adminserviceclient.Interface
 }
 
 func (mock *workflowClientBuilderMock) BuildServiceClient(c *cli.Context) (workflowserviceclient.Interface, error) {
 	return mock.service, nil
 }
 
+func (mock *workflowClientBuilderMock) BuildAdminClient(c *cli.Context) (adminserviceclient.Interface, error) {
+	return mock.admin, nil
+}
+
 // this is the mock for yarpcCallOptions, make sure length are the same
 var callOptions = []interface{}{gomock.Any(), gomock.Any(), gomock.Any()}
 
@@ -57,6 +70,8 @@ var commands = []string{
 	"domain", "d",
 	"workflow", "wf",
 	"tasklist", "tl",
+	"admin", "adm",
+	"context", "ctx",
 }
 
 var domainName = "cli-test-domain"
@@ -73,7 +88,8 @@ func (s *cliAppSuite) SetupSuite() {
 func (s *cliAppSuite) SetupTest() {
 	s.mockCtrl = gomock.NewController(s.T())
 	s.service = workflowservicetest.NewMockClient(s.mockCtrl)
-	SetBuilder(&workflowClientBuilderMock{service: s.service})
+	s.admin = adminservicetest.NewMockClient(s.mockCtrl)
+	SetBuilder(&workflowClientBuilderMock{service: s.service, admin: s.admin})
 }
 
 func (s *cliAppSuite) TearDownTest() {
@@ -161,6 +177,34 @@ func (s *cliAppSuite) TestDomainDescribe() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDomainDescribe_FromContext() {
+	dir, err := ioutil.TempDir("", "cadence-cli-config")
+	s.Nil(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.yaml")
+	os.Setenv(cliConfigEnvVar, path)
+	defer os.Unsetenv(cliConfigEnvVar)
+
+	s.Nil(s.app.Run([]string{"", "context", "set", "staging", "--do", "staging-domain"}))
+	s.Nil(s.app.Run([]string{"", "context", "set", "prod", "--do", "prod-domain", "--ad", "prod:7933"}))
+	s.Nil(s.app.Run([]string{"", "context", "use", "prod"}))
+
+	config, err := loadCliConfig()
+	s.Nil(err)
+	s.Equal("prod", config.CurrentContext)
+	s.Equal(&cliContext{Address: "prod:7933", Domain: "prod-domain"}, config.Contexts["prod"])
+
+	resp := describeDomainResponse
+	s.service.EXPECT().DescribeDomain(gomock.Any(), &shared.DescribeDomainRequest{Name: common.StringPtr("prod-domain")}, callOptions...).Return(resp, nil)
+	s.Nil(s.app.Run([]string{"", "domain", "describe"}))
+
+	s.service.EXPECT().DescribeDomain(gomock.Any(), &shared.DescribeDomainRequest{Name: common.StringPtr("staging-domain")}, callOptions...).Return(resp, nil)
+	s.Nil(s.app.Run([]string{"", "--context", "staging", "domain", "describe"}))
+
+	s.service.EXPECT().DescribeDomain(gomock.Any(), &shared.DescribeDomainRequest{Name: common.StringPtr(domainName)}, callOptions...).Return(resp, nil)
+	s.Nil(s.app.Run([]string{"", "--do", domainName, "domain", "describe"}))
+}
+
 func (s *cliAppSuite) TestDomainDescribe_DomainNotExist() {
 	resp := describeDomainResponse
 	s.service.EXPECT().DescribeDomain(gomock.Any(), gomock.Any(), callOptions...).Return(resp, &shared.EntityNotExistsError{})
@@ -403,6 +447,300 @@ func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminPauseTaskList() {
+	s.admin.EXPECT().PauseTaskList(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "tasklist", "pause", "-tl", "test-taskList", "-tlt", "activity"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminResumeTaskList() {
+	s.admin.EXPECT().ResumeTaskList(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "tasklist", "resume", "-tl", "test-taskList"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminGetDomainStats() {
+	resp := &admin.GetDomainStatsResponse{
+		Stats: []*admin.DomainDailyStats{
+			{
+				DayTimestamp:         common.Int64Ptr(time.Now().Truncate(24 * time.Hour).UnixNano()),
+				OpenExecutions:       common.Int64Ptr(10),
+				TotalBytesAppended:   common.Int64Ptr(2048),
+				EventsAppended:       common.Int64Ptr(20),
+				BytesAppended:        common.Int64Ptr(1024),
+				LastUpdatedTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			},
+		},
+	}
+	s.admin.EXPECT().GetDomainStats(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "stats", "--days", "3"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminTerminateWorkflow() {
+	s.admin.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "terminate", "-w", "wid", "-r", "rid", "--reason", "stuck", "--actor", "oncall"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminPauseWorkflow() {
+	s.admin.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "pause", "-w", "wid", "--reason", "incident", "--actor", "oncall"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminResumeWorkflow() {
+	s.admin.EXPECT().ResumeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "resume", "-w", "wid", "-r", "rid", "--reason", "mitigated", "--actor", "oncall"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminNukeWorkflow() {
+	resp := &admin.NukeWorkflowExecutionResponse{
+		MutableStateFound: common.BoolPtr(true),
+		HistoryFound:      common.BoolPtr(true),
+	}
+	s.admin.EXPECT().NukeWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "nuke", "-w", "wid", "-r", "rid", "--dry_run"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminGetWorkflowHistoryEvent() {
+	resp := &admin.GetWorkflowExecutionHistoryEventResponse{
+		Event: &serverShared.HistoryEvent{
+			EventId:   common.Int64Ptr(5),
+			EventType: serverShared.EventTypeActivityTaskFailed.Ptr(),
+		},
+	}
+	s.admin.EXPECT().GetWorkflowExecutionHistoryEvent(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "event", "-w", "wid", "-eid", "5"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminGetCurrentExecution() {
+	resp := &admin.GetCurrentExecutionResponse{
+		RunId:       common.StringPtr(uuid.New()),
+		State:       serverShared.WorkflowExecutionStateCompleted.Ptr(),
+		CloseStatus: serverShared.WorkflowExecutionCloseStatusContinuedAsNew.Ptr(),
+	}
+	s.admin.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "current", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListWorkflowChain() {
+	resp := &admin.ListWorkflowExecutionChainResponse{
+		Runs: []*admin.WorkflowExecutionChainEntry{
+			{RunId: common.StringPtr(uuid.New()), StartTimestamp: common.Int64Ptr(time.Now().UnixNano()), Attempt: common.Int32Ptr(0)},
+			{RunId: common.StringPtr(uuid.New()), StartTimestamp: common.Int64Ptr(time.Now().UnixNano()), Attempt: common.Int32Ptr(1)},
+		},
+		Truncated: common.BoolPtr(true),
+	}
+	s.admin.EXPECT().ListWorkflowExecutionChain(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "chain", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeHistoryHost() {
+	resp := &admin.DescribeHistoryHostResponse{
+		Address:        common.StringPtr("127.0.0.1:7934"),
+		NumberOfShards: common.Int32Ptr(1),
+		Shards: []*admin.HistoryShardStatus{
+			{
+				ShardId:               common.Int32Ptr(3),
+				TransferAckLevel:      common.Int64Ptr(1024),
+				TransferTaskIDLag:     common.Int64Ptr(12),
+				TransferTasksInFlight: common.Int32Ptr(2),
+				TimerAckLevel:         common.Int64Ptr(time.Now().UnixNano()),
+				HistoryCacheSize:      common.Int32Ptr(10),
+			},
+		},
+	}
+	s.admin.EXPECT().DescribeHistoryHost(gomock.Any(), &admin.DescribeHistoryHostRequest{
+		ShardIdForHost: common.Int32Ptr(3),
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "describe_host", "--sid", "3"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminWarmHistoryHost() {
+	resp := &admin.WarmHistoryHostResponse{
+		Address:          common.StringPtr("127.0.0.1:7934"),
+		NumberOfShards:   common.Int32Ptr(4),
+		ExecutionsLoaded: common.Int32Ptr(120),
+	}
+	s.admin.EXPECT().WarmHistoryHost(gomock.Any(), &admin.WarmHistoryHostRequest{
+		HostAddress:               common.StringPtr("127.0.0.1:7934"),
+		MaximumExecutionsPerShard: common.Int32Ptr(50),
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "warm_host", "--ha", "127.0.0.1:7934", "--me", "50"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminUpdateDomainRetention() {
+	s.admin.EXPECT().UpdateDomainRetention(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "update_retention", "--rd", "730", "--reason", "legal hold", "--actor", "oncall"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminUpdateMaintenanceMode() {
+	s.admin.EXPECT().UpdateMaintenanceMode(gomock.Any(), &admin.UpdateMaintenanceModeRequest{
+		Enabled: common.BoolPtr(true),
+		Reason:  common.StringPtr("cassandra upgrade"),
+		Actor:   common.StringPtr("oncall"),
+	}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "cluster", "start_maintenance", "--reason", "cassandra upgrade", "--actor", "oncall"})
+	s.Nil(err)
+
+	s.admin.EXPECT().UpdateMaintenanceMode(gomock.Any(), &admin.UpdateMaintenanceModeRequest{
+		Enabled: common.BoolPtr(false),
+		Reason:  common.StringPtr("upgrade done"),
+		Actor:   common.StringPtr("oncall"),
+	}).Return(nil)
+	err = s.app.Run([]string{"", "admin", "cl", "end_maintenance", "--reason", "upgrade done", "--actor", "oncall"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeMaintenanceMode() {
+	s.admin.EXPECT().DescribeMaintenanceMode(gomock.Any()).Return(&admin.DescribeMaintenanceModeResponse{
+		Enabled: common.BoolPtr(true),
+		Reason:  common.StringPtr("cassandra upgrade"),
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "cluster", "maintenance"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeShardDistribution() {
+	s.admin.EXPECT().DescribeShardDistribution(gomock.Any(), &admin.DescribeShardDistributionRequest{
+		HostAddress: common.StringPtr("127.0.0.1:7934"),
+	}).Return(&admin.DescribeShardDistributionResponse{
+		NumberOfShards: common.Int32Ptr(4),
+		Shards: []*admin.ShardOwner{
+			{ShardId: common.Int32Ptr(1), HostAddress: common.StringPtr("127.0.0.1:7934"), Pinned: common.BoolPtr(false)},
+			{ShardId: common.Int32Ptr(3), HostAddress: common.StringPtr("127.0.0.1:7934"), Pinned: common.BoolPtr(true)},
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "cluster", "shards", "--ha", "127.0.0.1:7934"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDrainHistoryHost() {
+	s.admin.EXPECT().DrainHistoryHost(gomock.Any(), &admin.DrainHistoryHostRequest{
+		HostAddress:     common.StringPtr("127.0.0.1:7934"),
+		ShardsPerMinute: common.Int32Ptr(5),
+		Reason:          common.StringPtr("kernel upgrade"),
+		Actor:           common.StringPtr("oncall"),
+	}).Return(&admin.DrainHistoryHostResponse{
+		Address:        common.StringPtr("127.0.0.1:7934"),
+		NumberOfShards: common.Int32Ptr(2),
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "cluster", "drain_host", "--ha", "127.0.0.1:7934", "--spm", "5",
+		"--reason", "kernel upgrade", "--actor", "oncall"})
+	s.Nil(err)
+
+	s.admin.EXPECT().ResetShardPlacement(gomock.Any(), &admin.ResetShardPlacementRequest{
+		Reason: common.StringPtr("kernel upgrade done"),
+		Actor:  common.StringPtr("oncall"),
+	}).Return(nil)
+	err = s.app.Run([]string{"", "admin", "cluster", "reset_shards", "--reason", "kernel upgrade done", "--actor", "oncall"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminRefreshDomainCache() {
+	s.admin.EXPECT().RefreshDomainCache(gomock.Any(), &admin.RefreshDomainCacheRequest{
+		Domain: common.StringPtr(domainName),
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "refresh_cache"})
+	s.Nil(err)
+
+	s.admin.EXPECT().RefreshDomainCache(gomock.Any(), &admin.RefreshDomainCacheRequest{
+		Domain: common.StringPtr(""),
+	}).Return(nil)
+	err = s.app.Run([]string{"", "admin", "domain", "rc", "--all_domains"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminResyncDomains() {
+	s.admin.EXPECT().ResyncDomains(gomock.Any(), &admin.ResyncDomainsRequest{
+		ClusterName: common.StringPtr("standby"),
+		Domain:      common.StringPtr(domainName),
+	}).Return(&admin.ResyncDomainsResponse{Domains: []string{domainName}}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "resync", "--cluster", "standby"})
+	s.Nil(err)
+
+	s.admin.EXPECT().ResyncDomains(gomock.Any(), &admin.ResyncDomainsRequest{
+		ClusterName: common.StringPtr("standby"),
+		Domain:      common.StringPtr(""),
+	}).Return(&admin.ResyncDomainsResponse{}, nil)
+	err = s.app.Run([]string{"", "admin", "domain", "resync", "--cluster", "standby", "--all_domains"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminBulkDeleteWorkflowExecutions() {
+	closedBefore := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+	s.admin.EXPECT().BulkDeleteWorkflowExecutions(gomock.Any(), &admin.BulkDeleteWorkflowExecutionsRequest{
+		Domain:           common.StringPtr(domainName),
+		ClosedBeforeTime: common.Int64Ptr(closedBefore.UnixNano()),
+		DeletesPerSecond: common.Int32Ptr(50),
+		Reason:           common.StringPtr("retention shortened"),
+		Actor:            common.StringPtr("oncall"),
+	}).Return(&admin.BulkDeleteWorkflowExecutionsResponse{
+		WorkflowId: common.StringPtr("cadence-bulk-delete-domain-id"),
+		RunId:      common.StringPtr(uuid.New()),
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "bulk_delete", "--cb", "2018-06-01T00:00:00Z",
+		"--dps", "50", "--re", "retention shortened", "--actor", "oncall"})
+	s.Nil(err)
+
+	s.admin.EXPECT().DescribeBulkDeleteWorkflowExecutions(gomock.Any(), &admin.DescribeBulkDeleteWorkflowExecutionsRequest{
+		Domain: common.StringPtr(domainName),
+	}).Return(&admin.DescribeBulkDeleteWorkflowExecutionsResponse{
+		Running:          common.BoolPtr(true),
+		ClosedBeforeTime: common.Int64Ptr(closedBefore.UnixNano()),
+		ScannedCount:     common.Int64Ptr(10),
+		DeletedCount:     common.Int64Ptr(9),
+	}, nil)
+	err = s.app.Run([]string{"", "--do", domainName, "admin", "domain", "bulk_delete_status"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminCloneDomain() {
+	s.service.EXPECT().DescribeDomain(gomock.Any(), gomock.Any(), callOptions...).Return(describeDomainResponse, nil)
+	s.service.EXPECT().DescribeDomain(gomock.Any(), gomock.Any(), callOptions...).Return(nil, &shared.EntityNotExistsError{})
+	s.service.EXPECT().RegisterDomain(gomock.Any(), &shared.RegisterDomainRequest{
+		Name:                                   common.StringPtr("target-domain"),
+		Description:                            common.StringPtr("a test domain"),
+		OwnerEmail:                             common.StringPtr("test@uber.com"),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(3),
+		EmitMetric:                             common.BoolPtr(true),
+	}, callOptions...).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "clone", "--td", "target-domain"})
+	s.Nil(err)
+
+	s.service.EXPECT().DescribeDomain(gomock.Any(), gomock.Any(), callOptions...).Return(describeDomainResponse, nil).Times(2)
+	s.service.EXPECT().UpdateDomain(gomock.Any(), gomock.Any(), callOptions...).Return(nil, nil)
+	err = s.app.Run([]string{"", "--do", domainName, "admin", "domain", "clone", "--td", "target-domain"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListAuditRecords() {
+	resp := &admin.ListAuditRecordsResponse{
+		Records: []*admin.AuditRecord{
+			{
+				ID:        common.StringPtr(uuid.New()),
+				Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+				Operation: common.StringPtr("TerminateWorkflowExecution"),
+				Actor:     common.StringPtr("oncall"),
+				Reason:    common.StringPtr("stuck"),
+				Keys:      map[string]string{"workflowID": "wid"},
+			},
+		},
+	}
+	s.admin.EXPECT().ListAuditRecords(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "audit"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
 	history := getWorkflowExecutionHistoryResponse
 	s.service.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any(), callOptions...).Return(history, nil).Times(2)
@@ -45,7 +45,8 @@ import (
 	"go.uber.org/cadence/client"
 )
 
-/**
+/*
+*
 Flags used to specify cli command line arguments
 */
 const (
@@ -109,6 +110,31 @@ const (
 	FlagActiveClusterNameWithAlias = FlagActiveClusterName + ", ac"
 	FlagClusters                   = "clusters"
 	FlagClustersWithAlias          = FlagClusters + ", cl"
+	FlagDays                       = "days"
+	FlagDaysWithAlias              = FlagDays + ", dy"
+	FlagActor                      = "actor"
+	FlagEventID                    = "event_id"
+	FlagEventIDWithAlias           = FlagEventID + ", eid"
+	FlagAllDomains                 = "all_domains"
+	FlagHistoryAddress             = "history_address"
+	FlagHistoryAddressWithAlias    = FlagHistoryAddress + ", ha"
+	FlagShardID                    = "shard_id"
+	FlagShardIDWithAlias           = FlagShardID + ", sid"
+	FlagCluster                    = "cluster"
+	FlagTargetDomain               = "target_domain"
+	FlagTargetDomainWithAlias      = FlagTargetDomain + ", td"
+	FlagTargetAddress              = "target_address"
+	FlagTargetAddressWithAlias     = FlagTargetAddress + ", ta"
+	FlagDryRun                     = "dry_run"
+	FlagMaxExecutions              = "max_executions"
+	FlagMaxExecutionsWithAlias     = FlagMaxExecutions + ", me"
+	FlagContext                    = "context"
+	FlagClosedBefore               = "closed_before"
+	FlagClosedBeforeWithAlias      = FlagClosedBefore + ", cb"
+	FlagDeletesPerSecond           = "deletes_per_second"
+	FlagDeletesPerSecondWithAlias  = FlagDeletesPerSecond + ", dps"
+	FlagShardsPerMinute            = "shards_per_minute"
+	FlagShardsPerMinuteWithAlias   = FlagShardsPerMinute + ", spm"
 )
 
 const (
@@ -240,7 +266,7 @@ func UpdateDomain(c *cli.Context) {
 			ActiveClusterName: common.StringPtr(activeCluster),
 		}
 		updateRequest = &s.UpdateDomainRequest{
-			Name: common.StringPtr(domain),
+			Name:                     common.StringPtr(domain),
 			ReplicationConfiguration: replicationConfig,
 		}
 	} else {
@@ -438,7 +464,7 @@ func StartWorkflow(c *cli.Context) {
 		TaskList: &s.TaskList{
 			Name: common.StringPtr(tasklist),
 		},
-		Input: []byte(input),
+		Input:                               []byte(input),
 		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(et)),
 		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(dt)),
 		Identity:                            common.StringPtr(getCliIdentity()),
@@ -488,7 +514,7 @@ func RunWorkflow(c *cli.Context) {
 		TaskList: &s.TaskList{
 			Name: common.StringPtr(tasklist),
 		},
-		Input: []byte(input),
+		Input:                               []byte(input),
 		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(et)),
 		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(dt)),
 		Identity:                            common.StringPtr(getCliIdentity()),
@@ -1063,7 +1089,7 @@ func getRequiredOption(c *cli.Context, optionName string) string {
 }
 
 func getRequiredGlobalOption(c *cli.Context, optionName string) string {
-	value := c.GlobalString(optionName)
+	value := getGlobalOption(c, optionName)
 	if len(value) == 0 {
 		ExitIfError(fmt.Errorf("%s is required", optionName))
 	}
@@ -0,0 +1,298 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// cliConfigEnvVar overrides the location of the cli config file
+	cliConfigEnvVar = "CADENCE_CLI_CONFIG"
+
+	defaultCliConfigDir  = ".cadence"
+	defaultCliConfigFile = "config.yaml"
+)
+
+type (
+	// cliConfig is the content of the cli config file, by default ~/.cadence/config.yaml
+	cliConfig struct {
+		CurrentContext string                 `yaml:"currentContext"`
+		Contexts       map[string]*cliContext `yaml:"contexts"`
+	}
+
+	// cliContext is a named set of default values for global flags
+	cliContext struct {
+		Address string `yaml:"address,omitempty"`
+		Domain  string `yaml:"domain,omitempty"`
+	}
+)
+
+func newContextCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List contexts, the current context is marked with *",
+			Action: func(c *cli.Context) {
+				ListContexts(c)
+			},
+		},
+		{
+			Name:      "use",
+			Aliases:   []string{"u"},
+			Usage:     "Switch the current context",
+			ArgsUsage: "context_name",
+			Action: func(c *cli.Context) {
+				UseContext(c)
+			},
+		},
+		{
+			Name:      "set",
+			Aliases:   []string{"s"},
+			Usage:     "Create or update a context",
+			ArgsUsage: "context_name",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagAddressWithAlias,
+					Usage: "host:port for cadence frontend service",
+				},
+				cli.StringFlag{
+					Name:  FlagDomainWithAlias,
+					Usage: "cadence workflow domain",
+				},
+			},
+			Action: func(c *cli.Context) {
+				SetContext(c)
+			},
+		},
+		{
+			Name:      "delete",
+			Aliases:   []string{"del"},
+			Usage:     "Delete a context",
+			ArgsUsage: "context_name",
+			Action: func(c *cli.Context) {
+				DeleteContext(c)
+			},
+		},
+	}
+}
+
+// ListContexts prints all contexts in the cli config file
+func ListContexts(c *cli.Context) {
+	config := loadCliConfigOrExit()
+
+	names := make([]string, 0, len(config.Contexts))
+	for name := range config.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Current", "Name", "Address", "Domain"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, name := range names {
+		current := ""
+		if name == config.CurrentContext {
+			current = "*"
+		}
+		ctx := config.Contexts[name]
+		table.Append([]string{current, name, ctx.Address, ctx.Domain})
+	}
+	table.Render()
+}
+
+// UseContext switches the current context
+func UseContext(c *cli.Context) {
+	name := getContextNameArg(c)
+	config := loadCliConfigOrExit()
+	if _, ok := config.Contexts[name]; !ok {
+		ErrorAndExit(fmt.Sprintf("Context %s does not exist", name), nil)
+	}
+
+	config.CurrentContext = name
+	if err := saveCliConfig(config); err != nil {
+		ErrorAndExit("Failed to save cli config", err)
+	}
+	fmt.Printf("Switched to context %s\n", name)
+}
+
+// SetContext creates a context or updates the given fields of an existing one
+func SetContext(c *cli.Context) {
+	name := getContextNameArg(c)
+	config := loadCliConfigOrExit()
+
+	ctx, ok := config.Contexts[name]
+	if !ok {
+		ctx = &cliContext{}
+		config.Contexts[name] = ctx
+	}
+	if c.IsSet(FlagAddress) {
+		ctx.Address = c.String(FlagAddress)
+	}
+	if c.IsSet(FlagDomain) {
+		ctx.Domain = c.String(FlagDomain)
+	}
+	if config.CurrentContext == "" {
+		config.CurrentContext = name
+	}
+
+	if err := saveCliConfig(config); err != nil {
+		ErrorAndExit("Failed to save cli config", err)
+	}
+	fmt.Printf("Context %s saved\n", name)
+}
+
+// DeleteContext removes a context from the cli config file
+func DeleteContext(c *cli.Context) {
+	name := getContextNameArg(c)
+	config := loadCliConfigOrExit()
+	if _, ok := config.Contexts[name]; !ok {
+		ErrorAndExit(fmt.Sprintf("Context %s does not exist", name), nil)
+	}
+
+	delete(config.Contexts, name)
+	if config.CurrentContext == name {
+		config.CurrentContext = ""
+	}
+	if err := saveCliConfig(config); err != nil {
+		ErrorAndExit("Failed to save cli config", err)
+	}
+	fmt.Printf("Context %s deleted\n", name)
+}
+
+func getContextNameArg(c *cli.Context) string {
+	if !c.Args().Present() {
+		ErrorAndExit("Context name is required", nil)
+	}
+	return c.Args().First()
+}
+
+// getGlobalOption returns the value of a global flag, falling back to the
+// value from the selected context when the flag is not given
+func getGlobalOption(c *cli.Context, optionName string) string {
+	if value := c.GlobalString(optionName); value != "" {
+		return value
+	}
+
+	ctx, err := getSelectedContext(c)
+	if err != nil {
+		ErrorAndExit("Failed to load cli context", err)
+	}
+	if ctx == nil {
+		return ""
+	}
+	switch optionName {
+	case FlagAddress:
+		return ctx.Address
+	case FlagDomain:
+		return ctx.Domain
+	}
+	return ""
+}
+
+// getSelectedContext returns the context named by --context, or the current
+// context from the cli config file, nil if neither is set
+func getSelectedContext(c *cli.Context) (*cliContext, error) {
+	config, err := loadCliConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	name := c.GlobalString(FlagContext)
+	if name == "" {
+		name = config.CurrentContext
+		if name == "" {
+			return nil, nil
+		}
+	}
+	ctx, ok := config.Contexts[name]
+	if !ok {
+		return nil, fmt.Errorf("context %s does not exist", name)
+	}
+	return ctx, nil
+}
+
+func getCliConfigPath() (string, error) {
+	if path := os.Getenv(cliConfigEnvVar); path != "" {
+		return path, nil
+	}
+	u, err := user.Current()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(u.HomeDir, defaultCliConfigDir, defaultCliConfigFile), nil
+}
+
+// loadCliConfig reads the cli config file, a missing file is treated as empty
+func loadCliConfig() (*cliConfig, error) {
+	config := &cliConfig{}
+	path, err := getCliConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	if config.Contexts == nil {
+		config.Contexts = make(map[string]*cliContext)
+	}
+	return config, nil
+}
+
+func loadCliConfigOrExit() *cliConfig {
+	config, err := loadCliConfig()
+	if err != nil {
+		ErrorAndExit("Failed to load cli config", err)
+	}
+	return config
+}
+
+func saveCliConfig(config *cliConfig) error {
+	path, err := getCliConfigPath()
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
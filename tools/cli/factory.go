@@ -23,6 +23,7 @@ package cli
 import (
 	"errors"
 
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	"github.com/urfave/cli"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc"
@@ -41,6 +42,7 @@ const (
 // The customized builder may have more processing on Env, Address and other info.
 type WorkflowClientBuilderInterface interface {
 	BuildServiceClient(c *cli.Context) (workflowserviceclient.Interface, error)
+	BuildAdminClient(c *cli.Context) (adminserviceclient.Interface, error)
 }
 
 // WorkflowClientBuilder build client to cadence service
@@ -65,7 +67,7 @@ func NewBuilder() *WorkflowClientBuilder {
 // BuildServiceClient builds a rpc service client to cadence service
 func (b *WorkflowClientBuilder) BuildServiceClient(c *cli.Context) (workflowserviceclient.Interface, error) {
 	b.hostPort = localHostPort
-	if addr := c.GlobalString(FlagAddress); addr != "" {
+	if addr := getGlobalOption(c, FlagAddress); addr != "" {
 		b.hostPort = addr
 	}
 
@@ -80,6 +82,36 @@ func (b *WorkflowClientBuilder) BuildServiceClient(c *cli.Context) (workflowserv
 	return workflowserviceclient.New(b.dispatcher.ClientConfig(_cadenceFrontendService)), nil
 }
 
+// BuildAdminClient builds a rpc client to the admin service hosted by cadence frontend
+func (b *WorkflowClientBuilder) BuildAdminClient(c *cli.Context) (adminserviceclient.Interface, error) {
+	b.hostPort = localHostPort
+	if addr := getGlobalOption(c, FlagAddress); addr != "" {
+		b.hostPort = addr
+	}
+
+	if err := b.build(); err != nil {
+		return nil, err
+	}
+
+	if b.dispatcher == nil {
+		b.logger.Fatal("No RPC dispatcher provided to create a connection to Cadence Service")
+	}
+
+	return adminserviceclient.New(b.dispatcher.ClientConfig(_cadenceFrontendService)), nil
+}
+
+// buildServiceClientForAddress builds a rpc service client to the cadence frontend at hostPort, which is used to
+// reach a cluster other than the one given by the global address option
+func buildServiceClientForAddress(hostPort string) (workflowserviceclient.Interface, error) {
+	b := NewBuilder()
+	b.hostPort = hostPort
+	if err := b.build(); err != nil {
+		return nil, err
+	}
+
+	return workflowserviceclient.New(b.dispatcher.ClientConfig(_cadenceFrontendService)), nil
+}
+
 func (b *WorkflowClientBuilder) build() error {
 	if b.dispatcher != nil {
 		return nil